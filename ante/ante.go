@@ -14,8 +14,9 @@ import (
 type HandlerOptions struct {
 	ante.HandlerOptions
 
-	IBCkeeper            *ibckeeper.Keeper
-	BypassMinFeeMsgTypes []string
+	IBCkeeper                       *ibckeeper.Keeper
+	BypassMinFeeMsgTypes            []string
+	MaxTotalBypassMinFeeMsgGasUsage uint64
 }
 
 func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
@@ -34,6 +35,12 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
 
+	// the minimum gas prices are enforced by the MinFeeDecorator, so that the bypass messages can skip them
+	var txFeeChecker = opts.TxFeeChecker
+	if txFeeChecker == nil {
+		txFeeChecker = TxFeePriority
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(),
 		ante.NewExtensionOptionsDecorator(opts.ExtensionOptionChecker),
@@ -41,7 +48,8 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
-		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker),
+		NewMinFeeDecorator(opts.BypassMinFeeMsgTypes, opts.MaxTotalBypassMinFeeMsgGasUsage),
+		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, txFeeChecker),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper),
 		ante.NewValidateSigCountDecorator(opts.AccountKeeper),
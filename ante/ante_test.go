@@ -11,11 +11,13 @@ import (
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	modtestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/persistenceOne/pstake-native/v2/ante"
 	pstakeapp "github.com/persistenceOne/pstake-native/v2/app"
 	pstakehelpers "github.com/persistenceOne/pstake-native/v2/app/helpers"
 )
@@ -97,3 +99,134 @@ func (s *IntegrationTestSuite) CreateTestTx(privs []cryptotypes.PrivKey, accNums
 
 	return s.txBuilder.GetTx(), nil
 }
+
+func (s *IntegrationTestSuite) TestMinFeeDecorator() {
+	priv, _, addr := testdata.KeyTestPubAddr()
+	bypassMsg := testdata.NewTestMsg(addr)
+	otherMsg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+
+	mfd := ante.NewMinFeeDecorator([]string{sdk.MsgTypeURL(bypassMsg)}, 200000)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// the required fee of a 100000 gas tx is 1000uxprt
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uxprt", sdk.NewDecWithPrec(1, 2)))
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		gas      uint64
+		fee      sdk.Coins
+		checkTx  bool
+		simulate bool
+		err      error
+	}{
+		{
+			name:    "insufficient fee",
+			msgs:    []sdk.Msg{otherMsg},
+			gas:     100000,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uxprt", 999)),
+			checkTx: true,
+			err:     sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "sufficient fee",
+			msgs:    []sdk.Msg{otherMsg},
+			gas:     100000,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uxprt", 1000)),
+			checkTx: true,
+		},
+		{
+			name:    "fee in another denom",
+			msgs:    []sdk.Msg{otherMsg},
+			gas:     100000,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+			checkTx: true,
+			err:     sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "bypass msg without fee",
+			msgs:    []sdk.Msg{bypassMsg},
+			gas:     100000,
+			checkTx: true,
+		},
+		{
+			name:    "bypass msgs up to the max gas without fee",
+			msgs:    []sdk.Msg{bypassMsg, bypassMsg},
+			gas:     200000,
+			checkTx: true,
+		},
+		{
+			name:    "bypass msg over the max gas without fee",
+			msgs:    []sdk.Msg{bypassMsg},
+			gas:     200001,
+			checkTx: true,
+			err:     sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:    "bypass msg over the max gas with fee",
+			msgs:    []sdk.Msg{bypassMsg},
+			gas:     300000,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("uxprt", 3000)),
+			checkTx: true,
+		},
+		{
+			name:    "bypass and non bypass msgs without fee",
+			msgs:    []sdk.Msg{bypassMsg, otherMsg},
+			gas:     100000,
+			checkTx: true,
+			err:     sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "deliver tx without fee",
+			msgs: []sdk.Msg{otherMsg},
+			gas:  100000,
+		},
+		{
+			name:     "simulation without fee",
+			msgs:     []sdk.Msg{otherMsg},
+			gas:      100000,
+			checkTx:  true,
+			simulate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(s.txBuilder.SetMsgs(tc.msgs...))
+			s.txBuilder.SetGasLimit(tc.gas)
+			s.txBuilder.SetFeeAmount(tc.fee)
+
+			tx, err := s.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}, s.ctx.ChainID())
+			s.Require().NoError(err)
+
+			ctx := s.ctx.WithIsCheckTx(tc.checkTx).WithMinGasPrices(minGasPrices)
+			_, err = antehandler(ctx, tx, tc.simulate)
+			if tc.err != nil {
+				s.Require().ErrorIs(err, tc.err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestTxFeePriority() {
+	priv, _, addr := testdata.KeyTestPubAddr()
+
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(s.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	s.txBuilder.SetGasLimit(100000)
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 300000), sdk.NewInt64Coin("uxprt", 2000000)))
+
+	tx, err := s.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{0}, []uint64{0}, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	// the min gas prices aren't checked, and the priority is the smallest gas price
+	fee, priority, err := ante.TxFeePriority(s.ctx.WithIsCheckTx(true).WithMinGasPrices(
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec("uxprt", sdk.OneDec())),
+	), tx)
+	s.Require().NoError(err)
+	s.Require().Equal(tx.GetFee(), fee)
+	s.Require().Equal(int64(3), priority)
+}
@@ -0,0 +1,117 @@
+package ante
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultMaxTotalBypassMinFeeMsgGasUsage is the default gas limit up to which a tx made only of bypass messages
+// doesn't need to pay the minimum fee.
+const DefaultMaxTotalBypassMinFeeMsgGasUsage uint64 = 1_000_000
+
+// MinFeeDecorator enforces the node minimum gas prices during CheckTx. Txs made only of the bypass message types
+// are exempt, as long as they don't request more gas than the max total bypass gas usage.
+type MinFeeDecorator struct {
+	BypassMinFeeMsgTypes            []string
+	MaxTotalBypassMinFeeMsgGasUsage uint64
+}
+
+func NewMinFeeDecorator(bypassMsgTypes []string, maxTotalBypassMinFeeMsgGasUsage uint64) MinFeeDecorator {
+	return MinFeeDecorator{
+		BypassMinFeeMsgTypes:            bypassMsgTypes,
+		MaxTotalBypassMinFeeMsgGasUsage: maxTotalBypassMinFeeMsgGasUsage,
+	}
+}
+
+func (mfd MinFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the minimum fee is only for local mempool purposes, and thus only checked on CheckTx
+	if !ctx.IsCheckTx() || simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	gas := feeTx.GetGas()
+	if mfd.IsBypassTx(feeTx.GetMsgs()) && gas <= mfd.MaxTotalBypassMinFeeMsgGasUsage {
+		return next(ctx, tx, simulate)
+	}
+
+	minGasPrices := ctx.MinGasPrices()
+	if !minGasPrices.IsZero() {
+		// the required fees are fee = ceil(minGasPrice * gasLimit) for each of the minimum gas prices
+		requiredFees := make(sdk.Coins, len(minGasPrices))
+		glDec := sdk.NewDec(int64(gas))
+		for i, gp := range minGasPrices {
+			fee := gp.Amount.Mul(glDec)
+			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+		}
+
+		feeCoins := feeTx.GetFee()
+		if !feeCoins.IsAnyGTE(requiredFees) {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFee,
+				"insufficient fees; got: %s required: %s",
+				feeCoins,
+				requiredFees,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// IsBypassTx returns true if all the messages are of a bypass message type
+func (mfd MinFeeDecorator) IsBypassTx(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		bypass := false
+		for _, msgType := range mfd.BypassMinFeeMsgTypes {
+			if sdk.MsgTypeURL(msg) == msgType {
+				bypass = true
+				break
+			}
+		}
+
+		if !bypass {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TxFeePriority is the TxFeeChecker of the fee deduction. It only computes the tx priority from its gas price, the
+// minimum gas prices are enforced by the MinFeeDecorator.
+func TxFeePriority(_ sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := int64(feeTx.GetGas())
+
+	// the priority is the gas price of the fee denom with the smallest gas price
+	var priority int64
+	for _, c := range feeCoins {
+		p := int64(math.MaxInt64)
+		gasPrice := c.Amount.QuoRaw(gas)
+		if gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+
+	return feeCoins, priority, nil
+}
@@ -189,32 +189,34 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:                    nil,
-		distrtypes.ModuleName:                         nil,
-		icatypes.ModuleName:                           nil,
-		minttypes.ModuleName:                          {authtypes.Minter},
-		stakingtypes.BondedPoolName:                   {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:                {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:                           {authtypes.Burner},
-		ibctransfertypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
-		ibcfeetypes.ModuleName:                        nil,
-		lscosmostypes.ModuleName:                      {authtypes.Minter, authtypes.Burner},
-		lscosmostypes.DepositModuleAccount:            nil,
-		lscosmostypes.DelegationModuleAccount:         nil,
-		lscosmostypes.RewardModuleAccount:             nil,
-		lscosmostypes.UndelegationModuleAccount:       nil,
-		lscosmostypes.RewardBoosterModuleAccount:      nil, //legacy, blocklist, no permissions
-		liquidstakeibctypes.ModuleName:                {authtypes.Minter, authtypes.Burner},
-		liquidstakeibctypes.DepositModuleAccount:      nil,
-		liquidstakeibctypes.UndelegationModuleAccount: {authtypes.Burner},
-		lspersistencetypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		authtypes.FeeCollectorName:                     nil,
+		distrtypes.ModuleName:                          nil,
+		icatypes.ModuleName:                            nil,
+		minttypes.ModuleName:                           {authtypes.Minter},
+		stakingtypes.BondedPoolName:                    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:                 {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                            {authtypes.Burner},
+		ibctransfertypes.ModuleName:                    {authtypes.Minter, authtypes.Burner},
+		ibcfeetypes.ModuleName:                         nil,
+		lscosmostypes.ModuleName:                       {authtypes.Minter, authtypes.Burner},
+		lscosmostypes.DepositModuleAccount:             nil,
+		lscosmostypes.DelegationModuleAccount:          nil,
+		lscosmostypes.RewardModuleAccount:              nil,
+		lscosmostypes.UndelegationModuleAccount:        nil,
+		lscosmostypes.RewardBoosterModuleAccount:       nil, //legacy, blocklist, no permissions
+		liquidstakeibctypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		liquidstakeibctypes.DepositModuleAccount:       nil,
+		liquidstakeibctypes.UndelegationModuleAccount:  {authtypes.Burner},
+		liquidstakeibctypes.LiquidityPoolModuleAccount: nil,
+		lspersistencetypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
 	}
 
 	receiveAllowedMAcc = map[string]bool{
-		lscosmostypes.UndelegationModuleAccount:       true,
-		lscosmostypes.DelegationModuleAccount:         true,
-		liquidstakeibctypes.DepositModuleAccount:      true,
-		liquidstakeibctypes.UndelegationModuleAccount: true,
+		lscosmostypes.UndelegationModuleAccount:        true,
+		lscosmostypes.DelegationModuleAccount:          true,
+		liquidstakeibctypes.DepositModuleAccount:       true,
+		liquidstakeibctypes.UndelegationModuleAccount:  true,
+		liquidstakeibctypes.LiquidityPoolModuleAccount: true,
 	}
 )
 
@@ -505,6 +507,7 @@ func NewpStakeApp(
 		app.EpochsKeeper,
 		app.ICAControllerKeeper,
 		app.IBCKeeper, // TODO: Move to module interface
+		app.TransferKeeper,
 		&app.InterchainQueryKeeper,
 		app.GetSubspace(liquidstakeibctypes.ModuleName),
 		app.MsgServiceRouter(),
@@ -579,9 +582,11 @@ func NewpStakeApp(
 		Example of setting gov params:
 		govConfig.MaxMetadataLen = 10000
 	*/
+	// the gov tally credits the liquid stakers with the voting power of the delegations backing their bTokens
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.AccountKeeper, app.BankKeeper,
-		app.StakingKeeper, app.MsgServiceRouter(), govConfig, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		lspersistencekeeper.NewGovStakingKeeper(app.StakingKeeper, app.LSPersistenceKeeper),
+		app.MsgServiceRouter(), govConfig, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// Set legacy router for backwards compatibility with gov v1beta1
@@ -643,7 +648,7 @@ func NewpStakeApp(
 		//ibchooker.NewAppModule(),
 		lscosmos.NewAppModule(appCodec, liquidStakeIBCModule, app.LSCosmosKeeper, app.AccountKeeper, app.BankKeeper),
 		interchainQueryModule,
-		liquidstakeibc.NewAppModule(app.LiquidStakeIBCKeeper),
+		liquidstakeibc.NewAppModule(appCodec, app.LiquidStakeIBCKeeper, app.AccountKeeper, app.BankKeeper),
 		lspersistence.NewAppModule(appCodec, app.LSPersistenceKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 	)
@@ -784,6 +789,7 @@ func NewpStakeApp(
 		//icaModule,
 		lscosmos.NewAppModule(appCodec, liquidStakeIBCModule, app.LSCosmosKeeper, app.AccountKeeper, app.BankKeeper),
 		lspersistence.NewAppModule(appCodec, app.LSPersistenceKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
+		liquidstakeibc.NewAppModule(appCodec, app.LiquidStakeIBCKeeper, app.AccountKeeper, app.BankKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -793,6 +799,12 @@ func NewpStakeApp(
 	app.MountTransientStores(tkeys)
 	app.MountMemoryStores(memKeys)
 
+	// nodes whose app.toml predates the bypass gas limit use the default one
+	maxTotalBypassMinFeeMsgGasUsage := pstakeante.DefaultMaxTotalBypassMinFeeMsgGasUsage
+	if gasUsage := appOpts.Get(pstakeappparams.MaxTotalBypassMinFeeMsgGasUsageKey); gasUsage != nil {
+		maxTotalBypassMinFeeMsgGasUsage = cast.ToUint64(gasUsage)
+	}
+
 	anteHandler, err := pstakeante.NewAnteHandler(
 		pstakeante.HandlerOptions{
 			HandlerOptions: ante.HandlerOptions{
@@ -802,8 +814,9 @@ func NewpStakeApp(
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			IBCkeeper:            app.IBCKeeper,
-			BypassMinFeeMsgTypes: cast.ToStringSlice(appOpts.Get(pstakeappparams.BypassMinFeeMsgTypesKey)),
+			IBCkeeper:                       app.IBCKeeper,
+			BypassMinFeeMsgTypes:            cast.ToStringSlice(appOpts.Get(pstakeappparams.BypassMinFeeMsgTypesKey)),
+			MaxTotalBypassMinFeeMsgGasUsage: maxTotalBypassMinFeeMsgGasUsage,
 		},
 	)
 	if err != nil {
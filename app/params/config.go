@@ -10,6 +10,11 @@ var (
 	//nolint:gosec,nocredentials
 	BypassMinFeeMsgTypesKey = "bypass-min-fee-msg-types"
 
+	// MaxTotalBypassMinFeeMsgGasUsageKey defines the configuration key for the
+	// MaxTotalBypassMinFeeMsgGasUsage value.
+	//nolint:gosec,nocredentials
+	MaxTotalBypassMinFeeMsgGasUsageKey = "max-total-bypass-min-fee-msg-gas-usage"
+
 	// CustomConfigTemplate defines pStake's custom application configuration TOML
 	// template. It extends the core SDK template.
 	CustomConfigTemplate = serverconfig.DefaultConfigTemplate + `
@@ -22,6 +27,10 @@ var (
 # Example:
 # ["/ibc.core.channel.v1.MsgRecvPacket", "/ibc.core.channel.v1.MsgAcknowledgement", ...]
 bypass-min-fee-msg-types = [{{ range .BypassMinFeeMsgTypes }}{{ printf "%q, " . }}{{end}}]
+
+# max-total-bypass-min-fee-msg-gas-usage defines the max gas a tx made only of
+# bypass-min-fee-msg-types can request and still bypass the minimum fee checks.
+max-total-bypass-min-fee-msg-gas-usage = "{{ .MaxTotalBypassMinFeeMsgGasUsage }}"
 `
 )
 
@@ -32,4 +41,8 @@ type CustomAppConfig struct {
 	// BypassMinFeeMsgTypes defines custom message types the operator may set that
 	// will bypass minimum fee checks during CheckTx.
 	BypassMinFeeMsgTypes []string `mapstructure:"bypass-min-fee-msg-types"`
+
+	// MaxTotalBypassMinFeeMsgGasUsage defines the max gas a tx made only of
+	// BypassMinFeeMsgTypes can request and still bypass the minimum fee checks.
+	MaxTotalBypassMinFeeMsgGasUsage uint64 `mapstructure:"max-total-bypass-min-fee-msg-gas-usage"`
 }
@@ -22,6 +22,10 @@ const (
 	DefaultWeightMsgLiquidStake   int = 80
 	DefaultWeightMsgLiquidUnstake int = 30
 
+	DefaultWeightMsgRedeem          int = 20
+	DefaultWeightMsgUpdateHostChain int = 5
+	DefaultWeightHostChainProgress  int = 50
+
 	DefaultWeightAddWhitelistValidatorsProposal    int = 50
 	DefaultWeightUpdateWhitelistValidatorsProposal int = 5
 	DefaultWeightDeleteWhitelistValidatorsProposal int = 5
@@ -44,7 +44,7 @@ func BenchmarkFullAppSimulation(b *testing.B) {
 		}
 	}()
 
-	app := pstake.NewpStakeApp(logger, db, nil, true, map[int64]bool{}, pstake.DefaultNodeHome, simcli.FlagPeriodValue, pstake.MakeEncodingConfig(), simtestutil.EmptyAppOptions{}, interBlockCacheOpt())
+	app := pstake.NewpStakeApp(logger, db, nil, true, map[int64]bool{}, pstake.DefaultNodeHome, simcli.FlagPeriodValue, pstake.MakeEncodingConfig(), simtestutil.EmptyAppOptions{}, interBlockCacheOpt(), baseapp.SetChainID(config.ChainID))
 
 	// Run randomized simulation:w
 	_, simParams, simErr := simulation.SimulateFromSeed(
@@ -109,7 +109,7 @@ func TestAppStateDeterminism(t *testing.T) {
 			}
 
 			db := dbm.NewMemDB()
-			app := pstake.NewpStakeApp(logger, db, nil, true, map[int64]bool{}, pstake.DefaultNodeHome, simcli.FlagPeriodValue, pstake.MakeEncodingConfig(), simtestutil.EmptyAppOptions{}, interBlockCacheOpt())
+			app := pstake.NewpStakeApp(logger, db, nil, true, map[int64]bool{}, pstake.DefaultNodeHome, simcli.FlagPeriodValue, pstake.MakeEncodingConfig(), simtestutil.EmptyAppOptions{}, interBlockCacheOpt(), baseapp.SetChainID(config.ChainID))
 
 			fmt.Printf(
 				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
@@ -31,6 +31,7 @@ import (
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
+	pstakeante "github.com/persistenceOne/pstake-native/v2/ante"
 	pstakeApp "github.com/persistenceOne/pstake-native/v2/app"
 	"github.com/persistenceOne/pstake-native/v2/app/params"
 )
@@ -102,6 +103,7 @@ func initAppConfig() (string, interface{}) {
 			sdk.MsgTypeURL(&ibcchanneltypes.MsgAcknowledgement{}),
 			sdk.MsgTypeURL(&ibcclienttypes.MsgUpdateClient{}),
 		},
+		MaxTotalBypassMinFeeMsgGasUsage: pstakeante.DefaultMaxTotalBypassMinFeeMsgGasUsage,
 	}
 }
 
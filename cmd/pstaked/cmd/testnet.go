@@ -37,6 +37,7 @@ import (
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	ibcchanneltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
+	pstakeante "github.com/persistenceOne/pstake-native/v2/ante"
 	"github.com/persistenceOne/pstake-native/v2/app/params"
 )
 
@@ -149,6 +150,7 @@ func InitTestnet(
 		sdk.MsgTypeURL(&ibcchanneltypes.MsgAcknowledgement{}),
 		sdk.MsgTypeURL(&ibcclienttypes.MsgUpdateClient{}),
 	}
+	simappConfig.MaxTotalBypassMinFeeMsgGasUsage = pstakeante.DefaultMaxTotalBypassMinFeeMsgGasUsage
 
 	var (
 		genAccounts []authtypes.GenesisAccount
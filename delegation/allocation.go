@@ -0,0 +1,209 @@
+package delegation
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// eligibleValidators returns the indexes of the validators the amount can be allocated to.
+func eligibleValidators(validators []Validator, amount math.Int, undelegating bool) ([]int, error) {
+	if amount.IsNil() || !amount.IsPositive() {
+		return nil, ErrInvalidAmount
+	}
+
+	eligible := make([]int, 0, len(validators))
+	delegated := sdk.ZeroInt()
+	for i, validator := range validators {
+		if undelegating && validator.Delegated.IsPositive() {
+			eligible = append(eligible, i)
+			delegated = delegated.Add(validator.Delegated)
+		}
+		if !undelegating && !validator.Weight.IsNil() && validator.Weight.IsPositive() {
+			eligible = append(eligible, i)
+		}
+	}
+
+	if len(eligible) == 0 {
+		return nil, ErrNoEligibleValidators
+	}
+	if undelegating && delegated.LT(amount) {
+		return nil, fmt.Errorf("%w: delegated %s, undelegating %s", ErrInsufficientDelegations, delegated, amount)
+	}
+
+	return eligible, nil
+}
+
+// normalizedWeights returns the weights of the validators scaled to add up to one.
+func normalizedWeights(validators []Validator) []sdk.Dec {
+	totalWeight := sdk.ZeroDec()
+	for _, validator := range validators {
+		if !validator.Weight.IsNil() && validator.Weight.IsPositive() {
+			totalWeight = totalWeight.Add(validator.Weight)
+		}
+	}
+
+	weights := make([]sdk.Dec, len(validators))
+	for i, validator := range validators {
+		weights[i] = sdk.ZeroDec()
+		if !validator.Weight.IsNil() && validator.Weight.IsPositive() {
+			weights[i] = validator.Weight.Quo(totalWeight)
+		}
+	}
+	return weights
+}
+
+// waterFill returns the amounts raising the delegation to weight ratio of the validators with the lowest ratio to
+// a common level, or lowering the ratio of the validators with the highest one when undelegating. Validators with
+// a zero weight are undelegated from first.
+func waterFill(validators []Validator, weights []sdk.Dec, eligible []int, amount math.Int, undelegating bool) []math.Int {
+	desired := zeroInts(len(validators))
+	remaining := amount
+
+	weighted := make([]int, 0, len(eligible))
+	for _, i := range eligible {
+		if weights[i].IsPositive() {
+			weighted = append(weighted, i)
+			continue
+		}
+		// only undelegating validators can have a zero weight
+		desired[i] = sdk.MinInt(validators[i].Delegated, remaining)
+		remaining = remaining.Sub(desired[i])
+	}
+	if !remaining.IsPositive() || len(weighted) == 0 {
+		return desired
+	}
+
+	ratios := make(map[int]sdk.Dec, len(weighted))
+	for _, i := range weighted {
+		ratios[i] = sdk.NewDecFromInt(validators[i].Delegated).Quo(weights[i])
+	}
+	sort.SliceStable(weighted, func(a, b int) bool {
+		if undelegating {
+			return ratios[weighted[a]].GT(ratios[weighted[b]])
+		}
+		return ratios[weighted[a]].LT(ratios[weighted[b]])
+	})
+
+	// find the level of the first validators reached before the next one
+	level := sdk.ZeroDec()
+	sumDelegated, sumWeights := sdk.ZeroDec(), sdk.ZeroDec()
+	count := 0
+	for count < len(weighted) {
+		i := weighted[count]
+		sumDelegated = sumDelegated.Add(sdk.NewDecFromInt(validators[i].Delegated))
+		sumWeights = sumWeights.Add(weights[i])
+		count++
+
+		if undelegating {
+			level = sumDelegated.Sub(sdk.NewDecFromInt(remaining)).Quo(sumWeights)
+			if count == len(weighted) || level.GTE(ratios[weighted[count]]) {
+				break
+			}
+		} else {
+			level = sumDelegated.Add(sdk.NewDecFromInt(remaining)).Quo(sumWeights)
+			if count == len(weighted) || level.LTE(ratios[weighted[count]]) {
+				break
+			}
+		}
+	}
+	level = sdk.MaxDec(level, sdk.ZeroDec())
+
+	for _, i := range weighted[:count] {
+		diff := level.Mul(weights[i]).Sub(sdk.NewDecFromInt(validators[i].Delegated))
+		if undelegating {
+			diff = diff.Neg()
+		}
+		desired[i] = sdk.MinInt(sdk.MaxInt(diff.TruncateInt(), sdk.ZeroInt()), remaining)
+		if undelegating {
+			desired[i] = sdk.MinInt(desired[i], validators[i].Delegated)
+		}
+		remaining = remaining.Sub(desired[i])
+	}
+
+	// the truncation dust goes to the levelled validators
+	for _, i := range weighted[:count] {
+		if !remaining.IsPositive() {
+			break
+		}
+		if undelegating && desired[i].GTE(validators[i].Delegated) {
+			continue
+		}
+		desired[i] = desired[i].Add(sdk.OneInt())
+		remaining = remaining.Sub(sdk.OneInt())
+	}
+
+	return desired
+}
+
+// fill allocates the amount following the desired amounts of the eligible validators in order, and then allocates
+// what is left to the eligible validators with room left in order.
+func fill(
+	validators []Validator,
+	eligible []int,
+	desired []math.Int,
+	amount math.Int,
+	undelegating bool,
+) ([]Allocation, error) {
+	allocated := zeroInts(len(validators))
+	remaining := amount
+
+	room := func(i int) math.Int {
+		if undelegating {
+			return sdk.MinInt(remaining, validators[i].Delegated.Sub(allocated[i]))
+		}
+		return remaining
+	}
+
+	for _, i := range eligible {
+		allocation := sdk.MinInt(desired[i], room(i))
+		allocated[i] = allocated[i].Add(allocation)
+		remaining = remaining.Sub(allocation)
+	}
+	for _, i := range eligible {
+		if !remaining.IsPositive() {
+			break
+		}
+		allocation := room(i)
+		allocated[i] = allocated[i].Add(allocation)
+		remaining = remaining.Sub(allocation)
+	}
+
+	if remaining.IsPositive() {
+		return nil, fmt.Errorf("%w: %s left to undelegate", ErrInsufficientDelegations, remaining)
+	}
+
+	allocations := make([]Allocation, 0, len(eligible))
+	for i, validator := range validators {
+		if allocated[i].IsPositive() {
+			allocations = append(allocations, Allocation{Address: validator.Address, Amount: allocated[i]})
+		}
+	}
+	return allocations, nil
+}
+
+func totalDelegated(validators []Validator) math.Int {
+	total := sdk.ZeroInt()
+	for _, validator := range validators {
+		total = total.Add(validator.Delegated)
+	}
+	return total
+}
+
+func sumInts(amounts []math.Int) math.Int {
+	sum := sdk.ZeroInt()
+	for _, amount := range amounts {
+		sum = sum.Add(amount)
+	}
+	return sum
+}
+
+func zeroInts(n int) []math.Int {
+	amounts := make([]math.Int, n)
+	for i := range amounts {
+		amounts[i] = sdk.ZeroInt()
+	}
+	return amounts
+}
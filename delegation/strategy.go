@@ -0,0 +1,273 @@
+// Package delegation implements the strategies used to split an amount to delegate or undelegate amongst a weighted
+// validator set. The strategies are shared by the liquid staking modules, which only translate their validator set
+// into Validator entries and the resulting Allocation entries into staking messages.
+package delegation
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// WeightProportional allocates to the validators below (or above when undelegating) their weighted share of
+	// the total delegations, in the validator set order.
+	WeightProportional = "weight_proportional"
+	// EqualSplit allocates the same amount to every validator.
+	EqualSplit = "equal_split"
+	// MinDeviation allocates so that the highest deviation of a validator from its weighted share is minimal.
+	MinDeviation = "min_deviation"
+	// CappedPerValidator allocates proportionally to the weights without letting a validator hold more than a
+	// share of the total delegations.
+	CappedPerValidator = "capped_per_validator"
+	// FillLowestFirst allocates to the validators with the lowest delegations first (the highest when
+	// undelegating), regardless of their weights.
+	FillLowestFirst = "fill_lowest_first"
+)
+
+var (
+	ErrUnknownStrategy         = errors.New("unknown delegation strategy")
+	ErrInvalidAmount           = errors.New("amount to allocate must be positive")
+	ErrNoEligibleValidators    = errors.New("no eligible validators")
+	ErrInsufficientDelegations = errors.New("insufficient delegations to undelegate")
+)
+
+// Validator is a validator the amount can be allocated to.
+type Validator struct {
+	Address string
+	// target weight of the validator, the weights don't need to be normalized
+	Weight sdk.Dec
+	// amount currently delegated to the validator
+	Delegated math.Int
+}
+
+// Allocation is the amount allocated to a validator.
+type Allocation struct {
+	Address string
+	Amount  math.Int
+}
+
+// Strategy splits an amount to delegate or undelegate amongst a validator set.
+//
+// Only the validators with a positive weight are delegated to, and only the validators with a positive delegation
+// are undelegated from. Every returned allocation is positive, undelegations never exceed the delegated amount of
+// the validator and the allocations always add up to the amount.
+type Strategy interface {
+	Name() string
+	Allocate(validators []Validator, amount math.Int, undelegating bool) ([]Allocation, error)
+}
+
+// Names returns the names of all the available strategies.
+func Names() []string {
+	return []string{WeightProportional, EqualSplit, MinDeviation, CappedPerValidator, FillLowestFirst}
+}
+
+// ValidateName returns an error if there is no strategy with the name, empty being the default strategy.
+func ValidateName(name string) error {
+	if name == "" {
+		return nil
+	}
+	for _, n := range Names() {
+		if n == name {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrUnknownStrategy, name)
+}
+
+// NewStrategy returns the strategy with the name, WeightProportional when empty. The validator cap is the max share
+// of the total delegations a validator can hold, only used by CappedPerValidator.
+func NewStrategy(name string, validatorCap sdk.Dec) (Strategy, error) {
+	switch name {
+	case "", WeightProportional:
+		return weightProportional{}, nil
+	case EqualSplit:
+		return equalSplit{}, nil
+	case MinDeviation:
+		return minDeviation{}, nil
+	case CappedPerValidator:
+		if validatorCap.IsNil() || !validatorCap.IsPositive() || validatorCap.GT(sdk.OneDec()) {
+			return nil, fmt.Errorf("invalid validator cap for %s strategy: %s", CappedPerValidator, validatorCap)
+		}
+		return cappedPerValidator{validatorCap: validatorCap}, nil
+	case FillLowestFirst:
+		return fillLowestFirst{}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownStrategy, name)
+	}
+}
+
+type weightProportional struct{}
+
+func (weightProportional) Name() string { return WeightProportional }
+
+// Allocate computes the difference between the truncated weighted share of the future total delegations and the current
+// delegation of each validator, and fills the positive differences in order until the amount runs out. The weights
+// are used as they are, which is only accurate if they add up to one.
+func (weightProportional) Allocate(validators []Validator, amount math.Int, undelegating bool) ([]Allocation, error) {
+	eligible, err := eligibleValidators(validators, amount, undelegating)
+	if err != nil {
+		return nil, err
+	}
+
+	future := sdk.NewDecFromInt(totalDelegated(validators).Add(amount))
+	if undelegating {
+		future = sdk.NewDecFromInt(totalDelegated(validators).Sub(amount))
+	}
+
+	desired := zeroInts(len(validators))
+	for _, i := range eligible {
+		weight := validators[i].Weight
+		if weight.IsNil() {
+			weight = sdk.ZeroDec()
+		}
+
+		diff := weight.Mul(future).TruncateInt().Sub(validators[i].Delegated)
+		if undelegating {
+			diff = diff.Neg()
+		}
+		desired[i] = sdk.MaxInt(diff, sdk.ZeroInt())
+	}
+
+	return fill(validators, eligible, desired, amount, undelegating)
+}
+
+type equalSplit struct{}
+
+func (equalSplit) Name() string { return EqualSplit }
+
+// Allocate splits the amount evenly, the undelegations a validator can't cover spilling over the next validators.
+func (equalSplit) Allocate(validators []Validator, amount math.Int, undelegating bool) ([]Allocation, error) {
+	eligible, err := eligibleValidators(validators, amount, undelegating)
+	if err != nil {
+		return nil, err
+	}
+
+	share := amount.QuoRaw(int64(len(eligible)))
+	desired := zeroInts(len(validators))
+	for _, i := range eligible {
+		desired[i] = share
+	}
+
+	return fill(validators, eligible, desired, amount, undelegating)
+}
+
+type minDeviation struct{}
+
+func (minDeviation) Name() string { return MinDeviation }
+
+// Allocate levels the delegation to weight ratio of the most under-weight validators (over-weight when undelegating).
+func (minDeviation) Allocate(validators []Validator, amount math.Int, undelegating bool) ([]Allocation, error) {
+	eligible, err := eligibleValidators(validators, amount, undelegating)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := waterFill(validators, normalizedWeights(validators), eligible, amount, undelegating)
+	return fill(validators, eligible, desired, amount, undelegating)
+}
+
+type fillLowestFirst struct{}
+
+func (fillLowestFirst) Name() string { return FillLowestFirst }
+
+// Allocate levels the delegations of the validators with the lowest delegations (the highest when undelegating).
+func (fillLowestFirst) Allocate(validators []Validator, amount math.Int, undelegating bool) ([]Allocation, error) {
+	eligible, err := eligibleValidators(validators, amount, undelegating)
+	if err != nil {
+		return nil, err
+	}
+
+	// every weighted validator counts the same, zero weighted ones are still drained first
+	weights := make([]sdk.Dec, len(validators))
+	for i, validator := range validators {
+		weights[i] = sdk.ZeroDec()
+		if validator.Weight.IsPositive() {
+			weights[i] = sdk.OneDec()
+		}
+	}
+
+	desired := waterFill(validators, weights, eligible, amount, undelegating)
+	return fill(validators, eligible, desired, amount, undelegating)
+}
+
+type cappedPerValidator struct {
+	validatorCap sdk.Dec
+}
+
+func (cappedPerValidator) Name() string { return CappedPerValidator }
+
+// Allocate splits the amount proportionally to the weights, redistributing what goes over the cap of a validator
+// amongst the others. If every validator is capped, the rest is allocated regardless of the cap. Undelegations
+// can't make a validator go over the cap and are allocated as with WeightProportional.
+func (s cappedPerValidator) Allocate(validators []Validator, amount math.Int, undelegating bool) ([]Allocation, error) {
+	if undelegating {
+		return weightProportional{}.Allocate(validators, amount, undelegating)
+	}
+
+	eligible, err := eligibleValidators(validators, amount, undelegating)
+	if err != nil {
+		return nil, err
+	}
+
+	maxDelegation := s.validatorCap.MulInt(totalDelegated(validators).Add(amount)).TruncateInt()
+	capacity := make([]math.Int, len(validators))
+	active := make([]int, 0, len(eligible))
+	for _, i := range eligible {
+		capacity[i] = sdk.MaxInt(maxDelegation.Sub(validators[i].Delegated), sdk.ZeroInt())
+		if capacity[i].IsPositive() {
+			active = append(active, i)
+		}
+	}
+
+	// give their capacity to the validators whose weighted share exceeds it, until none does
+	desired := zeroInts(len(validators))
+	remaining := amount
+	for len(active) > 0 {
+		totalWeight := sdk.ZeroDec()
+		for _, i := range active {
+			totalWeight = totalWeight.Add(validators[i].Weight)
+		}
+
+		shares := make(map[int]math.Int, len(active))
+		uncapped := make([]int, 0, len(active))
+		for _, i := range active {
+			shares[i] = validators[i].Weight.Quo(totalWeight).MulInt(remaining).TruncateInt()
+			if shares[i].LT(capacity[i]) {
+				uncapped = append(uncapped, i)
+			}
+		}
+
+		if len(uncapped) == len(active) {
+			for _, i := range active {
+				desired[i] = shares[i]
+			}
+			break
+		}
+
+		for _, i := range active {
+			if shares[i].GTE(capacity[i]) {
+				desired[i] = capacity[i]
+				remaining = remaining.Sub(capacity[i])
+			}
+		}
+		active = uncapped
+	}
+
+	// the truncation dust goes to the validators with capacity left
+	left := amount.Sub(sumInts(desired))
+	for _, i := range eligible {
+		if !left.IsPositive() {
+			break
+		}
+		extra := sdk.MinInt(left, capacity[i].Sub(desired[i]))
+		if extra.IsPositive() {
+			desired[i] = desired[i].Add(extra)
+			left = left.Sub(extra)
+		}
+	}
+
+	return fill(validators, eligible, desired, amount, undelegating)
+}
@@ -0,0 +1,166 @@
+package delegation_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/delegation"
+)
+
+func strategies(t *testing.T) []delegation.Strategy {
+	var all []delegation.Strategy
+	for _, name := range delegation.Names() {
+		s, err := delegation.NewStrategy(name, sdk.NewDecWithPrec(3, 1))
+		require.NoError(t, err)
+		require.Equal(t, name, s.Name())
+		all = append(all, s)
+	}
+	return all
+}
+
+func randomValidators(r *rand.Rand) []delegation.Validator {
+	validators := make([]delegation.Validator, 1+r.Intn(10))
+	for i := range validators {
+		weight := sdk.ZeroDec()
+		if r.Intn(4) > 0 {
+			weight = sdk.NewDecWithPrec(r.Int63n(1000)+1, 3)
+		}
+		delegated := sdk.ZeroInt()
+		if r.Intn(4) > 0 {
+			delegated = sdk.NewInt(r.Int63n(1_000_000_000))
+		}
+		validators[i] = delegation.Validator{
+			Address:   fmt.Sprintf("validator%d", i),
+			Weight:    weight,
+			Delegated: delegated,
+		}
+	}
+	return validators
+}
+
+// TestAllocateProperties checks on random validator sets that no strategy ever allocates more or less than the
+// amount, nor allocates to validators it isn't allowed to.
+func TestAllocateProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for n := 0; n < 2000; n++ {
+		validators := randomValidators(r)
+		amount := sdk.NewInt(1 + r.Int63n(2_000_000_000))
+		if r.Intn(4) == 0 {
+			amount = sdk.NewInt(1 + r.Int63n(10))
+		}
+
+		for _, s := range strategies(t) {
+			for _, undelegating := range []bool{false, true} {
+				allocations, err := s.Allocate(validators, amount, undelegating)
+				if err != nil {
+					require.True(
+						t,
+						errors.Is(err, delegation.ErrNoEligibleValidators) ||
+							errors.Is(err, delegation.ErrInsufficientDelegations),
+						"%s: %s", s.Name(), err,
+					)
+					continue
+				}
+
+				byAddress := make(map[string]delegation.Validator)
+				for _, validator := range validators {
+					byAddress[validator.Address] = validator
+				}
+
+				total := sdk.ZeroInt()
+				for _, allocation := range allocations {
+					validator := byAddress[allocation.Address]
+					require.True(t, allocation.Amount.IsPositive(), s.Name())
+					if undelegating {
+						require.True(t, allocation.Amount.LTE(validator.Delegated), s.Name())
+					} else {
+						require.True(t, validator.Weight.IsPositive(), s.Name())
+					}
+					total = total.Add(allocation.Amount)
+				}
+				require.Equal(t, amount, total, "%s undelegating=%t", s.Name(), undelegating)
+			}
+		}
+	}
+}
+
+func allocationsMap(allocations []delegation.Allocation) map[string]int64 {
+	amounts := make(map[string]int64)
+	for _, allocation := range allocations {
+		amounts[allocation.Address] = allocation.Amount.Int64()
+	}
+	return amounts
+}
+
+func TestAllocate(t *testing.T) {
+	validators := []delegation.Validator{
+		{Address: "a", Weight: sdk.NewDecWithPrec(5, 1), Delegated: sdk.NewInt(100)},
+		{Address: "b", Weight: sdk.NewDecWithPrec(3, 1), Delegated: sdk.NewInt(0)},
+		{Address: "c", Weight: sdk.NewDecWithPrec(2, 1), Delegated: sdk.NewInt(50)},
+		{Address: "d", Weight: sdk.ZeroDec(), Delegated: sdk.NewInt(30)},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		validatorCap sdk.Dec
+		amount       int64
+		undelegating bool
+		expected     map[string]int64
+	}{
+		// 0.5*280 - 100, 0.3*280 - 0, 0.2*280 - 50, greedy in order
+		{delegation.WeightProportional, sdk.Dec{}, 100, false, map[string]int64{"a": 40, "b": 60}},
+		// 0.5*80 - 100, 0.2*80 - 50 and the zero weighted validator
+		{delegation.WeightProportional, sdk.Dec{}, 100, true, map[string]int64{"a": 60, "c": 34, "d": 6}},
+		// the weighted shares are truncated before the difference, 100 - 39 and 50 - 15
+		{delegation.WeightProportional, sdk.Dec{}, 101, true, map[string]int64{"a": 61, "c": 35, "d": 5}},
+		{delegation.EqualSplit, sdk.Dec{}, 100, false, map[string]int64{"a": 34, "b": 33, "c": 33}},
+		// d can't cover its share, what is left spills over a
+		{delegation.EqualSplit, sdk.Dec{}, 120, true, map[string]int64{"a": 50, "c": 40, "d": 30}},
+		// b is raised to the delegation/weight ratio of a, then both to the one of c
+		{delegation.MinDeviation, sdk.Dec{}, 60, false, map[string]int64{"b": 60}},
+		{delegation.MinDeviation, sdk.Dec{}, 100, false, map[string]int64{"a": 25, "b": 75}},
+		// the zero weighted validator first, then c and a are lowered to a ratio of 185.7
+		{delegation.MinDeviation, sdk.Dec{}, 50, true, map[string]int64{"a": 7, "c": 13, "d": 30}},
+		{delegation.FillLowestFirst, sdk.Dec{}, 60, false, map[string]int64{"b": 55, "c": 5}},
+		{delegation.FillLowestFirst, sdk.Dec{}, 80, true, map[string]int64{"a": 50, "d": 30}},
+		// at most 0.3*280 = 84 for each validator, c is capped and what is left goes to b
+		{delegation.CappedPerValidator, sdk.NewDecWithPrec(3, 1), 100, false, map[string]int64{"b": 66, "c": 34}},
+	} {
+		t.Run(fmt.Sprintf("%s %d undelegating=%t", tc.name, tc.amount, tc.undelegating), func(t *testing.T) {
+			s, err := delegation.NewStrategy(tc.name, tc.validatorCap)
+			require.NoError(t, err)
+
+			allocations, err := s.Allocate(validators, sdk.NewInt(tc.amount), tc.undelegating)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, allocationsMap(allocations))
+		})
+	}
+}
+
+func TestAllocateErrors(t *testing.T) {
+	s, err := delegation.NewStrategy("", sdk.Dec{})
+	require.NoError(t, err)
+	require.Equal(t, delegation.WeightProportional, s.Name())
+
+	validators := []delegation.Validator{
+		{Address: "a", Weight: sdk.ZeroDec(), Delegated: sdk.NewInt(10)},
+	}
+	_, err = s.Allocate(validators, math.ZeroInt(), false)
+	require.ErrorIs(t, err, delegation.ErrInvalidAmount)
+	_, err = s.Allocate(validators, sdk.NewInt(10), false)
+	require.ErrorIs(t, err, delegation.ErrNoEligibleValidators)
+	_, err = s.Allocate(validators, sdk.NewInt(11), true)
+	require.ErrorIs(t, err, delegation.ErrInsufficientDelegations)
+
+	_, err = delegation.NewStrategy("unknown", sdk.Dec{})
+	require.ErrorIs(t, err, delegation.ErrUnknownStrategy)
+	require.ErrorIs(t, delegation.ValidateName("unknown"), delegation.ErrUnknownStrategy)
+	_, err = delegation.NewStrategy(delegation.CappedPerValidator, sdk.ZeroDec())
+	require.Error(t, err)
+}
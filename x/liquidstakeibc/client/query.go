@@ -29,12 +29,25 @@ func NewQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		QueryHostChainsCmd(),
 		QueryDepositsCmd(),
+		QueryLSMDepositsCmd(),
+		QueryRewardsHistoryCmd(),
+		QuerySlashesCmd(),
+		QueryIcaTxsCmd(),
+		QueryIcaTxCmd(),
+		QueryLiquidityPoolCmd(),
+		QueryLiquidityProviderCmd(),
+		QueryHostChainProposalsCmd(),
+		QueryProposalTallyCmd(),
 		QueryUnbondingsCmd(),
 		QueryUserUnbondingsCmd(),
 		QueryValidatorUnbondingsCmd(),
 		QueryDepositAccountBalanceCmd(),
 		QueryExchangeRateCmd(),
+		QueryExchangeRateHistoryCmd(),
+		QueryAprCmd(),
 		QueryUnbondingCmd(),
+		QueryDelegatorPortfolioCmd(),
+		QueryInvariantsCmd(),
 	)
 
 	return cmd
@@ -139,6 +152,331 @@ func QueryDepositsCmd() *cobra.Command {
 	return cmd
 }
 
+// QueryLSMDepositsCmd returns all user lsm deposits for a host chain.
+func QueryLSMDepositsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lsm-deposits [chain-id]",
+		Short: "Query lsm deposit records for a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query all lsm deposits: $ %s query liquidstakeibc lsm-deposits [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LSMDeposits(cmd.Context(), &types.QueryLSMDepositsRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryRewardsHistoryCmd returns the rewards records for a host chain.
+func QueryRewardsHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-history [chain-id]",
+		Short: "Query the rewards records for a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the rewards history: $ %s query liquidstakeibc rewards-history [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RewardsHistory(cmd.Context(), &types.QueryRewardsHistoryRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryIcaTxsCmd returns the ica transactions for a host chain.
+func QueryIcaTxsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-txs [chain-id]",
+		Short: "Query the ica transactions for a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the ica transactions: $ %s query liquidstakeibc ica-txs [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaTxs(cmd.Context(), &types.QueryIcaTxsRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryIcaTxCmd returns an ica transaction given its sequence id.
+func QueryIcaTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica-tx [sequence-id]",
+		Short: "Query an ica transaction by its sequence id",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query an ica transaction: $ %s query liquidstakeibc ica-tx [sequence-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.IcaTx(cmd.Context(), &types.QueryIcaTxRequest{SequenceId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryLiquidityPoolCmd returns the instant redemption liquidity pool of a host chain.
+func QueryLiquidityPoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-pool [chain-id]",
+		Short: "Query the instant redemption liquidity pool of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the liquidity pool of a host chain: $ %s query liquidstakeibc liquidity-pool [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidityPool(cmd.Context(), &types.QueryLiquidityPoolRequest{ChainId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryLiquidityProviderCmd returns the liquidity pool position of a provider.
+func QueryLiquidityProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-provider [chain-id] [address]",
+		Short: "Query the liquidity pool position of a provider",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query a liquidity provider: $ %s query liquidstakeibc liquidity-provider [chain-id] [address]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LiquidityProvider(
+				cmd.Context(),
+				&types.QueryLiquidityProviderRequest{ChainId: args[0], Address: args[1]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryHostChainProposalsCmd returns the tracked governance proposals of a host chain.
+func QueryHostChainProposalsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposals [chain-id]",
+		Short: "Query the tracked governance proposals of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query host chain proposals: $ %s query liquidstakeibc proposals [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.HostChainProposals(
+				cmd.Context(),
+				&types.QueryHostChainProposalsRequest{ChainId: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryProposalTallyCmd returns the stk holders tally of a host chain governance proposal.
+func QueryProposalTallyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-tally [chain-id] [proposal-id]",
+		Short: "Query the stk holders tally of a host chain governance proposal",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query a proposal tally: $ %s query liquidstakeibc proposal-tally [chain-id] [proposal-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ProposalTally(
+				cmd.Context(),
+				&types.QueryProposalTallyRequest{ChainId: args[0], ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QuerySlashesCmd returns the validator slashes for a host chain.
+func QuerySlashesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashes [chain-id] [validator-address]",
+		Short: "Query the validator slashes for a host chain",
+		Args:  cobra.RangeArgs(1, 2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the validator slashes: $ %s query liquidstakeibc slashes [chain-id] [validator-address]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QuerySlashesRequest{ChainId: args[0]}
+			if len(args) > 1 {
+				request.ValidatorAddress = args[1]
+			}
+
+			res, err := queryClient.Slashes(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // QueryUnbondingsCmd returns all unbonding records for a host chain.
 func QueryUnbondingsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -367,3 +705,163 @@ func QueryExchangeRateCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryExchangeRateHistoryCmd returns the c value history of a host chain.
+func QueryExchangeRateHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-history [chain-id]",
+		Short: "Query the exchange rate history of a host chain",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the exchange rate history of a host chain: $ %s query liquidstakeibc exchange-rate-history [chain-id]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExchangeRateHistory(
+				cmd.Context(),
+				&types.QueryExchangeRateHistoryRequest{ChainId: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryAprCmd returns the staking APR of a host chain over a window of c value epochs.
+func QueryAprCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apr [chain-id] [window]",
+		Short: "Query the staking APR of a host chain",
+		Args:  cobra.RangeArgs(1, 2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the staking APR of a host chain over the last [window] c value epochs: $ %s query liquidstakeibc apr [chain-id] [window]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var window uint64
+			if len(args) > 1 {
+				window, err = strconv.ParseUint(args[1], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.Apr(
+				cmd.Context(),
+				&types.QueryAprRequest{ChainId: args[0], Window: uint32(window)},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryDelegatorPortfolioCmd returns the liquid staking positions and ledger of an address.
+func QueryDelegatorPortfolioCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-portfolio [delegator-address]",
+		Short: "Query the liquid staking positions and ledger of an address",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Query the positions and ledger of an address: $ %s query liquidstakeibc delegator-portfolio [delegator-address]`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorPortfolio(
+				context.Background(),
+				&types.QueryDelegatorPortfolioRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryInvariantsCmd runs all the module invariants.
+func QueryInvariantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants",
+		Short: "Check all the liquidstakeibc invariants",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Check all the module invariants against the current state: $ %s query liquidstakeibc invariants`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Invariants(context.Background(), &types.QueryInvariantsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/spf13/cobra"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
@@ -28,9 +29,15 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewRegisterHostChainCmd(),
 		NewUpdateHostChainCmd(),
+		NewDeregisterHostChainCmd(),
+		NewResetCircuitBreakerCmd(),
 		NewLiquidStakeCmd(),
+		NewLiquidStakeLSMCmd(),
 		NewLiquidUnstakeCmd(),
 		NewRedeemCmd(),
+		NewDepositLiquidityCmd(),
+		NewWithdrawLiquidityCmd(),
+		NewVoteWeightedCmd(),
 		NewUpdateParamsCmd(),
 	)
 
@@ -122,6 +129,59 @@ func NewUpdateHostChainCmd() *cobra.Command {
 	return cmd
 }
 
+// NewDeregisterHostChainCmd implements the command to deregister a host chain.
+func NewDeregisterHostChainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-host-chain [chain-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Deregister a host chain, winding down all its delegations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeregisterHostChain(args[0], clientCtx.FromAddress.String())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewResetCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-circuit-breaker [chain-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Reset the circuit breaker of a host chain, resuming all its operations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(args[0], clientCtx.FromAddress.String())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewLiquidStakeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "liquid-stake [amount]",
@@ -151,6 +211,35 @@ func NewLiquidStakeCmd() *cobra.Command {
 	return cmd
 }
 
+func NewLiquidStakeLSMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquid-stake-lsm [delegations]",
+		Short: `Liquid Stake tokenized delegation shares from a registered host chain into stk tokens`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delegations, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			delegatorAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgLiquidStakeLSM(delegations, delegatorAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewLiquidUnstakeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "liquid-unstake [amount]",
@@ -209,6 +298,100 @@ func NewRedeemCmd() *cobra.Command {
 	return cmd
 }
 
+// NewDepositLiquidityCmd implements the command to provide liquidity to a host chain instant redemption pool.
+func NewDepositLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-liquidity [amount]",
+		Short: `Provide ibc host tokens to the instant redemption pool of a registered host chain`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			providerAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgDepositLiquidity(amount, providerAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawLiquidityCmd implements the command to withdraw liquidity from a host chain instant redemption pool.
+func NewWithdrawLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-liquidity [chain-id] [shares]",
+		Short: `Withdraw ibc host tokens from the instant redemption pool of a registered host chain`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			shares, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("unable to parse shares %s", args[1])
+			}
+
+			providerAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgWithdrawLiquidity(args[0], shares, providerAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewVoteWeightedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-weighted [chain-id] [proposal-id] [weighted-options]",
+		Short: `Vote on a host chain governance proposal with the stk balance, e.g. yes=0.6,no=0.3,abstain=0.1`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientctx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[1])
+			}
+
+			options, err := govv1beta1.WeightedVoteOptionsFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			voterAddress := clientctx.GetFromAddress()
+			msg := types.NewMsgVoteWeighted(args[0], proposalID, options, voterAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientctx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewUpdateParamsCmd implements the command to update the module params.
 func NewUpdateParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
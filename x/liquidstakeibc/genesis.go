@@ -28,9 +28,61 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState *types.GenesisState)
 	for _, valUnbonding := range genState.ValidatorUnbondings {
 		k.SetValidatorUnbonding(ctx, valUnbonding)
 	}
+	for _, lsmDeposit := range genState.LsmDeposits {
+		k.SetLSMDeposit(ctx, lsmDeposit)
+	}
+
+	for _, redelegation := range genState.Redelegations {
+		k.SetRedelegation(ctx, redelegation)
+	}
+
+	for _, record := range genState.RewardsRecords {
+		k.SetRewardsRecord(ctx, record)
+	}
+
+	for _, slash := range genState.Slashes {
+		k.SetSlash(ctx, slash)
+	}
+
+	for _, icaTx := range genState.IcaTxs {
+		k.SetICATx(ctx, icaTx)
+	}
+
+	for _, pool := range genState.LiquidityPools {
+		k.SetLiquidityPool(ctx, pool)
+	}
+
+	for _, provider := range genState.LiquidityProviders {
+		k.SetLiquidityProvider(ctx, provider)
+	}
+
+	for _, proposal := range genState.HostChainProposals {
+		k.SetHostChainProposal(ctx, proposal)
+	}
+
+	for _, vote := range genState.ProposalVotes {
+		k.SetProposalVote(ctx, vote)
+	}
+
+	// the ledger sequence continues after the highest imported entry id
+	for _, entry := range genState.LedgerEntries {
+		k.SetLedgerEntry(ctx, entry)
+		if entry.Id >= k.GetLedgerSequence(ctx) {
+			k.SetLedgerSequence(ctx, entry.Id+1)
+		}
+	}
+
+	for _, record := range genState.ExchangeRateRecords {
+		k.SetExchangeRateRecord(ctx, record)
+	}
+
+	for _, reserve := range genState.WindDownReserves {
+		k.SetWindDownReserve(ctx, reserve)
+	}
 
 	k.GetDepositModuleAccount(ctx)
 	k.GetUndelegationModuleAccount(ctx)
+	k.GetLiquidityPoolModuleAccount(ctx)
 }
 
 // ExportGenesis returns the liquidstakeibc module's genesis state.
@@ -43,5 +95,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Unbondings:          k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return true }),         //GetAll
 		UserUnbondings:      k.FilterUserUnbondings(ctx, func(u types.UserUnbonding) bool { return true }), //GetAll
 		ValidatorUnbondings: k.FilterValidatorUnbondings(ctx, func(u types.ValidatorUnbonding) bool { return true }),
+		LsmDeposits:         k.FilterLSMDeposits(ctx, func(d types.LSMDeposit) bool { return true }),
+		Redelegations:       k.FilterRedelegations(ctx, func(r types.Redelegation) bool { return true }),
+		RewardsRecords:      k.FilterRewardsRecords(ctx, func(r types.RewardsRecord) bool { return true }),
+		Slashes:             k.FilterSlashes(ctx, func(s types.Slash) bool { return true }),
+		IcaTxs:              k.FilterICATxs(ctx, func(t types.ICATx) bool { return true }),
+		LiquidityPools:      k.FilterLiquidityPools(ctx, func(p types.LiquidityPool) bool { return true }),
+		LiquidityProviders:  k.FilterLiquidityProviders(ctx, func(p types.LiquidityProvider) bool { return true }),
+		HostChainProposals:  k.FilterHostChainProposals(ctx, func(p types.HostChainProposal) bool { return true }),
+		ProposalVotes:       k.FilterProposalVotes(ctx, func(v types.ProposalVote) bool { return true }),
+		LedgerEntries:       k.FilterLedgerEntries(ctx, func(e types.LedgerEntry) bool { return true }),
+		ExchangeRateRecords: k.FilterExchangeRateRecords(ctx, func(r types.ExchangeRateRecord) bool { return true }),
+		WindDownReserves:    k.GetAllWindDownReserves(ctx),
 	}
 }
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
@@ -15,17 +16,38 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 
 	// perform BeginBlocker tasks for each chain
 	for _, hc := range k.GetAllHostChains(ctx) {
+		// move forward the deregistration of the chains being wound down
+		if hc.IsWindingDown() {
+			k.DoWindDown(ctx, hc)
+			continue
+		}
+
 		if !hc.Active {
 			// don't do anything on inactive chains
 			continue
 		}
 
+		if hc.IsHalted() {
+			// only pay out the unbondings that already matured on halted chains
+			k.DoClaim(ctx, hc)
+			continue
+		}
+
+		// pause the host chain if its icq callbacks stopped arriving
+		k.DoCheckICQStaleness(ctx, hc)
+
 		// attempt to recreate closed ICA channels
 		k.DoRecreateICA(ctx, hc)
 
+		// attempt to retry timed out ICA transactions
+		k.DoRetryICATxs(ctx, hc)
+
 		// attempt to delegate
 		k.DoDelegate(ctx, hc)
 
+		// attempt to redeem the tokenized shares received on the host chain
+		k.DoRedeemLSMTokens(ctx, hc)
+
 		// attempt to automatically claim matured undelegations
 		k.DoClaim(ctx, hc)
 
@@ -34,6 +56,40 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 
 		// attempt to update the validator set if there are any changes
 		k.DoUpdateValidatorSet(ctx, hc)
+
+		// attempt to cast the stk holders votes on the host chain proposals
+		k.DoSubmitProposalVotes(ctx, hc)
+	}
+
+	// pay out the stk tokens of the removed host chains that can now be converted
+	k.DoConvertWindDownReserves(ctx)
+}
+
+func (k *Keeper) DoRetryICATxs(ctx sdk.Context, hc *types.HostChain) {
+	icaTxs := k.FilterICATxs(
+		ctx,
+		func(t types.ICATx) bool {
+			return t.ChainId == hc.ChainId && t.Status == types.ICATx_ICA_TX_TIMED_OUT
+		},
+	)
+
+	for _, icaTx := range icaTxs {
+		// wait for the ICA channel to be recreated
+		if !k.IsICAChannelActive(ctx, hc, k.GetPortID(icaTx.Owner)) {
+			continue
+		}
+
+		if err := k.RetryICATx(ctx, icaTx); err != nil {
+			k.Logger(ctx).Error(
+				"could not retry ICA tx",
+				"host_chain",
+				hc.ChainId,
+				"sequence_id",
+				icaTx.SequenceId,
+				"error",
+				err,
+			)
+		}
 	}
 }
 
@@ -45,19 +101,49 @@ func (k *Keeper) DoDelegate(ctx sdk.Context, hc *types.HostChain) {
 		return
 	}
 
-	// get the total amount that can be delegated for that host chain
+	// group the deposits by the delegation account that received them
+	accountDeposits := make(map[string][]*types.Deposit)
+	for _, deposit := range deposits {
+		account, found := hc.GetDelegationAccountByOwner(deposit.DelegationAccountOwner)
+		if !found {
+			continue
+		}
+		accountDeposits[account.Owner] = append(accountDeposits[account.Owner], deposit)
+	}
+
+	for _, account := range hc.GetDelegationAccounts() {
+		if len(accountDeposits[account.Owner]) > 0 {
+			k.DoDelegateFromAccount(ctx, hc, account, accountDeposits[account.Owner])
+		}
+	}
+}
+
+// DoDelegateFromAccount delegates the deposits received by one of the host chain delegation accounts
+func (k *Keeper) DoDelegateFromAccount(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	account *types.ICAAccount,
+	deposits []*types.Deposit,
+) {
+	if account.ChannelState != types.ICAAccount_ICA_CHANNEL_CREATED {
+		return
+	}
+
+	// get the total amount that can be delegated for that account
 	totalDepositDelegation := sdk.ZeroInt()
 	for _, deposit := range deposits {
 		totalDepositDelegation = totalDepositDelegation.Add(deposit.Amount.Amount)
 	}
 
-	// generate the delegation messages based on the hc total amount
-	messages, err := k.GenerateDelegateMessages(hc, totalDepositDelegation)
+	// generate the delegation messages based on the account view of the host chain
+	messages, err := k.GenerateDelegateMessages(hc.DelegationAccountView(account), totalDepositDelegation)
 	if err != nil {
 		k.Logger(ctx).Error(
 			"could not generate delegate messages",
 			"host_chain",
 			hc.ChainId,
+			"delegation_account",
+			account.Owner,
 		)
 		return
 	}
@@ -66,7 +152,7 @@ func (k *Keeper) DoDelegate(ctx sdk.Context, hc *types.HostChain) {
 	sequenceID, err := k.GenerateAndExecuteICATx(
 		ctx,
 		hc.ConnectionId,
-		hc.DelegationAccount.Owner,
+		account.Owner,
 		messages,
 	)
 	if err != nil {
@@ -74,6 +160,8 @@ func (k *Keeper) DoDelegate(ctx sdk.Context, hc *types.HostChain) {
 			"could not send ICA delegate txs",
 			"host_chain",
 			hc.ChainId,
+			"delegation_account",
+			account.Owner,
 		)
 		return
 	}
@@ -86,6 +174,52 @@ func (k *Keeper) DoDelegate(ctx sdk.Context, hc *types.HostChain) {
 	}
 }
 
+func (k *Keeper) DoRedeemLSMTokens(ctx sdk.Context, hc *types.HostChain) {
+	deposits := k.FilterLSMDeposits(
+		ctx,
+		func(d types.LSMDeposit) bool {
+			return d.ChainId == hc.ChainId && d.State == types.LSMDeposit_DEPOSIT_RECEIVED
+		},
+	)
+
+	// nothing to do if there are no deposits
+	if len(deposits) == 0 {
+		return
+	}
+
+	// redeem the tokenized shares into a delegation of the delegation account
+	messages := make([]proto.Message, 0)
+	for _, deposit := range deposits {
+		messages = append(messages, &stakingtypes.MsgRedeemTokensForShares{
+			DelegatorAddress: hc.DelegationAccount.Address,
+			Amount:           sdk.NewCoin(deposit.Denom, deposit.Amount),
+		})
+	}
+
+	// execute the ICA transactions
+	sequenceID, err := k.GenerateAndExecuteICATx(
+		ctx,
+		hc.ConnectionId,
+		hc.DelegationAccount.Owner,
+		messages,
+	)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"could not send ICA redeem tokens for shares txs",
+			"host_chain",
+			hc.ChainId,
+		)
+		return
+	}
+
+	// if everything went well, update the deposit states and set the sequence id
+	for _, deposit := range deposits {
+		deposit.IbcSequenceId = sequenceID
+		deposit.State = types.LSMDeposit_DEPOSIT_UNTOKENIZING
+		k.SetLSMDeposit(ctx, deposit)
+	}
+}
+
 func (k *Keeper) DoClaim(ctx sdk.Context, hc *types.HostChain) {
 	claimableUnbondings := k.FilterUnbondings(
 		ctx,
@@ -110,15 +244,16 @@ func (k *Keeper) DoClaim(ctx sdk.Context, hc *types.HostChain) {
 				return
 			}
 
-			var claimableCoins sdk.Coins
+			var claimedCoin sdk.Coin
 			switch unbonding.State {
 			case types.Unbonding_UNBONDING_CLAIMABLE:
-				claimableCoins = sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), userUnbonding.UnbondAmount.Amount))
+				claimedCoin = sdk.NewCoin(hc.IBCDenom(), userUnbonding.UnbondAmount.Amount)
 				unbonding.UnbondAmount = unbonding.UnbondAmount.Sub(userUnbonding.UnbondAmount)
 			case types.Unbonding_UNBONDING_FAILED:
-				claimableCoins = sdk.NewCoins(sdk.NewCoin(hc.MintDenom(), userUnbonding.StkAmount.Amount))
+				claimedCoin = sdk.NewCoin(hc.MintDenom(), userUnbonding.StkAmount.Amount)
 				unbonding.BurnAmount = unbonding.BurnAmount.Sub(userUnbonding.StkAmount)
 			}
+			claimableCoins := sdk.NewCoins(claimedCoin)
 
 			// send coin to the delegator address from the undelegation module account
 			if err = k.bankKeeper.SendCoinsFromModuleToAccount(
@@ -145,6 +280,16 @@ func (k *Keeper) DoClaim(ctx sdk.Context, hc *types.HostChain) {
 			}
 
 			k.DeleteUserUnbonding(ctx, userUnbonding)
+
+			k.RecordLedgerEntry(
+				ctx,
+				hc,
+				userUnbonding.Address,
+				types.LedgerEntry_LEDGER_ENTRY_CLAIM,
+				claimedCoin,
+				userUnbonding.StkAmount,
+				sdk.NewCoin(hc.MintDenom(), sdk.ZeroInt()),
+			)
 		}
 	}
 }
@@ -186,17 +331,29 @@ func (k *Keeper) DoRecreateICA(ctx sdk.Context, hc *types.HostChain) {
 		return
 	}
 
-	// if the channel is closed, and it is not being recreated, recreate it
-	if !k.IsICAChannelActive(ctx, hc, k.GetPortID(hc.DelegationAccount.Owner)) &&
-		hc.DelegationAccount.ChannelState != types.ICAAccount_ICA_CHANNEL_CREATING {
-		if err := k.RegisterICAAccount(ctx, hc.ConnectionId, hc.DelegationAccount.Owner); err != nil {
-			k.Logger(ctx).Error("error recreating %s delegate ica: %w", hc.ChainId, err)
-		}
+	// if any of the delegation channels is closed, and it is not being recreated, recreate it
+	for _, account := range hc.GetDelegationAccounts() {
+		if !k.IsICAChannelActive(ctx, hc, k.GetPortID(account.Owner)) &&
+			account.ChannelState == types.ICAAccount_ICA_CHANNEL_CREATED {
+			if err := k.RegisterICAAccount(ctx, hc.ConnectionId, account.Owner); err != nil {
+				k.Logger(ctx).Error("error recreating %s delegate ica: %w", hc.ChainId, err)
+			}
 
-		k.Logger(ctx).Info("Recreating delegate ICA.", "chain", hc.ChainId)
+			k.Logger(ctx).Info("Recreating delegate ICA.", "chain", hc.ChainId, "owner", account.Owner)
 
-		hc.DelegationAccount.ChannelState = types.ICAAccount_ICA_CHANNEL_CREATING
-		k.SetHostChain(ctx, hc)
+			account.ChannelState = types.ICAAccount_ICA_CHANNEL_CREATING
+			k.SetHostChain(ctx, hc)
+
+			// deposits can't be delegated until the channel is back
+			if hc.CircuitBreaker != nil && hc.CircuitBreaker.TripOnIcaClose {
+				k.TripCircuitBreaker(
+					ctx,
+					hc,
+					types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED,
+					types.TripReasonICAChannelClosed,
+				)
+			}
+		}
 	}
 
 	// if the channel is closed, and it is not being recreated, recreate it
@@ -225,13 +382,25 @@ func (k *Keeper) DoProcessMaturedUndelegations(ctx sdk.Context, hc *types.HostCh
 	)
 
 	for _, unbonding := range unbondings {
+		account, found := hc.GetDelegationAccountByOwner(unbonding.DelegationAccountOwner)
+		if !found {
+			k.Logger(ctx).Error(
+				"Could not find the delegation account of the unbonding.",
+				"host_chain",
+				hc.ChainId,
+				"owner",
+				unbonding.DelegationAccountOwner,
+			)
+			continue
+		}
+
 		sequenceID, err := k.SendICATransfer(
 			ctx,
 			hc,
 			unbonding.UnbondAmount,
-			hc.DelegationAccount.Address,
+			account.Address,
 			authtypes.NewModuleAddress(types.UndelegationModuleAccount).String(),
-			hc.DelegationAccount.Owner,
+			account.Owner,
 		)
 		if err != nil {
 			k.Logger(ctx).Error(
@@ -260,13 +429,25 @@ func (k *Keeper) DoProcessMaturedUndelegations(ctx sdk.Context, hc *types.HostCh
 	)
 
 	for _, validatorUnbonding := range validatorUnbondings {
+		account, found := hc.GetDelegationAccountByOwner(validatorUnbonding.DelegationAccountOwner)
+		if !found {
+			k.Logger(ctx).Error(
+				"Could not find the delegation account of the validator unbonding.",
+				"host_chain",
+				hc.ChainId,
+				"owner",
+				validatorUnbonding.DelegationAccountOwner,
+			)
+			continue
+		}
+
 		sequenceID, err := k.SendICATransfer(
 			ctx,
 			hc,
 			validatorUnbonding.Amount,
-			hc.DelegationAccount.Address,
+			account.Address,
 			k.GetDepositModuleAccount(ctx).GetAddress().String(),
-			hc.DelegationAccount.Owner,
+			account.Owner,
 		)
 		if err != nil {
 			k.Logger(ctx).Error(
@@ -286,3 +467,51 @@ func (k *Keeper) DoProcessMaturedUndelegations(ctx sdk.Context, hc *types.HostCh
 		k.SetValidatorUnbonding(ctx, validatorUnbonding)
 	}
 }
+
+func (k *Keeper) DoSubmitProposalVotes(ctx sdk.Context, hc *types.HostChain) {
+	proposals := k.FilterHostChainProposals(
+		ctx,
+		func(p types.HostChainProposal) bool {
+			return p.ChainId == hc.ChainId
+		},
+	)
+
+	for _, proposal := range proposals {
+		switch {
+		// the voting window closed, tally the votes and submit them before the host chain voting period ends
+		case proposal.State == types.HostChainProposal_PROPOSAL_VOTING && !k.IsProposalVotingOpen(ctx, proposal):
+			if !ctx.BlockTime().Before(proposal.VotingEndTime) {
+				k.Logger(ctx).Error(
+					"Host chain proposal voting period ended before the vote could be submitted.",
+					"host_chain",
+					hc.ChainId,
+					"proposal",
+					proposal.ProposalId,
+				)
+				proposal.State = types.HostChainProposal_PROPOSAL_FAILED
+				k.SetHostChainProposal(ctx, proposal)
+				continue
+			}
+
+			if err := k.SubmitProposalVote(ctx, hc, proposal); err != nil {
+				k.Logger(ctx).Error(
+					"Could not submit the host chain proposal vote.",
+					"host_chain",
+					hc.ChainId,
+					"proposal",
+					proposal.ProposalId,
+					"error",
+					err.Error(),
+				)
+			}
+		// the proposal finished long enough ago, remove it alongside its votes
+		case proposal.State != types.HostChainProposal_PROPOSAL_DEPOSIT &&
+			proposal.State != types.HostChainProposal_PROPOSAL_SUBMITTING &&
+			ctx.BlockTime().After(proposal.VotingEndTime.Add(types.ProposalRetentionPeriod)):
+			for _, vote := range k.GetVotesForProposal(ctx, proposal.ChainId, proposal.ProposalId) {
+				k.DeleteProposalVote(ctx, vote)
+			}
+			k.DeleteHostChainProposal(ctx, proposal)
+		}
+	}
+}
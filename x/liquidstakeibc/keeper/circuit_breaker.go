@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// TripCircuitBreaker moves the host chain circuit breaker up to the state, it is never moved down by a trigger
+func (k *Keeper) TripCircuitBreaker(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	state types.HostChainCircuitBreaker_State,
+	reason string,
+) {
+	if hc.IsPaused(state) {
+		return
+	}
+
+	hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+	hc.CircuitBreaker.State = state
+	hc.CircuitBreaker.Reason = reason
+	hc.CircuitBreaker.TrippedHeight = ctx.BlockHeight()
+	k.SetHostChain(ctx, hc)
+
+	k.Logger(ctx).Error(
+		"Host chain circuit breaker tripped.",
+		"host_chain", hc.ChainId,
+		"state", state.String(),
+		"reason", reason,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCircuitBreakerTrip,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeCircuitBreakerState, state.String()),
+			sdk.NewAttribute(types.AttributeTripReason, reason),
+		),
+	)
+}
+
+// ClearCircuitBreaker resumes all the host chain operations, restarting the icq staleness window
+func (k *Keeper) ClearCircuitBreaker(ctx sdk.Context, hc *types.HostChain) {
+	hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+	hc.CircuitBreaker.State = types.HostChainCircuitBreaker_CIRCUIT_BREAKER_OPERATIONAL
+	hc.CircuitBreaker.Reason = ""
+	hc.CircuitBreaker.TrippedHeight = 0
+	hc.CircuitBreaker.LastIcqHeight = ctx.BlockHeight()
+	k.SetHostChain(ctx, hc)
+}
+
+// RecordICQCallback keeps track of the last icq callback received for a host chain
+func (k *Keeper) RecordICQCallback(ctx sdk.Context, chainID string) {
+	hc, found := k.GetHostChain(ctx, chainID)
+	if !found {
+		return
+	}
+
+	hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+	hc.CircuitBreaker.LastIcqHeight = ctx.BlockHeight()
+	k.SetHostChain(ctx, hc)
+}
+
+// DoCheckICQStaleness trips the circuit breaker if no icq callback has been received for too long
+func (k *Keeper) DoCheckICQStaleness(ctx sdk.Context, hc *types.HostChain) {
+	breaker := hc.CircuitBreaker
+	if breaker == nil || breaker.MaxIcqStaleness == 0 || breaker.LastIcqHeight == 0 {
+		return
+	}
+
+	if ctx.BlockHeight()-breaker.LastIcqHeight > breaker.MaxIcqStaleness {
+		k.TripCircuitBreaker(ctx, hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED, types.TripReasonICQStale)
+	}
+}
+
+// CheckCValueDeviation pauses the deposits if the c value moved too much since the last epoch, the stk holders can
+// still exit
+func (k *Keeper) CheckCValueDeviation(ctx sdk.Context, hc *types.HostChain) {
+	breaker := hc.CircuitBreaker
+	if breaker == nil || !breaker.MaxCValueDeviation.IsPositive() || !hc.LastCValue.IsPositive() {
+		return
+	}
+
+	deviation := hc.CValue.Sub(hc.LastCValue).Abs().Quo(hc.LastCValue)
+	if deviation.GT(breaker.MaxCValueDeviation) {
+		k.TripCircuitBreaker(
+			ctx,
+			hc,
+			types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED,
+			types.TripReasonCValueDeviation,
+		)
+	}
+}
+
+// CheckCircuitBreaker returns an error if the host chain circuit breaker pauses the operations at the state
+func CheckCircuitBreaker(hc *types.HostChain, state types.HostChainCircuitBreaker_State) error {
+	if hc.IsPaused(state) {
+		return errorsmod.Wrapf(
+			types.ErrCircuitBreakerPaused,
+			"host chain %s circuit breaker is %s: %s",
+			hc.ChainId,
+			hc.CircuitBreaker.State.String(),
+			hc.CircuitBreaker.Reason,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestTripCircuitBreaker() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+	suite.Require().NoError(keeper.CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED))
+
+	suite.app.LiquidStakeIBCKeeper.TripCircuitBreaker(
+		suite.ctx,
+		hc,
+		types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED,
+		types.TripReasonICQStale,
+	)
+
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED, hc.CircuitBreaker.State)
+	suite.Require().Equal(types.TripReasonICQStale, hc.CircuitBreaker.Reason)
+	suite.Require().Equal(suite.ctx.BlockHeight(), hc.CircuitBreaker.TrippedHeight)
+
+	// deposits and unstakes are paused, redemptions can still go through
+	suite.Require().ErrorIs(
+		keeper.CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED),
+		types.ErrCircuitBreakerPaused,
+	)
+	suite.Require().ErrorIs(
+		keeper.CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED),
+		types.ErrCircuitBreakerPaused,
+	)
+	suite.Require().NoError(keeper.CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_REDEMPTIONS_PAUSED))
+
+	// a lower state doesn't move the circuit breaker down
+	suite.app.LiquidStakeIBCKeeper.TripCircuitBreaker(
+		suite.ctx,
+		hc,
+		types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED,
+		types.TripReasonICAChannelClosed,
+	)
+
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED, hc.CircuitBreaker.State)
+	suite.Require().Equal(types.TripReasonICQStale, hc.CircuitBreaker.Reason)
+
+	// the reset resumes every operation
+	suite.app.LiquidStakeIBCKeeper.ClearCircuitBreaker(suite.ctx, hc)
+
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_OPERATIONAL, hc.CircuitBreaker.State)
+	suite.Require().Equal("", hc.CircuitBreaker.Reason)
+	suite.Require().Equal(false, hc.IsHalted())
+	suite.Require().NoError(keeper.CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED))
+}
+
+func (suite *IntegrationTestSuite) TestDoCheckICQStaleness() {
+	ctx := suite.ctx.WithBlockHeight(100)
+
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+	hc.CircuitBreaker.MaxIcqStaleness = 10
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+
+	// the staleness window only starts with the first icq callback
+	suite.app.LiquidStakeIBCKeeper.DoCheckICQStaleness(ctx.WithBlockHeight(ctx.BlockHeight()+100), hc)
+	suite.Require().Equal(false, hc.IsPaused(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED))
+
+	suite.app.LiquidStakeIBCKeeper.RecordICQCallback(ctx, hc.ChainId)
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(ctx.BlockHeight(), hc.CircuitBreaker.LastIcqHeight)
+
+	suite.app.LiquidStakeIBCKeeper.DoCheckICQStaleness(ctx.WithBlockHeight(ctx.BlockHeight()+10), hc)
+	suite.Require().Equal(false, hc.IsPaused(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED))
+
+	suite.app.LiquidStakeIBCKeeper.DoCheckICQStaleness(ctx.WithBlockHeight(ctx.BlockHeight()+11), hc)
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(ctx, hc.ChainId)
+	suite.Require().Equal(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED, hc.CircuitBreaker.State)
+	suite.Require().Equal(types.TripReasonICQStale, hc.CircuitBreaker.Reason)
+}
+
+func (suite *IntegrationTestSuite) TestCheckCValueDeviation() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	hc.LastCValue = sdk.OneDec()
+	hc.CValue = decFromStr("0.9")
+
+	// disabled trigger
+	suite.app.LiquidStakeIBCKeeper.CheckCValueDeviation(suite.ctx, hc)
+	suite.Require().Equal(false, hc.IsPaused(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED))
+
+	// deviation within the limit
+	hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+	hc.CircuitBreaker.MaxCValueDeviation = decFromStr("0.1")
+	suite.app.LiquidStakeIBCKeeper.CheckCValueDeviation(suite.ctx, hc)
+	suite.Require().Equal(false, hc.IsPaused(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED))
+
+	// deviation above the limit
+	hc.CValue = decFromStr("0.89")
+	suite.app.LiquidStakeIBCKeeper.CheckCValueDeviation(suite.ctx, hc)
+
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED, hc.CircuitBreaker.State)
+	suite.Require().Equal(types.TripReasonCValueDeviation, hc.CircuitBreaker.Reason)
+	suite.Require().Equal(false, hc.IsPaused(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED))
+}
+
+func (suite *IntegrationTestSuite) TestUpdateCValuesHalt() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	active := hc.Active
+
+	params := suite.app.LiquidStakeIBCKeeper.GetParams(suite.ctx)
+	params.UpperCValueLimit = decFromStr("0.5")
+	params.LowerCValueLimit = decFromStr("0.4")
+	suite.app.LiquidStakeIBCKeeper.SetParams(suite.ctx, params)
+
+	suite.app.LiquidStakeIBCKeeper.UpdateCValues(suite.ctx)
+
+	// the chain is halted by the circuit breaker, not deactivated
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(active, hc.Active)
+	suite.Require().Equal(true, hc.IsHalted())
+	suite.Require().Equal(types.TripReasonCValueLimits, hc.CircuitBreaker.Reason)
+}
+
+func (suite *IntegrationTestSuite) TestBeginBlockHaltedClaims() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	hc.Active = true
+	suite.app.LiquidStakeIBCKeeper.TripCircuitBreaker(
+		suite.ctx,
+		hc,
+		types.HostChainCircuitBreaker_CIRCUIT_BREAKER_HALTED,
+		types.TripReasonCValueLimits,
+	)
+
+	suite.app.LiquidStakeIBCKeeper.SetUnbonding(suite.ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  1,
+		BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 100),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 100),
+		State:        types.Unbonding_UNBONDING_CLAIMABLE,
+	})
+	suite.app.LiquidStakeIBCKeeper.SetUserUnbonding(suite.ctx, &types.UserUnbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  1,
+		Address:      TestAddress,
+		StkAmount:    sdk.NewInt64Coin(hc.MintDenom(), 100),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 100),
+	})
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			types.UndelegationModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 100)),
+		),
+	)
+
+	// the matured unbondings are still paid out while the host chain is halted
+	suite.app.LiquidStakeIBCKeeper.BeginBlock(suite.ctx)
+
+	suite.Require().Equal(
+		sdk.NewInt(100),
+		suite.app.BankKeeper.GetBalance(suite.ctx, sdk.MustAccAddressFromBech32(TestAddress), hc.IBCDenom()).Amount,
+	)
+	_, found = suite.app.LiquidStakeIBCKeeper.GetUnbonding(suite.ctx, hc.ChainId, 1)
+	suite.Require().Equal(false, found)
+}
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"sort"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/persistenceOne/pstake-native/v2/delegation"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
@@ -23,94 +26,137 @@ func (k *Keeper) GenerateUndelegateMessages(hc *types.HostChain, unbondAmount sd
 	return k.generateMessages(hc, unbondAmount, true)
 }
 
+// generateMessages splits the actionable amount amongst the host chain validators using the host chain delegation
+// strategy. Validators outside the active set are left to the validator undelegation workflow.
 func (k *Keeper) generateMessages(
 	hc *types.HostChain,
 	actionableAmount sdk.Int, //nolint:staticcheck
 	undelegating bool,
 ) ([]proto.Message, error) {
-	delegateAmounts := make([]DelegateAmount, 0)
-	for _, validator := range hc.Validators {
-		// calculate the new total delegated amount for the host chain
-		currentDelegation := hc.GetHostChainTotalDelegations()
-		futureDelegation := currentDelegation.Add(actionableAmount)
-		if undelegating {
-			futureDelegation = currentDelegation.Sub(actionableAmount)
-		}
+	noMessagesErr := errorsmod.Wrap(types.ErrInvalidMessages, "no messages to delegate")
+	if undelegating {
+		noMessagesErr = errorsmod.Wrap(types.ErrInvalidMessages, "no messages to undelegate")
+	}
 
-		if validator.Weight.Equal(sdk.ZeroDec()) ||
-			validator.Status != stakingtypes.BondStatusBonded {
-			continue // skip validators with zero weight or that are not in the active set
-		}
+	strategy, err := hc.GetDelegationStrategy()
+	if err != nil {
+		return nil, errorsmod.Wrap(noMessagesErr, err.Error())
+	}
 
-		// calculate the delegated/undelegated amount difference for the validator:
-		//     if the difference is positive, new coins have to be delegated/undelegated
-		//     if the difference is zero or negative, don't do anything, it will eventually balance out
-		newDelegatedAmount := validator.Weight.Mul(sdk.NewDecFromInt(futureDelegation))
-		newDelegationDifference := newDelegatedAmount.Sub(sdk.NewDecFromInt(validator.DelegatedAmount))
-		if undelegating {
-			newDelegationDifference = sdk.NewDecFromInt(validator.DelegatedAmount).Sub(newDelegatedAmount)
-		}
-		if newDelegationDifference.LTE(sdk.ZeroDec()) {
-			continue // we can't remove delegation from a validator, and we have limited re-stake operations
+	validators := make([]delegation.Validator, 0, len(hc.Validators))
+	for _, validator := range hc.Validators {
+		if validator.Status != stakingtypes.BondStatusBonded {
+			continue // skip validators that are not in the active set
 		}
 
-		delegateAmounts = append(delegateAmounts, DelegateAmount{
-			ValAddress: validator.OperatorAddress,
-			ValWeight:  validator.Weight,
-			Amount:     newDelegationDifference,
+		validators = append(validators, delegation.Validator{
+			Address:   validator.OperatorAddress,
+			Weight:    validator.Weight,
+			Delegated: validator.DelegatedAmount,
 		})
 	}
 
-	messages := make([]proto.Message, 0)
-	for _, delegationAmount := range delegateAmounts {
-		var message proto.Message
+	allocations, err := strategy.Allocate(validators, actionableAmount, undelegating)
+	if err != nil {
+		return nil, errorsmod.Wrap(noMessagesErr, err.Error())
+	}
+
+	messages := make([]proto.Message, 0, len(allocations))
+	for _, allocation := range allocations {
 		if !undelegating {
-			message = &stakingtypes.MsgDelegate{
+			messages = append(messages, &stakingtypes.MsgDelegate{
 				DelegatorAddress: hc.DelegationAccount.Address,
-				ValidatorAddress: delegationAmount.ValAddress,
-			}
+				ValidatorAddress: allocation.Address,
+				Amount:           sdk.NewCoin(hc.HostDenom, allocation.Amount),
+			})
 		} else {
-			message = &stakingtypes.MsgUndelegate{
+			messages = append(messages, &stakingtypes.MsgUndelegate{
 				DelegatorAddress: hc.DelegationAccount.Address,
-				ValidatorAddress: delegationAmount.ValAddress,
-			}
+				ValidatorAddress: allocation.Address,
+				Amount:           sdk.NewCoin(hc.HostDenom, allocation.Amount),
+			})
 		}
+	}
 
-		// return when there is nothing more to delegate/undelegate
-		if actionableAmount.LTE(delegationAmount.Amount.TruncateInt()) {
-			if !undelegating {
-				msgDelegate := message.(*stakingtypes.MsgDelegate)
-				msgDelegate.Amount = sdk.NewCoin(hc.HostDenom, actionableAmount)
-			} else {
-				msgUndelegate := message.(*stakingtypes.MsgUndelegate)
-				msgUndelegate.Amount = sdk.NewCoin(hc.HostDenom, actionableAmount)
-			}
-			messages = append(messages, message)
+	return messages, nil
+}
 
-			return messages, nil
-		}
+// GenerateRedelegateMessages computes the drift between the weight of each validator and its delegated amount
+// and generates the messages to move the surplus delegation of over-weight validators to under-weight ones.
+// Validators involved in an in-flight redelegation are never used as source, as it would be transitive.
+func (k *Keeper) GenerateRedelegateMessages(
+	hc *types.HostChain,
+	redelegations []*types.Redelegation,
+) ([]proto.Message, error) {
+	// validators with an incoming or outgoing redelegation can't be redelegated from, this also keeps every
+	// validator pair at a single redelegation entry on the host chain
+	blocked := make(map[string]bool)
+	for _, redelegation := range redelegations {
+		blocked[redelegation.SrcValidatorAddress] = true
+		blocked[redelegation.DstValidatorAddress] = true
+	}
 
-		// add the amount to the message and append it
-		if !undelegating {
-			msgDelegate := message.(*stakingtypes.MsgDelegate)
-			msgDelegate.Amount = sdk.NewCoin(hc.HostDenom, delegationAmount.Amount.TruncateInt())
-		} else {
-			msgUndelegate := message.(*stakingtypes.MsgUndelegate)
-			msgUndelegate.Amount = sdk.NewCoin(hc.HostDenom, delegationAmount.Amount.TruncateInt())
+	totalDelegation := sdk.NewDecFromInt(hc.GetHostChainTotalDelegations())
+
+	sources := make([]DelegateAmount, 0)
+	destinations := make([]DelegateAmount, 0)
+	for _, validator := range hc.Validators {
+		drift := sdk.NewDecFromInt(validator.DelegatedAmount).Sub(validator.Weight.Mul(totalDelegation))
+
+		switch {
+		case drift.IsPositive() && !blocked[validator.OperatorAddress]:
+			sources = append(sources, DelegateAmount{
+				ValAddress: validator.OperatorAddress,
+				ValWeight:  validator.Weight,
+				Amount:     drift,
+			})
+		case drift.IsNegative() &&
+			validator.Weight.IsPositive() &&
+			validator.Status == stakingtypes.BondStatusBonded:
+			destinations = append(destinations, DelegateAmount{
+				ValAddress: validator.OperatorAddress,
+				ValWeight:  validator.Weight,
+				Amount:     drift.Neg(),
+			})
 		}
-		messages = append(messages, message)
+	}
+
+	// sort both lists so the biggest drifts are fixed first
+	sortDelegateAmounts(sources)
+	sortDelegateAmounts(destinations)
 
-		// subtract the amount to delegate/undelegate from the actionable total
-		actionableAmount = actionableAmount.Sub(delegationAmount.Amount.TruncateInt())
+	messages := make([]proto.Message, 0)
+	for i := range sources {
+		for j := range destinations {
+			amount := sdk.MinDec(sources[i].Amount, destinations[j].Amount).TruncateInt()
+			if !amount.IsPositive() {
+				continue
+			}
+
+			messages = append(messages, &stakingtypes.MsgBeginRedelegate{
+				DelegatorAddress:    hc.DelegationAccount.Address,
+				ValidatorSrcAddress: sources[i].ValAddress,
+				ValidatorDstAddress: destinations[j].ValAddress,
+				Amount:              sdk.NewCoin(hc.HostDenom, amount),
+			})
+
+			sources[i].Amount = sources[i].Amount.Sub(sdk.NewDecFromInt(amount))
+			destinations[j].Amount = destinations[j].Amount.Sub(sdk.NewDecFromInt(amount))
+		}
 	}
 
 	if len(messages) == 0 {
-		err := errorsmod.Wrap(types.ErrInvalidMessages, "no messages to delegate")
-		if undelegating {
-			err = errorsmod.Wrap(types.ErrInvalidMessages, "no messages to undelegate")
-		}
-		return nil, err
+		return nil, errorsmod.Wrap(types.ErrInvalidMessages, "no messages to redelegate")
 	}
 
 	return messages, nil
 }
+
+func sortDelegateAmounts(amounts []DelegateAmount) {
+	sort.SliceStable(amounts, func(i, j int) bool {
+		if amounts[i].Amount.Equal(amounts[j].Amount) {
+			return amounts[i].ValAddress < amounts[j].ValAddress
+		}
+		return amounts[i].Amount.GT(amounts[j].Amount)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/pkg/errors"
 
+	"github.com/persistenceOne/pstake-native/v2/delegation"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
@@ -341,3 +342,173 @@ func (suite *IntegrationTestSuite) TestGenerateUndelegateMessages() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestGenerateMessagesDelegationStrategy() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(found, true)
+
+	hc.Validators = []*types.Validator{
+		{
+			OperatorAddress: hc.Validators[0].OperatorAddress,
+			Weight:          decFromStr("0.5"),
+			DelegatedAmount: sdk.NewInt(100),
+			Status:          stakingtypes.BondStatusBonded,
+		},
+		{
+			OperatorAddress: hc.Validators[1].OperatorAddress,
+			Weight:          decFromStr("0.5"),
+			DelegatedAmount: sdk.NewInt(0),
+			Status:          stakingtypes.BondStatusBonded,
+		},
+		{
+			OperatorAddress: hc.Validators[2].OperatorAddress,
+			Weight:          decFromStr("0"),
+			DelegatedAmount: sdk.NewInt(30),
+			Status:          stakingtypes.BondStatusUnbonding,
+		},
+	}
+
+	hc.DelegationParams = &types.HostChainDelegationParams{Strategy: delegation.EqualSplit, ValidatorCap: sdk.OneDec()}
+	messages, err := suite.app.LiquidStakeIBCKeeper.GenerateDelegateMessages(hc, sdk.NewInt(51))
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 2)
+	suite.Require().Equal(int64(26), messages[0].(*stakingtypes.MsgDelegate).Amount.Amount.Int64())
+	suite.Require().Equal(int64(25), messages[1].(*stakingtypes.MsgDelegate).Amount.Amount.Int64())
+
+	// validators outside the active set are never undelegated from, they are left to the validator undelegations
+	hc.DelegationParams.Strategy = delegation.FillLowestFirst
+	messages, err = suite.app.LiquidStakeIBCKeeper.GenerateUndelegateMessages(hc, sdk.NewInt(40))
+	suite.Require().NoError(err)
+	suite.Require().Len(messages, 1)
+	suite.Require().Equal(hc.Validators[0].OperatorAddress, messages[0].(*stakingtypes.MsgUndelegate).ValidatorAddress)
+	suite.Require().Equal(int64(40), messages[0].(*stakingtypes.MsgUndelegate).Amount.Amount.Int64())
+
+	hc.DelegationParams.Strategy = "unknown"
+	_, err = suite.app.LiquidStakeIBCKeeper.GenerateDelegateMessages(hc, sdk.NewInt(51))
+	suite.Require().ErrorIs(err, types.ErrInvalidMessages)
+}
+
+func (suite *IntegrationTestSuite) TestGenerateRedelegateMessages() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(found, true)
+
+	tc := []struct {
+		name          string
+		validators    []*types.Validator
+		redelegations []*types.Redelegation
+		expected      map[string]int64
+		err           error
+	}{
+		{
+			name: "Case 1",
+			validators: []*types.Validator{
+				{
+					OperatorAddress: hc.Validators[0].OperatorAddress,
+					Weight:          decFromStr("0.5"),
+					DelegatedAmount: sdk.NewInt(100),
+					Status:          stakingtypes.BondStatusBonded,
+				},
+				{
+					OperatorAddress: hc.Validators[1].OperatorAddress,
+					Weight:          decFromStr("0.5"),
+					DelegatedAmount: sdk.NewInt(0),
+					Status:          stakingtypes.BondStatusBonded,
+				},
+			},
+			expected: map[string]int64{
+				hc.Validators[0].OperatorAddress + hc.Validators[1].OperatorAddress: int64(50),
+			},
+		},
+		{
+			name: "Case 2",
+			validators: []*types.Validator{
+				{
+					OperatorAddress: hc.Validators[0].OperatorAddress,
+					Weight:          decFromStr("0"),
+					DelegatedAmount: sdk.NewInt(60),
+					Status:          stakingtypes.BondStatusUnbonding,
+				},
+				{
+					OperatorAddress: hc.Validators[1].OperatorAddress,
+					Weight:          decFromStr("0.7"),
+					DelegatedAmount: sdk.NewInt(40),
+					Status:          stakingtypes.BondStatusBonded,
+				},
+				{
+					OperatorAddress: hc.Validators[2].OperatorAddress,
+					Weight:          decFromStr("0.3"),
+					DelegatedAmount: sdk.NewInt(0),
+					Status:          stakingtypes.BondStatusBonded,
+				},
+			},
+			expected: map[string]int64{
+				hc.Validators[0].OperatorAddress + hc.Validators[1].OperatorAddress: int64(30),
+				hc.Validators[0].OperatorAddress + hc.Validators[2].OperatorAddress: int64(30),
+			},
+		},
+		{
+			name: "Blocked",
+			validators: []*types.Validator{
+				{
+					OperatorAddress: hc.Validators[0].OperatorAddress,
+					Weight:          decFromStr("0.5"),
+					DelegatedAmount: sdk.NewInt(100),
+					Status:          stakingtypes.BondStatusBonded,
+				},
+				{
+					OperatorAddress: hc.Validators[1].OperatorAddress,
+					Weight:          decFromStr("0.5"),
+					DelegatedAmount: sdk.NewInt(0),
+					Status:          stakingtypes.BondStatusBonded,
+				},
+			},
+			redelegations: []*types.Redelegation{
+				{
+					SrcValidatorAddress: hc.Validators[2].OperatorAddress,
+					DstValidatorAddress: hc.Validators[0].OperatorAddress,
+				},
+			},
+			expected: map[string]int64{},
+			err:      types.ErrInvalidMessages,
+		},
+		{
+			name: "Balanced",
+			validators: []*types.Validator{
+				{
+					OperatorAddress: hc.Validators[0].OperatorAddress,
+					Weight:          decFromStr("0.5"),
+					DelegatedAmount: sdk.NewInt(50),
+					Status:          stakingtypes.BondStatusBonded,
+				},
+				{
+					OperatorAddress: hc.Validators[1].OperatorAddress,
+					Weight:          decFromStr("0.5"),
+					DelegatedAmount: sdk.NewInt(50),
+					Status:          stakingtypes.BondStatusBonded,
+				},
+			},
+			expected: map[string]int64{},
+			err:      types.ErrInvalidMessages,
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			hc.Validators = t.validators
+
+			messages, err := suite.app.LiquidStakeIBCKeeper.GenerateRedelegateMessages(hc, t.redelegations)
+
+			suite.Require().Equal(errors.Cause(t.err), errors.Cause(err))
+			suite.Require().Equal(len(t.expected), len(messages))
+
+			for _, message := range messages {
+				msgRedelegate := message.(*stakingtypes.MsgBeginRedelegate)
+
+				suite.Require().Equal(
+					t.expected[msgRedelegate.ValidatorSrcAddress+msgRedelegate.ValidatorDstAddress],
+					msgRedelegate.Amount.Amount.Int64(),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetExchangeRateRecord(ctx sdk.Context, record *types.ExchangeRateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExchangeRateKey)
+	bytes := k.cdc.MustMarshal(record)
+	store.Set(types.GetExchangeRateStoreKey(record.ChainId, record.EpochNumber), bytes)
+}
+
+func (k *Keeper) DeleteExchangeRateRecord(ctx sdk.Context, record *types.ExchangeRateRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExchangeRateKey)
+	store.Delete(types.GetExchangeRateStoreKey(record.ChainId, record.EpochNumber))
+}
+
+// GetExchangeRateHistory returns the exchange rate records of a host chain, oldest first
+func (k *Keeper) GetExchangeRateHistory(ctx sdk.Context, chainID string) []*types.ExchangeRateRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExchangeRateKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(chainID))
+	defer iterator.Close()
+
+	records := make([]*types.ExchangeRateRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ExchangeRateRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		// the chain id is a prefix of the key, make sure it isn't the prefix of a longer chain id
+		if record.ChainId == chainID {
+			records = append(records, &record)
+		}
+	}
+
+	return records
+}
+
+func (k *Keeper) FilterExchangeRateRecords(
+	ctx sdk.Context,
+	filter func(r types.ExchangeRateRecord) bool,
+) []*types.ExchangeRateRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExchangeRateKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	records := make([]*types.ExchangeRateRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.ExchangeRateRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if filter(record) {
+			records = append(records, &record)
+		}
+	}
+
+	return records
+}
+
+// RecordExchangeRate adds the current host chain c value to its history, dropping the oldest records once the
+// history is over the configured size
+func (k *Keeper) RecordExchangeRate(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	totalStaked sdk.Int, //nolint:staticcheck
+	mintedAmount sdk.Int, //nolint:staticcheck
+) {
+	size := int(k.GetParams(ctx).ExchangeRateHistorySize)
+
+	if size > 0 {
+		k.SetExchangeRateRecord(
+			ctx,
+			&types.ExchangeRateRecord{
+				ChainId:      hc.ChainId,
+				EpochNumber:  k.GetEpochNumber(ctx, types.CValueEpoch),
+				Time:         ctx.BlockTime(),
+				CValue:       hc.CValue,
+				TotalStaked:  totalStaked,
+				MintedAmount: mintedAmount,
+			},
+		)
+	}
+
+	history := k.GetExchangeRateHistory(ctx, hc.ChainId)
+	for i := 0; i < len(history)-size; i++ {
+		k.DeleteExchangeRateRecord(ctx, history[i])
+	}
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestRecordExchangeRate() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	params := suite.app.LiquidStakeIBCKeeper.GetParams(suite.ctx)
+	params.ExchangeRateHistorySize = 3
+	suite.app.LiquidStakeIBCKeeper.SetParams(suite.ctx, params)
+
+	epochInfo := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.CValueEpoch)
+	epochInfo.CurrentEpoch = 10
+	suite.app.EpochsKeeper.DeleteEpochInfo(suite.ctx, types.CValueEpoch)
+	suite.Require().NoError(suite.app.EpochsKeeper.AddEpochInfo(suite.ctx, epochInfo))
+
+	epoch := suite.app.LiquidStakeIBCKeeper.GetEpochNumber(suite.ctx, types.CValueEpoch)
+	for i := int64(0); i < 5; i++ {
+		suite.app.LiquidStakeIBCKeeper.SetExchangeRateRecord(
+			suite.ctx,
+			&types.ExchangeRateRecord{
+				ChainId:      hc.ChainId,
+				EpochNumber:  epoch - 5 + i,
+				CValue:       sdk.OneDec(),
+				TotalStaked:  sdk.ZeroInt(),
+				MintedAmount: sdk.ZeroInt(),
+			},
+		)
+	}
+
+	suite.app.LiquidStakeIBCKeeper.RecordExchangeRate(suite.ctx, hc, sdk.NewInt(1000), sdk.NewInt(900))
+
+	// only the newest records are kept
+	history := suite.app.LiquidStakeIBCKeeper.GetExchangeRateHistory(suite.ctx, hc.ChainId)
+	suite.Require().Equal(3, len(history))
+	suite.Require().Equal(epoch-2, history[0].EpochNumber)
+	suite.Require().Equal(epoch-1, history[1].EpochNumber)
+	suite.Require().Equal(epoch, history[2].EpochNumber)
+	suite.Require().Equal(hc.CValue, history[2].CValue)
+	suite.Require().Equal(sdk.NewInt(1000), history[2].TotalStaked)
+	suite.Require().Equal(sdk.NewInt(900), history[2].MintedAmount)
+
+	// a zero size stops the recording and clears the history
+	params.ExchangeRateHistorySize = 0
+	suite.app.LiquidStakeIBCKeeper.SetParams(suite.ctx, params)
+
+	suite.app.LiquidStakeIBCKeeper.RecordExchangeRate(suite.ctx, hc, sdk.NewInt(1000), sdk.NewInt(900))
+	suite.Require().Equal(0, len(suite.app.LiquidStakeIBCKeeper.GetExchangeRateHistory(suite.ctx, hc.ChainId)))
+}
+
+func (suite *IntegrationTestSuite) TestQueryApr() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	cValues := []string{"1", "0.99", "0.98"}
+	for i, cValue := range cValues {
+		suite.app.LiquidStakeIBCKeeper.SetExchangeRateRecord(
+			suite.ctx,
+			&types.ExchangeRateRecord{
+				ChainId:      hc.ChainId,
+				EpochNumber:  int64(i + 1),
+				Time:         start.Add(time.Duration(i) * 365 * 24 * time.Hour),
+				CValue:       decFromStr(cValue),
+				TotalStaked:  sdk.ZeroInt(),
+				MintedAmount: sdk.ZeroInt(),
+			},
+		)
+	}
+
+	tc := []struct {
+		name  string
+		req   *types.QueryAprRequest
+		apr   sdk.Dec
+		epoch int64
+		err   error
+	}{
+		{
+			name:  "DefaultWindow",
+			req:   &types.QueryAprRequest{ChainId: hc.ChainId},
+			apr:   decFromStr("1").Quo(decFromStr("0.98")).Sub(sdk.OneDec()).QuoInt64(2),
+			epoch: 1,
+		},
+		{
+			name:  "Window",
+			req:   &types.QueryAprRequest{ChainId: hc.ChainId, Window: 1},
+			apr:   decFromStr("0.99").Quo(decFromStr("0.98")).Sub(sdk.OneDec()),
+			epoch: 2,
+		},
+		{
+			name: "NotFound",
+			req:  &types.QueryAprRequest{ChainId: "chain-1"},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			name: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			resp, err := suite.app.LiquidStakeIBCKeeper.Apr(suite.ctx, t.req)
+
+			suite.Require().Equal(t.err, err)
+			if t.err != nil {
+				return
+			}
+
+			suite.Require().Equal(t.apr, resp.Apr)
+			suite.Require().Equal(t.epoch, resp.From.EpochNumber)
+			suite.Require().Equal(int64(3), resp.To.EpochNumber)
+		})
+	}
+}
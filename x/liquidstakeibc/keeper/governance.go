@@ -0,0 +1,354 @@
+package keeper
+
+import (
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetHostChainProposal(ctx sdk.Context, proposal *types.HostChainProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainProposalKey)
+	bytes := k.cdc.MustMarshal(proposal)
+	store.Set(types.GetHostChainProposalStoreKey(proposal.ChainId, proposal.ProposalId), bytes)
+}
+
+func (k *Keeper) GetHostChainProposal(
+	ctx sdk.Context,
+	chainID string,
+	proposalID uint64,
+) (*types.HostChainProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainProposalKey)
+	bz := store.Get(types.GetHostChainProposalStoreKey(chainID, proposalID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var proposal types.HostChainProposal
+	k.cdc.MustUnmarshal(bz, &proposal)
+	return &proposal, true
+}
+
+func (k *Keeper) DeleteHostChainProposal(ctx sdk.Context, proposal *types.HostChainProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainProposalKey)
+	store.Delete(types.GetHostChainProposalStoreKey(proposal.ChainId, proposal.ProposalId))
+}
+
+func (k *Keeper) FilterHostChainProposals(
+	ctx sdk.Context,
+	filter func(p types.HostChainProposal) bool,
+) []*types.HostChainProposal {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainProposalKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	proposals := make([]*types.HostChainProposal, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		proposal := types.HostChainProposal{}
+		k.cdc.MustUnmarshal(iterator.Value(), &proposal)
+		if filter(proposal) {
+			proposals = append(proposals, &proposal)
+		}
+	}
+
+	return proposals
+}
+
+func (k *Keeper) SetProposalVote(ctx sdk.Context, vote *types.ProposalVote) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalVoteKey)
+	bytes := k.cdc.MustMarshal(vote)
+	store.Set(types.GetProposalVoteStoreKey(vote.ChainId, vote.ProposalId, vote.Voter), bytes)
+}
+
+func (k *Keeper) GetProposalVote(
+	ctx sdk.Context,
+	chainID string,
+	proposalID uint64,
+	voter string,
+) (*types.ProposalVote, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalVoteKey)
+	bz := store.Get(types.GetProposalVoteStoreKey(chainID, proposalID, voter))
+	if bz == nil {
+		return nil, false
+	}
+
+	var vote types.ProposalVote
+	k.cdc.MustUnmarshal(bz, &vote)
+	return &vote, true
+}
+
+func (k *Keeper) DeleteProposalVote(ctx sdk.Context, vote *types.ProposalVote) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalVoteKey)
+	store.Delete(types.GetProposalVoteStoreKey(vote.ChainId, vote.ProposalId, vote.Voter))
+}
+
+func (k *Keeper) FilterProposalVotes(
+	ctx sdk.Context,
+	filter func(v types.ProposalVote) bool,
+) []*types.ProposalVote {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalVoteKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	votes := make([]*types.ProposalVote, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		vote := types.ProposalVote{}
+		k.cdc.MustUnmarshal(iterator.Value(), &vote)
+		if filter(vote) {
+			votes = append(votes, &vote)
+		}
+	}
+
+	return votes
+}
+
+// GetVotesForProposal returns all the stk holder votes cast for a host chain proposal
+func (k *Keeper) GetVotesForProposal(ctx sdk.Context, chainID string, proposalID uint64) []*types.ProposalVote {
+	return k.FilterProposalVotes(
+		ctx,
+		func(v types.ProposalVote) bool {
+			return v.ChainId == chainID && v.ProposalId == proposalID
+		},
+	)
+}
+
+// IsProposalVotingOpen checks if stk holders can still vote on a proposal, the voting window closes
+// ProposalVoteSubmissionWindow before the host chain voting end time to leave time for the vote submission
+func (k *Keeper) IsProposalVotingOpen(ctx sdk.Context, proposal *types.HostChainProposal) bool {
+	return proposal.State == types.HostChainProposal_PROPOSAL_VOTING &&
+		ctx.BlockTime().Before(proposal.VotingEndTime.Add(-types.ProposalVoteSubmissionWindow))
+}
+
+// CastProposalVote records the weighted vote of a stk holder for a host chain proposal, replacing any previous one
+func (k *Keeper) CastProposalVote(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	proposalID uint64,
+	voter sdk.AccAddress,
+	options govv1beta1.WeightedVoteOptions,
+) error {
+	proposal, found := k.GetHostChainProposal(ctx, hc.ChainId, proposalID)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrProposalNotFound,
+			"proposal %d for host chain %s is not tracked",
+			proposalID,
+			hc.ChainId,
+		)
+	}
+
+	if !k.IsProposalVotingOpen(ctx, proposal) {
+		return errorsmod.Wrapf(
+			types.ErrVotingClosed,
+			"proposal %d for host chain %s is in state %s",
+			proposalID,
+			hc.ChainId,
+			proposal.State,
+		)
+	}
+
+	// the voting power is only computed when tallying, but voters need to hold stk tokens to vote
+	if !k.bankKeeper.GetBalance(ctx, voter, hc.MintDenom()).IsPositive() {
+		return errorsmod.Wrapf(types.ErrNoVotingPower, "voter %s has no %s balance", voter, hc.MintDenom())
+	}
+
+	k.SetProposalVote(ctx, &types.ProposalVote{
+		ChainId:    hc.ChainId,
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Options:    options,
+	})
+
+	return nil
+}
+
+// TallyProposalVotes weights the votes of a proposal by the current stk balance of their voters, returning the
+// normalised vote options and the total voting power. The balances are not snapshotted when the voting opens, stk
+// moved after casting a vote moves its voting power along until the vote is submitted.
+func (k *Keeper) TallyProposalVotes(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	proposal *types.HostChainProposal,
+) (govv1beta1.WeightedVoteOptions, sdk.Int) { //nolint:staticcheck
+	totalPower := sdk.ZeroInt()
+	optionPower := make(map[govv1beta1.VoteOption]sdk.Dec)
+	for _, vote := range k.GetVotesForProposal(ctx, proposal.ChainId, proposal.ProposalId) {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			continue
+		}
+
+		power := k.bankKeeper.GetBalance(ctx, voter, hc.MintDenom()).Amount
+		if !power.IsPositive() {
+			continue
+		}
+
+		for _, option := range vote.Options {
+			if _, ok := optionPower[option.Option]; !ok {
+				optionPower[option.Option] = sdk.ZeroDec()
+			}
+			optionPower[option.Option] = optionPower[option.Option].Add(option.Weight.MulInt(power))
+		}
+		totalPower = totalPower.Add(power)
+	}
+
+	if totalPower.IsZero() {
+		return govv1beta1.WeightedVoteOptions{}, totalPower
+	}
+
+	voteOptions := make([]govv1beta1.VoteOption, 0, len(optionPower))
+	for option := range optionPower {
+		voteOptions = append(voteOptions, option)
+	}
+	sort.Slice(voteOptions, func(i, j int) bool { return voteOptions[i] < voteOptions[j] })
+
+	// normalise the option weights, the rounding remainder goes to the option with the largest weight, so they
+	// add up to exactly 1 as required by the host chain
+	tally := make(govv1beta1.WeightedVoteOptions, 0, len(voteOptions))
+	totalWeight := sdk.ZeroDec()
+	largest := -1
+	for _, option := range voteOptions {
+		weight := optionPower[option].QuoInt(totalPower)
+		if !weight.IsPositive() {
+			continue
+		}
+
+		tally = append(tally, govv1beta1.WeightedVoteOption{Option: option, Weight: weight})
+		totalWeight = totalWeight.Add(weight)
+		if largest < 0 || weight.GT(tally[largest].Weight) {
+			largest = len(tally) - 1
+		}
+	}
+	tally[largest].Weight = tally[largest].Weight.Add(sdk.OneDec().Sub(totalWeight))
+
+	return tally, totalPower
+}
+
+// SubmitProposalVote tallies the votes of a proposal at the current height, recorded as its tally height, and
+// casts the result on the host chain with a MsgVoteWeighted sent from the delegation account
+func (k *Keeper) SubmitProposalVote(ctx sdk.Context, hc *types.HostChain, proposal *types.HostChainProposal) error {
+	tally, totalPower := k.TallyProposalVotes(ctx, hc, proposal)
+
+	proposal.TallyHeight = ctx.BlockHeight()
+	proposal.Tally = tally
+	proposal.TotalPower = totalPower
+
+	// nothing to submit if nobody voted
+	if totalPower.IsZero() {
+		proposal.State = types.HostChainProposal_PROPOSAL_NO_VOTES
+		k.SetHostChainProposal(ctx, proposal)
+		return nil
+	}
+
+	sequenceID, err := k.GenerateAndExecuteICATx(
+		ctx,
+		hc.ConnectionId,
+		hc.DelegationAccount.Owner,
+		[]proto.Message{&govv1beta1.MsgVoteWeighted{
+			ProposalId: proposal.ProposalId,
+			Voter:      hc.DelegationAccount.Address,
+			Options:    tally,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+
+	proposal.IbcSequenceId = sequenceID
+	proposal.State = types.HostChainProposal_PROPOSAL_SUBMITTING
+	k.SetHostChainProposal(ctx, proposal)
+
+	return nil
+}
+
+// UpdateProposalsForSequenceID sets the state of the proposals whose vote was sent with a sequence id
+func (k *Keeper) UpdateProposalsForSequenceID(
+	ctx sdk.Context,
+	sequenceID string,
+	state types.HostChainProposal_ProposalState,
+) {
+	proposals := k.FilterHostChainProposals(
+		ctx,
+		func(p types.HostChainProposal) bool {
+			return p.IbcSequenceId == sequenceID
+		},
+	)
+
+	for _, proposal := range proposals {
+		proposal.IbcSequenceId = ""
+		proposal.State = state
+		k.SetHostChainProposal(ctx, proposal)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalVoted,
+				sdk.NewAttribute(types.AttributeChainID, proposal.ChainId),
+				sdk.NewAttribute(types.AttributeProposalID, sdk.NewIntFromUint64(proposal.ProposalId).String()),
+				sdk.NewAttribute(types.AttributeVoteOptions, govv1beta1.WeightedVoteOptions(proposal.Tally).String()),
+				sdk.NewAttribute(types.AttributeVotingPower, proposal.TotalPower.String()),
+			),
+		)
+	}
+}
+
+// ProcessHostChainProposal updates the tracked proposals with the proposal state queried from the host chain
+func (k *Keeper) ProcessHostChainProposal(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	proposalID uint64,
+	hostProposal *govv1beta1.Proposal,
+) {
+	proposal, found := k.GetHostChainProposal(ctx, hc.ChainId, proposalID)
+
+	// the proposal doesn't exist (anymore) on the host chain, stop tracking it if it never reached voting
+	if hostProposal == nil {
+		if found && proposal.State == types.HostChainProposal_PROPOSAL_DEPOSIT {
+			k.DeleteHostChainProposal(ctx, proposal)
+		}
+		return
+	}
+
+	if !found {
+		proposal = &types.HostChainProposal{
+			ChainId:    hc.ChainId,
+			ProposalId: proposalID,
+			TotalPower: sdk.ZeroInt(),
+		}
+	}
+
+	// only proposals that are not voting yet can change state
+	if found && proposal.State != types.HostChainProposal_PROPOSAL_DEPOSIT {
+		return
+	}
+
+	switch hostProposal.Status {
+	case govv1beta1.StatusDepositPeriod:
+		proposal.State = types.HostChainProposal_PROPOSAL_DEPOSIT
+	case govv1beta1.StatusVotingPeriod:
+		proposal.State = types.HostChainProposal_PROPOSAL_VOTING
+		proposal.VotingEndTime = hostProposal.VotingEndTime
+	default:
+		// the proposal already finished, there is nothing to vote
+		if found {
+			k.DeleteHostChainProposal(ctx, proposal)
+		}
+		return
+	}
+
+	k.SetHostChainProposal(ctx, proposal)
+}
+
+// GetProposalIDFromQueryKey returns the proposal id of a gov proposal store key
+func GetProposalIDFromQueryKey(key []byte) (uint64, error) {
+	if len(key) != len(govtypes.ProposalKey(0)) {
+		return 0, errorsmod.Wrapf(types.ErrProposalNotFound, "invalid proposal key length %d", len(key))
+	}
+
+	return govtypes.SplitProposalKey(key), nil
+}
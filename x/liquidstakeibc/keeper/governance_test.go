@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestCastProposalVote() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000000, 0))
+	voter := sdk.MustAccAddressFromBech32(TestAddress)
+	options := govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes)
+
+	// the proposal is not tracked yet
+	err := suite.app.LiquidStakeIBCKeeper.CastProposalVote(ctx, hc, 1, voter, options)
+	suite.Require().ErrorIs(err, types.ErrProposalNotFound)
+
+	proposal := &types.HostChainProposal{
+		ChainId:       hc.ChainId,
+		ProposalId:    1,
+		VotingEndTime: ctx.BlockTime().Add(types.ProposalVoteSubmissionWindow + time.Hour),
+		State:         types.HostChainProposal_PROPOSAL_VOTING,
+		TotalPower:    sdk.ZeroInt(),
+	}
+	suite.app.LiquidStakeIBCKeeper.SetHostChainProposal(ctx, proposal)
+
+	// the voter doesn't hold any stk tokens
+	err = suite.app.LiquidStakeIBCKeeper.CastProposalVote(ctx, hc, 1, voter, options)
+	suite.Require().ErrorIs(err, types.ErrNoVotingPower)
+
+	suite.Require().NoError(
+		testutil.FundAccount(
+			suite.app.BankKeeper,
+			ctx,
+			voter,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+		),
+	)
+	suite.Require().NoError(suite.app.LiquidStakeIBCKeeper.CastProposalVote(ctx, hc, 1, voter, options))
+
+	vote, found := suite.app.LiquidStakeIBCKeeper.GetProposalVote(ctx, hc.ChainId, 1, TestAddress)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(options, govv1beta1.WeightedVoteOptions(vote.Options))
+
+	// the voting window closes before the host chain voting period ends
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	err = suite.app.LiquidStakeIBCKeeper.CastProposalVote(ctx, hc, 1, voter, options)
+	suite.Require().ErrorIs(err, types.ErrVotingClosed)
+}
+
+func (suite *IntegrationTestSuite) TestTallyProposalVotes() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	proposal := &types.HostChainProposal{
+		ChainId:    hc.ChainId,
+		ProposalId: 1,
+		State:      types.HostChainProposal_PROPOSAL_VOTING,
+		TotalPower: sdk.ZeroInt(),
+	}
+	suite.app.LiquidStakeIBCKeeper.SetHostChainProposal(suite.ctx, proposal)
+
+	tally, power := suite.app.LiquidStakeIBCKeeper.TallyProposalVotes(suite.ctx, hc, proposal)
+	suite.Require().Equal(sdk.ZeroInt(), power)
+	suite.Require().Len(tally, 0)
+
+	voters := []struct {
+		address string
+		balance int64
+		options govv1beta1.WeightedVoteOptions
+	}{
+		{
+			address: TestAddress,
+			balance: 300,
+			options: govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionYes),
+		},
+		{
+			address: sdk.AccAddress("test_voter_address__").String(),
+			balance: 100,
+			options: govv1beta1.WeightedVoteOptions{
+				{Option: govv1beta1.OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+				{Option: govv1beta1.OptionNo, Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		},
+	}
+
+	for _, voter := range voters {
+		suite.Require().NoError(
+			testutil.FundAccount(
+				suite.app.BankKeeper,
+				suite.ctx,
+				sdk.MustAccAddressFromBech32(voter.address),
+				sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), voter.balance)),
+			),
+		)
+		suite.app.LiquidStakeIBCKeeper.SetProposalVote(suite.ctx, &types.ProposalVote{
+			ChainId:    hc.ChainId,
+			ProposalId: proposal.ProposalId,
+			Voter:      voter.address,
+			Options:    voter.options,
+		})
+	}
+
+	tally, power = suite.app.LiquidStakeIBCKeeper.TallyProposalVotes(suite.ctx, hc, proposal)
+	suite.Require().Equal(sdk.NewInt(400), power)
+	suite.Require().Equal(
+		govv1beta1.WeightedVoteOptions{
+			{Option: govv1beta1.OptionYes, Weight: sdk.MustNewDecFromStr("0.875")},
+			{Option: govv1beta1.OptionNo, Weight: sdk.MustNewDecFromStr("0.125")},
+		},
+		tally,
+	)
+	suite.Require().NoError(types.ValidateWeightedVoteOptions(tally))
+}
+
+func (suite *IntegrationTestSuite) TestDoSubmitProposalVotes() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	ctx := suite.ctx.WithBlockTime(time.Unix(1000000, 0))
+
+	proposals := []*types.HostChainProposal{
+		// voting window closed without votes
+		{
+			ChainId:       hc.ChainId,
+			ProposalId:    1,
+			VotingEndTime: ctx.BlockTime().Add(time.Hour),
+			State:         types.HostChainProposal_PROPOSAL_VOTING,
+			TotalPower:    sdk.ZeroInt(),
+		},
+		// host chain voting period ended before submitting
+		{
+			ChainId:       hc.ChainId,
+			ProposalId:    2,
+			VotingEndTime: ctx.BlockTime().Add(-time.Hour),
+			State:         types.HostChainProposal_PROPOSAL_VOTING,
+			TotalPower:    sdk.ZeroInt(),
+		},
+		// voting window still open
+		{
+			ChainId:       hc.ChainId,
+			ProposalId:    3,
+			VotingEndTime: ctx.BlockTime().Add(types.ProposalVoteSubmissionWindow + time.Hour),
+			State:         types.HostChainProposal_PROPOSAL_VOTING,
+			TotalPower:    sdk.ZeroInt(),
+		},
+		// finished more than the retention period ago
+		{
+			ChainId:       hc.ChainId,
+			ProposalId:    4,
+			VotingEndTime: ctx.BlockTime().Add(-types.ProposalRetentionPeriod - time.Hour),
+			State:         types.HostChainProposal_PROPOSAL_SUBMITTED,
+			TotalPower:    sdk.ZeroInt(),
+		},
+	}
+	for _, proposal := range proposals {
+		suite.app.LiquidStakeIBCKeeper.SetHostChainProposal(ctx, proposal)
+	}
+
+	suite.app.LiquidStakeIBCKeeper.DoSubmitProposalVotes(ctx, hc)
+
+	proposal, found := suite.app.LiquidStakeIBCKeeper.GetHostChainProposal(ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.HostChainProposal_PROPOSAL_NO_VOTES, proposal.State)
+	suite.Require().Equal(ctx.BlockHeight(), proposal.TallyHeight)
+
+	proposal, found = suite.app.LiquidStakeIBCKeeper.GetHostChainProposal(ctx, hc.ChainId, 2)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.HostChainProposal_PROPOSAL_FAILED, proposal.State)
+
+	proposal, found = suite.app.LiquidStakeIBCKeeper.GetHostChainProposal(ctx, hc.ChainId, 3)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.HostChainProposal_PROPOSAL_VOTING, proposal.State)
+
+	_, found = suite.app.LiquidStakeIBCKeeper.GetHostChainProposal(ctx, hc.ChainId, 4)
+	suite.Require().Equal(false, found)
+}
+
+func (suite *IntegrationTestSuite) TestProcessHostChainProposal() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	votingEndTime := time.Unix(1000000, 0).UTC()
+
+	// proposals in deposit period are tracked until they start their voting period
+	suite.app.LiquidStakeIBCKeeper.ProcessHostChainProposal(
+		suite.ctx,
+		hc,
+		1,
+		&govv1beta1.Proposal{ProposalId: 1, Status: govv1beta1.StatusDepositPeriod},
+	)
+	proposal, found := suite.app.LiquidStakeIBCKeeper.GetHostChainProposal(suite.ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.HostChainProposal_PROPOSAL_DEPOSIT, proposal.State)
+
+	suite.app.LiquidStakeIBCKeeper.ProcessHostChainProposal(
+		suite.ctx,
+		hc,
+		1,
+		&govv1beta1.Proposal{ProposalId: 1, Status: govv1beta1.StatusVotingPeriod, VotingEndTime: votingEndTime},
+	)
+	proposal, found = suite.app.LiquidStakeIBCKeeper.GetHostChainProposal(suite.ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.HostChainProposal_PROPOSAL_VOTING, proposal.State)
+	suite.Require().Equal(votingEndTime, proposal.VotingEndTime)
+
+	// proposals that never reached their voting period are dropped
+	suite.app.LiquidStakeIBCKeeper.ProcessHostChainProposal(
+		suite.ctx,
+		hc,
+		2,
+		&govv1beta1.Proposal{ProposalId: 2, Status: govv1beta1.StatusDepositPeriod},
+	)
+	suite.app.LiquidStakeIBCKeeper.ProcessHostChainProposal(suite.ctx, hc, 2, nil)
+	_, found = suite.app.LiquidStakeIBCKeeper.GetHostChainProposal(suite.ctx, hc.ChainId, 2)
+	suite.Require().Equal(false, found)
+
+	// finished proposals are not tracked
+	suite.app.LiquidStakeIBCKeeper.ProcessHostChainProposal(
+		suite.ctx,
+		hc,
+		3,
+		&govv1beta1.Proposal{ProposalId: 3, Status: govv1beta1.StatusPassed},
+	)
+	_, found = suite.app.LiquidStakeIBCKeeper.GetHostChainProposal(suite.ctx, hc.ChainId, 3)
+	suite.Require().Equal(false, found)
+
+	// the submission state is updated once the vote ack is received
+	proposal.State = types.HostChainProposal_PROPOSAL_SUBMITTING
+	proposal.IbcSequenceId = "channel-0-sequence-1"
+	suite.app.LiquidStakeIBCKeeper.SetHostChainProposal(suite.ctx, proposal)
+	suite.app.LiquidStakeIBCKeeper.UpdateProposalsForSequenceID(
+		suite.ctx,
+		"channel-0-sequence-1",
+		types.HostChainProposal_PROPOSAL_SUBMITTED,
+	)
+	proposal, found = suite.app.LiquidStakeIBCKeeper.GetHostChainProposal(suite.ctx, hc.ChainId, 1)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.HostChainProposal_PROPOSAL_SUBMITTED, proposal.State)
+	suite.Require().Equal("", proposal.IbcSequenceId)
+}
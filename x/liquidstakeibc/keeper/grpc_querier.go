@@ -75,6 +75,241 @@ func (k *Keeper) Deposits(
 	return &types.QueryDepositsResponse{Deposits: k.GetDepositsForHostChain(ctx, hc.ChainId)}, nil
 }
 
+func (k *Keeper) RewardsHistory(
+	goCtx context.Context,
+	request *types.QueryRewardsHistoryRequest,
+) (*types.QueryRewardsHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	records := k.FilterRewardsRecords(
+		ctx,
+		func(r types.RewardsRecord) bool {
+			return r.ChainId == hc.ChainId
+		},
+	)
+
+	return &types.QueryRewardsHistoryResponse{RewardsRecords: records}, nil
+}
+
+func (k *Keeper) Slashes(
+	goCtx context.Context,
+	request *types.QuerySlashesRequest,
+) (*types.QuerySlashesResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	slashes := k.FilterSlashes(
+		ctx,
+		func(s types.Slash) bool {
+			return s.ChainId == hc.ChainId &&
+				(request.ValidatorAddress == "" || s.ValidatorAddress == request.ValidatorAddress)
+		},
+	)
+
+	return &types.QuerySlashesResponse{Slashes: slashes}, nil
+}
+
+func (k *Keeper) IcaTxs(
+	goCtx context.Context,
+	request *types.QueryIcaTxsRequest,
+) (*types.QueryIcaTxsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	icaTxs := k.FilterICATxs(
+		ctx,
+		func(t types.ICATx) bool {
+			return t.ChainId == hc.ChainId
+		},
+	)
+
+	return &types.QueryIcaTxsResponse{IcaTxs: icaTxs}, nil
+}
+
+func (k *Keeper) IcaTx(
+	goCtx context.Context,
+	request *types.QueryIcaTxRequest,
+) (*types.QueryIcaTxResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if request.SequenceId == "" {
+		return nil, status.Error(codes.InvalidArgument, "sequence_id cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	icaTx, found := k.GetICATx(ctx, request.SequenceId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryIcaTxResponse{IcaTx: icaTx}, nil
+}
+
+func (k *Keeper) LiquidityPool(
+	goCtx context.Context,
+	request *types.QueryLiquidityPoolRequest,
+) (*types.QueryLiquidityPoolResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	pool, _ := k.GetLiquidityPool(ctx, hc.ChainId)
+
+	return &types.QueryLiquidityPoolResponse{
+		Pool:       pool,
+		Available:  k.GetLiquidityPoolAvailable(ctx, hc),
+		Unbonding:  k.GetLiquidityPoolUnbonding(ctx, hc),
+		TotalValue: k.GetLiquidityPoolValue(ctx, hc),
+	}, nil
+}
+
+func (k *Keeper) LiquidityProvider(
+	goCtx context.Context,
+	request *types.QueryLiquidityProviderRequest,
+) (*types.QueryLiquidityProviderResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	provider, found := k.GetLiquidityProvider(ctx, hc.ChainId, request.Address)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	pool, _ := k.GetLiquidityPool(ctx, hc.ChainId)
+
+	return &types.QueryLiquidityProviderResponse{
+		Provider: provider,
+		Value:    k.GetLiquidityProviderValue(ctx, hc, pool, provider.Shares),
+	}, nil
+}
+
+func (k *Keeper) HostChainProposals(
+	goCtx context.Context,
+	request *types.QueryHostChainProposalsRequest,
+) (*types.QueryHostChainProposalsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	proposals := k.FilterHostChainProposals(
+		ctx,
+		func(p types.HostChainProposal) bool {
+			return p.ChainId == hc.ChainId
+		},
+	)
+
+	return &types.QueryHostChainProposalsResponse{Proposals: proposals}, nil
+}
+
+func (k *Keeper) ProposalTally(
+	goCtx context.Context,
+	request *types.QueryProposalTallyRequest,
+) (*types.QueryProposalTallyResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	proposal, found := k.GetHostChainProposal(ctx, hc.ChainId, request.ProposalId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	// use the submitted tally once the voting window closed, otherwise compute it with the current balances
+	tally, totalPower := proposal.Tally, proposal.TotalPower
+	if proposal.State == types.HostChainProposal_PROPOSAL_DEPOSIT ||
+		proposal.State == types.HostChainProposal_PROPOSAL_VOTING {
+		tally, totalPower = k.TallyProposalVotes(ctx, hc, proposal)
+	}
+
+	return &types.QueryProposalTallyResponse{
+		Proposal:   proposal,
+		Tally:      tally,
+		TotalPower: totalPower,
+		Votes:      uint64(len(k.GetVotesForProposal(ctx, hc.ChainId, proposal.ProposalId))),
+	}, nil
+}
+
+func (k *Keeper) LSMDeposits(
+	goCtx context.Context,
+	request *types.QueryLSMDepositsRequest,
+) (*types.QueryLSMDepositsResponse, error) {
+	if request == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	deposits := k.FilterLSMDeposits(
+		ctx,
+		func(d types.LSMDeposit) bool {
+			return d.ChainId == hc.ChainId
+		},
+	)
+
+	return &types.QueryLSMDepositsResponse{Deposits: deposits}, nil
+}
+
 func (k *Keeper) Unbondings(
 	goCtx context.Context,
 	request *types.QueryUnbondingsRequest,
@@ -213,3 +448,109 @@ func (k *Keeper) ExchangeRate(
 
 	return &types.QueryExchangeRateResponse{Rate: hc.CValue}, nil
 }
+
+func (k *Keeper) DelegatorPortfolio(
+	goCtx context.Context,
+	request *types.QueryDelegatorPortfolioRequest,
+) (*types.QueryDelegatorPortfolioResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(request.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	entries := k.GetAddressLedgerEntries(ctx, address.String())
+
+	// only the host chains the address has a position or history on are listed
+	positions := make([]types.PortfolioPosition, 0)
+	for _, hc := range k.GetAllHostChains(ctx) {
+		position := k.GetPortfolioPosition(ctx, hc, address, entries)
+		if position.StkBalance.IsZero() && position.Unbonding.IsZero() &&
+			position.Staked.IsZero() && position.Withdrawn.IsZero() {
+			continue
+		}
+
+		positions = append(positions, position)
+	}
+
+	return &types.QueryDelegatorPortfolioResponse{Positions: positions, Entries: entries}, nil
+}
+
+func (k *Keeper) ExchangeRateHistory(
+	goCtx context.Context,
+	request *types.QueryExchangeRateHistoryRequest,
+) (*types.QueryExchangeRateHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryExchangeRateHistoryResponse{Records: k.GetExchangeRateHistory(ctx, hc.ChainId)}, nil
+}
+
+func (k *Keeper) Apr(
+	goCtx context.Context,
+	request *types.QueryAprRequest,
+) (*types.QueryAprResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, request.ChainId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	window := request.Window
+	if window == 0 {
+		window = types.DefaultAprWindow
+	}
+
+	history := k.GetExchangeRateHistory(ctx, hc.ChainId)
+	if len(history) < 2 {
+		return nil, status.Error(codes.FailedPrecondition, "not enough exchange rate history to compute the apr")
+	}
+
+	// the window is shortened to the available history
+	to := history[len(history)-1]
+	from := history[0]
+	if len(history) > int(window) {
+		from = history[len(history)-1-int(window)]
+	}
+
+	apr, err := types.ComputeApr(from, to)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAprResponse{Apr: apr, From: from, To: to}, nil
+}
+
+func (k *Keeper) Invariants(
+	goCtx context.Context,
+	request *types.QueryInvariantsRequest,
+) (*types.QueryInvariantsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryInvariantsResponse{Invariants: CheckInvariants(ctx, *k)}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -33,6 +34,8 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 					FeeAddress:       "persistence1xruvjju28j0a5ud5325rfdak8f5a04h0s30mld",
 					UpperCValueLimit: decFromStr("1.1"),
 					LowerCValueLimit: decFromStr("0.85"),
+
+					ExchangeRateHistorySize: types.DefaultExchangeRateHistorySize,
 				},
 			},
 		},
@@ -166,6 +169,142 @@ func (suite *IntegrationTestSuite) TestQueryDeposits() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestQueryRewardsHistory() {
+	records := make([]*types.RewardsRecord, 0)
+	for i := 0; i < MultipleTestSize; i += 1 {
+		record := &types.RewardsRecord{
+			ChainId:        suite.path.EndpointB.Chain.ChainID,
+			Epoch:          int64(i),
+			GrossRewards:   sdk.NewCoin(HostDenom, sdk.NewInt(100)),
+			RestakeFee:     sdk.NewCoin(HostDenom, sdk.NewInt(2)),
+			FeeMinted:      sdk.NewCoin(MintDenom, sdk.NewInt(2)),
+			RestakedAmount: sdk.NewCoin(HostDenom, sdk.NewInt(100)),
+		}
+		suite.app.LiquidStakeIBCKeeper.SetRewardsRecord(suite.ctx, record)
+		records = append(records, record)
+	}
+
+	tc := []struct {
+		name string
+		req  *types.QueryRewardsHistoryRequest
+		resp *types.QueryRewardsHistoryResponse
+		err  error
+	}{
+		{
+			name: "Success",
+			req:  &types.QueryRewardsHistoryRequest{ChainId: suite.path.EndpointB.Chain.ChainID},
+			resp: &types.QueryRewardsHistoryResponse{RewardsRecords: records},
+		},
+		{
+			name: "NotFound",
+			req:  &types.QueryRewardsHistoryRequest{ChainId: "chain-1"},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			name: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+
+			resp, err := suite.app.LiquidStakeIBCKeeper.RewardsHistory(suite.ctx, t.req)
+
+			suite.Require().Equal(err, t.err)
+			suite.Require().Equal(resp, t.resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryLiquidityPool() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	pool := &types.LiquidityPool{ChainId: hc.ChainId, TotalShares: sdk.NewInt(1000)}
+	suite.app.LiquidStakeIBCKeeper.SetLiquidityPool(suite.ctx, pool)
+
+	zero := sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt())
+
+	tc := []struct {
+		name string
+		req  *types.QueryLiquidityPoolRequest
+		resp *types.QueryLiquidityPoolResponse
+		err  error
+	}{
+		{
+			name: "Success",
+			req:  &types.QueryLiquidityPoolRequest{ChainId: hc.ChainId},
+			resp: &types.QueryLiquidityPoolResponse{Pool: pool, Available: zero, Unbonding: zero, TotalValue: zero},
+		},
+		{
+			name: "NotFound",
+			req:  &types.QueryLiquidityPoolRequest{ChainId: "chain-1"},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			name: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+
+			resp, err := suite.app.LiquidStakeIBCKeeper.LiquidityPool(suite.ctx, t.req)
+
+			suite.Require().Equal(err, t.err)
+			suite.Require().Equal(resp, t.resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryIcaTx() {
+	icaTx := &types.ICATx{
+		SequenceId: "channel-0-sequence-1",
+		ChainId:    suite.path.EndpointB.Chain.ChainID,
+		Status:     types.ICATx_ICA_TX_PENDING,
+	}
+	suite.app.LiquidStakeIBCKeeper.SetICATx(suite.ctx, icaTx)
+
+	tc := []struct {
+		name string
+		req  *types.QueryIcaTxRequest
+		resp *types.QueryIcaTxResponse
+		err  error
+	}{
+		{
+			name: "Success",
+			req:  &types.QueryIcaTxRequest{SequenceId: "channel-0-sequence-1"},
+			resp: &types.QueryIcaTxResponse{IcaTx: icaTx},
+		},
+		{
+			name: "NotFound",
+			req:  &types.QueryIcaTxRequest{SequenceId: "channel-0-sequence-2"},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			name: "InvalidRequest",
+			req:  &types.QueryIcaTxRequest{SequenceId: ""},
+			err:  status.Error(codes.InvalidArgument, "sequence_id cannot be empty"),
+		},
+		{
+			name: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+
+			resp, err := suite.app.LiquidStakeIBCKeeper.IcaTx(suite.ctx, t.req)
+
+			suite.Require().Equal(err, t.err)
+			suite.Require().Equal(resp, t.resp)
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestQueryUnbondings() {
 	unbondings := make([]*types.Unbonding, 0)
 	for i := 0; i < MultipleTestSize; i += 1 {
@@ -311,3 +450,55 @@ func (suite *IntegrationTestSuite) TestQueryValidatorUnbondings() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestQueryProposalTally() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	tally := govv1beta1.NewNonSplitVoteOption(govv1beta1.OptionNo)
+	proposal := &types.HostChainProposal{
+		ChainId:    hc.ChainId,
+		ProposalId: 1,
+		State:      types.HostChainProposal_PROPOSAL_SUBMITTED,
+		Tally:      tally,
+		TotalPower: sdk.NewInt(100),
+	}
+	suite.app.LiquidStakeIBCKeeper.SetHostChainProposal(suite.ctx, proposal)
+
+	tc := []struct {
+		name string
+		req  *types.QueryProposalTallyRequest
+		resp *types.QueryProposalTallyResponse
+		err  error
+	}{
+		{
+			name: "Success",
+			req:  &types.QueryProposalTallyRequest{ChainId: hc.ChainId, ProposalId: 1},
+			resp: &types.QueryProposalTallyResponse{Proposal: proposal, Tally: tally, TotalPower: sdk.NewInt(100)},
+		},
+		{
+			name: "ProposalNotFound",
+			req:  &types.QueryProposalTallyRequest{ChainId: hc.ChainId, ProposalId: 2},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			name: "ChainNotFound",
+			req:  &types.QueryProposalTallyRequest{ChainId: "chain-1", ProposalId: 1},
+			err:  sdkerrors.ErrKeyNotFound,
+		},
+		{
+			name: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+
+			resp, err := suite.app.LiquidStakeIBCKeeper.ProposalTally(suite.ctx, t.req)
+
+			suite.Require().Equal(err, t.err)
+			suite.Require().Equal(resp, t.resp)
+		})
+	}
+}
@@ -95,6 +95,14 @@ func (k *Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epoch
 func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
 	if epochIdentifier == liquidstakeibctypes.DelegationEpoch {
 		k.DepositWorkflow(ctx, epochNumber)
+		k.LSMDepositWorkflow(ctx, epochNumber)
+		k.RebalanceWorkflow(ctx, epochNumber)
+
+		// remove the finished ica txs that are old enough
+		k.PruneICATxs(ctx)
+
+		// remove the ledger entries past their retention
+		k.PruneLedgerEntries(ctx)
 	}
 
 	if epochIdentifier == liquidstakeibctypes.UndelegationEpoch {
@@ -103,10 +111,16 @@ func (k *Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNum
 		k.ValidatorUndelegationWorkflow(ctx, epochNumber)
 
 		k.UndelegationWorkflow(ctx, epochNumber)
+
+		// undelegate the next batch of the host chains being deregistered
+		k.WindDownWorkflow(ctx, epochNumber)
 	}
 
-	if epochIdentifier == liquidstakeibctypes.RewardsEpochIdentifier {
-		k.RewardsWorkflow(ctx, epochNumber)
+	// every host chain withdraws its rewards on its own rewards epoch
+	k.RewardsWorkflow(ctx, epochIdentifier, epochNumber)
+
+	if epochIdentifier == liquidstakeibctypes.ProposalEpoch {
+		k.ProposalsWorkflow(ctx, epochNumber)
 	}
 
 	return nil
@@ -139,6 +153,15 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 		return err
 	}
 
+	// the transfer memo asks for a module operation on the received tokens
+	memo, err := liquidstakeibctypes.ParseIBCMemo(data.GetMemo())
+	if err != nil {
+		return k.RefundIBCTransfer(ctx, packet, data, err)
+	}
+	if memo != nil {
+		return k.OnRecvIBCMemoTransfer(ctx, packet, data, memo)
+	}
+
 	// if the transfer isn't from any of the registered host chains, return
 	denom := data.GetDenom()
 	hc, found := k.GetHostChainFromHostDenom(ctx, denom)
@@ -146,8 +169,10 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 		return nil
 	}
 
+	_, fromDelegationAccount := hc.GetDelegationAccountByAddress(data.GetSender())
+
 	// the transfer is part of the undelegation process
-	if data.GetSender() == hc.DelegationAccount.Address &&
+	if fromDelegationAccount &&
 		data.GetReceiver() == k.GetUndelegationModuleAccount(ctx).GetAddress().String() &&
 		data.Memo == "" {
 		k.Logger(ctx).Info(
@@ -179,7 +204,7 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 	}
 
 	// the transfer is part of a total validator unbonding
-	if data.GetSender() == hc.DelegationAccount.Address &&
+	if fromDelegationAccount &&
 		data.GetReceiver() == k.GetDepositModuleAccount(ctx).GetAddress().String() &&
 		data.Memo == "" {
 		k.Logger(ctx).Info(
@@ -245,24 +270,37 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 			)
 		}
 
-		// calculate protocol fee
+		// calculate the protocol fee, the whole rewards amount is restaked and the fee is
+		// minted as stk tokens, so it doesn't reduce the amount of tokens backing the stk supply
 		feeAmount := hc.Params.RestakeFee.MulInt(transferAmount)
-		fee, _ := sdk.NewDecCoinFromDec(hc.IBCDenom(), feeAmount).TruncateDecimal()
+		fee, _ := sdk.NewDecCoinFromDec(hc.MintDenom(), feeAmount.Mul(hc.CValue)).TruncateDecimal()
+
+		if fee.IsPositive() {
+			// mint the protocol fee
+			if err := k.bankKeeper.MintCoins(ctx, liquidstakeibctypes.ModuleName, sdk.NewCoins(fee)); err != nil {
+				return errorsmod.Wrapf(
+					liquidstakeibctypes.ErrMintFailed,
+					"failed to mint restake fee %s: %s",
+					fee.String(),
+					err.Error(),
+				)
+			}
 
-		// send the protocol fee
-		err := k.SendProtocolFee(
-			ctx,
-			sdk.NewCoins(fee),
-			liquidstakeibctypes.DepositModuleAccount,
-			k.GetParams(ctx).FeeAddress,
-		)
-		if err != nil {
-			return errorsmod.Wrapf(
-				liquidstakeibctypes.ErrFailedDeposit,
-				"failed to send restake fee to module fee address %s: %s",
+			// send the protocol fee
+			err := k.SendProtocolFee(
+				ctx,
+				sdk.NewCoins(fee),
+				liquidstakeibctypes.ModuleName,
 				k.GetParams(ctx).FeeAddress,
-				err.Error(),
 			)
+			if err != nil {
+				return errorsmod.Wrapf(
+					liquidstakeibctypes.ErrFailedDeposit,
+					"failed to send restake fee to module fee address %s: %s",
+					k.GetParams(ctx).FeeAddress,
+					err.Error(),
+				)
+			}
 		}
 
 		// add the deposit amount to the deposit record for that chain/epoch
@@ -278,8 +316,11 @@ func (k *Keeper) OnRecvIBCTransferPacket(
 		}
 
 		// update the deposit
-		deposit.Amount.Amount = deposit.Amount.Amount.Add(transferAmount.Sub(feeAmount.TruncateInt()))
+		deposit.Amount.Amount = deposit.Amount.Amount.Add(transferAmount)
 		k.SetDeposit(ctx, deposit)
+
+		// account for the rewards and the fee taken
+		k.AddRewardsRecord(ctx, hc, transferAmount, feeAmount.TruncateInt(), fee)
 	}
 
 	return nil
@@ -315,6 +356,26 @@ func (k *Keeper) OnAcknowledgementIBCTransferPacket(
 		return fmt.Errorf("could not parse ibc transfer amount %s", data.Amount)
 	}
 
+	// if the sender is the deposit module account, mark the corresponding lsm deposits as received
+	if data.GetSender() == authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String() {
+		lsmDeposits := k.GetLSMDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence))
+		for _, deposit := range lsmDeposits {
+			deposit.IbcSequenceId = ""
+			deposit.State = liquidstakeibctypes.LSMDeposit_DEPOSIT_RECEIVED
+			k.SetLSMDeposit(ctx, deposit)
+
+			k.Logger(ctx).Info(
+				"Got LSM deposit received ACK.",
+				"host chain",
+				deposit.ChainId,
+				"sequence",
+				packet.Sequence,
+				"channel",
+				packet.SourceChannel,
+			)
+		}
+	}
+
 	// if the sender is the deposit module account, mark the corresponding deposits as received and update the balance
 	if data.GetSender() == authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String() {
 		deposits := k.GetDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence))
@@ -329,9 +390,15 @@ func (k *Keeper) OnAcknowledgementIBCTransferPacket(
 				return fmt.Errorf("host chain with id %s is not registered", deposit.ChainId)
 			}
 
-			hc.DelegationAccount.Balance = hc.DelegationAccount.Balance.Add(
+			// the deposit was sent to the delegation account that will delegate it
+			account, found := hc.GetDelegationAccountByOwner(deposit.DelegationAccountOwner)
+			if !found {
+				return fmt.Errorf("delegation account %s of host chain %s not found", deposit.DelegationAccountOwner, hc.ChainId)
+			}
+
+			account.Balance = account.Balance.Add(
 				sdk.Coin{
-					Denom:  hc.DelegationAccount.Balance.Denom,
+					Denom:  account.Balance.Denom,
 					Amount: transferAmount,
 				},
 			)
@@ -370,6 +437,22 @@ func (k *Keeper) OnTimeoutIBCTransferPacket(
 		return err
 	}
 
+	// revert the state of the lsm deposits that timed out (if any)
+	lsmDeposits := k.GetLSMDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence))
+	if len(lsmDeposits) > 0 {
+		k.RevertLSMDepositsState(ctx, lsmDeposits)
+
+		k.Logger(ctx).Info(
+			"LSM deposit transfer timed out.",
+			"sequence",
+			packet.Sequence,
+			"channel",
+			packet.SourceChannel,
+		)
+
+		return nil
+	}
+
 	// if the transfer doesn't belong to any of the registered host chains, return
 	ibcDenom := ibctransfertypes.ParseDenomTrace(data.GetDenom()).IBCDenom()
 	hc, found := k.GetHostChainFromIbcDenom(ctx, ibcDenom)
@@ -378,8 +461,9 @@ func (k *Keeper) OnTimeoutIBCTransferPacket(
 	}
 
 	// if the transfer is not from deposit module account -> delegation host account, return
+	_, toDelegationAccount := hc.GetDelegationAccountByAddress(data.GetReceiver())
 	if data.GetSender() != authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String() ||
-		data.GetReceiver() != hc.DelegationAccount.Address ||
+		!toDelegationAccount ||
 		data.GetDenom() != ibctransfertypes.GetPrefixedDenom(hc.PortId, hc.ChannelId, hc.HostDenom) {
 		return nil
 	}
@@ -429,7 +513,7 @@ func (k *Keeper) DepositWorkflow(ctx sdk.Context, epoch int64) {
 		}
 
 		// don't do anything if the chain is not active
-		if !hc.Active {
+		if !hc.Active || hc.IsHalted() {
 			continue
 		}
 
@@ -444,12 +528,15 @@ func (k *Keeper) DepositWorkflow(ctx sdk.Context, epoch int64) {
 			clientState.GetLatestHeight().GetRevisionHeight()+liquidstakeibctypes.IBCTimeoutHeightIncrement,
 		)
 
+		// spread the deposits across the delegation accounts, rotating them every epoch
+		account := hc.RotateDelegationAccount(epoch)
+
 		msg := ibctransfertypes.NewMsgTransfer(
 			ibctransfertypes.PortID,
 			hc.ChannelId,
 			deposit.Amount,
 			authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String(),
-			hc.DelegationAccount.Address,
+			account.Address,
 			timeoutHeight,
 			0,
 			"",
@@ -471,17 +558,152 @@ func (k *Keeper) DepositWorkflow(ctx sdk.Context, epoch int64) {
 		}
 
 		deposit.State = liquidstakeibctypes.Deposit_DEPOSIT_SENT
+		deposit.DelegationAccountOwner = account.Owner
 		deposit.IbcSequenceId = k.GetTransactionSequenceID(hc.ChannelId, msgTransferResponse.Sequence)
 		k.SetDeposit(ctx, deposit)
 	}
 }
 
+func (k *Keeper) LSMDepositWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running lsm deposit workflow.", "epoch", epoch)
+
+	deposits := k.FilterLSMDeposits(
+		ctx,
+		func(d liquidstakeibctypes.LSMDeposit) bool {
+			return d.State == liquidstakeibctypes.LSMDeposit_DEPOSIT_PENDING
+		},
+	)
+
+	for _, deposit := range deposits {
+		hc, found := k.GetHostChain(ctx, deposit.ChainId)
+		if !found {
+			// we can't error out here as all the deposits need to be executed
+			continue
+		}
+
+		// don't do anything if the chain is not active
+		if !hc.Active || hc.IsHalted() {
+			continue
+		}
+
+		clientState, err := k.GetClientState(ctx, hc.ConnectionId)
+		if err != nil {
+			// we can't error out here as all the deposits need to be executed
+			continue
+		}
+
+		timeoutHeight := clienttypes.NewHeight(
+			clientState.GetLatestHeight().GetRevisionNumber(),
+			clientState.GetLatestHeight().GetRevisionHeight()+liquidstakeibctypes.IBCTimeoutHeightIncrement,
+		)
+
+		msg := ibctransfertypes.NewMsgTransfer(
+			ibctransfertypes.PortID,
+			hc.ChannelId,
+			sdk.NewCoin(deposit.IbcDenom, deposit.Amount),
+			authtypes.NewModuleAddress(liquidstakeibctypes.DepositModuleAccount).String(),
+			hc.DelegationAccount.Address,
+			timeoutHeight,
+			0,
+			"",
+		)
+
+		handler := k.msgRouter.Handler(msg)
+		res, err := handler(ctx, msg)
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("could not send lsm transfer msg via MsgServiceRouter, error: %s", err))
+			// we can't error out here as all the deposits need to be executed
+			continue
+		}
+		ctx.EventManager().EmitEvents(res.GetEvents())
+
+		var msgTransferResponse ibctransfertypes.MsgTransferResponse
+		if err = k.cdc.Unmarshal(res.MsgResponses[0].Value, &msgTransferResponse); err != nil {
+			// we can't error out here as all the deposits need to be executed
+			continue
+		}
+
+		deposit.State = liquidstakeibctypes.LSMDeposit_DEPOSIT_SENT
+		deposit.IbcSequenceId = k.GetTransactionSequenceID(hc.ChannelId, msgTransferResponse.Sequence)
+		k.SetLSMDeposit(ctx, deposit)
+	}
+}
+
+func (k *Keeper) RebalanceWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running rebalance workflow.", "epoch", epoch)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		// don't do anything if the chain is not active
+		if !hc.Active || hc.IsHalted() {
+			continue
+		}
+
+		// remove the redelegations that already completed on the host chain
+		k.DeleteMatureRedelegations(ctx, hc.ChainId)
+
+		// rebalancing is disabled or it is not a rebalancing epoch for the host chain, continue
+		if hc.RebalanceFactor == 0 || epoch%hc.RebalanceFactor != 0 {
+			continue
+		}
+
+		redelegations := k.FilterRedelegations(
+			ctx,
+			func(r liquidstakeibctypes.Redelegation) bool {
+				return r.ChainId == hc.ChainId
+			},
+		)
+
+		// generate the redelegation messages based on the validator weight drift
+		messages, err := k.GenerateRedelegateMessages(hc, redelegations)
+		if err != nil {
+			k.Logger(ctx).Info(
+				"No redelegations to rebalance the host chain.",
+				"host_chain",
+				hc.ChainId,
+			)
+			continue
+		}
+
+		// execute the ICA transactions
+		sequenceID, err := k.GenerateAndExecuteICATx(
+			ctx,
+			hc.ConnectionId,
+			hc.DelegationAccount.Owner,
+			messages,
+		)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"could not send ICA redelegate txs",
+				"host_chain",
+				hc.ChainId,
+			)
+			continue
+		}
+
+		// track the in-flight redelegations, so their validators are not redelegated again until they mature
+		for _, message := range messages {
+			msgRedelegate := message.(*stakingtypes.MsgBeginRedelegate)
+			k.SetRedelegation(
+				ctx,
+				&liquidstakeibctypes.Redelegation{
+					ChainId:             hc.ChainId,
+					SrcValidatorAddress: msgRedelegate.ValidatorSrcAddress,
+					DstValidatorAddress: msgRedelegate.ValidatorDstAddress,
+					Amount:              msgRedelegate.Amount,
+					IbcSequenceId:       sequenceID,
+					State:               liquidstakeibctypes.Redelegation_REDELEGATION_INITIATED,
+				},
+			)
+		}
+	}
+}
+
 func (k *Keeper) UndelegationWorkflow(ctx sdk.Context, epoch int64) {
 	k.Logger(ctx).Info("Running undelegation workflow.", "epoch", epoch)
 
 	for _, hc := range k.GetAllHostChains(ctx) {
 		// don't do anything if the chain is not active
-		if !hc.Active {
+		if !hc.Active || hc.IsHalted() {
 			continue
 		}
 
@@ -513,8 +735,21 @@ func (k *Keeper) UndelegationWorkflow(ctx sdk.Context, epoch int64) {
 			continue
 		}
 
+		// pick the delegation account that will undelegate the whole unbonding amount for the epoch
+		account, found := k.GetUndelegationAccount(hc, epoch, unbonding.UnbondAmount.Amount)
+		if !found {
+			k.Logger(ctx).Error(
+				"no delegation account can undelegate the unbonding amount",
+				"host_chain",
+				hc.ChainId,
+				"amount",
+				unbonding.UnbondAmount,
+			)
+			continue
+		}
+
 		// generate the undelegation messages based on the total unbonding amount for the epoch
-		messages, err := k.GenerateUndelegateMessages(hc, unbonding.UnbondAmount.Amount)
+		messages, err := k.GenerateUndelegateMessages(hc.DelegationAccountView(account), unbonding.UnbondAmount.Amount)
 		if err != nil {
 			k.Logger(ctx).Error(
 				"could not generate undelegate messages",
@@ -528,7 +763,7 @@ func (k *Keeper) UndelegationWorkflow(ctx sdk.Context, epoch int64) {
 		sequenceID, err := k.GenerateAndExecuteICATx(
 			ctx,
 			hc.ConnectionId,
-			hc.DelegationAccount.Owner,
+			account.Owner,
 			messages,
 		)
 		if err != nil {
@@ -540,19 +775,38 @@ func (k *Keeper) UndelegationWorkflow(ctx sdk.Context, epoch int64) {
 			return
 		}
 
-		// update the unbonding ibc sequence id and state
+		// update the unbonding ibc sequence id, state and undelegating account
 		unbonding.IbcSequenceId = sequenceID
 		unbonding.State = liquidstakeibctypes.Unbonding_UNBONDING_INITIATED
+		unbonding.DelegationAccountOwner = account.Owner
 		k.SetUnbonding(ctx, unbonding)
 	}
 }
 
+// GetUndelegationAccount rotates the delegation accounts every unbonding epoch, returning the first one starting
+// from the rotation index that has enough delegations to undelegate the amount
+func (k *Keeper) GetUndelegationAccount(
+	hc *liquidstakeibctypes.HostChain,
+	epoch int64,
+	amount sdk.Int, //nolint:staticcheck
+) (*liquidstakeibctypes.ICAAccount, bool) {
+	accounts := hc.GetActiveDelegationAccounts()
+	for i := range accounts {
+		account := accounts[(epoch/hc.UnbondingFactor+int64(i))%int64(len(accounts))]
+		if hc.GetAccountTotalDelegations(account).GTE(amount) {
+			return account, true
+		}
+	}
+
+	return nil, false
+}
+
 func (k *Keeper) ValidatorUndelegationWorkflow(ctx sdk.Context, epoch int64) {
 	k.Logger(ctx).Info("Running validator undelegation workflow.", "epoch", epoch)
 
 	for _, hc := range k.GetAllHostChains(ctx) {
 		// don't do anything if the chain is not active
-		if !hc.Active {
+		if !hc.Active || hc.IsHalted() {
 			continue
 		}
 
@@ -566,18 +820,36 @@ func (k *Keeper) ValidatorUndelegationWorkflow(ctx sdk.Context, epoch int64) {
 			if validator.UnbondingEpoch > 0 &&
 				validator.UnbondingEpoch+liquidstakeibctypes.UnbondingStateEpochLimit <= epoch {
 
-				// unbond all delegated tokens from the validator
+				// unbond the validator delegations one delegation account at a time
+				var account *liquidstakeibctypes.ICAAccount
+				for _, delegationAccount := range hc.GetActiveDelegationAccounts() {
+					if hc.GetAccountDelegatedAmount(delegationAccount, validator.OperatorAddress).IsPositive() {
+						account = delegationAccount
+						break
+					}
+				}
+
+				// nothing left to unbond from the validator, just make sure it has no weight
+				if account == nil {
+					if validator.Weight.IsPositive() {
+						k.RedistributeValidatorWeight(ctx, hc, validator)
+					}
+					continue
+				}
+
+				// unbond all delegated tokens of the account from the validator
 				validatorUnbonding := &liquidstakeibctypes.ValidatorUnbonding{
-					ChainId:          hc.ChainId,
-					EpochNumber:      epoch,
-					MatureTime:       time.Time{},
-					ValidatorAddress: validator.OperatorAddress,
-					Amount:           sdk.NewCoin(hc.HostDenom, validator.DelegatedAmount),
+					ChainId:                hc.ChainId,
+					EpochNumber:            epoch,
+					MatureTime:             time.Time{},
+					ValidatorAddress:       validator.OperatorAddress,
+					Amount:                 sdk.NewCoin(hc.HostDenom, hc.GetAccountDelegatedAmount(account, validator.OperatorAddress)),
+					DelegationAccountOwner: account.Owner,
 				}
 
 				// create the MsgUndelegate
 				message := &stakingtypes.MsgUndelegate{
-					DelegatorAddress: hc.DelegationAccount.Address,
+					DelegatorAddress: account.Address,
 					ValidatorAddress: validatorUnbonding.ValidatorAddress,
 					Amount:           validatorUnbonding.Amount,
 				}
@@ -586,7 +858,7 @@ func (k *Keeper) ValidatorUndelegationWorkflow(ctx sdk.Context, epoch int64) {
 				sequenceID, err := k.GenerateAndExecuteICATx(
 					ctx,
 					hc.ConnectionId,
-					hc.DelegationAccount.Owner,
+					account.Owner,
 					[]proto.Message{message},
 				)
 				if err != nil {
@@ -603,7 +875,9 @@ func (k *Keeper) ValidatorUndelegationWorkflow(ctx sdk.Context, epoch int64) {
 				k.SetValidatorUnbonding(ctx, validatorUnbonding)
 
 				// redistribute the unbonding validator weight among all the other validators with weight
-				k.RedistributeValidatorWeight(ctx, hc, validator)
+				if validator.Weight.IsPositive() {
+					k.RedistributeValidatorWeight(ctx, hc, validator)
+				}
 
 				k.Logger(ctx).Info(
 					"Started total validator unbonding.",
@@ -621,42 +895,46 @@ func (k *Keeper) ValidatorUndelegationWorkflow(ctx sdk.Context, epoch int64) {
 	}
 }
 
-func (k *Keeper) RewardsWorkflow(ctx sdk.Context, epoch int64) {
-	k.Logger(ctx).Info("Running rewards workflow.", "epoch", epoch)
-
+func (k *Keeper) RewardsWorkflow(ctx sdk.Context, epochIdentifier string, epoch int64) {
 	for _, hc := range k.GetAllHostChains(ctx) {
-		// don't do anything if the chain is not active
-		if !hc.Active {
+		// don't do anything if the chain is not active or its rewards epoch didn't end
+		if !hc.Active || hc.IsHalted() || hc.GetRewardsEpochIdentifier() != epochIdentifier {
 			continue
 		}
 
-		// generate the messages
-		messages := make([]proto.Message, 0)
-		for _, validator := range hc.Validators {
-			if validator.DelegatedAmount.GT(sdk.ZeroInt()) {
-				message := &distributiontypes.MsgWithdrawDelegatorReward{
-					DelegatorAddress: hc.DelegationAccount.Address,
-					ValidatorAddress: validator.OperatorAddress,
+		k.Logger(ctx).Info("Running rewards workflow.", "host_chain", hc.ChainId, "epoch", epoch)
+
+		// withdraw the rewards of every delegation account
+		for _, account := range hc.GetActiveDelegationAccounts() {
+			// if there is a claim threshold, estimate the pending rewards before withdrawing them
+			if hc.GetRewardsClaimThreshold().IsPositive() {
+				if err := k.QueryDelegationTotalRewards(ctx, hc, account); err != nil {
+					k.Logger(ctx).Error(
+						"Could not send delegation rewards ICQ",
+						"host_chain",
+						hc.ChainId,
+						"delegation_account",
+						account.Owner,
+					)
 				}
-				messages = append(messages, message)
+				continue
 			}
-		}
 
-		if len(messages) > 0 {
-			// execute the ICA transactions
-			_, err := k.GenerateAndExecuteICATx(
-				ctx,
-				hc.ConnectionId,
-				hc.DelegationAccount.Owner,
-				messages,
-			)
-			if err != nil {
+			validators := make([]string, 0)
+			for _, validator := range hc.Validators {
+				if hc.GetAccountDelegatedAmount(account, validator.OperatorAddress).GT(sdk.ZeroInt()) {
+					validators = append(validators, validator.OperatorAddress)
+				}
+			}
+
+			if err := k.WithdrawDelegationRewards(ctx, hc, account, validators); err != nil {
 				k.Logger(ctx).Error(
 					"Could not send ICA withdraw delegator reward txs",
 					"host_chain",
 					hc.ChainId,
+					"delegation_account",
+					account.Owner,
 				)
-				continue
 			}
 		}
 
@@ -673,3 +951,80 @@ func (k *Keeper) RewardsWorkflow(ctx sdk.Context, epoch int64) {
 		}
 	}
 }
+
+// WithdrawDelegationRewards withdraws the rewards of a delegation account from a set of validators,
+// splitting the messages in as many ICA txs as the host chain max messages per tx requires
+func (k *Keeper) WithdrawDelegationRewards(
+	ctx sdk.Context,
+	hc *liquidstakeibctypes.HostChain,
+	account *liquidstakeibctypes.ICAAccount,
+	validators []string,
+) error {
+	messages := make([]proto.Message, 0, len(validators))
+	for _, validator := range validators {
+		messages = append(messages, &distributiontypes.MsgWithdrawDelegatorReward{
+			DelegatorAddress: account.Address,
+			ValidatorAddress: validator,
+		})
+	}
+
+	maxMsgs := int(hc.GetRewardsMaxMsgsPerTx())
+	if maxMsgs == 0 {
+		maxMsgs = len(messages)
+	}
+
+	for start := 0; start < len(messages); start += maxMsgs {
+		end := start + maxMsgs
+		if end > len(messages) {
+			end = len(messages)
+		}
+
+		if _, err := k.GenerateAndExecuteICATx(ctx, hc.ConnectionId, account.Owner, messages[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k *Keeper) ProposalsWorkflow(ctx sdk.Context, epoch int64) {
+	k.Logger(ctx).Info("Running proposals workflow.", "epoch", epoch)
+
+	for _, hc := range k.GetAllHostChains(ctx) {
+		// don't do anything if the chain is not active or proposals are not tracked
+		if !hc.Active || hc.IsHalted() || hc.NextProposalId == 0 {
+			continue
+		}
+
+		// look for new proposals on the host chain
+		if err := k.QueryHostChainProposal(ctx, hc, hc.NextProposalId); err != nil {
+			k.Logger(ctx).Error(
+				"Could not send host chain proposal ICQ",
+				"host_chain",
+				hc.ChainId,
+				"proposal",
+				hc.NextProposalId,
+			)
+			continue
+		}
+
+		// check if the proposals in deposit period reached their voting period
+		proposals := k.FilterHostChainProposals(
+			ctx,
+			func(p liquidstakeibctypes.HostChainProposal) bool {
+				return p.ChainId == hc.ChainId && p.State == liquidstakeibctypes.HostChainProposal_PROPOSAL_DEPOSIT
+			},
+		)
+		for _, proposal := range proposals {
+			if err := k.QueryHostChainProposal(ctx, hc, proposal.ProposalId); err != nil {
+				k.Logger(ctx).Error(
+					"Could not send host chain proposal ICQ",
+					"host_chain",
+					hc.ChainId,
+					"proposal",
+					proposal.ProposalId,
+				)
+			}
+		}
+	}
+}
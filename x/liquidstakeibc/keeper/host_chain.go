@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -17,6 +18,12 @@ func (k *Keeper) SetHostChain(ctx sdk.Context, hc *types.HostChain) {
 	store.Set([]byte(hc.ChainId), bytes)
 }
 
+// DeleteHostChain removes a host chain from the store
+func (k *Keeper) DeleteHostChain(ctx sdk.Context, hc *types.HostChain) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainKey)
+	store.Delete([]byte(hc.ChainId))
+}
+
 // SetHostChainValidator sets a validator on the target host chain
 func (k *Keeper) SetHostChainValidator(
 	ctx sdk.Context,
@@ -48,17 +55,21 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 	val, found := hc.GetValidator(validator.OperatorAddress)
 
 	if !found {
-		hc.Validators = append(
-			hc.Validators,
-			&types.Validator{
-				OperatorAddress: validator.OperatorAddress,
-				Status:          validator.Status.String(),
-				Weight:          sdk.ZeroDec(),
-				DelegatedAmount: sdk.ZeroInt(),
-				TotalAmount:     validator.Tokens,
-				DelegatorShares: validator.DelegatorShares,
-			},
-		)
+		val = &types.Validator{
+			OperatorAddress: validator.OperatorAddress,
+			Status:          validator.Status.String(),
+			Weight:          sdk.ZeroDec(),
+			DelegatedAmount: sdk.ZeroInt(),
+			TotalAmount:     validator.Tokens,
+			DelegatorShares: validator.DelegatorShares,
+			CommissionRate:  validator.Commission.Rate,
+			Jailed:          validator.Jailed,
+		}
+		if validator.Jailed {
+			val.JailedCount = 1
+		}
+
+		hc.Validators = append(hc.Validators, val)
 		k.SetHostChain(ctx, hc)
 	} else {
 		if validator.Status.String() != val.Status {
@@ -93,19 +104,83 @@ func (k *Keeper) ProcessHostChainValidatorUpdates(
 			val.TotalAmount = validator.Tokens
 			k.SetHostChainValidator(ctx, hc, val)
 		}
+		if !validator.Commission.Rate.IsNil() &&
+			(val.CommissionRate.IsNil() || !validator.Commission.Rate.Equal(val.CommissionRate)) {
+			val.CommissionRate = validator.Commission.Rate
+			k.SetHostChainValidator(ctx, hc, val)
+		}
+		if validator.Jailed != val.Jailed {
+			if validator.Jailed {
+				val.JailedCount++
+			}
+
+			val.Jailed = validator.Jailed
+			k.SetHostChainValidator(ctx, hc, val)
+		}
 	}
 
+	k.EnforceValidatorEligibility(ctx, hc, val)
+
 	return nil
 }
 
+// EnforceValidatorEligibility removes the weight of a validator that doesn't meet the host chain eligibility rules
+func (k *Keeper) EnforceValidatorEligibility(ctx sdk.Context, hc *types.HostChain, validator *types.Validator) {
+	if validator.Weight.IsNil() || !validator.Weight.IsPositive() {
+		return
+	}
+
+	reason := hc.GetValidatorIneligibility(validator)
+	if reason == "" {
+		return
+	}
+
+	k.Logger(ctx).Info(
+		"Removing the weight of an ineligible validator.",
+		"host_chain", hc.ChainId,
+		"validator", validator.OperatorAddress,
+		"reason", reason,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorIneligible,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
+			sdk.NewAttribute(types.AttributeIneligibilityReason, reason),
+		),
+	)
+
+	k.RedistributeValidatorWeight(ctx, hc, validator)
+}
+
 func (k *Keeper) RedistributeValidatorWeight(ctx sdk.Context, hc *types.HostChain, validator *types.Validator) {
 	validatorsWithWeight := make([]*types.Validator, 0)
 	for _, val := range hc.Validators {
-		if val.Weight.GT(sdk.ZeroDec()) && val.OperatorAddress != validator.OperatorAddress {
+		if val.Weight.GT(sdk.ZeroDec()) && val.OperatorAddress != validator.OperatorAddress &&
+			hc.GetValidatorIneligibility(val) == "" {
 			validatorsWithWeight = append(validatorsWithWeight, val)
 		}
 	}
 
+	// the last validator with weight keeps it, otherwise the deposits would have nowhere to be delegated to
+	if len(validatorsWithWeight) == 0 {
+		k.Logger(ctx).Error(
+			"Could not redistribute the weight of the last validator with weight.",
+			"host_chain", hc.ChainId,
+			"validator", validator.OperatorAddress,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidatorWeightKept,
+				sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+				sdk.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
+			),
+		)
+		return
+	}
+
 	weightDiff := validator.Weight.Quo(sdk.NewDec(int64(len(validatorsWithWeight))))
 	for _, val := range validatorsWithWeight {
 		val.Weight = val.Weight.Add(weightDiff)
@@ -189,7 +264,30 @@ func (k *Keeper) GetHostChainFromHostDenom(ctx sdk.Context, hostDenom string) (*
 	return &hc, found
 }
 
-// GetHostChainFromDelegatorAddress returns a host chain given its delegator address
+// GetHostChainFromMintDenom returns a host chain given its stk denomination
+func (k *Keeper) GetHostChainFromMintDenom(ctx sdk.Context, mintDenom string) (*types.HostChain, bool) {
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if hc.MintDenom() == mintDenom {
+			return hc, true
+		}
+	}
+
+	return &types.HostChain{}, false
+}
+
+// GetHostChainFromLSMDenomPath returns a host chain and the host chain tokenized share denom
+// given the denom trace path of a tokenized share on Persistence
+func (k *Keeper) GetHostChainFromLSMDenomPath(ctx sdk.Context, path string) (*types.HostChain, string, bool) {
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if denom, found := hc.GetLSMTokenDenom(path); found {
+			return hc, denom, true
+		}
+	}
+
+	return &types.HostChain{}, "", false
+}
+
+// GetHostChainFromDelegatorAddress returns a host chain given the address of any of its delegation accounts
 func (k *Keeper) GetHostChainFromDelegatorAddress(ctx sdk.Context, delegatorAddress string) (*types.HostChain, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.HostChainKey)
 	iterator := sdk.KVStorePrefixIterator(store, nil)
@@ -201,7 +299,7 @@ func (k *Keeper) GetHostChainFromDelegatorAddress(ctx sdk.Context, delegatorAddr
 		chain := types.HostChain{}
 		k.cdc.MustUnmarshal(iterator.Value(), &chain)
 
-		if chain.DelegationAccount != nil && chain.DelegationAccount.Address == delegatorAddress {
+		if _, isDelegator := chain.GetDelegationAccountByAddress(delegatorAddress); isDelegator {
 			hc = chain
 			found = true
 			break
@@ -226,6 +324,11 @@ func (k *Keeper) UpdateHostChainValidatorWeight(
 	found := false
 	for i, validator := range hc.Validators {
 		if validator.OperatorAddress == address {
+			// ineligible validators can only be given a zero weight
+			if reason := hc.GetValidatorIneligibility(validator); reason != "" && newWeight.IsPositive() {
+				return errorsmod.Wrapf(types.ErrValidatorIneligible, "validator %s: %s", address, reason)
+			}
+
 			hc.Validators[i].Weight = newWeight
 			found = true
 			break
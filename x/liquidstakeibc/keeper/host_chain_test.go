@@ -240,6 +240,58 @@ func (suite *IntegrationTestSuite) TestRedistributeValidatorWeight() {
 				"valoper4": decFromStr("0.35"),
 			},
 		},
+		{
+			name: "IneligibleValidatorsSkipped",
+			hc: &types.HostChain{
+				ChainId:           hcs[0].ChainId,
+				EligibilityParams: &types.HostChainEligibilityParams{Denylist: []string{"valoper3"}},
+			},
+			hcValidators: []*types.Validator{
+				{
+					OperatorAddress: "valoper1",
+					Weight:          decFromStr("0.6"),
+				},
+				{
+					OperatorAddress: "valoper2",
+					Weight:          decFromStr("0.2"),
+				},
+				{
+					OperatorAddress: "valoper3",
+					Weight:          decFromStr("0.2"),
+				},
+			},
+			validator: &types.Validator{
+				OperatorAddress: "valoper1",
+				Weight:          decFromStr("0.6"),
+			},
+			expected: map[string]sdk.Dec{
+				"valoper1": decFromStr("0"),
+				"valoper2": decFromStr("0.8"),
+				"valoper3": decFromStr("0.2"),
+			},
+		},
+		{
+			name: "LastValidatorWithWeight",
+			hc:   hcs[0],
+			hcValidators: []*types.Validator{
+				{
+					OperatorAddress: "valoper1",
+					Weight:          decFromStr("1"),
+				},
+				{
+					OperatorAddress: "valoper2",
+					Weight:          decFromStr("0"),
+				},
+			},
+			validator: &types.Validator{
+				OperatorAddress: "valoper1",
+				Weight:          decFromStr("1"),
+			},
+			expected: map[string]sdk.Dec{
+				"valoper1": decFromStr("1"),
+				"valoper2": decFromStr("0"),
+			},
+		},
 	}
 
 	for _, t := range tc {
@@ -257,6 +309,141 @@ func (suite *IntegrationTestSuite) TestRedistributeValidatorWeight() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestEnforceValidatorEligibility() {
+	hcs := suite.app.LiquidStakeIBCKeeper.GetAllHostChains(suite.ctx)
+
+	tc := []struct {
+		name      string
+		params    *types.HostChainEligibilityParams
+		validator stakingtypes.Validator
+		expected  map[string]sdk.Dec
+		reason    string
+	}{
+		{
+			name: "Eligible",
+			params: &types.HostChainEligibilityParams{
+				MaxCommission:       decFromStr("0.1"),
+				MaxVotingPowerShare: sdk.ZeroDec(),
+			},
+			validator: stakingtypes.Validator{
+				OperatorAddress: "valoper1",
+				Status:          stakingtypes.Bonded,
+				Tokens:          sdk.NewInt(100),
+				Commission:      stakingtypes.Commission{CommissionRates: stakingtypes.CommissionRates{Rate: decFromStr("0.05")}},
+			},
+			expected: map[string]sdk.Dec{
+				"valoper1": decFromStr("0.5"),
+				"valoper2": decFromStr("0.5"),
+			},
+		},
+		{
+			name: "CommissionTooHigh",
+			params: &types.HostChainEligibilityParams{
+				MaxCommission:       decFromStr("0.1"),
+				MaxVotingPowerShare: sdk.ZeroDec(),
+			},
+			validator: stakingtypes.Validator{
+				OperatorAddress: "valoper1",
+				Status:          stakingtypes.Bonded,
+				Tokens:          sdk.NewInt(100),
+				Commission:      stakingtypes.Commission{CommissionRates: stakingtypes.CommissionRates{Rate: decFromStr("0.2")}},
+			},
+			expected: map[string]sdk.Dec{
+				"valoper1": sdk.ZeroDec(),
+				"valoper2": sdk.OneDec(),
+			},
+			reason: types.IneligibleCommission,
+		},
+		{
+			name: "JailedTooOften",
+			params: &types.HostChainEligibilityParams{
+				MaxCommission:       sdk.ZeroDec(),
+				MaxJailedCount:      1,
+				MaxVotingPowerShare: sdk.ZeroDec(),
+			},
+			validator: stakingtypes.Validator{
+				OperatorAddress: "valoper1",
+				Status:          stakingtypes.Bonded,
+				Tokens:          sdk.NewInt(100),
+				Jailed:          true,
+				Commission:      stakingtypes.Commission{CommissionRates: stakingtypes.CommissionRates{Rate: decFromStr("0.05")}},
+			},
+			expected: map[string]sdk.Dec{
+				"valoper1": sdk.ZeroDec(),
+				"valoper2": sdk.OneDec(),
+			},
+			reason: types.IneligibleJailedCount,
+		},
+		{
+			name: "Denylisted",
+			params: &types.HostChainEligibilityParams{
+				MaxCommission:       sdk.ZeroDec(),
+				MaxVotingPowerShare: sdk.ZeroDec(),
+				Denylist:            []string{"valoper1"},
+			},
+			validator: stakingtypes.Validator{
+				OperatorAddress: "valoper1",
+				Status:          stakingtypes.Bonded,
+				Tokens:          sdk.NewInt(100),
+				Commission:      stakingtypes.Commission{CommissionRates: stakingtypes.CommissionRates{Rate: decFromStr("0.05")}},
+			},
+			expected: map[string]sdk.Dec{
+				"valoper1": sdk.ZeroDec(),
+				"valoper2": sdk.OneDec(),
+			},
+			reason: types.IneligibleDenylisted,
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+
+			hc := *hcs[0]
+			hc.EligibilityParams = t.params
+			hc.Validators = []*types.Validator{
+				{
+					OperatorAddress: "valoper1",
+					Status:          stakingtypes.BondStatusBonded,
+					Weight:          decFromStr("0.5"),
+					TotalAmount:     sdk.NewInt(100),
+					DelegatedAmount: sdk.ZeroInt(),
+					CommissionRate:  decFromStr("0.05"),
+					JailedCount:     1,
+				},
+				{
+					OperatorAddress: "valoper2",
+					Status:          stakingtypes.BondStatusBonded,
+					Weight:          decFromStr("0.5"),
+					TotalAmount:     sdk.NewInt(100),
+					DelegatedAmount: sdk.ZeroInt(),
+					CommissionRate:  decFromStr("0.05"),
+				},
+			}
+
+			err := suite.app.LiquidStakeIBCKeeper.ProcessHostChainValidatorUpdates(ctx, &hc, t.validator)
+			suite.Require().NoError(err)
+
+			for _, validator := range hc.Validators {
+				suite.Require().Equal(t.expected[validator.OperatorAddress], validator.Weight)
+			}
+
+			var reason string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeValidatorIneligible {
+					continue
+				}
+				for _, attribute := range event.Attributes {
+					if attribute.Key == types.AttributeIneligibilityReason {
+						reason = attribute.Value
+					}
+				}
+			}
+			suite.Require().Equal(t.reason, reason)
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestGetAllHostChains() {
 	hostChains := suite.app.LiquidStakeIBCKeeper.GetAllHostChains(suite.ctx)
 
@@ -402,6 +589,42 @@ func (suite *IntegrationTestSuite) TestUpdateHostChainValidatorWeight() {
 			validatorWeight:  "weight",
 			success:          false,
 		},
+		{
+			name: "IneligibleValidator",
+			hc: types.HostChain{
+				ChainId:           suite.path.EndpointB.Chain.ChainID,
+				EligibilityParams: &types.HostChainEligibilityParams{Denylist: []string{"valoper1"}},
+				Validators: []*types.Validator{
+					{
+						OperatorAddress: "valoper1",
+						Status:          stakingtypes.BondStatusBonded,
+						Weight:          sdk.ZeroDec(),
+						DelegatedAmount: sdk.NewInt(100),
+					},
+				},
+			},
+			validatorAddress: "valoper1",
+			validatorWeight:  "0.5",
+			success:          false,
+		},
+		{
+			name: "IneligibleValidatorZeroWeight",
+			hc: types.HostChain{
+				ChainId:           suite.path.EndpointB.Chain.ChainID,
+				EligibilityParams: &types.HostChainEligibilityParams{Denylist: []string{"valoper1"}},
+				Validators: []*types.Validator{
+					{
+						OperatorAddress: "valoper1",
+						Status:          stakingtypes.BondStatusBonded,
+						Weight:          sdk.OneDec(),
+						DelegatedAmount: sdk.NewInt(100),
+					},
+				},
+			},
+			validatorAddress: "valoper1",
+			validatorWeight:  "0",
+			success:          true,
+		},
 	}
 
 	for _, t := range tc {
@@ -424,3 +647,63 @@ func (suite *IntegrationTestSuite) TestUpdateHostChainValidatorWeight() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestGetUndelegationAccount() {
+	hc := &types.HostChain{
+		UnbondingFactor: 4,
+		DelegationAccount: &types.ICAAccount{
+			Owner:        types.DelegateAccountPortOwner(suite.path.EndpointB.Chain.ChainID, 0),
+			ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+		},
+		AdditionalDelegationAccounts: []*types.ICAAccount{
+			{
+				Owner:        types.DelegateAccountPortOwner(suite.path.EndpointB.Chain.ChainID, 1),
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+				Delegations:  []*types.AccountDelegation{{ValidatorAddress: TestAddress, Amount: sdk.NewInt(40)}},
+			},
+		},
+		Validators: []*types.Validator{{OperatorAddress: TestAddress, DelegatedAmount: sdk.NewInt(100)}},
+	}
+
+	tc := []struct {
+		name   string
+		epoch  int64
+		amount sdk.Int
+		owner  string
+		found  bool
+	}{
+		{
+			name:   "AdditionalAccount",
+			epoch:  4,
+			amount: sdk.NewInt(40),
+			owner:  hc.AdditionalDelegationAccounts[0].Owner,
+		},
+		{
+			name:   "RotatedAccount",
+			epoch:  8,
+			amount: sdk.NewInt(40),
+			owner:  hc.DelegationAccount.Owner,
+		},
+		{
+			name:   "SkipsAccountWithoutEnoughDelegations",
+			epoch:  4,
+			amount: sdk.NewInt(50),
+			owner:  hc.DelegationAccount.Owner,
+		},
+		{
+			name:   "NotEnoughDelegations",
+			epoch:  4,
+			amount: sdk.NewInt(70),
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			account, found := suite.app.LiquidStakeIBCKeeper.GetUndelegationAccount(hc, t.epoch, t.amount)
+			suite.Require().Equal(t.owner != "", found)
+			if found {
+				suite.Require().Equal(t.owner, account.Owner)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
@@ -79,6 +80,13 @@ func (k *Keeper) OnChanOpenAck(
 		hc.DelegationAccount = icaAccount
 	case portOwner == hc.RewardsAccount.Owner:
 		hc.RewardsAccount = icaAccount
+	case hc.IsAdditionalDelegationAccountOwner(portOwner):
+		account, _ := hc.GetDelegationAccountByOwner(portOwner)
+		account.Address = icaAccount.Address
+		account.ChannelState = icaAccount.ChannelState
+		if account.Balance.Denom == "" {
+			account.Balance = icaAccount.Balance
+		}
 	default:
 		k.Logger(ctx).Error("Unrecognised ICA account type for the module", "port-id:", portID, "chain-id", chainID)
 		return nil
@@ -87,8 +95,8 @@ func (k *Keeper) OnChanOpenAck(
 	// save the changes of the host chain
 	k.SetHostChain(ctx, hc)
 
-	// send an ICQ query to get the delegator account balance
-	if hc.DelegationAccount != nil && hc.DelegationAccount.ChannelState == types.ICAAccount_ICA_CHANNEL_CREATED {
+	// send an ICQ query to get the delegator accounts balances
+	if len(hc.GetActiveDelegationAccounts()) > 0 {
 		if err := k.QueryDelegationHostChainAccountBalance(ctx, hc); err != nil {
 			return fmt.Errorf(
 				"error querying host chain %s for delegation account balances: %v",
@@ -135,6 +143,12 @@ func (k *Keeper) OnAcknowledgementPacket(
 		if err != nil {
 			return err
 		}
+		k.UpdateICATxStatus(
+			ctx,
+			k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence),
+			types.ICATx_ICA_TX_FAILED,
+			resp.Error,
+		)
 		k.Logger(ctx).Info(fmt.Sprintln("ICS-27 tx failed with ack:", ack.String()))
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -147,6 +161,12 @@ func (k *Keeper) OnAcknowledgementPacket(
 		if err != nil {
 			return err
 		}
+		k.UpdateICATxStatus(
+			ctx,
+			k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence),
+			types.ICATx_ICA_TX_SUCCEEDED,
+			"",
+		)
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypePacket,
@@ -180,8 +200,22 @@ func (k *Keeper) OnTimeoutPacket(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 tx message data: %v", err)
 	}
 
-	if err := k.handleUnsuccessfulAck(ctx, icaPacket, packet.SourceChannel, packet.Sequence); err != nil {
-		return err
+	// if the transaction can be retried, keep its records as they are until it is sent again
+	icaTx, found := k.GetICATx(ctx, k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence))
+	if found && k.IsRetriableICATx(icaTx) {
+		icaTx.Status = types.ICATx_ICA_TX_TIMED_OUT
+		icaTx.LastError = types.ErrICATxTimeout.Error()
+		k.SetICATx(ctx, icaTx)
+	} else {
+		if err := k.handleUnsuccessfulAck(ctx, icaPacket, packet.SourceChannel, packet.Sequence); err != nil {
+			return err
+		}
+		k.UpdateICATxStatus(
+			ctx,
+			k.GetTransactionSequenceID(packet.SourceChannel, packet.Sequence),
+			types.ICATx_ICA_TX_FAILED,
+			types.ErrICATxTimeout.Error(),
+		)
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -220,6 +254,19 @@ func (k *Keeper) handleUnsuccessfulAck(
 		case sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}):
 			// revert all the deposits for that sequence back to the previous state
 			k.RevertDepositsState(ctx, k.GetDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence)))
+		case sdk.MsgTypeURL(&stakingtypes.MsgRedeemTokensForShares{}):
+			// revert the lsm deposits so the redemption is attempted again
+			k.RevertLSMDepositsState(ctx, k.GetLSMDepositsWithSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence)))
+		case sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):
+			// remove the redelegations so the validators can be rebalanced again
+			k.DeleteRedelegationsForSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
+		case sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}):
+			// the vote can't be cast anymore, as the voting window is already closed
+			k.UpdateProposalsForSequenceID(
+				ctx,
+				k.GetTransactionSequenceID(channel, sequence),
+				types.HostChainProposal_PROPOSAL_FAILED,
+			)
 		case sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):
 			// mark all the unbondings for the previous epoch as failed
 			k.FailAllUnbondingsForSequenceID(ctx, k.GetTransactionSequenceID(channel, sequence))
@@ -277,6 +324,44 @@ func (k *Keeper) handleSuccessfulAck(
 			if err = k.HandleDelegateResponse(ctx, msg, channel, sequence); err != nil {
 				return err
 			}
+		case sdk.MsgTypeURL(&stakingtypes.MsgRedeemTokensForShares{}):
+			var data []byte
+			if len(txMsgData.Data) == 0 {
+				data = txMsgData.GetMsgResponses()[i].Value
+			} else {
+				data = txMsgData.Data[i].Data
+			}
+
+			var msgResponse stakingtypes.MsgRedeemTokensForSharesResponse
+			if err := k.cdc.Unmarshal(data, &msgResponse); err != nil {
+				return errorsmod.Wrapf(
+					sdkerrors.ErrJSONUnmarshal, "cannot unmarshal redeem tokens for shares response message: %s",
+					err.Error(),
+				)
+			}
+
+			if err = k.HandleRedeemTokensForSharesResponse(ctx, msg, msgResponse, channel, sequence); err != nil {
+				return err
+			}
+		case sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):
+			var data []byte
+			if len(txMsgData.Data) == 0 {
+				data = txMsgData.GetMsgResponses()[i].Value
+			} else {
+				data = txMsgData.Data[i].Data
+			}
+
+			var msgResponse stakingtypes.MsgBeginRedelegateResponse
+			if err := k.cdc.Unmarshal(data, &msgResponse); err != nil {
+				return errorsmod.Wrapf(
+					sdkerrors.ErrJSONUnmarshal, "cannot unmarshal begin redelegate response message: %s",
+					err.Error(),
+				)
+			}
+
+			if err = k.HandleBeginRedelegateResponse(ctx, msg, msgResponse); err != nil {
+				return err
+			}
 		case sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):
 			var data []byte
 			if len(txMsgData.Data) == 0 {
@@ -315,6 +400,12 @@ func (k *Keeper) handleSuccessfulAck(
 			if err = k.HandleMsgTransfer(ctx, msg, msgResponse, channel, sequence); err != nil {
 				return err
 			}
+		case sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}):
+			k.UpdateProposalsForSequenceID(
+				ctx,
+				k.GetTransactionSequenceID(channel, sequence),
+				types.HostChainProposal_PROPOSAL_SUBMITTED,
+			)
 		}
 	}
 
@@ -0,0 +1,408 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// OnRecvIBCMemoTransfer runs the module operation of the memo of an incoming transfer. The transfer has already been
+// acknowledged and the tokens credited to the receiver, so failed operations refund the tokens to the source chain.
+func (k *Keeper) OnRecvIBCMemoTransfer(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ibctransfertypes.FungibleTokenPacketData,
+	memo *types.IBCMemo,
+) error {
+	switch {
+	case memo.LiquidStake != nil:
+		return k.LiquidStakeIBCTransfer(ctx, packet, data, memo.LiquidStake)
+	case memo.Unstake != nil:
+		return k.UnstakeIBCTransfer(ctx, packet, data, memo.Unstake)
+	case memo.Redeem != nil:
+		return k.RedeemIBCTransfer(ctx, packet, data, memo.Redeem)
+	default:
+		return nil
+	}
+}
+
+// LiquidStakeIBCTransfer liquid stakes the tokens received by a transfer on behalf of its receiver, the same way a
+// MsgLiquidStake would, and optionally forwards the stk tokens to another chain.
+func (k *Keeper) LiquidStakeIBCTransfer(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ibctransfertypes.FungibleTokenPacketData,
+	memo *types.LiquidStakeMemo,
+) error {
+	receiver, err := GetIBCMemoReceiver(data, memo.Receiver)
+	if err != nil {
+		return k.RefundIBCTransfer(ctx, packet, data, err)
+	}
+
+	amount, err := GetIBCTransferReceivedCoin(packet, data)
+	if err != nil {
+		return err
+	}
+
+	hc, found := k.GetHostChainFromIbcDenom(ctx, amount.Denom)
+	if !found {
+		return k.RefundIBCTransfer(
+			ctx,
+			packet,
+			data,
+			errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with ibc denom %s not registered", amount.Denom),
+		)
+	}
+
+	stkAmount, err := k.ExecuteIBCMemoMsg(ctx, types.NewMsgLiquidStake(amount, receiver), receiver, hc.MintDenom())
+	if err != nil {
+		return k.RefundIBCTransfer(ctx, packet, data, err)
+	}
+
+	k.Logger(ctx).Info(
+		"Liquid staked IBC transfer.",
+		"host chain",
+		hc.ChainId,
+		"receiver",
+		receiver.String(),
+		"amount",
+		amount.String(),
+		"sequence",
+		packet.Sequence,
+		"channel",
+		packet.DestinationChannel,
+	)
+
+	if memo.Forward == nil || !stkAmount.IsPositive() {
+		return nil
+	}
+
+	// the stk tokens stay with the receiver if they can't be forwarded
+	return k.ForwardIBCMemoTokens(ctx, packet, receiver, memo.Forward, stkAmount)
+}
+
+// UnstakeIBCTransfer liquid unstakes the stk tokens received by a transfer on behalf of its receiver, the same way a
+// MsgLiquidUnstake would. The host chain needs to have the ibc memo exits enabled.
+func (k *Keeper) UnstakeIBCTransfer(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ibctransfertypes.FungibleTokenPacketData,
+	memo *types.UnstakeMemo,
+) error {
+	receiver, err := GetIBCMemoReceiver(data, memo.Receiver)
+	if err != nil {
+		return k.RefundIBCTransfer(ctx, packet, data, err)
+	}
+
+	amount, err := GetIBCTransferReceivedCoin(packet, data)
+	if err != nil {
+		return err
+	}
+
+	hc, err := k.getIBCMemoExitHostChain(ctx, amount.Denom)
+	if err != nil {
+		return k.RefundIBCTransfer(ctx, packet, data, err)
+	}
+
+	_, err = k.ExecuteIBCMemoMsg(ctx, types.NewMsgLiquidUnstake(amount, receiver), receiver, hc.IBCDenom())
+	if err != nil {
+		return k.RefundIBCTransfer(ctx, packet, data, err)
+	}
+
+	k.Logger(ctx).Info(
+		"Liquid unstaked IBC transfer.",
+		"host chain",
+		hc.ChainId,
+		"receiver",
+		receiver.String(),
+		"amount",
+		amount.String(),
+		"sequence",
+		packet.Sequence,
+		"channel",
+		packet.DestinationChannel,
+	)
+
+	return nil
+}
+
+// RedeemIBCTransfer instantly redeems the stk tokens received by a transfer on behalf of its receiver, the same way a
+// MsgRedeem would, and optionally forwards the host chain tokens to another chain. The host chain needs to have the
+// ibc memo exits enabled.
+func (k *Keeper) RedeemIBCTransfer(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ibctransfertypes.FungibleTokenPacketData,
+	memo *types.RedeemMemo,
+) error {
+	receiver, err := GetIBCMemoReceiver(data, memo.Receiver)
+	if err != nil {
+		return k.RefundIBCTransfer(ctx, packet, data, err)
+	}
+
+	amount, err := GetIBCTransferReceivedCoin(packet, data)
+	if err != nil {
+		return err
+	}
+
+	hc, err := k.getIBCMemoExitHostChain(ctx, amount.Denom)
+	if err != nil {
+		return k.RefundIBCTransfer(ctx, packet, data, err)
+	}
+
+	redeemAmount, err := k.ExecuteIBCMemoMsg(ctx, types.NewMsgRedeem(amount, receiver), receiver, hc.IBCDenom())
+	if err != nil {
+		return k.RefundIBCTransfer(ctx, packet, data, err)
+	}
+
+	k.Logger(ctx).Info(
+		"Redeemed IBC transfer.",
+		"host chain",
+		hc.ChainId,
+		"receiver",
+		receiver.String(),
+		"amount",
+		amount.String(),
+		"sequence",
+		packet.Sequence,
+		"channel",
+		packet.DestinationChannel,
+	)
+
+	if memo.Forward == nil || !redeemAmount.IsPositive() {
+		return nil
+	}
+
+	// the host chain tokens stay with the receiver if they can't be forwarded
+	return k.ForwardIBCMemoTokens(ctx, packet, receiver, memo.Forward, redeemAmount)
+}
+
+// getIBCMemoExitHostChain returns the host chain of the stk tokens to unstake or redeem with an ibc memo
+func (k *Keeper) getIBCMemoExitHostChain(ctx sdk.Context, mintDenom string) (*types.HostChain, error) {
+	hc, found := k.GetHostChainFromMintDenom(ctx, mintDenom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain with stk denom %s not registered", mintDenom)
+	}
+
+	if !hc.IbcMemoExits {
+		return nil, errorsmod.Wrapf(types.ErrInvalidIBCMemo, "ibc memo exits are disabled for host chain %s", hc.ChainId)
+	}
+
+	return hc, nil
+}
+
+// ExecuteIBCMemoMsg executes a module msg on behalf of the transfer receiver in a cached context, so that nothing is
+// written if it fails, and returns the amount of the output denom the receiver got from it.
+func (k *Keeper) ExecuteIBCMemoMsg(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	receiver sdk.AccAddress,
+	outputDenom string,
+) (sdk.Coin, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	balance := k.bankKeeper.GetBalance(cacheCtx, receiver, outputDenom)
+
+	handler := k.msgRouter.Handler(msg)
+	if handler == nil {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message type: %s", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	output := k.bankKeeper.GetBalance(cacheCtx, receiver, outputDenom).Sub(balance)
+
+	writeCache()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return output, nil
+}
+
+// ForwardIBCMemoTokens sends the tokens resulting from a memo operation to the forward receiver.
+func (k *Keeper) ForwardIBCMemoTokens(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	sender sdk.AccAddress,
+	forward *types.ForwardMemo,
+	amount sdk.Coin,
+) error {
+	// the tokens go back to the source chain unless the memo sets another channel
+	channel := packet.DestinationChannel
+	if forward.Channel != "" {
+		channel = forward.Channel
+	}
+
+	if err := k.SendIBCMemoTransfer(ctx, packet.DestinationPort, channel, sender, forward.Receiver, amount); err != nil {
+		k.Logger(ctx).Error(
+			"Could not forward IBC memo tokens.",
+			"sender",
+			sender.String(),
+			"receiver",
+			forward.Receiver,
+			"channel",
+			channel,
+			"amount",
+			amount.String(),
+			"error",
+			err.Error(),
+		)
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCMemoForward,
+			sdk.NewAttribute(types.AttributeDelegatorAddress, sender.String()),
+			sdk.NewAttribute(types.AttributeReceiverAddress, forward.Receiver),
+			sdk.NewAttribute(types.AttributeChannelID, channel),
+			sdk.NewAttribute(types.AttributeAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// RefundIBCTransfer sends the tokens received by a transfer whose memo operation failed back to the sender. If the
+// refund can't be sent the tokens stay with the transfer receiver. It returns the reason of the refund.
+func (k *Keeper) RefundIBCTransfer(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ibctransfertypes.FungibleTokenPacketData,
+	reason error,
+) error {
+	receiver, err := sdk.AccAddressFromBech32(data.GetReceiver())
+	if err != nil {
+		return err
+	}
+
+	amount, err := GetIBCTransferReceivedCoin(packet, data)
+	if err != nil {
+		return err
+	}
+
+	err = k.SendIBCMemoTransfer(ctx, packet.DestinationPort, packet.DestinationChannel, receiver, data.GetSender(), amount)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"Could not refund IBC memo transfer.",
+			"receiver",
+			receiver.String(),
+			"amount",
+			amount.String(),
+			"reason",
+			reason.Error(),
+			"error",
+			err.Error(),
+		)
+		return errorsmod.Wrapf(reason, "could not refund transfer: %s", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCMemoRefund,
+			sdk.NewAttribute(types.AttributeDelegatorAddress, receiver.String()),
+			sdk.NewAttribute(types.AttributeReceiverAddress, data.GetSender()),
+			sdk.NewAttribute(types.AttributeAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeRefundReason, reason.Error()),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"Refunded IBC memo transfer.",
+		"receiver",
+		receiver.String(),
+		"amount",
+		amount.String(),
+		"reason",
+		reason.Error(),
+	)
+
+	return reason
+}
+
+// SendIBCMemoTransfer transfers the tokens resulting from a memo operation through a Persistence channel.
+func (k *Keeper) SendIBCMemoTransfer(
+	ctx sdk.Context,
+	port string,
+	channel string,
+	sender sdk.AccAddress,
+	receiver string,
+	amount sdk.Coin,
+) error {
+	msg := ibctransfertypes.NewMsgTransfer(
+		port,
+		channel,
+		amount,
+		sender.String(),
+		receiver,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(types.IBCMemoTransferTimeoutTimestamp).UnixNano()),
+		"",
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// the transfer runs in a cached context, so that nothing is written if it fails
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	handler := k.msgRouter.Handler(msg)
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return nil
+}
+
+// GetIBCMemoReceiver returns the address of the transfer receiver, which is the only one that can be set as the
+// memo receiver, as the operation is run with the tokens it received.
+func GetIBCMemoReceiver(data ibctransfertypes.FungibleTokenPacketData, memoReceiver string) (sdk.AccAddress, error) {
+	if memoReceiver != data.GetReceiver() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidIBCMemo,
+			"memo receiver %s is not the transfer receiver %s",
+			memoReceiver,
+			data.GetReceiver(),
+		)
+	}
+
+	return sdk.AccAddressFromBech32(data.GetReceiver())
+}
+
+// GetIBCTransferReceivedCoin returns the coin a transfer credited to its receiver, following the ics-20 denom rules.
+func GetIBCTransferReceivedCoin(packet channeltypes.Packet, data ibctransfertypes.FungibleTokenPacketData) (sdk.Coin, error) {
+	amount, ok := sdk.NewIntFromString(data.GetAmount())
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrParsingAmount, "could not parse transfer amount %s", data.GetAmount())
+	}
+
+	// tokens coming back to Persistence lose the prefix they were sent with, the rest get the receiving channel one
+	var denom string
+	if ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.GetDenom()) {
+		prefix := ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denom = ibctransfertypes.ParseDenomTrace(data.GetDenom()[len(prefix):]).IBCDenom()
+	} else {
+		prefixedDenom := ibctransfertypes.GetPrefixedDenom(
+			packet.GetDestPort(),
+			packet.GetDestChannel(),
+			data.GetDenom(),
+		)
+		denom = ibctransfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
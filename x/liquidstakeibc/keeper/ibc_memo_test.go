@@ -0,0 +1,372 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// openTransferChannel opens the host chain transfer channel, so that the module can send transfers through it
+func (suite *IntegrationTestSuite) openTransferChannel() {
+	pstakeApp, ctx := suite.app, suite.ctx
+
+	capability, err := pstakeApp.ScopedTransferKeeper.NewCapability(
+		ctx,
+		host.ChannelCapabilityPath(TransferPort, TransferChannel),
+	)
+	suite.Require().NoError(err)
+	suite.Require().NoError(pstakeApp.ScopedIBCKeeper.ClaimCapability(
+		ctx,
+		capability,
+		host.ChannelCapabilityPath(TransferPort, TransferChannel),
+	))
+
+	pstakeApp.IBCKeeper.ChannelKeeper.SetChannel(
+		ctx,
+		TransferPort,
+		TransferChannel,
+		channeltypes.NewChannel(
+			channeltypes.OPEN,
+			channeltypes.UNORDERED,
+			channeltypes.NewCounterparty(TransferPort, "channel-7"),
+			[]string{suite.path.EndpointA.ConnectionID},
+			ibctransfertypes.Version,
+		),
+	)
+	pstakeApp.IBCKeeper.ChannelKeeper.SetNextSequenceSend(ctx, TransferPort, TransferChannel, 1)
+}
+
+func (suite *IntegrationTestSuite) TestLiquidStakeIBCTransfer() {
+	pstakeApp := suite.app
+	suite.openTransferChannel()
+
+	hc, found := pstakeApp.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Active = true
+	pstakeApp.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	epoch := pstakeApp.LiquidStakeIBCKeeper.GetEpochNumber(suite.ctx, types.DelegationEpoch)
+	pstakeApp.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt()),
+		Epoch:   sdk.NewInt(epoch),
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	receiver := sdk.AccAddress("receiver____________")
+	sender := "cosmos1hsqr8ln5aakv3x3fxsvdnxh7qs3qz2gdpfne3s"
+	escrowAddress := ibctransfertypes.GetEscrowAddress(TransferPort, TransferChannel)
+	amount := sdk.NewInt(1000)
+
+	tc := []struct {
+		name         string
+		memo         string
+		destChannel  string
+		inactive     bool
+		refunded     bool
+		stkReceived  sdk.Int
+		stkForwarded sdk.Int
+		ibcDeposited sdk.Int
+	}{
+		{
+			name:         "liquid stake",
+			memo:         fmt.Sprintf(`{"liquidstake":{"receiver":"%s"}}`, receiver),
+			stkReceived:  sdk.NewInt(990),
+			stkForwarded: sdk.ZeroInt(),
+			ibcDeposited: amount,
+		},
+		{
+			name:         "liquid stake and forward",
+			memo:         fmt.Sprintf(`{"liquidstake":{"receiver":"%s","forward":{"receiver":"%s"}}}`, receiver, sender),
+			stkReceived:  sdk.ZeroInt(),
+			stkForwarded: sdk.NewInt(990),
+			ibcDeposited: amount,
+		},
+		{
+			name:         "memo of another application",
+			memo:         `{"wasm":{"contract":"persistence1"}}`,
+			stkReceived:  sdk.ZeroInt(),
+			stkForwarded: sdk.ZeroInt(),
+			ibcDeposited: sdk.ZeroInt(),
+		},
+		{
+			name:         "invalid memo",
+			memo:         `{"liquidstake":{"receiver":"persistence1invalid"}}`,
+			refunded:     true,
+			stkReceived:  sdk.ZeroInt(),
+			stkForwarded: sdk.ZeroInt(),
+			ibcDeposited: sdk.ZeroInt(),
+		},
+		{
+			name:         "memo receiver is not the transfer receiver",
+			memo:         fmt.Sprintf(`{"liquidstake":{"receiver":"%s"}}`, sdk.AccAddress("other_______________")),
+			refunded:     true,
+			stkReceived:  sdk.ZeroInt(),
+			stkForwarded: sdk.ZeroInt(),
+			ibcDeposited: sdk.ZeroInt(),
+		},
+		{
+			name:         "inactive host chain",
+			memo:         fmt.Sprintf(`{"liquidstake":{"receiver":"%s"}}`, receiver),
+			inactive:     true,
+			refunded:     true,
+			stkReceived:  sdk.ZeroInt(),
+			stkForwarded: sdk.ZeroInt(),
+			ibcDeposited: sdk.ZeroInt(),
+		},
+		{
+			name:         "failed refund",
+			memo:         fmt.Sprintf(`{"liquidstake":{"receiver":"%s"}}`, receiver),
+			destChannel:  "channel-1",
+			stkReceived:  sdk.ZeroInt(),
+			stkForwarded: sdk.ZeroInt(),
+			ibcDeposited: sdk.ZeroInt(),
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			if t.inactive {
+				hc.Active = false
+				pstakeApp.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+			}
+
+			destChannel := TransferChannel
+			if t.destChannel != "" {
+				destChannel = t.destChannel
+			}
+
+			data := ibctransfertypes.NewFungibleTokenPacketData(
+				HostDenom,
+				amount.String(),
+				sender,
+				receiver.String(),
+				t.memo,
+			)
+			packet := channeltypes.NewPacket(
+				data.GetBytes(),
+				1,
+				TransferPort,
+				"channel-7",
+				TransferPort,
+				destChannel,
+				clienttypes.ZeroHeight(),
+				uint64(ctx.BlockTime().UnixNano()),
+			)
+
+			// the transfer module credits the tokens before the hook runs
+			received, err := keeper.GetIBCTransferReceivedCoin(packet, data)
+			suite.Require().NoError(err)
+			pstakeApp.TransferKeeper.SetDenomTrace(
+				ctx,
+				ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(TransferPort, destChannel, HostDenom)),
+			)
+			suite.Require().NoError(testutil.FundAccount(pstakeApp.BankKeeper, ctx, receiver, sdk.NewCoins(received)))
+
+			_ = pstakeApp.LiquidStakeIBCKeeper.OnRecvIBCTransferPacket(
+				ctx,
+				packet,
+				sdk.AccAddress{},
+				channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+			)
+
+			ibcBalance := pstakeApp.BankKeeper.GetBalance(ctx, receiver, received.Denom).Amount
+			switch {
+			case t.refunded:
+				suite.Require().True(ibcBalance.IsZero())
+				suite.Require().True(pstakeApp.BankKeeper.GetSupply(ctx, received.Denom).Amount.IsZero())
+			case t.ibcDeposited.IsPositive():
+				suite.Require().True(ibcBalance.IsZero())
+			default:
+				suite.Require().Equal(amount, ibcBalance)
+			}
+
+			deposit, found := pstakeApp.LiquidStakeIBCKeeper.GetDepositForChainAndEpoch(ctx, hc.ChainId, epoch)
+			suite.Require().True(found)
+			suite.Require().Equal(t.ibcDeposited, deposit.Amount.Amount)
+
+			suite.Require().Equal(t.stkReceived, pstakeApp.BankKeeper.GetBalance(ctx, receiver, MintDenom).Amount)
+			suite.Require().Equal(t.stkForwarded, pstakeApp.BankKeeper.GetBalance(ctx, escrowAddress, MintDenom).Amount)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestUnstakeAndRedeemIBCTransfer() {
+	pstakeApp := suite.app
+	suite.openTransferChannel()
+
+	hc, found := pstakeApp.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.chainB.ChainID)
+	suite.Require().True(found)
+	hc.Active = true
+	hc.Validators[0].DelegatedAmount = sdk.NewInt(10000)
+	pstakeApp.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	pstakeApp.TransferKeeper.SetDenomTrace(
+		suite.ctx,
+		ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(TransferPort, TransferChannel, HostDenom)),
+	)
+
+	// the deposits serve the instant redemptions
+	suite.Require().NoError(testutil.FundModuleAccount(
+		pstakeApp.BankKeeper,
+		suite.ctx,
+		types.DepositModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 10000)),
+	))
+
+	receiver := sdk.AccAddress("receiver____________")
+	sender := "cosmos1hsqr8ln5aakv3x3fxsvdnxh7qs3qz2gdpfne3s"
+	escrowAddress := ibctransfertypes.GetEscrowAddress(TransferPort, TransferChannel)
+	undelegationAddress := pstakeApp.LiquidStakeIBCKeeper.GetUndelegationModuleAccount(suite.ctx).GetAddress()
+	amount := sdk.NewInt(1000)
+
+	tc := []struct {
+		name            string
+		memo            string
+		exitsDisabled   bool
+		stkReceived     sdk.Int
+		stkRefunded     sdk.Int
+		stkUnstaked     sdk.Int
+		ibcReceived     sdk.Int
+		ibcForwarded    sdk.Int
+		depositedAmount sdk.Int
+	}{
+		{
+			name:            "unstake",
+			memo:            fmt.Sprintf(`{"unstake":{"receiver":"%s"}}`, receiver),
+			stkReceived:     sdk.ZeroInt(),
+			stkRefunded:     sdk.ZeroInt(),
+			stkUnstaked:     sdk.NewInt(970),
+			ibcReceived:     sdk.ZeroInt(),
+			ibcForwarded:    sdk.ZeroInt(),
+			depositedAmount: sdk.NewInt(10000),
+		},
+		{
+			name:            "redeem",
+			memo:            fmt.Sprintf(`{"redeem":{"receiver":"%s"}}`, receiver),
+			stkReceived:     sdk.ZeroInt(),
+			stkRefunded:     sdk.ZeroInt(),
+			stkUnstaked:     sdk.ZeroInt(),
+			ibcReceived:     sdk.NewInt(970),
+			ibcForwarded:    sdk.ZeroInt(),
+			depositedAmount: sdk.NewInt(9030),
+		},
+		{
+			name:            "redeem and forward",
+			memo:            fmt.Sprintf(`{"redeem":{"receiver":"%s","forward":{"receiver":"%s"}}}`, receiver, sender),
+			stkReceived:     sdk.ZeroInt(),
+			stkRefunded:     sdk.ZeroInt(),
+			stkUnstaked:     sdk.ZeroInt(),
+			ibcReceived:     sdk.ZeroInt(),
+			ibcForwarded:    sdk.NewInt(970),
+			depositedAmount: sdk.NewInt(9030),
+		},
+		{
+			name: "redeem and forward through a closed channel",
+			memo: fmt.Sprintf(
+				`{"redeem":{"receiver":"%s","forward":{"receiver":"%s","channel":"channel-1"}}}`,
+				receiver,
+				sender,
+			),
+			stkReceived:     sdk.ZeroInt(),
+			stkRefunded:     sdk.ZeroInt(),
+			stkUnstaked:     sdk.ZeroInt(),
+			ibcReceived:     sdk.NewInt(970),
+			ibcForwarded:    sdk.ZeroInt(),
+			depositedAmount: sdk.NewInt(9030),
+		},
+		{
+			name:            "memo receiver is not the transfer receiver",
+			memo:            fmt.Sprintf(`{"redeem":{"receiver":"%s"}}`, sdk.AccAddress("other_______________")),
+			stkReceived:     sdk.ZeroInt(),
+			stkRefunded:     amount,
+			stkUnstaked:     sdk.ZeroInt(),
+			ibcReceived:     sdk.ZeroInt(),
+			ibcForwarded:    sdk.ZeroInt(),
+			depositedAmount: sdk.NewInt(10000),
+		},
+		{
+			name:            "ibc memo exits disabled",
+			memo:            fmt.Sprintf(`{"unstake":{"receiver":"%s"}}`, receiver),
+			exitsDisabled:   true,
+			stkReceived:     sdk.ZeroInt(),
+			stkRefunded:     amount,
+			stkUnstaked:     sdk.ZeroInt(),
+			ibcReceived:     sdk.ZeroInt(),
+			ibcForwarded:    sdk.ZeroInt(),
+			depositedAmount: sdk.NewInt(10000),
+		},
+	}
+
+	for _, t := range tc {
+		suite.Run(t.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+
+			hc.IbcMemoExits = !t.exitsDisabled
+			pstakeApp.LiquidStakeIBCKeeper.SetHostChain(ctx, hc)
+
+			// the stk tokens come back from the chain they were sent to
+			data := ibctransfertypes.NewFungibleTokenPacketData(
+				ibctransfertypes.GetPrefixedDenom(TransferPort, "channel-7", MintDenom),
+				amount.String(),
+				sender,
+				receiver.String(),
+				t.memo,
+			)
+			packet := channeltypes.NewPacket(
+				data.GetBytes(),
+				1,
+				TransferPort,
+				"channel-7",
+				TransferPort,
+				TransferChannel,
+				clienttypes.ZeroHeight(),
+				uint64(ctx.BlockTime().UnixNano()),
+			)
+
+			// the transfer module credits the tokens before the hook runs
+			received, err := keeper.GetIBCTransferReceivedCoin(packet, data)
+			suite.Require().NoError(err)
+			suite.Require().Equal(MintDenom, received.Denom)
+			suite.Require().NoError(testutil.FundAccount(pstakeApp.BankKeeper, ctx, receiver, sdk.NewCoins(received)))
+			ibcSupply := pstakeApp.BankKeeper.GetSupply(ctx, hc.IBCDenom()).Amount
+
+			_ = pstakeApp.LiquidStakeIBCKeeper.OnRecvIBCTransferPacket(
+				ctx,
+				packet,
+				sdk.AccAddress{},
+				channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+			)
+
+			suite.Require().Equal(t.stkReceived, pstakeApp.BankKeeper.GetBalance(ctx, receiver, MintDenom).Amount)
+			suite.Require().Equal(t.stkRefunded, pstakeApp.BankKeeper.GetBalance(ctx, escrowAddress, MintDenom).Amount)
+			suite.Require().Equal(
+				t.stkUnstaked,
+				pstakeApp.BankKeeper.GetBalance(ctx, undelegationAddress, MintDenom).Amount,
+			)
+			suite.Require().Equal(t.ibcReceived, pstakeApp.BankKeeper.GetBalance(ctx, receiver, hc.IBCDenom()).Amount)
+			suite.Require().Equal(
+				ibcSupply.Sub(t.ibcForwarded).String(),
+				pstakeApp.BankKeeper.GetSupply(ctx, hc.IBCDenom()).Amount.String(),
+			)
+			suite.Require().Equal(
+				t.depositedAmount,
+				pstakeApp.BankKeeper.GetBalance(
+					ctx,
+					pstakeApp.LiquidStakeIBCKeeper.GetDepositModuleAccount(ctx).GetAddress(),
+					hc.IBCDenom(),
+				).Amount,
+			)
+		})
+	}
+}
@@ -19,6 +19,10 @@ func (k *Keeper) GenerateAndExecuteICATx(
 	ownerID string,
 	messages []proto.Message,
 ) (string, error) {
+	chainID, err := k.GetChainID(ctx, connectionID)
+	if err != nil {
+		return "", errorsmod.Wrapf(liquidstakeibctypes.ErrICATxFailure, "failed to get chain id: %v", err)
+	}
 
 	msgData, err := icatypes.SerializeCosmosTx(k.cdc, messages)
 	if err != nil {
@@ -82,5 +86,16 @@ func (k *Keeper) GenerateAndExecuteICATx(
 		),
 	)
 
-	return k.GetTransactionSequenceID(channelID, msgSendTxResponse.Sequence), nil
+	// keep track of the transaction until it gets acknowledged
+	sequenceID := k.GetTransactionSequenceID(channelID, msgSendTxResponse.Sequence)
+	if err = k.RecordICATx(ctx, chainID, connectionID, ownerID, sequenceID, messages); err != nil {
+		return "", errorsmod.Wrapf(
+			liquidstakeibctypes.ErrICATxFailure,
+			"failed to record ica tx %s: %v",
+			sequenceID,
+			err,
+		)
+	}
+
+	return sequenceID, nil
 }
@@ -36,9 +36,6 @@ func (k *Keeper) HandleDelegateResponse(ctx sdk.Context, msg sdk.Msg, channel st
 		)
 	}
 
-	// update delegation account balance
-	hc.DelegationAccount.Balance = hc.DelegationAccount.Balance.Sub(parsedMsg.Amount)
-
 	// get the validator that the delegation was performed to
 	validator, found := hc.GetValidator(parsedMsg.ValidatorAddress)
 	if !found {
@@ -49,6 +46,13 @@ func (k *Keeper) HandleDelegateResponse(ctx sdk.Context, msg sdk.Msg, channel st
 		)
 	}
 
+	// update the balance and the delegations of the delegation account
+	account, _ := hc.GetDelegationAccountByAddress(parsedMsg.DelegatorAddress)
+	account.Balance = account.Balance.Sub(parsedMsg.Amount)
+	if !hc.IsMainDelegationAccount(account) {
+		account.AddDelegatedAmount(parsedMsg.ValidatorAddress, parsedMsg.Amount.Amount)
+	}
+
 	// update the validator delegated amount
 	validator.DelegatedAmount = validator.DelegatedAmount.Add(parsedMsg.Amount.Amount)
 	k.SetHostChainValidator(ctx, hc, validator)
@@ -68,6 +72,154 @@ func (k *Keeper) HandleDelegateResponse(ctx sdk.Context, msg sdk.Msg, channel st
 	return nil
 }
 
+func (k *Keeper) HandleRedeemTokensForSharesResponse(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	resp stakingtypes.MsgRedeemTokensForSharesResponse,
+	channel string,
+	sequence uint64,
+) error {
+	parsedMsg, ok := msg.(*stakingtypes.MsgRedeemTokensForShares)
+	if !ok {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidType,
+			"unable to cast msg of type %s to MsgRedeemTokensForShares",
+			sdk.MsgTypeURL(msg),
+		)
+	}
+
+	// get the host chain of the redemption using its delegator address
+	hc, found := k.GetHostChainFromDelegatorAddress(ctx, parsedMsg.DelegatorAddress)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidHostChain,
+			"host chain with delegator address %s not registered, or account not associated",
+			parsedMsg.DelegatorAddress,
+		)
+	}
+
+	// a message is sent for every deposit, in the deposits store order, so each response settles exactly one
+	// deposit, the first one of the sequence with the message denom and amount
+	deposits := k.FilterLSMDeposits(
+		ctx,
+		func(d types.LSMDeposit) bool {
+			return d.IbcSequenceId == k.GetTransactionSequenceID(channel, sequence) &&
+				d.Denom == parsedMsg.Amount.Denom &&
+				d.Amount.Equal(parsedMsg.Amount.Amount)
+		},
+	)
+	if len(deposits) == 0 {
+		return nil
+	}
+
+	validatorAddress := deposits[0].GetValidatorAddress()
+	k.DeleteLSMDeposit(ctx, deposits[0])
+
+	// get the validator that the shares were redeemed to
+	validator, found := hc.GetValidator(validatorAddress)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrValidatorNotFound,
+			"validator with operator address %s not found",
+			validatorAddress,
+		)
+	}
+
+	// update the validator delegated amount with the tokens the shares were redeemed for
+	validator.DelegatedAmount = validator.DelegatedAmount.Add(resp.Amount.Amount)
+	k.SetHostChainValidator(ctx, hc, validator)
+
+	k.Logger(ctx).Info(
+		"Received redeem tokens for shares acknowledgement",
+		"delegator",
+		parsedMsg.DelegatorAddress,
+		"validator",
+		validatorAddress,
+		"amount",
+		resp.Amount.String(),
+	)
+
+	return nil
+}
+
+func (k *Keeper) HandleBeginRedelegateResponse(
+	ctx sdk.Context,
+	msg sdk.Msg,
+	resp stakingtypes.MsgBeginRedelegateResponse,
+) error {
+	parsedMsg, ok := msg.(*stakingtypes.MsgBeginRedelegate)
+	if !ok {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidType,
+			"unable to cast msg of type %s to MsgBeginRedelegate",
+			sdk.MsgTypeURL(msg),
+		)
+	}
+
+	// get the host chain of the redelegation using its delegator address
+	hc, found := k.GetHostChainFromDelegatorAddress(ctx, parsedMsg.DelegatorAddress)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrInvalidHostChain,
+			"host chain with delegator address %s not registered, or account not associated",
+			parsedMsg.DelegatorAddress,
+		)
+	}
+
+	srcValidator, found := hc.GetValidator(parsedMsg.ValidatorSrcAddress)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrValidatorNotFound,
+			"validator with operator address %s not found",
+			parsedMsg.ValidatorSrcAddress,
+		)
+	}
+
+	dstValidator, found := hc.GetValidator(parsedMsg.ValidatorDstAddress)
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrValidatorNotFound,
+			"validator with operator address %s not found",
+			parsedMsg.ValidatorDstAddress,
+		)
+	}
+
+	// move the delegated amount from the source to the destination validator
+	srcValidator.DelegatedAmount = srcValidator.DelegatedAmount.Sub(parsedMsg.Amount.Amount)
+	k.SetHostChainValidator(ctx, hc, srcValidator)
+
+	dstValidator.DelegatedAmount = dstValidator.DelegatedAmount.Add(parsedMsg.Amount.Amount)
+	k.SetHostChainValidator(ctx, hc, dstValidator)
+
+	// the redelegation is now maturing, keep it until the completion time is reached
+	redelegation, found := k.GetRedelegation(
+		ctx,
+		hc.ChainId,
+		parsedMsg.ValidatorSrcAddress,
+		parsedMsg.ValidatorDstAddress,
+	)
+	if found {
+		redelegation.IbcSequenceId = ""
+		redelegation.CompletionTime = resp.CompletionTime
+		redelegation.State = types.Redelegation_REDELEGATION_MATURING
+		k.SetRedelegation(ctx, redelegation)
+	}
+
+	k.Logger(ctx).Info(
+		"Received redelegation acknowledgement",
+		"delegator",
+		parsedMsg.DelegatorAddress,
+		"source",
+		parsedMsg.ValidatorSrcAddress,
+		"destination",
+		parsedMsg.ValidatorDstAddress,
+		"amount",
+		parsedMsg.Amount.String(),
+	)
+
+	return nil
+}
+
 func (k *Keeper) HandleUndelegateResponse(
 	ctx sdk.Context,
 	msg sdk.Msg,
@@ -104,6 +256,12 @@ func (k *Keeper) HandleUndelegateResponse(
 		)
 	}
 
+	// update the delegations of the delegation account
+	account, _ := hc.GetDelegationAccountByAddress(parsedMsg.DelegatorAddress)
+	if !hc.IsMainDelegationAccount(account) {
+		account.AddDelegatedAmount(parsedMsg.ValidatorAddress, parsedMsg.Amount.Amount.Neg())
+	}
+
 	// update the validator delegated amount
 	validator.DelegatedAmount = validator.DelegatedAmount.Sub(parsedMsg.Amount.Amount)
 	k.SetHostChainValidator(ctx, hc, validator)
@@ -196,8 +354,10 @@ func (k *Keeper) HandleMsgTransfer(
 		)
 	}
 
+	_, fromDelegationAccount := hc.GetDelegationAccountByAddress(parsedMsg.Sender)
+
 	// the transfer is part of the undelegation process
-	if parsedMsg.Sender == hc.DelegationAccount.Address &&
+	if fromDelegationAccount &&
 		parsedMsg.Receiver == k.GetUndelegationModuleAccount(ctx).GetAddress().String() {
 		// get all the unbondings for that ibc sequence id
 		unbondings := k.FilterUnbondings(
@@ -214,7 +374,7 @@ func (k *Keeper) HandleMsgTransfer(
 		}
 	}
 
-	if parsedMsg.Sender == hc.DelegationAccount.Address &&
+	if fromDelegationAccount &&
 		parsedMsg.Receiver == k.GetDepositModuleAccount(ctx).GetAddress().String() {
 		validatorUnbondings := k.FilterValidatorUnbondings(
 			ctx,
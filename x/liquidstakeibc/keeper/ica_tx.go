@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetICATx(ctx sdk.Context, icaTx *types.ICATx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ICATxKey)
+	bytes := k.cdc.MustMarshal(icaTx)
+	store.Set([]byte(icaTx.SequenceId), bytes)
+}
+
+func (k *Keeper) GetICATx(ctx sdk.Context, sequenceID string) (*types.ICATx, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ICATxKey)
+	bz := store.Get([]byte(sequenceID))
+	if bz == nil {
+		return &types.ICATx{}, false
+	}
+
+	var icaTx types.ICATx
+	k.cdc.MustUnmarshal(bz, &icaTx)
+	return &icaTx, true
+}
+
+func (k *Keeper) DeleteICATx(ctx sdk.Context, icaTx *types.ICATx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ICATxKey)
+	store.Delete([]byte(icaTx.SequenceId))
+}
+
+func (k *Keeper) FilterICATxs(ctx sdk.Context, filter func(t types.ICATx) bool) []*types.ICATx {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ICATxKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	icaTxs := make([]*types.ICATx, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		icaTx := types.ICATx{}
+		k.cdc.MustUnmarshal(iterator.Value(), &icaTx)
+		if filter(icaTx) {
+			icaTxs = append(icaTxs, &icaTx)
+		}
+	}
+
+	return icaTxs
+}
+
+// RecordICATx creates the record of an ICA transaction that has just been sent
+func (k *Keeper) RecordICATx(
+	ctx sdk.Context,
+	chainID string,
+	connectionID string,
+	owner string,
+	sequenceID string,
+	messages []proto.Message,
+) error {
+	anys := make([]*codectypes.Any, 0, len(messages))
+	for _, message := range messages {
+		anyMsg, err := codectypes.NewAnyWithValue(message)
+		if err != nil {
+			return err
+		}
+		anys = append(anys, anyMsg)
+	}
+
+	k.SetICATx(
+		ctx,
+		&types.ICATx{
+			SequenceId:   sequenceID,
+			ChainId:      chainID,
+			ConnectionId: connectionID,
+			Owner:        owner,
+			Messages:     anys,
+			SentHeight:   ctx.BlockHeight(),
+			Status:       types.ICATx_ICA_TX_PENDING,
+		},
+	)
+
+	return nil
+}
+
+// UpdateICATxStatus updates the status of an ICA transaction, if it's being tracked
+func (k *Keeper) UpdateICATxStatus(
+	ctx sdk.Context,
+	sequenceID string,
+	status types.ICATx_ICATxStatus,
+	lastError string,
+) {
+	icaTx, found := k.GetICATx(ctx, sequenceID)
+	if !found {
+		return
+	}
+
+	icaTx.Status = status
+	if lastError != "" {
+		icaTx.LastError = lastError
+	}
+	k.SetICATx(ctx, icaTx)
+}
+
+// GetICATxMessages unpacks the messages of an ICA transaction
+func (k *Keeper) GetICATxMessages(icaTx *types.ICATx) ([]proto.Message, error) {
+	messages := make([]proto.Message, 0, len(icaTx.Messages))
+	for _, anyMsg := range icaTx.Messages {
+		var msg sdk.Msg
+		if err := k.cdc.UnpackAny(anyMsg, &msg); err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
+
+// IsRetriableICATx checks if an ICA transaction can be retried after timing out, only delegations,
+// undelegations and transfers are retried, as the records of the rest get reverted and picked up again
+func (k *Keeper) IsRetriableICATx(icaTx *types.ICATx) bool {
+	if icaTx.RetryCount >= types.MaxICATxRetries || len(icaTx.Messages) == 0 {
+		return false
+	}
+
+	for _, anyMsg := range icaTx.Messages {
+		switch anyMsg.TypeUrl {
+		case sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+			sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+			sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// RetryICATx sends again the messages of a timed out ICA transaction and moves all the records
+// that were waiting on it to the new transaction sequence
+func (k *Keeper) RetryICATx(ctx sdk.Context, icaTx *types.ICATx) error {
+	messages, err := k.GetICATxMessages(icaTx)
+	if err != nil {
+		return err
+	}
+
+	// refresh the timeout of the transfers, otherwise they would time out again on arrival
+	for _, message := range messages {
+		if msgTransfer, ok := message.(*ibctransfertypes.MsgTransfer); ok {
+			msgTransfer.TimeoutHeight = clienttypes.NewHeight(
+				clienttypes.GetSelfHeight(ctx).GetRevisionNumber(),
+				clienttypes.GetSelfHeight(ctx).GetRevisionHeight()+types.IBCTimeoutHeightIncrement,
+			)
+		}
+	}
+
+	sequenceID, err := k.GenerateAndExecuteICATx(ctx, icaTx.ConnectionId, icaTx.Owner, messages)
+	if err != nil {
+		return err
+	}
+
+	retry, found := k.GetICATx(ctx, sequenceID)
+	if !found {
+		return fmt.Errorf("ica tx %s not recorded after sending it", sequenceID)
+	}
+	retry.RetryCount = icaTx.RetryCount + 1
+	retry.LastError = icaTx.LastError
+	k.SetICATx(ctx, retry)
+
+	icaTx.Status = types.ICATx_ICA_TX_RETRIED
+	k.SetICATx(ctx, icaTx)
+
+	k.ReplaceSequenceID(ctx, icaTx.SequenceId, sequenceID)
+
+	return nil
+}
+
+// ReplaceSequenceID updates the ibc sequence id of all the records waiting on an ICA transaction
+func (k *Keeper) ReplaceSequenceID(ctx sdk.Context, oldSequenceID, newSequenceID string) {
+	for _, deposit := range k.GetDepositsWithSequenceID(ctx, oldSequenceID) {
+		deposit.IbcSequenceId = newSequenceID
+		k.SetDeposit(ctx, deposit)
+	}
+
+	for _, deposit := range k.GetLSMDepositsWithSequenceID(ctx, oldSequenceID) {
+		deposit.IbcSequenceId = newSequenceID
+		k.SetLSMDeposit(ctx, deposit)
+	}
+
+	unbondings := k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.IbcSequenceId == oldSequenceID
+		},
+	)
+	for _, unbonding := range unbondings {
+		unbonding.IbcSequenceId = newSequenceID
+		k.SetUnbonding(ctx, unbonding)
+	}
+
+	validatorUnbondings := k.FilterValidatorUnbondings(
+		ctx,
+		func(u types.ValidatorUnbonding) bool {
+			return u.IbcSequenceId == oldSequenceID
+		},
+	)
+	for _, validatorUnbonding := range validatorUnbondings {
+		validatorUnbonding.IbcSequenceId = newSequenceID
+		k.SetValidatorUnbonding(ctx, validatorUnbonding)
+	}
+
+	redelegations := k.FilterRedelegations(
+		ctx,
+		func(r types.Redelegation) bool {
+			return r.IbcSequenceId == oldSequenceID
+		},
+	)
+	for _, redelegation := range redelegations {
+		redelegation.IbcSequenceId = newSequenceID
+		k.SetRedelegation(ctx, redelegation)
+	}
+
+	proposals := k.FilterHostChainProposals(
+		ctx,
+		func(p types.HostChainProposal) bool {
+			return p.IbcSequenceId == oldSequenceID
+		},
+	)
+	for _, proposal := range proposals {
+		proposal.IbcSequenceId = newSequenceID
+		k.SetHostChainProposal(ctx, proposal)
+	}
+}
+
+// PruneICATxs removes the ICA transactions that finished more than ICATxRetentionBlocks ago
+func (k *Keeper) PruneICATxs(ctx sdk.Context) {
+	icaTxs := k.FilterICATxs(
+		ctx,
+		func(t types.ICATx) bool {
+			return t.Status != types.ICATx_ICA_TX_PENDING &&
+				t.Status != types.ICATx_ICA_TX_TIMED_OUT &&
+				t.SentHeight+types.ICATxRetentionBlocks < ctx.BlockHeight()
+		},
+	)
+
+	for _, icaTx := range icaTxs {
+		k.DeleteICATx(ctx, icaTx)
+	}
+}
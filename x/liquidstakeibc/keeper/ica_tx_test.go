@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestRecordICATx() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	messages := []proto.Message{
+		&stakingtypes.MsgDelegate{
+			DelegatorAddress: hc.DelegationAccount.Address,
+			ValidatorAddress: hc.Validators[0].OperatorAddress,
+			Amount:           sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+		},
+	}
+
+	suite.Require().NoError(
+		suite.app.LiquidStakeIBCKeeper.RecordICATx(
+			suite.ctx,
+			hc.ChainId,
+			hc.ConnectionId,
+			hc.DelegationAccount.Owner,
+			"channel-0-sequence-1",
+			messages,
+		),
+	)
+
+	icaTx, found := suite.app.LiquidStakeIBCKeeper.GetICATx(suite.ctx, "channel-0-sequence-1")
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(hc.ChainId, icaTx.ChainId)
+	suite.Require().Equal(types.ICATx_ICA_TX_PENDING, icaTx.Status)
+
+	unpacked, err := suite.app.LiquidStakeIBCKeeper.GetICATxMessages(icaTx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(messages, unpacked)
+	suite.Require().Equal(true, suite.app.LiquidStakeIBCKeeper.IsRetriableICATx(icaTx))
+
+	icaTx.RetryCount = types.MaxICATxRetries
+	suite.Require().Equal(false, suite.app.LiquidStakeIBCKeeper.IsRetriableICATx(icaTx))
+
+	suite.app.LiquidStakeIBCKeeper.UpdateICATxStatus(
+		suite.ctx,
+		"channel-0-sequence-1",
+		types.ICATx_ICA_TX_FAILED,
+		"failed",
+	)
+
+	icaTx, _ = suite.app.LiquidStakeIBCKeeper.GetICATx(suite.ctx, "channel-0-sequence-1")
+	suite.Require().Equal(types.ICATx_ICA_TX_FAILED, icaTx.Status)
+	suite.Require().Equal("failed", icaTx.LastError)
+}
+
+func (suite *IntegrationTestSuite) TestIsRetriableICATx() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	suite.Require().NoError(
+		suite.app.LiquidStakeIBCKeeper.RecordICATx(
+			suite.ctx,
+			hc.ChainId,
+			hc.ConnectionId,
+			hc.DelegationAccount.Owner,
+			"channel-0-sequence-2",
+			[]proto.Message{
+				&distributiontypes.MsgSetWithdrawAddress{
+					DelegatorAddress: hc.DelegationAccount.Address,
+					WithdrawAddress:  hc.RewardsAccount.Address,
+				},
+			},
+		),
+	)
+
+	icaTx, found := suite.app.LiquidStakeIBCKeeper.GetICATx(suite.ctx, "channel-0-sequence-2")
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(false, suite.app.LiquidStakeIBCKeeper.IsRetriableICATx(icaTx))
+}
+
+func (suite *IntegrationTestSuite) TestReplaceSequenceID() {
+	epoch := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DelegationEpoch).CurrentEpoch
+
+	suite.app.LiquidStakeIBCKeeper.SetDeposit(
+		suite.ctx,
+		&types.Deposit{
+			ChainId:       suite.path.EndpointB.Chain.ChainID,
+			Amount:        sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+			Epoch:         sdk.NewInt(epoch),
+			State:         types.Deposit_DEPOSIT_DELEGATING,
+			IbcSequenceId: "sequence-1",
+		},
+	)
+	suite.app.LiquidStakeIBCKeeper.SetUnbonding(
+		suite.ctx,
+		&types.Unbonding{
+			ChainId:       suite.path.EndpointB.Chain.ChainID,
+			EpochNumber:   epoch,
+			IbcSequenceId: "sequence-1",
+			State:         types.Unbonding_UNBONDING_INITIATED,
+		},
+	)
+
+	suite.app.LiquidStakeIBCKeeper.ReplaceSequenceID(suite.ctx, "sequence-1", "sequence-2")
+
+	suite.Require().Equal(0, len(suite.app.LiquidStakeIBCKeeper.GetDepositsWithSequenceID(suite.ctx, "sequence-1")))
+	suite.Require().Equal(1, len(suite.app.LiquidStakeIBCKeeper.GetDepositsWithSequenceID(suite.ctx, "sequence-2")))
+
+	unbonding, found := suite.app.LiquidStakeIBCKeeper.GetUnbonding(suite.ctx, suite.path.EndpointB.Chain.ChainID, epoch)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal("sequence-2", unbonding.IbcSequenceId)
+	suite.Require().Equal(types.Unbonding_UNBONDING_INITIATED, unbonding.State)
+}
+
+func (suite *IntegrationTestSuite) TestPruneICATxs() {
+	suite.ctx = suite.ctx.WithBlockHeight(types.ICATxRetentionBlocks + 10)
+
+	icaTxs := []*types.ICATx{
+		{
+			SequenceId: "sequence-1",
+			ChainId:    suite.path.EndpointB.Chain.ChainID,
+			SentHeight: 1,
+			Status:     types.ICATx_ICA_TX_SUCCEEDED,
+		},
+		{
+			SequenceId: "sequence-2",
+			ChainId:    suite.path.EndpointB.Chain.ChainID,
+			SentHeight: 1,
+			Status:     types.ICATx_ICA_TX_TIMED_OUT,
+		},
+		{
+			SequenceId: "sequence-3",
+			ChainId:    suite.path.EndpointB.Chain.ChainID,
+			SentHeight: types.ICATxRetentionBlocks + 5,
+			Status:     types.ICATx_ICA_TX_FAILED,
+		},
+	}
+
+	for _, icaTx := range icaTxs {
+		suite.app.LiquidStakeIBCKeeper.SetICATx(suite.ctx, icaTx)
+	}
+
+	suite.app.LiquidStakeIBCKeeper.PruneICATxs(suite.ctx)
+
+	_, found := suite.app.LiquidStakeIBCKeeper.GetICATx(suite.ctx, "sequence-1")
+	suite.Require().Equal(false, found)
+	_, found = suite.app.LiquidStakeIBCKeeper.GetICATx(suite.ctx, "sequence-2")
+	suite.Require().Equal(true, found)
+	_, found = suite.app.LiquidStakeIBCKeeper.GetICATx(suite.ctx, "sequence-3")
+	suite.Require().Equal(true, found)
+}
@@ -5,10 +5,15 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	q "github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
 	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
@@ -20,6 +25,8 @@ const (
 	RewardAccountBalances     = "reward-balances"
 	DelegationAccountBalances = "delegation-balances"
 	Delegation                = "validator-delegation"
+	Proposal                  = "proposal"
+	DelegationRewards         = "delegation-rewards"
 )
 
 type CallbackFn func(Keeper, sdk.Context, []byte, icqtypes.Query) error
@@ -40,7 +47,14 @@ func (c Callbacks) AddCallback(id string, fn interface{}) icqtypes.QueryCallback
 }
 
 func (c Callbacks) Call(ctx sdk.Context, id string, args []byte, query icqtypes.Query) error {
-	return c.callbacks[id](c.k, ctx, args, query)
+	if err := c.callbacks[id](c.k, ctx, args, query); err != nil {
+		return err
+	}
+
+	// keep track of the host chain icq liveness for the circuit breaker
+	c.k.RecordICQCallback(ctx, query.ChainId)
+
+	return nil
 }
 
 func (c Callbacks) Has(id string) bool {
@@ -54,7 +68,9 @@ func (c Callbacks) RegisterCallbacks() icqtypes.QueryCallbacks {
 		AddCallback(Validator, CallbackFn(ValidatorCallback)).
 		AddCallback(RewardAccountBalances, CallbackFn(RewardsAccountBalanceCallback)).
 		AddCallback(DelegationAccountBalances, CallbackFn(DelegationAccountBalanceCallback)).
-		AddCallback(Delegation, CallbackFn(DelegationCallback))
+		AddCallback(Delegation, CallbackFn(DelegationCallback)).
+		AddCallback(Proposal, CallbackFn(ProposalCallback)).
+		AddCallback(DelegationRewards, CallbackFn(DelegationRewardsCallback))
 
 	return a.(Callbacks)
 }
@@ -93,7 +109,9 @@ func ValidatorSetCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes
 
 		// if it is a new validator or any of the attributes we track has changed, query for it
 		if !found || (val != nil && (validator.Status.String() != val.Status ||
-			!validator.DelegatorShares.Equal(val.DelegatorShares) || !validator.Tokens.Equal(val.TotalAmount))) {
+			!validator.DelegatorShares.Equal(val.DelegatorShares) || !validator.Tokens.Equal(val.TotalAmount) ||
+			val.CommissionRate.IsNil() || !validator.Commission.Rate.Equal(val.CommissionRate) ||
+			validator.Jailed != val.Jailed)) {
 			if err := k.QueryHostChainValidator(ctx, hc, validator.OperatorAddress); err != nil {
 				return errorsmod.Wrapf(types.ErrFailedICQRequest, "error querying for validator: %s", err.Error())
 			}
@@ -137,13 +155,25 @@ func DelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Q
 		)
 	}
 
+	account, found := hc.GetDelegationAccountByAddress(delegation.DelegatorAddress)
+	if !found {
+		return fmt.Errorf(
+			"delegator %s is not a delegation account of host chain %s",
+			delegation.DelegatorAddress,
+			query.ChainId,
+		)
+	}
+
+	// compare the queried delegation against the one tracked for that delegation account
+	existingDelegation := hc.GetAccountDelegatedAmount(account, validator.OperatorAddress)
 	delegatedAmount := validator.SharesToTokens(delegation.Shares)
-	slashedAmount := validator.DelegatedAmount.Sub(delegatedAmount)
+	slashedAmount := existingDelegation.Sub(delegatedAmount)
 
 	if slashedAmount.IsPositive() {
 		k.Logger(ctx).Info("Validator has been slashed !!!",
 			"host-chain:", hc.ChainId,
 			"validator:", validator.OperatorAddress,
+			"delegator:", account.Address,
 			"slashed-amount:", slashedAmount,
 		)
 
@@ -151,13 +181,20 @@ func DelegationCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Q
 			sdk.NewEvent(
 				types.EventTypeSlashing,
 				sdk.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
-				sdk.NewAttribute(types.AttributeExistingDelegation, validator.DelegatedAmount.String()),
+				sdk.NewAttribute(types.AttributeExistingDelegation, existingDelegation.String()),
 				sdk.NewAttribute(types.AttributeUpdatedDelegation, delegatedAmount.String()),
 				sdk.NewAttribute(types.AttributeSlashedAmount, slashedAmount.String()),
 			)})
+
+		// record the slash and socialise the loss across the in-flight unbondings
+		k.HandleValidatorSlash(ctx, hc, validator, slashedAmount)
 	}
 
-	validator.DelegatedAmount = delegatedAmount
+	// the validator delegated amount is the sum of the delegations of all the delegation accounts
+	validator.DelegatedAmount = validator.DelegatedAmount.Sub(existingDelegation).Add(delegatedAmount)
+	if !hc.IsMainDelegationAccount(account) {
+		account.AddDelegatedAmount(validator.OperatorAddress, delegatedAmount.Sub(existingDelegation))
+	}
 	k.SetHostChainValidator(ctx, hc, validator)
 
 	return nil
@@ -174,7 +211,23 @@ func DelegationAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, qu
 		return fmt.Errorf("could unmarshal balance from ICQ balances request: %w", err)
 	}
 
-	hc.DelegationAccount.Balance = balance
+	// the queried account is the one encoded in the balance store key
+	address, _, err := banktypes.AddressAndDenomFromBalancesStore(query.Request[len(banktypes.BalancesPrefix):])
+	if err != nil {
+		return fmt.Errorf("could not parse the address from the ICQ balances request: %w", err)
+	}
+
+	hrp, _, err := bech32.DecodeAndConvert(hc.DelegationAccount.Address)
+	if err != nil {
+		return fmt.Errorf("could not decode the delegation account address: %w", err)
+	}
+
+	account, found := hc.GetDelegationAccountByAddress(sdk.MustBech32ifyAddressBytes(hrp, address))
+	if !found {
+		return fmt.Errorf("queried address is not a delegation account of host chain %s", hc.ChainId)
+	}
+
+	account.Balance = balance
 
 	k.SetHostChain(ctx, hc)
 
@@ -212,3 +265,73 @@ func RewardsAccountBalanceCallback(k Keeper, ctx sdk.Context, data []byte, query
 
 	return nil
 }
+
+func ProposalCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	proposalID, err := GetProposalIDFromQueryKey(query.Request)
+	if err != nil {
+		return fmt.Errorf("could not parse the proposal id from the ICQ proposal request: %w", err)
+	}
+
+	// an empty response means the proposal doesn't exist on the host chain
+	if len(data) == 0 {
+		k.ProcessHostChainProposal(ctx, hc, proposalID, nil)
+		return nil
+	}
+
+	// the proposal content is not unpacked, as it might contain messages unknown to this chain
+	proposal := govv1beta1.Proposal{}
+	if err = proto.Unmarshal(data, &proposal); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ proposal response: %w", err)
+	}
+
+	k.ProcessHostChainProposal(ctx, hc, proposalID, &proposal)
+
+	// keep tracking the host chain proposals one by one as they are found
+	if proposalID == hc.NextProposalId {
+		hc.NextProposalId++
+		k.SetHostChain(ctx, hc)
+
+		if err = k.QueryHostChainProposal(ctx, hc, hc.NextProposalId); err != nil {
+			return errorsmod.Wrapf(types.ErrFailedICQRequest, "error querying for proposal: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+func DelegationRewardsCallback(k Keeper, ctx sdk.Context, data []byte, query icqtypes.Query) error {
+	hc, found := k.GetHostChain(ctx, query.ChainId)
+	if !found {
+		return fmt.Errorf("host chain with id %s is not registered", query.ChainId)
+	}
+
+	request := distributiontypes.QueryDelegationTotalRewardsRequest{}
+	if err := k.cdc.Unmarshal(query.Request, &request); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ delegation rewards request: %w", err)
+	}
+
+	account, found := hc.GetDelegationAccountByAddress(request.DelegatorAddress)
+	if !found {
+		return fmt.Errorf("delegation account %s not found for host chain %s", request.DelegatorAddress, hc.ChainId)
+	}
+
+	response := distributiontypes.QueryDelegationTotalRewardsResponse{}
+	if err := k.cdc.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("could not unmarshall ICQ delegation rewards response: %w", err)
+	}
+
+	// only withdraw from the validators whose accrued rewards are worth the ICA gas
+	validators := make([]string, 0)
+	for _, reward := range response.Rewards {
+		if reward.Reward.AmountOf(hc.HostDenom).TruncateInt().GTE(hc.GetRewardsClaimThreshold()) {
+			validators = append(validators, reward.ValidatorAddress)
+		}
+	}
+
+	return k.WithdrawDelegationRewards(ctx, hc, account, validators)
+}
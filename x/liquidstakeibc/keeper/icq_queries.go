@@ -4,6 +4,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
@@ -62,12 +64,27 @@ func (k *Keeper) QueryHostChainValidator(
 	return nil
 }
 
-// QueryDelegationHostChainAccountBalance sends an ICQ query to get the delegation host account balance
+// QueryDelegationHostChainAccountBalance sends an ICQ query to get the balance of every delegation host account
 func (k *Keeper) QueryDelegationHostChainAccountBalance(
 	ctx sdk.Context,
 	hc *types.HostChain,
 ) error {
-	_, byteAddress, err := bech32.DecodeAndConvert(hc.DelegationAccount.Address)
+	for _, account := range hc.GetActiveDelegationAccounts() {
+		if err := k.QueryDelegationAccountBalance(ctx, hc, account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// QueryDelegationAccountBalance sends an ICQ query to get the balance of a delegation host account
+func (k *Keeper) QueryDelegationAccountBalance(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	account *types.ICAAccount,
+) error {
+	_, byteAddress, err := bech32.DecodeAndConvert(account.Address)
 	if err != nil {
 		return err
 	}
@@ -116,13 +133,29 @@ func (k *Keeper) QueryRewardsHostChainAccountBalance(
 	return nil
 }
 
-// QueryValidatorDelegation sends an ICQ query to get a validator delegation
+// QueryValidatorDelegation sends an ICQ query to get the validator delegation of every delegation account
 func (k *Keeper) QueryValidatorDelegation(
 	ctx sdk.Context,
 	hc *types.HostChain,
 	validator *types.Validator,
 ) error {
-	_, delegatorAddr, err := bech32.DecodeAndConvert(hc.DelegationAccount.Address)
+	for _, account := range hc.GetActiveDelegationAccounts() {
+		if err := k.QueryAccountValidatorDelegation(ctx, hc, account, validator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// QueryAccountValidatorDelegation sends an ICQ query to get the validator delegation of a delegation account
+func (k *Keeper) QueryAccountValidatorDelegation(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	account *types.ICAAccount,
+	validator *types.Validator,
+) error {
+	_, delegatorAddr, err := bech32.DecodeAndConvert(account.Address)
 	if err != nil {
 		return err
 	}
@@ -146,3 +179,50 @@ func (k *Keeper) QueryValidatorDelegation(
 
 	return nil
 }
+
+// QueryHostChainProposal sends an ICQ query to get a host chain governance proposal
+func (k *Keeper) QueryHostChainProposal(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	proposalID uint64,
+) error {
+	k.icqKeeper.MakeRequest(
+		ctx,
+		hc.ConnectionId,
+		hc.ChainId,
+		types.GovStoreQuery,
+		govtypes.ProposalKey(proposalID),
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		Proposal,
+		0,
+	)
+
+	return nil
+}
+
+// QueryDelegationTotalRewards sends an ICQ query to estimate the pending rewards of a delegation host account
+func (k *Keeper) QueryDelegationTotalRewards(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	account *types.ICAAccount,
+) error {
+	bz, err := k.cdc.Marshal(&distributiontypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: account.Address})
+	if err != nil {
+		return err
+	}
+
+	k.icqKeeper.MakeRequest(
+		ctx,
+		hc.ConnectionId,
+		hc.ChainId,
+		"cosmos.distribution.v1beta1.Query/DelegationTotalRewards",
+		bz,
+		sdk.NewInt(int64(-1)),
+		types.ModuleName,
+		DelegationRewards,
+		0,
+	)
+
+	return nil
+}
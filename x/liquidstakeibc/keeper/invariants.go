@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+type routedInvariant struct {
+	route     string
+	invariant func(Keeper) sdk.Invariant
+	// whether the invariant is registered with the crisis module, which halts the chain when it breaks
+	registered bool
+}
+
+var invariants = []routedInvariant{
+	// the c value only moves once per c value epoch, so a slash can deviate the stk supply from it until the next
+	// update, the stk supply is only checked through the invariants query
+	{"stk-supply", StkSupplyInvariant, false},
+	{"undelegation-account-balance", UndelegationAccountBalanceInvariant, true},
+	{"user-unbondings", UserUnbondingsInvariant, true},
+	{"deposit-account-balance", DepositAccountBalanceInvariant, true},
+	{"validator-weights", ValidatorWeightsInvariant, true},
+}
+
+// RegisterInvariants registers the liquidstakeibc invariants that can halt the chain.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, inv := range invariants {
+		if inv.registered {
+			ir.RegisterRoute(types.ModuleName, inv.route, inv.invariant(k))
+		}
+	}
+}
+
+// AllInvariants runs all the registered invariants of the liquidstakeibc module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range invariants {
+			if !inv.registered {
+				continue
+			}
+
+			res, stop := inv.invariant(k)(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// CheckInvariants runs all invariants of the liquidstakeibc module and returns the result of each of them.
+func CheckInvariants(ctx sdk.Context, k Keeper) []types.InvariantResult {
+	results := make([]types.InvariantResult, 0, len(invariants))
+	for _, inv := range invariants {
+		msg, broken := inv.invariant(k)(ctx)
+		results = append(results, types.InvariantResult{Route: inv.route, Broken: broken, Message: msg})
+	}
+	return results
+}
+
+// StkSupplyInvariant checks that the stk supply of every host chain matches its liquid staked amount at the c value.
+func StkSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+		for _, hc := range k.GetAllHostChains(ctx) {
+			// the c value of the host chains being deregistered is fixed by the wind down
+			if hc.IsWindingDown() {
+				continue
+			}
+
+			mintedAmount := k.bankKeeper.GetSupply(ctx, hc.MintDenom()).Amount
+			liquidStakedAmount := k.GetLiquidStakedAmount(ctx, hc)
+			if mintedAmount.IsZero() || liquidStakedAmount.IsZero() {
+				continue
+			}
+
+			expected := hc.CValue.MulInt(liquidStakedAmount)
+			deviation := sdk.NewDecFromInt(mintedAmount).Sub(expected).Abs().QuoInt(mintedAmount)
+			if deviation.GT(types.CValueInvariantTolerance) {
+				msg += fmt.Sprintf(
+					"\thost chain %s stk supply %s differs from its expected supply %s (c value %s, liquid staked %s)\n",
+					hc.ChainId, mintedAmount, expected, hc.CValue, liquidStakedAmount,
+				)
+				broken = true
+			}
+		}
+		return sdk.FormatInvariant(
+			types.ModuleName, "stk supply with c value invariant broken",
+			msg,
+		), broken
+	}
+}
+
+// UndelegationAccountBalanceInvariant checks that the undelegation module account holds the tokens of all the
+// claimable and failed unbondings, and of the wind down reserves.
+func UndelegationAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, hc := range k.GetAllHostChains(ctx) {
+			unbondings := k.FilterUnbondings(
+				ctx,
+				func(u types.Unbonding) bool {
+					return u.ChainId == hc.ChainId &&
+						(u.State == types.Unbonding_UNBONDING_CLAIMABLE || u.State == types.Unbonding_UNBONDING_FAILED)
+				},
+			)
+			for _, unbonding := range unbondings {
+				switch unbonding.State {
+				case types.Unbonding_UNBONDING_CLAIMABLE:
+					expected = expected.Add(sdk.NewCoin(hc.IBCDenom(), unbonding.UnbondAmount.Amount))
+				case types.Unbonding_UNBONDING_FAILED:
+					expected = expected.Add(sdk.NewCoin(hc.MintDenom(), unbonding.BurnAmount.Amount))
+				}
+			}
+		}
+
+		for _, reserve := range k.GetAllWindDownReserves(ctx) {
+			expected = expected.Add(reserve.Amount)
+		}
+
+		msg, broken := checkModuleAccountBalance(ctx, k, types.UndelegationModuleAccount, expected)
+		return sdk.FormatInvariant(
+			types.ModuleName, "undelegation module account balance invariant broken",
+			msg,
+		), broken
+	}
+}
+
+// UserUnbondingsInvariant checks that the user unbondings of every epoch add up to the epoch unbonding. The user
+// unbondings can add up to less once they start being claimed or after a slash, which rounds them down.
+func UserUnbondingsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+		for _, unbonding := range k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return true }) {
+			userUnbondings := k.FilterUserUnbondings(
+				ctx,
+				func(u types.UserUnbonding) bool {
+					return u.ChainId == unbonding.ChainId && u.EpochNumber == unbonding.EpochNumber
+				},
+			)
+
+			stkAmount := sdk.ZeroInt()
+			unbondAmount := sdk.ZeroInt()
+			for _, userUnbonding := range userUnbondings {
+				stkAmount = stkAmount.Add(userUnbonding.StkAmount.Amount)
+				unbondAmount = unbondAmount.Add(userUnbonding.UnbondAmount.Amount)
+			}
+
+			claiming := unbonding.State == types.Unbonding_UNBONDING_CLAIMABLE ||
+				unbonding.State == types.Unbonding_UNBONDING_FAILED
+			if (claiming && stkAmount.GT(unbonding.BurnAmount.Amount)) ||
+				(!claiming && !stkAmount.Equal(unbonding.BurnAmount.Amount)) ||
+				unbondAmount.GT(unbonding.UnbondAmount.Amount) {
+				msg += fmt.Sprintf(
+					"\thost chain %s epoch %d user unbondings %s stk and %s unbonded don't match the unbonding %s and %s\n",
+					unbonding.ChainId, unbonding.EpochNumber, stkAmount, unbondAmount,
+					unbonding.BurnAmount, unbonding.UnbondAmount,
+				)
+				broken = true
+			}
+		}
+		return sdk.FormatInvariant(
+			types.ModuleName, "user unbondings with epoch unbonding invariant broken",
+			msg,
+		), broken
+	}
+}
+
+// DepositAccountBalanceInvariant checks that the deposit module account holds the tokens of all the deposits that
+// haven't left Persistence.
+func DepositAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, deposit := range k.GetAllDeposits(ctx) {
+			if deposit.State == types.Deposit_DEPOSIT_PENDING {
+				expected = expected.Add(deposit.Amount)
+			}
+		}
+
+		msg, broken := checkModuleAccountBalance(ctx, k, types.DepositModuleAccount, expected)
+		return sdk.FormatInvariant(
+			types.ModuleName, "deposit module account balance invariant broken",
+			msg,
+		), broken
+	}
+}
+
+// ValidatorWeightsInvariant checks that the validator weights of every host chain add up to one, once set.
+func ValidatorWeightsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg := ""
+		broken := false
+		for _, hc := range k.GetAllHostChains(ctx) {
+			if hc.IsWindingDown() {
+				continue
+			}
+
+			totalWeight := sdk.ZeroDec()
+			for _, validator := range hc.Validators {
+				if !validator.Weight.IsNil() {
+					totalWeight = totalWeight.Add(validator.Weight)
+				}
+			}
+
+			if !totalWeight.IsZero() && totalWeight.Sub(sdk.OneDec()).Abs().GT(types.WeightInvariantTolerance) {
+				msg += fmt.Sprintf("\thost chain %s validator weights add up to %s\n", hc.ChainId, totalWeight)
+				broken = true
+			}
+		}
+		return sdk.FormatInvariant(
+			types.ModuleName, "validator weights invariant broken",
+			msg,
+		), broken
+	}
+}
+
+// checkModuleAccountBalance checks that the module account balance covers the expected coins
+func checkModuleAccountBalance(ctx sdk.Context, k Keeper, moduleAccount string, expected sdk.Coins) (string, bool) {
+	msg := ""
+	broken := false
+	address := k.accountKeeper.GetModuleAccount(ctx, moduleAccount).GetAddress()
+	for _, coin := range expected {
+		balance := k.bankKeeper.GetBalance(ctx, address, coin.Denom)
+		if balance.IsLT(coin) {
+			msg += fmt.Sprintf("\t%s balance %s is lower than the expected %s\n", moduleAccount, balance, coin)
+			broken = true
+		}
+	}
+	return msg, broken
+}
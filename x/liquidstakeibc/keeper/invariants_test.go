@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) requireBrokenInvariants(routes ...string) {
+	broken := make([]string, 0)
+	for _, result := range keeper.CheckInvariants(suite.ctx, suite.app.LiquidStakeIBCKeeper) {
+		if result.Broken {
+			broken = append(broken, result.Route)
+		}
+	}
+	suite.Require().ElementsMatch(routes, broken)
+}
+
+func (suite *IntegrationTestSuite) TestInvariants() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	suite.requireBrokenInvariants()
+
+	// a pending deposit not held by the deposit module account
+	deposit := &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.NewInt(1000)),
+		Epoch:   sdk.NewInt(1),
+		State:   types.Deposit_DEPOSIT_PENDING,
+	}
+	suite.app.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, deposit)
+	suite.requireBrokenInvariants("deposit-account-balance")
+
+	suite.Require().NoError(
+		testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.DepositModuleAccount, sdk.NewCoins(deposit.Amount)),
+	)
+	suite.requireBrokenInvariants()
+
+	// stk tokens minted out of the c value
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			types.ModuleName,
+			sdk.NewCoins(sdk.NewCoin(hc.MintDenom(), sdk.NewInt(1100))),
+		),
+	)
+	suite.requireBrokenInvariants("stk-supply")
+
+	hc.CValue = decFromStr("1.1")
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+	suite.requireBrokenInvariants()
+
+	// a claimable unbonding whose user unbondings add up to more than it, with no tokens to claim
+	suite.app.LiquidStakeIBCKeeper.SetUnbonding(
+		suite.ctx,
+		&types.Unbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  1,
+			BurnAmount:   sdk.NewCoin(hc.MintDenom(), sdk.NewInt(100)),
+			UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.NewInt(100)),
+			State:        types.Unbonding_UNBONDING_CLAIMABLE,
+		},
+	)
+	suite.app.LiquidStakeIBCKeeper.SetUserUnbonding(
+		suite.ctx,
+		&types.UserUnbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  1,
+			Address:      TestAddress,
+			StkAmount:    sdk.NewCoin(hc.MintDenom(), sdk.NewInt(100)),
+			UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.NewInt(101)),
+		},
+	)
+	suite.requireBrokenInvariants("undelegation-account-balance", "user-unbondings")
+
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			types.UndelegationModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), sdk.NewInt(100))),
+		),
+	)
+	suite.app.LiquidStakeIBCKeeper.SetUserUnbonding(
+		suite.ctx,
+		&types.UserUnbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  1,
+			Address:      TestAddress,
+			StkAmount:    sdk.NewCoin(hc.MintDenom(), sdk.NewInt(100)),
+			UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.NewInt(99)),
+		},
+	)
+	suite.requireBrokenInvariants()
+
+	// validator weights not adding up to one
+	hc.Validators[0].Weight = hc.Validators[0].Weight.Add(decFromStr("0.1"))
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+	suite.requireBrokenInvariants("validator-weights")
+}
+
+type routesRegistry []string
+
+func (r *routesRegistry) RegisterRoute(_, route string, _ sdk.Invariant) {
+	*r = append(*r, route)
+}
+
+func (suite *IntegrationTestSuite) TestRegisterInvariants() {
+	routes := routesRegistry{}
+	keeper.RegisterInvariants(&routes, suite.app.LiquidStakeIBCKeeper)
+
+	// the stk supply only follows the c value after its update, so it can't halt the chain
+	suite.Require().ElementsMatch(
+		[]string{"undelegation-account-balance", "user-unbondings", "deposit-account-balance", "validator-weights"},
+		[]string(routes),
+	)
+
+	// the stk supply deviation from the c value is still reported by the invariants query
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+	deposit := &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewCoin(hc.IBCDenom(), sdk.NewInt(1000)),
+		Epoch:   sdk.NewInt(1),
+		State:   types.Deposit_DEPOSIT_PENDING,
+	}
+	suite.app.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, deposit)
+	suite.Require().NoError(
+		testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.DepositModuleAccount, sdk.NewCoins(deposit.Amount)),
+	)
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			types.ModuleName,
+			sdk.NewCoins(sdk.NewCoin(hc.MintDenom(), sdk.NewInt(1100))),
+		),
+	)
+	suite.requireBrokenInvariants("stk-supply")
+
+	_, broken := keeper.AllInvariants(suite.app.LiquidStakeIBCKeeper)(suite.ctx)
+	suite.Require().False(broken)
+}
@@ -30,6 +30,7 @@ type Keeper struct {
 	epochsKeeper        types.EpochsKeeper
 	icaControllerKeeper types.ICAControllerKeeper
 	ibcKeeper           *ibckeeper.Keeper
+	ibcTransferKeeper   types.IBCTransferKeeper
 	icqKeeper           types.ICQKeeper
 
 	paramSpace paramtypes.Subspace
@@ -48,6 +49,7 @@ func NewKeeper(
 	epochsKeeper types.EpochsKeeper,
 	icaControllerKeeper types.ICAControllerKeeper,
 	ibcKeeper *ibckeeper.Keeper,
+	ibcTransferKeeper types.IBCTransferKeeper,
 	icqKeeper types.ICQKeeper,
 
 	paramSpace paramtypes.Subspace,
@@ -63,6 +65,7 @@ func NewKeeper(
 		epochsKeeper:        epochsKeeper,
 		icaControllerKeeper: icaControllerKeeper,
 		ibcKeeper:           ibcKeeper,
+		ibcTransferKeeper:   ibcTransferKeeper,
 		icqKeeper:           icqKeeper,
 		storeKey:            storeKey,
 		paramSpace:          paramSpace,
@@ -105,6 +108,11 @@ func (k *Keeper) GetUndelegationModuleAccount(ctx sdk.Context) authtypes.ModuleA
 	return k.accountKeeper.GetModuleAccount(ctx, types.UndelegationModuleAccount)
 }
 
+// GetLiquidityPoolModuleAccount returns liquidity pool module account interface
+func (k *Keeper) GetLiquidityPoolModuleAccount(ctx sdk.Context) authtypes.ModuleAccountI {
+	return k.accountKeeper.GetModuleAccount(ctx, types.LiquidityPoolModuleAccount)
+}
+
 // SendProtocolFee to the community pool
 func (k *Keeper) SendProtocolFee(ctx sdk.Context, protocolFee sdk.Coins, moduleAccount, feeAddress string) error {
 	addr, err := sdk.AccAddressFromBech32(feeAddress)
@@ -183,17 +191,30 @@ func (k *Keeper) RegisterICAAccount(ctx sdk.Context, connectionID, owner string)
 	)
 }
 
-// SetWithdrawAddress sends a MsgSetWithdrawAddress to set the withdrawal address to the rewards account
+// SetWithdrawAddress sends a MsgSetWithdrawAddress to set the withdrawal address of every delegation account to
+// the rewards account
 func (k *Keeper) SetWithdrawAddress(ctx sdk.Context, hc *types.HostChain) error {
+	for _, account := range hc.GetActiveDelegationAccounts() {
+		if err := k.SetAccountWithdrawAddress(ctx, hc, account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetAccountWithdrawAddress sends a MsgSetWithdrawAddress to set the withdrawal address of a delegation account
+// to the rewards account
+func (k *Keeper) SetAccountWithdrawAddress(ctx sdk.Context, hc *types.HostChain, account *types.ICAAccount) error {
 	msgSetWithdrawAddress := &distributiontypes.MsgSetWithdrawAddress{
-		DelegatorAddress: hc.DelegationAccount.Address,
+		DelegatorAddress: account.Address,
 		WithdrawAddress:  hc.RewardsAccount.Address,
 	}
 
 	_, err := k.GenerateAndExecuteICATx(
 		ctx,
 		hc.ConnectionId,
-		hc.DelegationAccount.Owner,
+		account.Owner,
 		[]proto.Message{msgSetWithdrawAddress},
 	)
 	if err != nil {
@@ -267,6 +288,10 @@ func (k *Keeper) UpdateCValues(ctx sdk.Context) {
 	hostChains := k.GetAllHostChains(ctx)
 
 	for _, hc := range hostChains {
+		// the c value of the host chains being deregistered is fixed by the wind down
+		if hc.IsWindingDown() {
+			continue
+		}
 
 		// total stk tokens minted
 		mintedAmount := k.bankKeeper.GetSupply(ctx, hc.MintDenom()).Amount
@@ -283,8 +308,15 @@ func (k *Keeper) UpdateCValues(ctx sdk.Context) {
 		// amount unbonded from a validator that has been in the Unbonding state for more than 4 unbonding epochs
 		totalUnbondingAmount := k.GetAllValidatorUnbondedAmount(ctx, hc)
 
+		// value of the tokenized shares deposited that haven't been redeemed into a delegation yet
+		lsmDepositAmount := k.GetLSMDepositAmountUntokenized(ctx, hc)
+
 		// total amount staked
-		liquidStakedAmount := stakedAmount.Add(amountOnPersistence).Add(amountOnHostChain).Add(totalUnbondingAmount)
+		liquidStakedAmount := stakedAmount.
+			Add(amountOnPersistence).
+			Add(amountOnHostChain).
+			Add(totalUnbondingAmount).
+			Add(lsmDepositAmount)
 
 		var cValue sdk.Dec
 		if mintedAmount.IsZero() || liquidStakedAmount.IsZero() {
@@ -295,7 +327,7 @@ func (k *Keeper) UpdateCValues(ctx sdk.Context) {
 
 		k.Logger(ctx).Info(
 			fmt.Sprintf(
-				"Updated CValue for %s. Total minted amount: %v. Total liquid staked amount: %v. Composed of %v staked tokens, %v tokens on Persistence, %v tokens on the host chain, %v tokens from a validator total unbonding. New c_value: %v - Old c_value: %v",
+				"Updated CValue for %s. Total minted amount: %v. Total liquid staked amount: %v. Composed of %v staked tokens, %v tokens on Persistence, %v tokens on the host chain, %v tokens from a validator total unbonding, %v tokens from tokenized shares. New c_value: %v - Old c_value: %v",
 				hc.ChainId,
 				mintedAmount,
 				liquidStakedAmount,
@@ -303,6 +335,7 @@ func (k *Keeper) UpdateCValues(ctx sdk.Context) {
 				amountOnPersistence,
 				amountOnHostChain,
 				totalUnbondingAmount,
+				lsmDepositAmount,
 				cValue,
 				hc.CValue,
 			),
@@ -312,23 +345,29 @@ func (k *Keeper) UpdateCValues(ctx sdk.Context) {
 		hc.CValue = cValue
 		k.SetHostChain(ctx, hc)
 
-		// if the c value is out of bounds, disable the chain
+		k.RecordExchangeRate(ctx, hc, liquidStakedAmount, mintedAmount)
+
+		// if the c value is out of bounds, halt the chain
 		if !k.CValueWithinLimits(ctx, hc) {
-			hc.Active = false
-			k.SetHostChain(ctx, hc)
-
-			k.Logger(ctx).Error(fmt.Sprintf("C value out of limits !!! Disabling chain %s with c value %v.", hc.ChainId, hc.CValue))
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeChainDisabled,
-					sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
-					sdk.NewAttribute(types.AttributeCValue, hc.CValue.String()),
-				),
-			)
+			k.Logger(ctx).Error(fmt.Sprintf("C value out of limits !!! Halting chain %s with c value %v.", hc.ChainId, hc.CValue))
+			k.TripCircuitBreaker(ctx, hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_HALTED, types.TripReasonCValueLimits)
+			continue
 		}
+
+		k.CheckCValueDeviation(ctx, hc)
 	}
 }
 
 func (k *Keeper) CValueWithinLimits(ctx sdk.Context, hc *types.HostChain) bool {
 	return hc.CValue.LT(k.GetParams(ctx).UpperCValueLimit) && hc.CValue.GT(k.GetParams(ctx).LowerCValueLimit)
 }
+
+// GetLiquidStakedAmount returns the total amount of host chain tokens backing the stk tokens, the same way it is
+// computed for the c value updates
+func (k *Keeper) GetLiquidStakedAmount(ctx sdk.Context, hc *types.HostChain) sdk.Int { //nolint:staticcheck
+	return hc.GetHostChainTotalDelegations().
+		Add(k.GetDepositAmountOnPersistence(ctx, hc.ChainId)).
+		Add(k.GetDepositAmountOnHostChain(ctx, hc.ChainId)).
+		Add(k.GetAllValidatorUnbondedAmount(ctx, hc)).
+		Add(k.GetLSMDepositAmountUntokenized(ctx, hc))
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetLedgerEntry(ctx sdk.Context, entry *types.LedgerEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LedgerEntryKey)
+	bytes := k.cdc.MustMarshal(entry)
+	store.Set(types.GetLedgerEntryStoreKey(entry.Address, entry.Id), bytes)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LedgerHeightIndexKey)
+	indexStore.Set(types.GetLedgerHeightIndexStoreKey(entry.Height, entry.Id), []byte(entry.Address))
+}
+
+func (k *Keeper) DeleteLedgerEntry(ctx sdk.Context, entry *types.LedgerEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LedgerEntryKey)
+	store.Delete(types.GetLedgerEntryStoreKey(entry.Address, entry.Id))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LedgerHeightIndexKey)
+	indexStore.Delete(types.GetLedgerHeightIndexStoreKey(entry.Height, entry.Id))
+}
+
+// GetAddressLedgerEntries returns the ledger entries of an address, oldest first
+func (k *Keeper) GetAddressLedgerEntries(ctx sdk.Context, address string) []*types.LedgerEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LedgerEntryKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(address))
+	defer iterator.Close()
+
+	entries := make([]*types.LedgerEntry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		entry := types.LedgerEntry{}
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		// the address is a prefix of the key, make sure it isn't the prefix of a longer address
+		if entry.Address == address {
+			entries = append(entries, &entry)
+		}
+	}
+
+	return entries
+}
+
+func (k *Keeper) FilterLedgerEntries(ctx sdk.Context, filter func(e types.LedgerEntry) bool) []*types.LedgerEntry {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LedgerEntryKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	entries := make([]*types.LedgerEntry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		entry := types.LedgerEntry{}
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		if filter(entry) {
+			entries = append(entries, &entry)
+		}
+	}
+
+	return entries
+}
+
+// GetLedgerSequence returns the id the next ledger entry will have
+func (k *Keeper) GetLedgerSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LedgerSequenceKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k *Keeper) SetLedgerSequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LedgerSequenceKey, sdk.Uint64ToBigEndian(sequence))
+}
+
+// RecordLedgerEntry adds a liquid staking operation to the ledger of an address, if the ledger is enabled
+func (k *Keeper) RecordLedgerEntry(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	address string,
+	entryType types.LedgerEntry_EntryType,
+	amount sdk.Coin,
+	stkAmount sdk.Coin,
+	fee sdk.Coin,
+) {
+	if !k.GetParams(ctx).LedgerEnabled {
+		return
+	}
+
+	id := k.GetLedgerSequence(ctx)
+	k.SetLedgerSequence(ctx, id+1)
+
+	k.SetLedgerEntry(
+		ctx,
+		&types.LedgerEntry{
+			Id:        id,
+			Address:   address,
+			ChainId:   hc.ChainId,
+			Type:      entryType,
+			Amount:    amount,
+			StkAmount: stkAmount,
+			Fee:       fee,
+			CValue:    hc.CValue,
+			Height:    ctx.BlockHeight(),
+			Time:      ctx.BlockTime(),
+		},
+	)
+}
+
+// PruneLedgerEntries removes the ledger entries older than the ledger retention blocks
+func (k *Keeper) PruneLedgerEntries(ctx sdk.Context) {
+	retention := k.GetParams(ctx).LedgerRetentionBlocks
+	if retention == 0 || ctx.BlockHeight()-retention <= 0 {
+		return
+	}
+
+	// the height index is walked oldest first and stops at the first entry that is still retained
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LedgerHeightIndexKey)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()-retention)))
+	defer iterator.Close()
+
+	entries := make([]*types.LedgerEntry, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		entries = append(
+			entries,
+			&types.LedgerEntry{
+				Id:      sdk.BigEndianToUint64(key[8:]),
+				Address: string(iterator.Value()),
+				Height:  int64(sdk.BigEndianToUint64(key[:8])),
+			},
+		)
+	}
+
+	for _, entry := range entries {
+		k.DeleteLedgerEntry(ctx, entry)
+	}
+}
+
+// GetPortfolioPosition values the stk balance of an address at the host chain c value and sums up its ledger
+func (k *Keeper) GetPortfolioPosition(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	address sdk.AccAddress,
+	entries []*types.LedgerEntry,
+) types.PortfolioPosition {
+	stkBalance := k.bankKeeper.GetBalance(ctx, address, hc.MintDenom())
+
+	value := sdk.ZeroInt()
+	if hc.CValue.IsPositive() {
+		value = sdk.NewDecFromInt(stkBalance.Amount).Quo(hc.CValue).TruncateInt()
+	}
+
+	unbonding := sdk.ZeroInt()
+	userUnbondings := k.FilterUserUnbondings(
+		ctx,
+		func(u types.UserUnbonding) bool {
+			return u.ChainId == hc.ChainId && u.Address == address.String()
+		},
+	)
+	for _, userUnbonding := range userUnbondings {
+		unbonding = unbonding.Add(userUnbonding.UnbondAmount.Amount)
+	}
+
+	staked, withdrawn := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, entry := range entries {
+		if entry.ChainId != hc.ChainId {
+			continue
+		}
+
+		switch entry.Type {
+		case types.LedgerEntry_LEDGER_ENTRY_LIQUID_STAKE:
+			staked = staked.Add(entry.Amount.Amount)
+		case types.LedgerEntry_LEDGER_ENTRY_REDEEM:
+			withdrawn = withdrawn.Add(entry.Amount.Amount)
+		case types.LedgerEntry_LEDGER_ENTRY_CLAIM:
+			// the claims of failed unbondings return stk tokens, which are already part of the balance
+			if entry.Amount.Denom == hc.IBCDenom() {
+				withdrawn = withdrawn.Add(entry.Amount.Amount)
+			}
+		}
+	}
+
+	return types.PortfolioPosition{
+		ChainId:    hc.ChainId,
+		StkBalance: stkBalance,
+		CValue:     hc.CValue,
+		Value:      sdk.NewCoin(hc.IBCDenom(), value),
+		Unbonding:  sdk.NewCoin(hc.IBCDenom(), unbonding),
+		Staked:     sdk.NewCoin(hc.IBCDenom(), staked),
+		Withdrawn:  sdk.NewCoin(hc.IBCDenom(), withdrawn),
+		Profit:     value.Add(unbonding).Add(withdrawn).Sub(staked),
+	}
+}
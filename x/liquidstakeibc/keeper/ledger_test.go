@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestRecordLedgerEntry() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	amount := sdk.NewInt64Coin(hc.IBCDenom(), 1000)
+	stkAmount := sdk.NewInt64Coin(hc.MintDenom(), 1000)
+	fee := sdk.NewInt64Coin(hc.MintDenom(), 0)
+
+	// nothing is recorded while the ledger is disabled
+	suite.app.LiquidStakeIBCKeeper.RecordLedgerEntry(
+		suite.ctx, hc, TestAddress, types.LedgerEntry_LEDGER_ENTRY_LIQUID_STAKE, amount, stkAmount, fee,
+	)
+	suite.Require().Equal(0, len(suite.app.LiquidStakeIBCKeeper.GetAddressLedgerEntries(suite.ctx, TestAddress)))
+
+	params := suite.app.LiquidStakeIBCKeeper.GetParams(suite.ctx)
+	params.LedgerEnabled = true
+	suite.app.LiquidStakeIBCKeeper.SetParams(suite.ctx, params)
+
+	suite.app.LiquidStakeIBCKeeper.RecordLedgerEntry(
+		suite.ctx, hc, TestAddress, types.LedgerEntry_LEDGER_ENTRY_LIQUID_STAKE, amount, stkAmount, fee,
+	)
+	suite.app.LiquidStakeIBCKeeper.RecordLedgerEntry(
+		suite.ctx, hc, TestAddress, types.LedgerEntry_LEDGER_ENTRY_REDEEM, amount, stkAmount, fee,
+	)
+
+	entries := suite.app.LiquidStakeIBCKeeper.GetAddressLedgerEntries(suite.ctx, TestAddress)
+	suite.Require().Equal(2, len(entries))
+	suite.Require().Equal(uint64(0), entries[0].Id)
+	suite.Require().Equal(types.LedgerEntry_LEDGER_ENTRY_LIQUID_STAKE, entries[0].Type)
+	suite.Require().Equal(uint64(1), entries[1].Id)
+	suite.Require().Equal(types.LedgerEntry_LEDGER_ENTRY_REDEEM, entries[1].Type)
+	suite.Require().Equal(hc.ChainId, entries[1].ChainId)
+	suite.Require().Equal(hc.CValue, entries[1].CValue)
+	suite.Require().Equal(suite.ctx.BlockHeight(), entries[1].Height)
+	suite.Require().Equal(uint64(2), suite.app.LiquidStakeIBCKeeper.GetLedgerSequence(suite.ctx))
+}
+
+func (suite *IntegrationTestSuite) TestPruneLedgerEntries() {
+	params := suite.app.LiquidStakeIBCKeeper.GetParams(suite.ctx)
+	params.LedgerRetentionBlocks = 100
+	suite.app.LiquidStakeIBCKeeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockHeight(200)
+
+	otherAddress := sdk.AccAddress("other_ledger_address").String()
+	entries := []*types.LedgerEntry{
+		{Id: 0, Address: TestAddress, Height: 50},
+		{Id: 1, Address: TestAddress, Height: 150},
+		{Id: 2, Address: otherAddress, Height: 99},
+		{Id: 3, Address: otherAddress, Height: 100},
+	}
+	for _, entry := range entries {
+		suite.app.LiquidStakeIBCKeeper.SetLedgerEntry(suite.ctx, entry)
+	}
+
+	suite.app.LiquidStakeIBCKeeper.PruneLedgerEntries(suite.ctx)
+
+	remaining := suite.app.LiquidStakeIBCKeeper.GetAddressLedgerEntries(suite.ctx, TestAddress)
+	suite.Require().Equal(1, len(remaining))
+	suite.Require().Equal(uint64(1), remaining[0].Id)
+
+	remaining = suite.app.LiquidStakeIBCKeeper.GetAddressLedgerEntries(suite.ctx, otherAddress)
+	suite.Require().Equal(1, len(remaining))
+	suite.Require().Equal(uint64(3), remaining[0].Id)
+
+	// the entries are pruned once the retention is over
+	suite.ctx = suite.ctx.WithBlockHeight(251)
+	suite.app.LiquidStakeIBCKeeper.PruneLedgerEntries(suite.ctx)
+
+	remaining = suite.app.LiquidStakeIBCKeeper.FilterLedgerEntries(suite.ctx, func(e types.LedgerEntry) bool { return true })
+	suite.Require().Equal(0, len(remaining))
+}
+
+func (suite *IntegrationTestSuite) TestQueryDelegatorPortfolio() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	hc.CValue = sdk.MustNewDecFromStr("0.8")
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	params := suite.app.LiquidStakeIBCKeeper.GetParams(suite.ctx)
+	params.LedgerEnabled = true
+	suite.app.LiquidStakeIBCKeeper.SetParams(suite.ctx, params)
+
+	delegator := sdk.MustAccAddressFromBech32(TestAddress)
+	suite.Require().NoError(
+		testutil.FundAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			delegator,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 800)),
+		),
+	)
+
+	suite.app.LiquidStakeIBCKeeper.RecordLedgerEntry(
+		suite.ctx,
+		hc,
+		TestAddress,
+		types.LedgerEntry_LEDGER_ENTRY_LIQUID_STAKE,
+		sdk.NewInt64Coin(hc.IBCDenom(), 1000),
+		sdk.NewInt64Coin(hc.MintDenom(), 800),
+		sdk.NewInt64Coin(hc.MintDenom(), 0),
+	)
+	suite.app.LiquidStakeIBCKeeper.RecordLedgerEntry(
+		suite.ctx,
+		hc,
+		TestAddress,
+		types.LedgerEntry_LEDGER_ENTRY_REDEEM,
+		sdk.NewInt64Coin(hc.IBCDenom(), 100),
+		sdk.NewInt64Coin(hc.MintDenom(), 80),
+		sdk.NewInt64Coin(hc.MintDenom(), 0),
+	)
+
+	resp, err := suite.app.LiquidStakeIBCKeeper.DelegatorPortfolio(
+		suite.ctx,
+		&types.QueryDelegatorPortfolioRequest{Address: TestAddress},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(2, len(resp.Entries))
+	suite.Require().Equal(1, len(resp.Positions))
+
+	position := resp.Positions[0]
+	suite.Require().Equal(hc.ChainId, position.ChainId)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.MintDenom(), 800), position.StkBalance)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 1000), position.Value)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 0), position.Unbonding)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 1000), position.Staked)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 100), position.Withdrawn)
+	suite.Require().Equal(sdk.NewInt(100), position.Profit)
+
+	_, err = suite.app.LiquidStakeIBCKeeper.DelegatorPortfolio(suite.ctx, &types.QueryDelegatorPortfolioRequest{})
+	suite.Require().Error(err)
+}
@@ -0,0 +1,345 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetLiquidityPool(ctx sdk.Context, pool *types.LiquidityPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityPoolKey)
+	bytes := k.cdc.MustMarshal(pool)
+	store.Set([]byte(pool.ChainId), bytes)
+}
+
+// GetLiquidityPool returns the liquidity pool of a host chain, or an empty one if nobody provided liquidity yet
+func (k *Keeper) GetLiquidityPool(ctx sdk.Context, chainID string) (*types.LiquidityPool, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityPoolKey)
+	bz := store.Get([]byte(chainID))
+	if bz == nil {
+		return &types.LiquidityPool{ChainId: chainID, TotalShares: sdk.ZeroInt()}, false
+	}
+
+	var pool types.LiquidityPool
+	k.cdc.MustUnmarshal(bz, &pool)
+	return &pool, true
+}
+
+func (k *Keeper) DeleteLiquidityPool(ctx sdk.Context, pool *types.LiquidityPool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityPoolKey)
+	store.Delete([]byte(pool.ChainId))
+}
+
+func (k *Keeper) FilterLiquidityPools(ctx sdk.Context, filter func(p types.LiquidityPool) bool) []*types.LiquidityPool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityPoolKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	pools := make([]*types.LiquidityPool, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		pool := types.LiquidityPool{}
+		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+		if filter(pool) {
+			pools = append(pools, &pool)
+		}
+	}
+
+	return pools
+}
+
+func (k *Keeper) SetLiquidityProvider(ctx sdk.Context, provider *types.LiquidityProvider) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityProviderKey)
+	bytes := k.cdc.MustMarshal(provider)
+	store.Set(types.GetLiquidityProviderStoreKey(provider.ChainId, provider.Address), bytes)
+}
+
+func (k *Keeper) GetLiquidityProvider(ctx sdk.Context, chainID, address string) (*types.LiquidityProvider, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityProviderKey)
+	bz := store.Get(types.GetLiquidityProviderStoreKey(chainID, address))
+	if bz == nil {
+		return &types.LiquidityProvider{ChainId: chainID, Address: address, Shares: sdk.ZeroInt()}, false
+	}
+
+	var provider types.LiquidityProvider
+	k.cdc.MustUnmarshal(bz, &provider)
+	return &provider, true
+}
+
+func (k *Keeper) DeleteLiquidityProvider(ctx sdk.Context, provider *types.LiquidityProvider) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityProviderKey)
+	store.Delete(types.GetLiquidityProviderStoreKey(provider.ChainId, provider.Address))
+}
+
+func (k *Keeper) FilterLiquidityProviders(
+	ctx sdk.Context,
+	filter func(p types.LiquidityProvider) bool,
+) []*types.LiquidityProvider {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LiquidityProviderKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	providers := make([]*types.LiquidityProvider, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		provider := types.LiquidityProvider{}
+		k.cdc.MustUnmarshal(iterator.Value(), &provider)
+		if filter(provider) {
+			providers = append(providers, &provider)
+		}
+	}
+
+	return providers
+}
+
+// GetLiquidityPoolAddress returns the address of the account holding the liquidity of all the pools
+func (k *Keeper) GetLiquidityPoolAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.LiquidityPoolModuleAccount)
+}
+
+// GetLiquidityPoolAvailable returns the host tokens of a pool that can be used to serve redemptions
+func (k *Keeper) GetLiquidityPoolAvailable(ctx sdk.Context, hc *types.HostChain) sdk.Coin {
+	return k.bankKeeper.GetBalance(ctx, k.GetLiquidityPoolAddress(), hc.IBCDenom())
+}
+
+// GetLiquidityPoolUnbonding returns the host tokens the pool will claim once its queued unbondings mature
+func (k *Keeper) GetLiquidityPoolUnbonding(ctx sdk.Context, hc *types.HostChain) sdk.Coin {
+	poolAddress := k.GetLiquidityPoolAddress().String()
+	userUnbondings := k.FilterUserUnbondings(
+		ctx,
+		func(u types.UserUnbonding) bool {
+			return u.ChainId == hc.ChainId && u.Address == poolAddress
+		},
+	)
+
+	amount := sdk.ZeroInt()
+	for _, userUnbonding := range userUnbondings {
+		amount = amount.Add(userUnbonding.UnbondAmount.Amount)
+	}
+
+	return sdk.NewCoin(hc.IBCDenom(), amount)
+}
+
+// GetLiquidityPoolValue returns the total host token value of a pool: its available liquidity, its queued
+// unbondings and any stk tokens returned to it by failed unbondings
+func (k *Keeper) GetLiquidityPoolValue(ctx sdk.Context, hc *types.HostChain) sdk.Coin {
+	value := k.GetLiquidityPoolAvailable(ctx, hc).Add(k.GetLiquidityPoolUnbonding(ctx, hc))
+
+	stkBalance := k.bankKeeper.GetBalance(ctx, k.GetLiquidityPoolAddress(), hc.MintDenom())
+	if stkBalance.IsPositive() && hc.CValue.IsPositive() {
+		value = value.AddAmount(sdk.NewDecFromInt(stkBalance.Amount).Quo(hc.CValue).TruncateInt())
+	}
+
+	return value
+}
+
+// GetLiquidityProviderValue returns the host token value of an amount of shares of a pool
+func (k *Keeper) GetLiquidityProviderValue(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	pool *types.LiquidityPool,
+	shares sdk.Int, //nolint:staticcheck
+) sdk.Coin {
+	if !pool.TotalShares.IsPositive() {
+		return sdk.NewCoin(hc.IBCDenom(), sdk.ZeroInt())
+	}
+
+	value := k.GetLiquidityPoolValue(ctx, hc)
+	return sdk.NewCoin(hc.IBCDenom(), value.Amount.Mul(shares).Quo(pool.TotalShares))
+}
+
+// AddLiquidity moves host tokens from a provider into the pool of a host chain, issuing shares
+// proportional to the value of the pool. The first deposit must be larger than the minimum shares locked in the pool.
+func (k *Keeper) AddLiquidity(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	providerAddress sdk.AccAddress,
+	amount sdk.Coin,
+) (sdk.Int, error) { //nolint:staticcheck
+	pool, _ := k.GetLiquidityPool(ctx, hc.ChainId)
+	value := k.GetLiquidityPoolValue(ctx, hc)
+
+	// the first deposit locks the minimum shares in the pool, they are never owned by any provider
+	shares := amount.Amount
+	lockedShares := sdk.ZeroInt()
+	if pool.TotalShares.IsPositive() {
+		if !value.IsPositive() {
+			return sdk.ZeroInt(), errorsmod.Wrapf(
+				types.ErrNotEnoughLiquidity,
+				"liquidity pool for chain %s has outstanding shares but no value",
+				hc.ChainId,
+			)
+		}
+		shares = amount.Amount.Mul(pool.TotalShares).Quo(value.Amount)
+	} else {
+		lockedShares = sdk.NewInt(types.LiquidityPoolMinimumShares)
+	}
+
+	providerShares := shares.Sub(lockedShares)
+	if !providerShares.IsPositive() {
+		return sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrNotEnoughShares,
+			"deposit of %s is too small to issue any shares",
+			amount.String(),
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		providerAddress,
+		types.LiquidityPoolModuleAccount,
+		sdk.NewCoins(amount),
+	); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	provider, _ := k.GetLiquidityProvider(ctx, hc.ChainId, providerAddress.String())
+	provider.Shares = provider.Shares.Add(providerShares)
+	k.SetLiquidityProvider(ctx, provider)
+
+	pool.TotalShares = pool.TotalShares.Add(shares)
+	k.SetLiquidityPool(ctx, pool)
+
+	return providerShares, nil
+}
+
+// RemoveLiquidity burns shares of a provider and sends their value back in host tokens, as long as the
+// pool has enough available liquidity to pay for them
+func (k *Keeper) RemoveLiquidity(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	providerAddress sdk.AccAddress,
+	shares sdk.Int, //nolint:staticcheck
+) (sdk.Coin, error) {
+	provider, found := k.GetLiquidityProvider(ctx, hc.ChainId, providerAddress.String())
+	if !found || provider.Shares.LT(shares) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrNotEnoughShares,
+			"provider %s has %s shares, requested %s",
+			providerAddress.String(),
+			provider.Shares.String(),
+			shares.String(),
+		)
+	}
+
+	pool, _ := k.GetLiquidityPool(ctx, hc.ChainId)
+	amount := k.GetLiquidityProviderValue(ctx, hc, pool, shares)
+
+	available := k.GetLiquidityPoolAvailable(ctx, hc)
+	if available.IsLT(amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrNotEnoughLiquidity,
+			"can't withdraw %s, only %s is available",
+			amount.String(),
+			available.String(),
+		)
+	}
+
+	if amount.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.LiquidityPoolModuleAccount,
+			providerAddress,
+			sdk.NewCoins(amount),
+		); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	provider.Shares = provider.Shares.Sub(shares)
+	if provider.Shares.IsZero() {
+		k.DeleteLiquidityProvider(ctx, provider)
+	} else {
+		k.SetLiquidityProvider(ctx, provider)
+	}
+
+	pool.TotalShares = pool.TotalShares.Sub(shares)
+	k.SetLiquidityPool(ctx, pool)
+
+	return amount, nil
+}
+
+// RedeemFromLiquidityPool serves an instant redemption with the liquidity of the host chain pool. The stk
+// tokens, which must be held by the module account, are queued for unbonding on behalf of the pool, and the
+// redemption fee stays in the pool as the liquidity providers' earnings.
+func (k *Keeper) RedeemFromLiquidityPool(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	redeemAddress sdk.AccAddress,
+	stkAmount sdk.Coin,
+	fee sdk.Coin,
+) (sdk.Coin, error) {
+	if !hc.CValue.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrRedeemFailed, "host chain %s has a zero c value", hc.ChainId)
+	}
+
+	// amount of host tokens the pool will receive once the stk tokens are unbonded
+	unbondAmount := sdk.NewDecFromInt(stkAmount.Amount).Quo(hc.CValue).TruncateInt()
+
+	// amount of host tokens the redeemer receives, net of the redemption fee
+	redeemAmount := sdk.NewDecFromInt(stkAmount.Sub(fee).Amount).Quo(hc.CValue).TruncateInt()
+	redeemToken := sdk.NewCoin(hc.IBCDenom(), redeemAmount)
+
+	available := k.GetLiquidityPoolAvailable(ctx, hc)
+	if available.IsLT(redeemToken) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrNotEnoughLiquidity,
+			"can't instant redeem %s tokens, only %s is available",
+			redeemToken.String(),
+			available.Amount.String(),
+		)
+	}
+
+	// calculate the current unbonding epoch
+	epoch := k.epochsKeeper.GetEpochInfo(ctx, types.UndelegationEpoch)
+	unbondingEpoch := types.CurrentUnbondingEpoch(hc.UnbondingFactor, epoch.CurrentEpoch)
+
+	// move the stk tokens to the undelegation module account, where they are burnt once unbonded
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		types.ModuleName,
+		types.UndelegationModuleAccount,
+		sdk.NewCoins(stkAmount),
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// queue the unbonding on behalf of the pool
+	unbondToken := sdk.NewCoin(hc.HostDenom, unbondAmount)
+	k.IncreaseUserUnbondingAmountForEpoch(
+		ctx,
+		hc.ChainId,
+		k.GetLiquidityPoolAddress().String(),
+		unbondingEpoch,
+		stkAmount,
+		unbondToken,
+	)
+	k.IncreaseUndelegatingAmountForEpoch(ctx, hc.ChainId, unbondingEpoch, stkAmount, unbondToken)
+
+	// check the total unbonding for the epoch can be fulfilled with the current delegations
+	totalUnbondings, _ := k.GetUnbonding(ctx, hc.ChainId, unbondingEpoch)
+	totalDelegations := hc.GetHostChainTotalDelegations()
+	if totalDelegations.LT(totalUnbondings.UnbondAmount.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			types.ErrNotEnoughDelegations,
+			"delegated amount %s is less than the total undelegation %s for epoch %d",
+			totalDelegations,
+			totalUnbondings.UnbondAmount,
+			unbondingEpoch,
+		)
+	}
+
+	if redeemToken.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.LiquidityPoolModuleAccount,
+			redeemAddress,
+			sdk.NewCoins(redeemToken),
+		); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return redeemToken, nil
+}
@@ -0,0 +1,211 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestAddRemoveLiquidity() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	provider := sdk.MustAccAddressFromBech32(TestAddress)
+	suite.Require().NoError(
+		testutil.FundAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			provider,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 2000)),
+		),
+	)
+
+	shares, err := suite.app.LiquidStakeIBCKeeper.AddLiquidity(
+		suite.ctx,
+		hc,
+		provider,
+		sdk.NewInt64Coin(hc.IBCDenom(), 2000),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2000-types.LiquidityPoolMinimumShares), shares)
+
+	// the minimum shares of the first deposit stay locked in the pool
+	pool, found := suite.app.LiquidStakeIBCKeeper.GetLiquidityPool(suite.ctx, hc.ChainId)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt(2000), pool.TotalShares)
+	suite.Require().Equal(
+		sdk.NewInt64Coin(hc.IBCDenom(), 2000),
+		suite.app.LiquidStakeIBCKeeper.GetLiquidityPoolValue(suite.ctx, hc),
+	)
+
+	_, err = suite.app.LiquidStakeIBCKeeper.RemoveLiquidity(suite.ctx, hc, provider, sdk.NewInt(1001))
+	suite.Require().ErrorIs(err, types.ErrNotEnoughShares)
+
+	amount, err := suite.app.LiquidStakeIBCKeeper.RemoveLiquidity(suite.ctx, hc, provider, sdk.NewInt(400))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 400), amount)
+	suite.Require().Equal(
+		sdk.NewInt64Coin(hc.IBCDenom(), 400),
+		suite.app.BankKeeper.GetBalance(suite.ctx, provider, hc.IBCDenom()),
+	)
+
+	lp, found := suite.app.LiquidStakeIBCKeeper.GetLiquidityProvider(suite.ctx, hc.ChainId, TestAddress)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt(600), lp.Shares)
+
+	_, err = suite.app.LiquidStakeIBCKeeper.RemoveLiquidity(suite.ctx, hc, provider, sdk.NewInt(600))
+	suite.Require().NoError(err)
+
+	_, found = suite.app.LiquidStakeIBCKeeper.GetLiquidityProvider(suite.ctx, hc.ChainId, TestAddress)
+	suite.Require().Equal(false, found)
+}
+
+func (suite *IntegrationTestSuite) TestAddLiquidityDonation() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	attacker := sdk.MustAccAddressFromBech32(TestAddress)
+	provider := sdk.MustAccAddressFromBech32(PstakeFeeAddress)
+	suite.Require().NoError(
+		testutil.FundAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			attacker,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 1000001)),
+		),
+	)
+	suite.Require().NoError(
+		testutil.FundAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			provider,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 2000)),
+		),
+	)
+
+	// the first deposit has to issue more shares than the locked minimum
+	_, err := suite.app.LiquidStakeIBCKeeper.AddLiquidity(
+		suite.ctx,
+		hc,
+		attacker,
+		sdk.NewInt64Coin(hc.IBCDenom(), types.LiquidityPoolMinimumShares),
+	)
+	suite.Require().ErrorIs(err, types.ErrNotEnoughShares)
+
+	shares, err := suite.app.LiquidStakeIBCKeeper.AddLiquidity(
+		suite.ctx,
+		hc,
+		attacker,
+		sdk.NewInt64Coin(hc.IBCDenom(), types.LiquidityPoolMinimumShares+1),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneInt(), shares)
+
+	// donating to the pool account inflates the share price, deposits that would issue no shares are rejected
+	suite.Require().NoError(
+		suite.app.BankKeeper.SendCoins(
+			suite.ctx,
+			attacker,
+			suite.app.LiquidStakeIBCKeeper.GetLiquidityPoolAddress(),
+			sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 999000)),
+		),
+	)
+
+	_, err = suite.app.LiquidStakeIBCKeeper.AddLiquidity(
+		suite.ctx,
+		hc,
+		provider,
+		sdk.NewInt64Coin(hc.IBCDenom(), 999),
+	)
+	suite.Require().ErrorIs(err, types.ErrNotEnoughShares)
+
+	shares, err = suite.app.LiquidStakeIBCKeeper.AddLiquidity(
+		suite.ctx,
+		hc,
+		provider,
+		sdk.NewInt64Coin(hc.IBCDenom(), 2000),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2), shares)
+
+	// most of the donation is owned by the locked shares, the attacker can only get back its share of it
+	pool, _ := suite.app.LiquidStakeIBCKeeper.GetLiquidityPool(suite.ctx, hc.ChainId)
+	suite.Require().Equal(
+		sdk.NewInt64Coin(hc.IBCDenom(), 999),
+		suite.app.LiquidStakeIBCKeeper.GetLiquidityProviderValue(suite.ctx, hc, pool, sdk.OneInt()),
+	)
+}
+
+func (suite *IntegrationTestSuite) TestRedeemFromLiquidityPool() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	hc.Validators[0].DelegatedAmount = sdk.NewInt(10000)
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	provider := sdk.MustAccAddressFromBech32(TestAddress)
+	suite.Require().NoError(
+		testutil.FundAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			provider,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 2000)),
+		),
+	)
+	_, err := suite.app.LiquidStakeIBCKeeper.AddLiquidity(
+		suite.ctx,
+		hc,
+		provider,
+		sdk.NewInt64Coin(hc.IBCDenom(), 2000),
+	)
+	suite.Require().NoError(err)
+
+	stkAmount := sdk.NewInt64Coin(hc.MintDenom(), 100)
+	suite.Require().NoError(
+		testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.ModuleName, sdk.NewCoins(stkAmount)),
+	)
+
+	redeemer := sdk.MustAccAddressFromBech32(PstakeFeeAddress)
+	_, err = suite.app.LiquidStakeIBCKeeper.RedeemFromLiquidityPool(
+		suite.ctx,
+		hc,
+		redeemer,
+		sdk.NewInt64Coin(hc.MintDenom(), 3000),
+		sdk.NewInt64Coin(hc.MintDenom(), 90),
+	)
+	suite.Require().ErrorIs(err, types.ErrNotEnoughLiquidity)
+
+	redeemed, err := suite.app.LiquidStakeIBCKeeper.RedeemFromLiquidityPool(
+		suite.ctx,
+		hc,
+		redeemer,
+		stkAmount,
+		sdk.NewInt64Coin(hc.MintDenom(), 3),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 97), redeemed)
+	suite.Require().Equal(redeemed, suite.app.BankKeeper.GetBalance(suite.ctx, redeemer, hc.IBCDenom()))
+
+	// the pool earns the redemption fee through its queued unbonding
+	suite.Require().Equal(
+		sdk.NewInt64Coin(hc.IBCDenom(), 1903),
+		suite.app.LiquidStakeIBCKeeper.GetLiquidityPoolAvailable(suite.ctx, hc),
+	)
+	suite.Require().Equal(
+		sdk.NewInt64Coin(hc.IBCDenom(), 100),
+		suite.app.LiquidStakeIBCKeeper.GetLiquidityPoolUnbonding(suite.ctx, hc),
+	)
+	suite.Require().Equal(
+		sdk.NewInt64Coin(hc.IBCDenom(), 2003),
+		suite.app.LiquidStakeIBCKeeper.GetLiquidityPoolValue(suite.ctx, hc),
+	)
+	suite.Require().Equal(
+		stkAmount,
+		suite.app.BankKeeper.GetBalance(
+			suite.ctx,
+			suite.app.LiquidStakeIBCKeeper.GetUndelegationModuleAccount(suite.ctx).GetAddress(),
+			hc.MintDenom(),
+		),
+	)
+}
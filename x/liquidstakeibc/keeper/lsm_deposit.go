@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetLSMDeposit(ctx sdk.Context, deposit *types.LSMDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LSMDepositKey)
+	bytes := k.cdc.MustMarshal(deposit)
+	store.Set(types.GetLSMDepositStoreKey(deposit.ChainId, deposit.DelegatorAddress, deposit.Denom), bytes)
+}
+
+func (k *Keeper) GetLSMDeposit(
+	ctx sdk.Context,
+	chainID string,
+	delegatorAddress string,
+	denom string,
+) (*types.LSMDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LSMDepositKey)
+	bz := store.Get(types.GetLSMDepositStoreKey(chainID, delegatorAddress, denom))
+	if bz == nil {
+		return &types.LSMDeposit{}, false
+	}
+
+	var deposit types.LSMDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return &deposit, true
+}
+
+func (k *Keeper) DeleteLSMDeposit(ctx sdk.Context, deposit *types.LSMDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LSMDepositKey)
+	store.Delete(types.GetLSMDepositStoreKey(deposit.ChainId, deposit.DelegatorAddress, deposit.Denom))
+}
+
+func (k *Keeper) FilterLSMDeposits(ctx sdk.Context, filter func(d types.LSMDeposit) bool) []*types.LSMDeposit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.LSMDepositKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	deposits := make([]*types.LSMDeposit, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		deposit := types.LSMDeposit{}
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		if filter(deposit) {
+			deposits = append(deposits, &deposit)
+		}
+	}
+
+	return deposits
+}
+
+func (k *Keeper) GetLSMDepositsWithSequenceID(ctx sdk.Context, sequenceID string) []*types.LSMDeposit {
+	return k.FilterLSMDeposits(
+		ctx,
+		func(d types.LSMDeposit) bool {
+			return d.IbcSequenceId == sequenceID
+		},
+	)
+}
+
+func (k *Keeper) RevertLSMDepositsState(ctx sdk.Context, deposits []*types.LSMDeposit) {
+	for _, deposit := range deposits {
+		deposit.IbcSequenceId = ""
+
+		if deposit.State != types.LSMDeposit_DEPOSIT_PENDING {
+			deposit.State--
+		}
+
+		k.SetLSMDeposit(ctx, deposit)
+	}
+}
+
+// GetLSMDepositAmountUntokenized returns the host token value of all the tokenized shares deposited
+// for a host chain that haven't been redeemed into a delegation yet
+func (k *Keeper) GetLSMDepositAmountUntokenized(ctx sdk.Context, hc *types.HostChain) sdk.Int { //nolint:staticcheck
+	deposits := k.FilterLSMDeposits(
+		ctx,
+		func(d types.LSMDeposit) bool {
+			return d.ChainId == hc.ChainId
+		},
+	)
+
+	amount := sdk.ZeroInt()
+	for _, deposit := range deposits {
+		validator, found := hc.GetValidator(deposit.GetValidatorAddress())
+		if !found {
+			continue
+		}
+
+		amount = amount.Add(validator.SharesToTokens(sdk.NewDecFromInt(deposit.Amount)))
+	}
+
+	return amount
+}
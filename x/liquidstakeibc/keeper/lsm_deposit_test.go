@@ -0,0 +1,189 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestGetSetLSMDeposit() {
+	suite.app.LiquidStakeIBCKeeper.SetLSMDeposit(
+		suite.ctx,
+		&types.LSMDeposit{
+			ChainId:          suite.path.EndpointB.Chain.ChainID,
+			Amount:           sdk.NewInt(1000),
+			Denom:            "cosmosvaloper1/1",
+			DelegatorAddress: TestAddress,
+		},
+	)
+
+	deposit, found := suite.app.LiquidStakeIBCKeeper.GetLSMDeposit(
+		suite.ctx,
+		suite.path.EndpointB.Chain.ChainID,
+		TestAddress,
+		"cosmosvaloper1/1",
+	)
+
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt(1000), deposit.Amount)
+	suite.Require().Equal("cosmosvaloper1", deposit.GetValidatorAddress())
+}
+
+func (suite *IntegrationTestSuite) TestDeleteLSMDeposit() {
+	deposit := &types.LSMDeposit{
+		ChainId:          suite.path.EndpointB.Chain.ChainID,
+		Amount:           sdk.NewInt(1000),
+		Denom:            "cosmosvaloper1/1",
+		DelegatorAddress: TestAddress,
+	}
+
+	suite.app.LiquidStakeIBCKeeper.SetLSMDeposit(suite.ctx, deposit)
+	suite.app.LiquidStakeIBCKeeper.DeleteLSMDeposit(suite.ctx, deposit)
+
+	_, found := suite.app.LiquidStakeIBCKeeper.GetLSMDeposit(
+		suite.ctx,
+		suite.path.EndpointB.Chain.ChainID,
+		TestAddress,
+		"cosmosvaloper1/1",
+	)
+
+	suite.Require().Equal(false, found)
+}
+
+func (suite *IntegrationTestSuite) TestGetLSMDepositsWithSequenceID() {
+	deposits := []*types.LSMDeposit{
+		{
+			ChainId:          suite.path.EndpointB.Chain.ChainID,
+			Amount:           sdk.NewInt(1000),
+			Denom:            "cosmosvaloper1/1",
+			DelegatorAddress: TestAddress,
+			IbcSequenceId:    "sequence-1",
+		},
+		{
+			ChainId:          suite.path.EndpointB.Chain.ChainID,
+			Amount:           sdk.NewInt(1000),
+			Denom:            "cosmosvaloper1/2",
+			DelegatorAddress: TestAddress,
+			IbcSequenceId:    "sequence-1",
+		},
+		{
+			ChainId:          suite.path.EndpointB.Chain.ChainID,
+			Amount:           sdk.NewInt(1000),
+			Denom:            "cosmosvaloper1/3",
+			DelegatorAddress: TestAddress,
+			IbcSequenceId:    "sequence-2",
+		},
+	}
+
+	for _, deposit := range deposits {
+		suite.app.LiquidStakeIBCKeeper.SetLSMDeposit(suite.ctx, deposit)
+	}
+
+	suite.Require().Equal(2, len(suite.app.LiquidStakeIBCKeeper.GetLSMDepositsWithSequenceID(suite.ctx, "sequence-1")))
+	suite.Require().Equal(1, len(suite.app.LiquidStakeIBCKeeper.GetLSMDepositsWithSequenceID(suite.ctx, "sequence-2")))
+}
+
+func (suite *IntegrationTestSuite) TestRevertLSMDepositsState() {
+	deposits := []*types.LSMDeposit{
+		{
+			ChainId:          suite.path.EndpointB.Chain.ChainID,
+			Amount:           sdk.NewInt(1000),
+			Denom:            "cosmosvaloper1/1",
+			DelegatorAddress: TestAddress,
+			State:            types.LSMDeposit_DEPOSIT_PENDING,
+		},
+		{
+			ChainId:          suite.path.EndpointB.Chain.ChainID,
+			Amount:           sdk.NewInt(1000),
+			Denom:            "cosmosvaloper1/2",
+			DelegatorAddress: TestAddress,
+			State:            types.LSMDeposit_DEPOSIT_SENT,
+		},
+		{
+			ChainId:          suite.path.EndpointB.Chain.ChainID,
+			Amount:           sdk.NewInt(1000),
+			Denom:            "cosmosvaloper1/3",
+			DelegatorAddress: TestAddress,
+			State:            types.LSMDeposit_DEPOSIT_UNTOKENIZING,
+			IbcSequenceId:    "sequence-1",
+		},
+	}
+
+	suite.app.LiquidStakeIBCKeeper.RevertLSMDepositsState(suite.ctx, deposits)
+
+	updatedDeposits := suite.app.LiquidStakeIBCKeeper.FilterLSMDeposits(
+		suite.ctx,
+		func(d types.LSMDeposit) bool { return true },
+	)
+
+	suite.Require().Equal(3, len(updatedDeposits))
+
+	for _, deposit := range updatedDeposits {
+		suite.Require().Equal("", deposit.IbcSequenceId)
+
+		switch deposit.Denom {
+		case "cosmosvaloper1/1":
+			suite.Assert().Equal(types.LSMDeposit_DEPOSIT_PENDING, deposit.State)
+		case "cosmosvaloper1/2":
+			suite.Assert().Equal(types.LSMDeposit_DEPOSIT_PENDING, deposit.State)
+		case "cosmosvaloper1/3":
+			suite.Assert().Equal(types.LSMDeposit_DEPOSIT_RECEIVED, deposit.State)
+		}
+	}
+}
+
+func (suite *IntegrationTestSuite) TestHandleRedeemTokensForSharesResponse() {
+	pstakeApp, ctx := suite.app, suite.ctx
+
+	hc, found := pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	validator := hc.Validators[0]
+	sequenceID := pstakeApp.LiquidStakeIBCKeeper.GetTransactionSequenceID(hc.ChannelId, 1)
+	denom := validator.OperatorAddress + "/1"
+
+	// two deposits of the same lsm denom redeemed in the same batch
+	deposits := []*types.LSMDeposit{
+		{
+			ChainId:          hc.ChainId,
+			Amount:           sdk.NewInt(1000),
+			Denom:            denom,
+			DelegatorAddress: TestAddress,
+			IbcSequenceId:    sequenceID,
+			State:            types.LSMDeposit_DEPOSIT_UNTOKENIZING,
+		},
+		{
+			ChainId:          hc.ChainId,
+			Amount:           sdk.NewInt(3000),
+			Denom:            denom,
+			DelegatorAddress: sdk.AccAddress("lsm_depositor_______").String(),
+			IbcSequenceId:    sequenceID,
+			State:            types.LSMDeposit_DEPOSIT_UNTOKENIZING,
+		},
+	}
+	for _, deposit := range deposits {
+		pstakeApp.LiquidStakeIBCKeeper.SetLSMDeposit(ctx, deposit)
+	}
+
+	// every deposit has its own message and response in the ica tx
+	for _, deposit := range pstakeApp.LiquidStakeIBCKeeper.GetLSMDepositsWithSequenceID(ctx, sequenceID) {
+		msg := &stakingtypes.MsgRedeemTokensForShares{
+			DelegatorAddress: hc.DelegationAccount.Address,
+			Amount:           sdk.Coin{Denom: deposit.Denom, Amount: deposit.Amount},
+		}
+		resp := stakingtypes.MsgRedeemTokensForSharesResponse{
+			Amount: sdk.NewCoin(HostDenom, deposit.Amount),
+		}
+
+		suite.Require().NoError(
+			pstakeApp.LiquidStakeIBCKeeper.HandleRedeemTokensForSharesResponse(ctx, msg, resp, hc.ChannelId, 1),
+		)
+	}
+
+	suite.Require().Equal(0, len(pstakeApp.LiquidStakeIBCKeeper.GetLSMDepositsWithSequenceID(ctx, sequenceID)))
+
+	hc, _ = pstakeApp.LiquidStakeIBCKeeper.GetHostChain(ctx, hc.ChainId)
+	redeemed, _ := hc.GetValidator(validator.OperatorAddress)
+	suite.Require().Equal(validator.DelegatedAmount.Add(sdk.NewInt(4000)).String(), redeemed.DelegatedAmount.String())
+}
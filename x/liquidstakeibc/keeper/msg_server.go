@@ -11,8 +11,10 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/persistenceOne/pstake-native/v2/delegation"
 	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
 )
 
@@ -25,6 +27,24 @@ const (
 	KeyMinimumDeposit     string = "min_deposit"
 	KeyActive             string = "active"
 	KeySetWithdrawAddress string = "set_withdraw_address"
+	KeyRebalanceFactor    string = "rebalance_factor"
+	KeyZeroWeightOnSlash  string = "zero_weight_on_slash"
+	KeyDelegationAccounts string = "delegation_accounts"
+	KeyNextProposalID     string = "next_proposal_id"
+	KeyRewardsEpoch       string = "rewards_epoch"
+	KeyRewardsThreshold   string = "rewards_claim_threshold"
+	KeyRewardsMaxMsgs     string = "rewards_max_msgs"
+	KeyDelegationStrategy string = "delegation_strategy"
+	KeyValidatorCap       string = "validator_cap"
+	KeyMaxCommission      string = "max_commission"
+	KeyMaxJailedCount     string = "max_jailed_count"
+	KeyMaxVotingPower     string = "max_voting_power_share"
+	KeyValidatorDenylist  string = "validator_denylist"
+	KeyMaxCValueDeviation string = "max_c_value_deviation"
+	KeyTripOnICAClose     string = "trip_on_ica_close"
+	KeyMaxICQStaleness    string = "max_icq_staleness"
+	KeyMaxSlashFraction   string = "max_slash_fraction"
+	KeyIBCMemoExits       string = "ibc_memo_exits"
 )
 
 type msgServer struct {
@@ -58,6 +78,15 @@ func (k msgServer) RegisterHostChain(
 		return nil, fmt.Errorf("chain id not found for connection \"%s\": \"%w\"", msg.ConnectionId, err)
 	}
 
+	// the stk tokens of a removed host chain with the same id are still being paid out of its wind down reserve
+	if _, found := k.GetWindDownReserve(ctx, chainID); found {
+		return nil, errorsmod.Wrapf(
+			types.ErrHostChainWindingDown,
+			"the stk tokens of host chain %s are still being converted",
+			chainID,
+		)
+	}
+
 	// build the host chain params
 	hostChainParams := &types.HostChainLSParams{
 		DepositFee:    msg.DepositFee,
@@ -139,6 +168,12 @@ func (k msgServer) UpdateHostChain(
 		return nil, fmt.Errorf("invalid chain id \"%s\", host chain is not registered", msg.ChainId)
 	}
 
+	// a host chain being deregistered can't be updated, as it could be reactivated
+	if hc.IsWindingDown() {
+		return nil, errorsmod.Wrapf(types.ErrHostChainWindingDown, "host chain %s can't be updated", hc.ChainId)
+	}
+
+	eligibilityUpdated := false
 	for _, update := range msg.Updates {
 		switch update.Key {
 		case KeyValidatorWeight:
@@ -211,6 +246,209 @@ func (k msgServer) UpdateHostChain(
 			}
 
 			hc.Active = active
+		case KeyRebalanceFactor:
+			rebalanceFactor, err := strconv.ParseInt(update.Value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to int64")
+			}
+
+			if rebalanceFactor < 0 {
+				return nil, fmt.Errorf("invalid rebalance factor value less than zero")
+			}
+
+			hc.RebalanceFactor = rebalanceFactor
+		case KeyZeroWeightOnSlash:
+			zeroWeightOnSlash, err := strconv.ParseBool(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to bool")
+			}
+
+			hc.ZeroWeightOnSlash = zeroWeightOnSlash
+		case KeyIBCMemoExits:
+			ibcMemoExits, err := strconv.ParseBool(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to bool")
+			}
+
+			hc.IbcMemoExits = ibcMemoExits
+		case KeyDelegationAccounts:
+			delegationAccounts, err := strconv.ParseUint(update.Value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to uint32")
+			}
+
+			// delegation accounts can't be removed, as they might still hold delegations
+			current := len(hc.GetDelegationAccounts())
+			if int(delegationAccounts) < current {
+				return nil, fmt.Errorf(
+					"host chain %s already has %d delegation accounts, they can't be removed",
+					hc.ChainId,
+					current,
+				)
+			}
+
+			// register the new delegation accounts, they will be set up once their channels are open
+			for i := current; i < int(delegationAccounts); i++ {
+				owner := types.DelegateAccountPortOwner(hc.ChainId, i)
+				if err = k.RegisterICAAccount(ctx, hc.ConnectionId, owner); err != nil {
+					return nil, errorsmod.Wrapf(types.ErrRegisterFailed, "error registering %s delegate ica: %s", owner, err)
+				}
+
+				hc.AdditionalDelegationAccounts = append(hc.AdditionalDelegationAccounts, &types.ICAAccount{
+					Owner:        owner,
+					Balance:      sdktypes.Coin{Amount: sdktypes.ZeroInt(), Denom: hc.HostDenom},
+					ChannelState: types.ICAAccount_ICA_CHANNEL_CREATING,
+				})
+			}
+		case KeyNextProposalID:
+			nextProposalID, err := strconv.ParseUint(update.Value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to uint64")
+			}
+
+			hc.NextProposalId = nextProposalID
+		case KeyRewardsEpoch:
+			if k.epochsKeeper.GetEpochInfo(ctx, update.Value).Identifier != update.Value {
+				return nil, fmt.Errorf("invalid rewards epoch identifier %s, epoch not found", update.Value)
+			}
+
+			hc.RewardsParams = hc.GetOrInitRewardsParams()
+			hc.RewardsParams.EpochIdentifier = update.Value
+		case KeyRewardsThreshold:
+			threshold, ok := sdktypes.NewIntFromString(update.Value)
+			if !ok {
+				return nil, fmt.Errorf("unable to parse string to sdk.Int")
+			}
+
+			if threshold.IsNegative() {
+				return nil, fmt.Errorf("invalid rewards claim threshold value less than zero")
+			}
+
+			hc.RewardsParams = hc.GetOrInitRewardsParams()
+			hc.RewardsParams.MinClaimThreshold = threshold
+		case KeyRewardsMaxMsgs:
+			maxMsgs, err := strconv.ParseUint(update.Value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to uint64")
+			}
+
+			hc.RewardsParams = hc.GetOrInitRewardsParams()
+			hc.RewardsParams.MaxMsgsPerTx = maxMsgs
+		case KeyDelegationStrategy:
+			if err := delegation.ValidateName(update.Value); err != nil {
+				return nil, err
+			}
+
+			hc.DelegationParams = hc.GetOrInitDelegationParams()
+			hc.DelegationParams.Strategy = update.Value
+		case KeyValidatorCap:
+			validatorCap, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			if !validatorCap.IsPositive() || validatorCap.GT(sdktypes.OneDec()) {
+				return nil, fmt.Errorf("invalid validator cap value, must be between zero and one")
+			}
+
+			hc.DelegationParams = hc.GetOrInitDelegationParams()
+			hc.DelegationParams.ValidatorCap = validatorCap
+		case KeyMaxCommission:
+			maxCommission, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			if maxCommission.IsNegative() || maxCommission.GT(sdktypes.OneDec()) {
+				return nil, fmt.Errorf("invalid max commission value, must be between zero and one")
+			}
+
+			hc.EligibilityParams = hc.GetOrInitEligibilityParams()
+			hc.EligibilityParams.MaxCommission = maxCommission
+			eligibilityUpdated = true
+		case KeyMaxJailedCount:
+			maxJailedCount, err := strconv.ParseUint(update.Value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to uint32")
+			}
+
+			hc.EligibilityParams = hc.GetOrInitEligibilityParams()
+			hc.EligibilityParams.MaxJailedCount = uint32(maxJailedCount)
+			eligibilityUpdated = true
+		case KeyMaxVotingPower:
+			maxVotingPowerShare, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			if maxVotingPowerShare.IsNegative() || maxVotingPowerShare.GT(sdktypes.OneDec()) {
+				return nil, fmt.Errorf("invalid max voting power share value, must be between zero and one")
+			}
+
+			hc.EligibilityParams = hc.GetOrInitEligibilityParams()
+			hc.EligibilityParams.MaxVotingPowerShare = maxVotingPowerShare
+			eligibilityUpdated = true
+		case KeyValidatorDenylist:
+			// the denylist is replaced as a whole, an empty value clears it
+			denylist := make([]string, 0)
+			if update.Value != "" {
+				denylist = strings.Split(update.Value, ",")
+			}
+
+			hc.EligibilityParams = hc.GetOrInitEligibilityParams()
+			hc.EligibilityParams.Denylist = denylist
+			if err := hc.EligibilityParams.Validate(); err != nil {
+				return nil, fmt.Errorf("invalid validator denylist: %w", err)
+			}
+			eligibilityUpdated = true
+		case KeyMaxCValueDeviation:
+			maxCValueDeviation, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			if maxCValueDeviation.IsNegative() {
+				return nil, fmt.Errorf("invalid max c value deviation value, must be non-negative")
+			}
+
+			hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+			hc.CircuitBreaker.MaxCValueDeviation = maxCValueDeviation
+		case KeyTripOnICAClose:
+			tripOnICAClose, err := strconv.ParseBool(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to bool")
+			}
+
+			hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+			hc.CircuitBreaker.TripOnIcaClose = tripOnICAClose
+		case KeyMaxICQStaleness:
+			maxICQStaleness, err := strconv.ParseInt(update.Value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to int64")
+			}
+
+			if maxICQStaleness < 0 {
+				return nil, fmt.Errorf("invalid max icq staleness value, must be non-negative")
+			}
+
+			hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+			hc.CircuitBreaker.MaxIcqStaleness = maxICQStaleness
+			// start the staleness window from the update
+			if hc.CircuitBreaker.LastIcqHeight == 0 {
+				hc.CircuitBreaker.LastIcqHeight = ctx.BlockHeight()
+			}
+		case KeyMaxSlashFraction:
+			maxSlashFraction, err := sdktypes.NewDecFromStr(update.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse string to sdk.Dec: %w", err)
+			}
+
+			if maxSlashFraction.IsNegative() || maxSlashFraction.GT(sdktypes.OneDec()) {
+				return nil, fmt.Errorf("invalid max slash fraction value, must be between zero and one")
+			}
+
+			hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+			hc.CircuitBreaker.MaxSlashFraction = maxSlashFraction
 		case KeySetWithdrawAddress:
 			err := k.SetWithdrawAddress(ctx, hc)
 			if err != nil {
@@ -224,9 +462,77 @@ func (k msgServer) UpdateHostChain(
 
 	k.SetHostChain(ctx, hc)
 
+	// the validators that are no longer eligible lose their weight right away
+	if eligibilityUpdated {
+		for _, validator := range hc.Validators {
+			k.EnforceValidatorEligibility(ctx, hc, validator)
+		}
+	}
+
 	return &types.MsgUpdateHostChainResponse{}, nil
 }
 
+// DeregisterHostChain starts the wind down of a registered host chain
+func (k msgServer) DeregisterHostChain(
+	goCtx context.Context,
+	msg *types.MsgDeregisterHostChain,
+) (*types.MsgDeregisterHostChainResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// only governance can deregister a host chain
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not the governance authority")
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, fmt.Errorf("invalid chain id \"%s\", host chain is not registered", msg.ChainId)
+	}
+
+	if hc.IsWindingDown() {
+		return nil, errorsmod.Wrapf(types.ErrHostChainWindingDown, "host chain %s is already being deregistered", hc.ChainId)
+	}
+
+	k.StartHostChainWindDown(ctx, hc)
+
+	return &types.MsgDeregisterHostChainResponse{}, nil
+}
+
+// ResetCircuitBreaker resumes the operations of a host chain paused by its circuit breaker
+func (k msgServer) ResetCircuitBreaker(
+	goCtx context.Context,
+	msg *types.MsgResetCircuitBreaker,
+) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// authority needs to be either the gov module account (for proposals)
+	// or the module admin account (for normal txs)
+	if msg.Authority != k.authority && msg.Authority != k.GetParams(ctx).AdminAddress {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "tx signer is not a module authority")
+	}
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain %s not registered", msg.ChainId)
+	}
+
+	k.ClearCircuitBreaker(ctx, hc)
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeCircuitBreakerReset,
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
 // LiquidStake defines a method for liquid staking tokens
 func (k msgServer) LiquidStake(
 	goCtx context.Context,
@@ -248,6 +554,10 @@ func (k msgServer) LiquidStake(
 		return nil, types.ErrHostChainInactive
 	}
 
+	if err := CheckCircuitBreaker(hostChain, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED); err != nil {
+		return nil, err
+	}
+
 	// check for minimum deposit amount
 	if msg.Amount.Amount.LT(hostChain.MinimumDeposit) {
 		return nil, errorsmod.Wrapf(
@@ -341,6 +651,17 @@ func (k msgServer) LiquidStake(
 			)
 		}
 	}
+
+	k.RecordLedgerEntry(
+		ctx,
+		hostChain,
+		delegatorAddress.String(),
+		types.LedgerEntry_LEDGER_ENTRY_LIQUID_STAKE,
+		msg.Amount,
+		mintToken.Sub(protocolFee),
+		protocolFee,
+	)
+
 	ctx.EventManager().EmitEvents(sdktypes.Events{
 		sdktypes.NewEvent(
 			types.EventTypeLiquidStake,
@@ -358,6 +679,194 @@ func (k msgServer) LiquidStake(
 	return &types.MsgLiquidStakeResponse{}, nil
 }
 
+// LiquidStakeLSM defines a method for liquid staking tokenized delegation shares
+func (k msgServer) LiquidStakeLSM(
+	goCtx context.Context,
+	msg *types.MsgLiquidStakeLSM,
+) (*types.MsgLiquidStakeLSMResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// get the delegator address from the bech32 string
+	delegatorAddress, err := sdktypes.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "error parsing delegator address: %s", err)
+	}
+
+	// retrieve the module params
+	params := k.GetParams(ctx)
+
+	for _, delegation := range msg.Delegations {
+		// get the denom trace path of the tokenized shares
+		path, err := k.ibcTransferKeeper.DenomPathFromHash(ctx, delegation.Denom)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidLSMDenom,
+				"could not retrieve denom trace for %s: %s",
+				delegation.Denom,
+				err,
+			)
+		}
+
+		// retrieve the host chain the shares are coming from
+		hc, lsmDenom, found := k.GetHostChainFromLSMDenomPath(ctx, path)
+		if !found {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidLSMDenom,
+				"denom %s is not a tokenized share of any registered host chain",
+				path,
+			)
+		}
+
+		if !hc.Active {
+			return nil, types.ErrHostChainInactive
+		}
+
+		if err := CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED); err != nil {
+			return nil, err
+		}
+
+		deposit, found := k.GetLSMDeposit(ctx, hc.ChainId, delegatorAddress.String(), lsmDenom)
+		if !found {
+			deposit = &types.LSMDeposit{
+				ChainId:          hc.ChainId,
+				Amount:           sdktypes.ZeroInt(),
+				Denom:            lsmDenom,
+				IbcDenom:         delegation.Denom,
+				DelegatorAddress: delegatorAddress.String(),
+				State:            types.LSMDeposit_DEPOSIT_PENDING,
+			}
+		}
+
+		// deposits that already left Persistence can't be increased
+		if deposit.State != types.LSMDeposit_DEPOSIT_PENDING {
+			return nil, errorsmod.Wrapf(
+				types.ErrLSMDepositProcessing,
+				"a deposit of %s from %s is already being processed",
+				lsmDenom,
+				delegatorAddress.String(),
+			)
+		}
+
+		// the shares need to belong to a validator that the module can hold delegations with
+		validator, found := hc.GetValidator(deposit.GetValidatorAddress())
+		if !found {
+			return nil, errorsmod.Wrapf(
+				types.ErrValidatorNotFound,
+				"validator with operator address %s not found",
+				deposit.GetValidatorAddress(),
+			)
+		}
+		if validator.Status != stakingtypes.BondStatusBonded {
+			return nil, errorsmod.Wrapf(
+				types.ErrLSMValidatorInvalid,
+				"validator %s is not bonded",
+				validator.OperatorAddress,
+			)
+		}
+
+		// value the shares in host chain tokens using the validator share/token ratio
+		tokenAmount := validator.SharesToTokens(sdktypes.NewDecFromInt(delegation.Amount))
+		if tokenAmount.LT(hc.MinimumDeposit) {
+			return nil, errorsmod.Wrapf(
+				types.ErrMinDeposit,
+				"expected amount more than %s, got %s",
+				hc.MinimumDeposit,
+				tokenAmount,
+			)
+		}
+
+		// amount of stk tokens to be minted
+		mintDenom := hc.MintDenom()
+		mintAmount := sdktypes.NewDecFromInt(tokenAmount).Mul(hc.CValue)
+		mintToken, _ := sdktypes.NewDecCoinFromDec(mintDenom, mintAmount).TruncateDecimal()
+
+		// send the tokenized shares to the deposit module account
+		err = k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			delegatorAddress,
+			types.DepositModuleAccount,
+			sdktypes.NewCoins(delegation),
+		)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrFailedDeposit,
+				"failed to deposit tokens to module account %s: %s",
+				types.DepositModuleAccount,
+				err,
+			)
+		}
+
+		// add the shares to the lsm deposit record
+		deposit.Amount = deposit.Amount.Add(delegation.Amount)
+		k.SetLSMDeposit(ctx, deposit)
+
+		// mint stk tokens in the module account
+		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdktypes.NewCoins(mintToken))
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrMintFailed,
+				"failed to mint coins in module %s: %s",
+				types.ModuleName, err,
+			)
+		}
+
+		// calculate protocol fee
+		protocolFeeAmount := hc.Params.DepositFee.MulInt(mintToken.Amount)
+		protocolFee, _ := sdktypes.NewDecCoinFromDec(mintDenom, protocolFeeAmount).TruncateDecimal()
+
+		// send stk tokens to the delegator address
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.ModuleName,
+			delegatorAddress,
+			sdktypes.NewCoins(mintToken.Sub(protocolFee)),
+		)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrMintFailed,
+				"failed to send coins from module %s to account %s: %s",
+				types.ModuleName,
+				delegatorAddress.String(),
+				err,
+			)
+		}
+
+		// send the protocol fee to the protocol pool
+		if protocolFee.IsPositive() {
+			err = k.SendProtocolFee(ctx, sdktypes.NewCoins(protocolFee), types.ModuleName, params.FeeAddress)
+			if err != nil {
+				return nil, errorsmod.Wrapf(
+					types.ErrFailedDeposit,
+					"failed to send protocol fee to pStake fee address %s: %s",
+					params.FeeAddress,
+					err,
+				)
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdktypes.NewEvent(
+				types.EventTypeLiquidStakeLSM,
+				sdktypes.NewAttribute(types.AttributeDelegatorAddress, delegatorAddress.String()),
+				sdktypes.NewAttribute(types.AttributeValidatorAddress, validator.OperatorAddress),
+				sdktypes.NewAttribute(types.AttributeAmount, mintToken.String()),
+				sdktypes.NewAttribute(types.AttributeAmountReceived, mintToken.Sub(protocolFee).String()),
+				sdktypes.NewAttribute(types.AttributePstakeDepositFee, protocolFee.String()),
+			),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgLiquidStakeLSMResponse{}, nil
+}
+
 // LiquidUnstake defines a method for unstaking liquid staked tokens
 func (k msgServer) LiquidUnstake(
 	goCtx context.Context,
@@ -387,6 +896,10 @@ func (k msgServer) LiquidUnstake(
 		return nil, types.ErrHostChainInactive
 	}
 
+	if err := CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED); err != nil {
+		return nil, err
+	}
+
 	// check if the message amount has the correct denom
 	if msg.Amount.Denom != hc.MintDenom() {
 		return nil, errorsmod.Wrapf(types.ErrInvalidDenom,
@@ -457,6 +970,16 @@ func (k msgServer) LiquidUnstake(
 		)
 	}
 
+	k.RecordLedgerEntry(
+		ctx,
+		hc,
+		msg.DelegatorAddress,
+		types.LedgerEntry_LEDGER_ENTRY_LIQUID_UNSTAKE,
+		unbondAmount,
+		msg.Amount,
+		sdktypes.NewCoin(msg.Amount.Denom, feeAmount),
+	)
+
 	ctx.EventManager().EmitEvents(sdktypes.Events{
 		sdktypes.NewEvent(
 			types.EventTypeLiquidUnstake,
@@ -505,6 +1028,10 @@ func (k msgServer) Redeem(
 		return nil, types.ErrHostChainInactive
 	}
 
+	if err := CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_REDEMPTIONS_PAUSED); err != nil {
+		return nil, err
+	}
+
 	// check the msg amount denom is the host chain mint denom
 	if msg.Amount.Denom != hc.MintDenom() {
 		return nil, errorsmod.Wrapf(
@@ -543,6 +1070,58 @@ func (k msgServer) Redeem(
 		hc.Params.RedemptionFee.MulInt(msg.Amount.Amount),
 	).TruncateDecimal()
 
+	// amount of tokens to be redeemed
+	stkAmount := msg.Amount.Sub(fee)
+	redeemAmount := sdktypes.NewDecCoinFromCoin(stkAmount).Amount.Mul(hc.CValue)
+	redeemToken, _ := sdktypes.NewDecCoinFromDec(hc.IBCDenom(), redeemAmount).TruncateDecimal()
+
+	// check if there is enough deposits to fulfill the instant redemption request, otherwise
+	// serve it from the host chain liquidity pool
+	depositAccountBalance := k.bankKeeper.GetBalance(
+		ctx,
+		authtypes.NewModuleAddress(types.DepositModuleAccount),
+		hc.IBCDenom(),
+	)
+	if redeemToken.IsGTE(depositAccountBalance) {
+		redeemToken, err = k.RedeemFromLiquidityPool(ctx, hc, redeemAddress, msg.Amount, fee)
+		if err != nil {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFunds,
+				"can't instant redeem %s tokens, only %s is available in deposits: %s",
+				msg.Amount.String(),
+				depositAccountBalance.Amount.String(),
+				err.Error(),
+			)
+		}
+
+		k.RecordLedgerEntry(
+			ctx,
+			hc,
+			redeemAddress.String(),
+			types.LedgerEntry_LEDGER_ENTRY_REDEEM,
+			redeemToken,
+			msg.Amount,
+			fee,
+		)
+
+		ctx.EventManager().EmitEvents(sdktypes.Events{
+			sdktypes.NewEvent(
+				types.EventTypeRedeem,
+				sdktypes.NewAttribute(types.AttributeDelegatorAddress, redeemAddress.String()),
+				sdktypes.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+				sdktypes.NewAttribute(types.AttributeAmountReceived, redeemToken.String()),
+				sdktypes.NewAttribute(types.AttributePstakeRedeemFee, fee.String()),
+			),
+			sdktypes.NewEvent(
+				sdktypes.EventTypeMessage,
+				sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+				sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.DelegatorAddress),
+			)},
+		)
+
+		return &types.MsgRedeemResponse{}, nil
+	}
+
 	// send the protocol fee to the module fee address
 	if fee.IsPositive() {
 		err = k.SendProtocolFee(
@@ -561,26 +1140,6 @@ func (k msgServer) Redeem(
 		}
 	}
 
-	// amount of tokens to be redeemed
-	stkAmount := msg.Amount.Sub(fee)
-	redeemAmount := sdktypes.NewDecCoinFromCoin(stkAmount).Amount.Mul(hc.CValue)
-	redeemToken, _ := sdktypes.NewDecCoinFromDec(hc.IBCDenom(), redeemAmount).TruncateDecimal()
-
-	// check if there is enough deposits to fulfill the instant redemption request
-	depositAccountBalance := k.bankKeeper.GetBalance(
-		ctx,
-		authtypes.NewModuleAddress(types.DepositModuleAccount),
-		hc.IBCDenom(),
-	)
-	if redeemToken.IsGTE(depositAccountBalance) {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInsufficientFunds,
-			"can't instant redeem %s tokens, only %s is available",
-			redeemToken.String(),
-			depositAccountBalance.Amount.String(),
-		)
-	}
-
 	// subtract the redemption amount from the deposits
 	if err := k.AdjustDepositsForRedemption(ctx, hc, redeemToken); err != nil {
 		return nil, errorsmod.Wrapf(
@@ -617,6 +1176,16 @@ func (k msgServer) Redeem(
 		)
 	}
 
+	k.RecordLedgerEntry(
+		ctx,
+		hc,
+		redeemAddress.String(),
+		types.LedgerEntry_LEDGER_ENTRY_REDEEM,
+		redeemToken,
+		msg.Amount,
+		fee,
+	)
+
 	ctx.EventManager().EmitEvents(sdktypes.Events{
 		sdktypes.NewEvent(
 			types.EventTypeRedeem,
@@ -635,6 +1204,142 @@ func (k msgServer) Redeem(
 	return &types.MsgRedeemResponse{}, nil
 }
 
+// DepositLiquidity defines a method for providing host tokens to a host chain instant redemption pool
+func (k msgServer) DepositLiquidity(
+	goCtx context.Context,
+	msg *types.MsgDepositLiquidity,
+) (*types.MsgDepositLiquidityResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	// get the host chain of the provided ibc denom
+	hc, found := k.GetHostChainFromIbcDenom(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidHostChain,
+			"host chain with ibc denom %s not registered",
+			msg.Amount.Denom,
+		)
+	}
+
+	if !hc.Active {
+		return nil, types.ErrHostChainInactive
+	}
+
+	if err := CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED); err != nil {
+		return nil, err
+	}
+
+	providerAddress, err := sdktypes.AccAddressFromBech32(msg.ProviderAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "got error : %s", err)
+	}
+
+	shares, err := k.AddLiquidity(ctx, hc, providerAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeDepositLiquidity,
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.ProviderAddress),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+			sdktypes.NewAttribute(types.AttributeShares, shares.String()),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.ProviderAddress),
+		)},
+	)
+
+	return &types.MsgDepositLiquidityResponse{}, nil
+}
+
+// WithdrawLiquidity defines a method for withdrawing host tokens from a host chain instant redemption pool
+func (k msgServer) WithdrawLiquidity(
+	goCtx context.Context,
+	msg *types.MsgWithdrawLiquidity,
+) (*types.MsgWithdrawLiquidityResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain %s not registered", msg.ChainId)
+	}
+
+	if err := CheckCircuitBreaker(hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_REDEMPTIONS_PAUSED); err != nil {
+		return nil, err
+	}
+
+	providerAddress, err := sdktypes.AccAddressFromBech32(msg.ProviderAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "got error : %s", err)
+	}
+
+	amount, err := k.RemoveLiquidity(ctx, hc, providerAddress, msg.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeWithdrawLiquidity,
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.ProviderAddress),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeShares, msg.Shares.String()),
+			sdktypes.NewAttribute(types.AttributeAmountReceived, amount.String()),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.ProviderAddress),
+		)},
+	)
+
+	return &types.MsgWithdrawLiquidityResponse{}, nil
+}
+
+// VoteWeighted defines a method for stk holders to vote on a host chain governance proposal
+func (k msgServer) VoteWeighted(
+	goCtx context.Context,
+	msg *types.MsgVoteWeighted,
+) (*types.MsgVoteWeightedResponse, error) {
+	ctx := sdktypes.UnwrapSDKContext(goCtx)
+
+	hc, found := k.GetHostChain(ctx, msg.ChainId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidHostChain, "host chain %s not registered", msg.ChainId)
+	}
+
+	voterAddress, err := sdktypes.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "got error : %s", err)
+	}
+
+	if err = k.CastProposalVote(ctx, hc, msg.ProposalId, voterAddress, msg.Options); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdktypes.Events{
+		sdktypes.NewEvent(
+			types.EventTypeVoteWeighted,
+			sdktypes.NewAttribute(types.AttributeDelegatorAddress, msg.Voter),
+			sdktypes.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdktypes.NewAttribute(types.AttributeProposalID, sdktypes.NewIntFromUint64(msg.ProposalId).String()),
+			sdktypes.NewAttribute(types.AttributeVoteOptions, govv1beta1.WeightedVoteOptions(msg.Options).String()),
+		),
+		sdktypes.NewEvent(
+			sdktypes.EventTypeMessage,
+			sdktypes.NewAttribute(sdktypes.AttributeKeyModule, types.AttributeValueCategory),
+			sdktypes.NewAttribute(sdktypes.AttributeKeySender, msg.Voter),
+		)},
+	)
+
+	return &types.MsgVoteWeightedResponse{}, nil
+}
+
 // UpdateParams defines a method for updating the module params
 func (k msgServer) UpdateParams(
 	goCtx context.Context,
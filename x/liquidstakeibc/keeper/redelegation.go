@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetRedelegation(ctx sdk.Context, redelegation *types.Redelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	bytes := k.cdc.MustMarshal(redelegation)
+	store.Set(
+		types.GetRedelegationStoreKey(
+			redelegation.ChainId,
+			redelegation.SrcValidatorAddress,
+			redelegation.DstValidatorAddress,
+		),
+		bytes,
+	)
+}
+
+func (k *Keeper) GetRedelegation(
+	ctx sdk.Context,
+	chainID string,
+	srcValidatorAddress string,
+	dstValidatorAddress string,
+) (*types.Redelegation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	bz := store.Get(types.GetRedelegationStoreKey(chainID, srcValidatorAddress, dstValidatorAddress))
+	if bz == nil {
+		return &types.Redelegation{}, false
+	}
+
+	var redelegation types.Redelegation
+	k.cdc.MustUnmarshal(bz, &redelegation)
+	return &redelegation, true
+}
+
+func (k *Keeper) DeleteRedelegation(ctx sdk.Context, redelegation *types.Redelegation) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	store.Delete(
+		types.GetRedelegationStoreKey(
+			redelegation.ChainId,
+			redelegation.SrcValidatorAddress,
+			redelegation.DstValidatorAddress,
+		),
+	)
+}
+
+func (k *Keeper) FilterRedelegations(
+	ctx sdk.Context,
+	filter func(r types.Redelegation) bool,
+) []*types.Redelegation {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RedelegationKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	redelegations := make([]*types.Redelegation, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		redelegation := types.Redelegation{}
+		k.cdc.MustUnmarshal(iterator.Value(), &redelegation)
+		if filter(redelegation) {
+			redelegations = append(redelegations, &redelegation)
+		}
+	}
+
+	return redelegations
+}
+
+// DeleteRedelegationsForSequenceID removes the redelegations initiated by a failed ICA transaction
+func (k *Keeper) DeleteRedelegationsForSequenceID(ctx sdk.Context, sequenceID string) {
+	redelegations := k.FilterRedelegations(
+		ctx,
+		func(r types.Redelegation) bool {
+			return r.IbcSequenceId == sequenceID
+		},
+	)
+
+	for _, redelegation := range redelegations {
+		k.DeleteRedelegation(ctx, redelegation)
+	}
+}
+
+// DeleteMatureRedelegations removes the redelegations of a host chain that have already completed,
+// freeing their validators to be part of a new rebalancing
+func (k *Keeper) DeleteMatureRedelegations(ctx sdk.Context, chainID string) {
+	redelegations := k.FilterRedelegations(
+		ctx,
+		func(r types.Redelegation) bool {
+			return r.ChainId == chainID &&
+				r.State == types.Redelegation_REDELEGATION_MATURING &&
+				!ctx.BlockTime().Before(r.CompletionTime)
+		},
+	)
+
+	for _, redelegation := range redelegations {
+		k.DeleteRedelegation(ctx, redelegation)
+	}
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestGetSetRedelegation() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	suite.app.LiquidStakeIBCKeeper.SetRedelegation(
+		suite.ctx,
+		&types.Redelegation{
+			ChainId:             hc.ChainId,
+			SrcValidatorAddress: hc.Validators[0].OperatorAddress,
+			DstValidatorAddress: hc.Validators[1].OperatorAddress,
+			Amount:              sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+		},
+	)
+
+	redelegation, found := suite.app.LiquidStakeIBCKeeper.GetRedelegation(
+		suite.ctx,
+		hc.ChainId,
+		hc.Validators[0].OperatorAddress,
+		hc.Validators[1].OperatorAddress,
+	)
+
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewCoin(HostDenom, sdk.NewInt(1000)), redelegation.Amount)
+}
+
+func (suite *IntegrationTestSuite) TestDeleteRedelegationsForSequenceID() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	redelegations := []*types.Redelegation{
+		{
+			ChainId:             hc.ChainId,
+			SrcValidatorAddress: hc.Validators[0].OperatorAddress,
+			DstValidatorAddress: hc.Validators[1].OperatorAddress,
+			Amount:              sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+			IbcSequenceId:       "sequence-1",
+		},
+		{
+			ChainId:             hc.ChainId,
+			SrcValidatorAddress: hc.Validators[0].OperatorAddress,
+			DstValidatorAddress: hc.Validators[2].OperatorAddress,
+			Amount:              sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+			IbcSequenceId:       "sequence-2",
+		},
+	}
+
+	for _, redelegation := range redelegations {
+		suite.app.LiquidStakeIBCKeeper.SetRedelegation(suite.ctx, redelegation)
+	}
+
+	suite.app.LiquidStakeIBCKeeper.DeleteRedelegationsForSequenceID(suite.ctx, "sequence-1")
+
+	updatedRedelegations := suite.app.LiquidStakeIBCKeeper.FilterRedelegations(
+		suite.ctx,
+		func(r types.Redelegation) bool { return true },
+	)
+
+	suite.Require().Equal(1, len(updatedRedelegations))
+	suite.Require().Equal("sequence-2", updatedRedelegations[0].IbcSequenceId)
+}
+
+func (suite *IntegrationTestSuite) TestDeleteMatureRedelegations() {
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(1700000000, 0))
+
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	redelegations := []*types.Redelegation{
+		{
+			ChainId:             hc.ChainId,
+			SrcValidatorAddress: hc.Validators[0].OperatorAddress,
+			DstValidatorAddress: hc.Validators[1].OperatorAddress,
+			Amount:              sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+			CompletionTime:      suite.ctx.BlockTime().Add(-time.Hour),
+			State:               types.Redelegation_REDELEGATION_MATURING,
+		},
+		{
+			ChainId:             hc.ChainId,
+			SrcValidatorAddress: hc.Validators[0].OperatorAddress,
+			DstValidatorAddress: hc.Validators[2].OperatorAddress,
+			Amount:              sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+			CompletionTime:      suite.ctx.BlockTime().Add(time.Hour),
+			State:               types.Redelegation_REDELEGATION_MATURING,
+		},
+		{
+			ChainId:             hc.ChainId,
+			SrcValidatorAddress: hc.Validators[1].OperatorAddress,
+			DstValidatorAddress: hc.Validators[2].OperatorAddress,
+			Amount:              sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+			State:               types.Redelegation_REDELEGATION_INITIATED,
+		},
+	}
+
+	for _, redelegation := range redelegations {
+		suite.app.LiquidStakeIBCKeeper.SetRedelegation(suite.ctx, redelegation)
+	}
+
+	suite.app.LiquidStakeIBCKeeper.DeleteMatureRedelegations(suite.ctx, hc.ChainId)
+
+	updatedRedelegations := suite.app.LiquidStakeIBCKeeper.FilterRedelegations(
+		suite.ctx,
+		func(r types.Redelegation) bool { return true },
+	)
+
+	suite.Require().Equal(2, len(updatedRedelegations))
+}
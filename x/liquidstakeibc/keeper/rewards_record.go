@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetRewardsRecord(ctx sdk.Context, record *types.RewardsRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardsRecordKey)
+	bytes := k.cdc.MustMarshal(record)
+	store.Set(types.GetRewardsRecordStoreKey(record.ChainId, record.Epoch), bytes)
+}
+
+func (k *Keeper) GetRewardsRecord(ctx sdk.Context, chainID string, epoch int64) (*types.RewardsRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardsRecordKey)
+	bz := store.Get(types.GetRewardsRecordStoreKey(chainID, epoch))
+	if bz == nil {
+		return &types.RewardsRecord{}, false
+	}
+
+	var record types.RewardsRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record, true
+}
+
+func (k *Keeper) DeleteRewardsRecord(ctx sdk.Context, record *types.RewardsRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardsRecordKey)
+	store.Delete(types.GetRewardsRecordStoreKey(record.ChainId, record.Epoch))
+}
+
+func (k *Keeper) FilterRewardsRecords(
+	ctx sdk.Context,
+	filter func(r types.RewardsRecord) bool,
+) []*types.RewardsRecord {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RewardsRecordKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	records := make([]*types.RewardsRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.RewardsRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if filter(record) {
+			records = append(records, &record)
+		}
+	}
+
+	return records
+}
+
+// AddRewardsRecord accumulates the rewards restaked for a host chain into the record of the current rewards epoch
+func (k *Keeper) AddRewardsRecord(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	grossRewards sdk.Int, //nolint:staticcheck
+	restakeFee sdk.Int, //nolint:staticcheck
+	feeMinted sdk.Coin,
+) {
+	epoch := k.GetEpochNumber(ctx, hc.GetRewardsEpochIdentifier())
+
+	record, found := k.GetRewardsRecord(ctx, hc.ChainId, epoch)
+	if !found {
+		record = &types.RewardsRecord{
+			ChainId:        hc.ChainId,
+			Epoch:          epoch,
+			GrossRewards:   sdk.NewCoin(hc.HostDenom, sdk.ZeroInt()),
+			RestakeFee:     sdk.NewCoin(hc.HostDenom, sdk.ZeroInt()),
+			FeeMinted:      sdk.NewCoin(hc.MintDenom(), sdk.ZeroInt()),
+			RestakedAmount: sdk.NewCoin(hc.HostDenom, sdk.ZeroInt()),
+		}
+	}
+
+	record.GrossRewards = record.GrossRewards.AddAmount(grossRewards)
+	record.RestakeFee = record.RestakeFee.AddAmount(restakeFee)
+	record.FeeMinted = record.FeeMinted.Add(feeMinted)
+	record.RestakedAmount = record.RestakedAmount.AddAmount(grossRewards)
+
+	k.SetRewardsRecord(ctx, record)
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestGetSetRewardsRecord() {
+	suite.app.LiquidStakeIBCKeeper.SetRewardsRecord(
+		suite.ctx,
+		&types.RewardsRecord{
+			ChainId:      suite.path.EndpointB.Chain.ChainID,
+			Epoch:        1,
+			GrossRewards: sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+		},
+	)
+
+	record, found := suite.app.LiquidStakeIBCKeeper.GetRewardsRecord(
+		suite.ctx,
+		suite.path.EndpointB.Chain.ChainID,
+		1,
+	)
+
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewCoin(HostDenom, sdk.NewInt(1000)), record.GrossRewards)
+}
+
+func (suite *IntegrationTestSuite) TestAddRewardsRecord() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	epoch := suite.app.LiquidStakeIBCKeeper.GetEpochNumber(suite.ctx, types.RewardsEpochIdentifier)
+
+	suite.app.LiquidStakeIBCKeeper.AddRewardsRecord(
+		suite.ctx,
+		hc,
+		sdk.NewInt(1000),
+		sdk.NewInt(20),
+		sdk.NewCoin(hc.MintDenom(), sdk.NewInt(20)),
+	)
+	suite.app.LiquidStakeIBCKeeper.AddRewardsRecord(
+		suite.ctx,
+		hc,
+		sdk.NewInt(500),
+		sdk.NewInt(10),
+		sdk.NewCoin(hc.MintDenom(), sdk.NewInt(10)),
+	)
+
+	record, found := suite.app.LiquidStakeIBCKeeper.GetRewardsRecord(suite.ctx, hc.ChainId, epoch)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewCoin(HostDenom, sdk.NewInt(1500)), record.GrossRewards)
+	suite.Require().Equal(sdk.NewCoin(HostDenom, sdk.NewInt(30)), record.RestakeFee)
+	suite.Require().Equal(sdk.NewCoin(hc.MintDenom(), sdk.NewInt(30)), record.FeeMinted)
+	suite.Require().Equal(sdk.NewCoin(HostDenom, sdk.NewInt(1500)), record.RestakedAmount)
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (k *Keeper) SetSlash(ctx sdk.Context, slash *types.Slash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKey)
+	bytes := k.cdc.MustMarshal(slash)
+	store.Set(types.GetSlashStoreKey(slash.ChainId, slash.ValidatorAddress, slash.Height), bytes)
+}
+
+func (k *Keeper) GetSlash(
+	ctx sdk.Context,
+	chainID string,
+	validatorAddress string,
+	height int64,
+) (*types.Slash, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKey)
+	bz := store.Get(types.GetSlashStoreKey(chainID, validatorAddress, height))
+	if bz == nil {
+		return &types.Slash{}, false
+	}
+
+	var slash types.Slash
+	k.cdc.MustUnmarshal(bz, &slash)
+	return &slash, true
+}
+
+func (k *Keeper) DeleteSlash(ctx sdk.Context, slash *types.Slash) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKey)
+	store.Delete(types.GetSlashStoreKey(slash.ChainId, slash.ValidatorAddress, slash.Height))
+}
+
+func (k *Keeper) FilterSlashes(ctx sdk.Context, filter func(s types.Slash) bool) []*types.Slash {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	slashes := make([]*types.Slash, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		slash := types.Slash{}
+		k.cdc.MustUnmarshal(iterator.Value(), &slash)
+		if filter(slash) {
+			slashes = append(slashes, &slash)
+		}
+	}
+
+	return slashes
+}
+
+// HandleValidatorSlash records a validator slash and socialises its loss across the in-flight unbondings
+// of the host chain. It needs to be called before the validator delegated amount is updated.
+func (k *Keeper) HandleValidatorSlash(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	validator *types.Validator,
+	slashedAmount sdk.Int, //nolint:staticcheck
+) {
+	totalDelegations := hc.GetHostChainTotalDelegations()
+	if !validator.DelegatedAmount.IsPositive() || !totalDelegations.IsPositive() {
+		return
+	}
+
+	// fraction of the validator delegation that was slashed, and share of the validator in the host chain delegations
+	fraction := sdk.NewDecFromInt(slashedAmount).QuoInt(validator.DelegatedAmount)
+	share := sdk.NewDecFromInt(validator.DelegatedAmount).QuoInt(totalDelegations)
+
+	unbondingLoss := sdk.ZeroInt()
+
+	// unbondings that are still unbonding on the host chain get slashed proportionally to the validator share
+	unbondings := k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.ChainId == hc.ChainId &&
+				(u.State == types.Unbonding_UNBONDING_INITIATED || u.State == types.Unbonding_UNBONDING_MATURING)
+		},
+	)
+	for _, unbonding := range unbondings {
+		loss := sdk.NewDecFromInt(unbonding.UnbondAmount.Amount).Mul(share).Mul(fraction).TruncateInt()
+		if !loss.IsPositive() {
+			continue
+		}
+
+		k.SlashUnbonding(ctx, unbonding, loss)
+		unbondingLoss = unbondingLoss.Add(loss)
+	}
+
+	// validator unbondings are fully delegated to the validator, so they get slashed by the whole fraction
+	validatorUnbondings := k.FilterValidatorUnbondings(
+		ctx,
+		func(u types.ValidatorUnbonding) bool {
+			return u.ChainId == hc.ChainId && u.ValidatorAddress == validator.OperatorAddress
+		},
+	)
+	for _, validatorUnbonding := range validatorUnbondings {
+		loss := sdk.NewDecFromInt(validatorUnbonding.Amount.Amount).Mul(fraction).TruncateInt()
+		if !loss.IsPositive() {
+			continue
+		}
+
+		validatorUnbonding.Amount = validatorUnbonding.Amount.SubAmount(loss)
+		k.SetValidatorUnbonding(ctx, validatorUnbonding)
+		unbondingLoss = unbondingLoss.Add(loss)
+	}
+
+	k.SetSlash(
+		ctx,
+		&types.Slash{
+			ChainId:          hc.ChainId,
+			ValidatorAddress: validator.OperatorAddress,
+			Height:           ctx.BlockHeight(),
+			Time:             ctx.BlockTime(),
+			Amount:           sdk.NewCoin(hc.HostDenom, slashedAmount),
+			Fraction:         fraction,
+			UnbondingLoss:    sdk.NewCoin(hc.HostDenom, unbondingLoss),
+		},
+	)
+
+	// remove the validator from the delegation strategy if the host chain is configured to do so
+	if hc.ZeroWeightOnSlash && validator.Weight.IsPositive() {
+		k.RedistributeValidatorWeight(ctx, hc, validator)
+	}
+
+	// pause the deposits if the slash is too big a share of the delegations, the stk holders can still exit
+	if hc.CircuitBreaker != nil && hc.CircuitBreaker.MaxSlashFraction.IsPositive() &&
+		sdk.NewDecFromInt(slashedAmount).QuoInt(totalDelegations).GT(hc.CircuitBreaker.MaxSlashFraction) {
+		k.TripCircuitBreaker(ctx, hc, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED, types.TripReasonSlash)
+	}
+}
+
+// SlashUnbonding reduces the unbond amount of an unbonding and pro-rates the loss across all its user unbondings,
+// so every user claims the same fraction of the unbonded tokens
+func (k *Keeper) SlashUnbonding(ctx sdk.Context, unbonding *types.Unbonding, loss sdk.Int) { //nolint:staticcheck
+	if !unbonding.UnbondAmount.Amount.IsPositive() {
+		return
+	}
+
+	ratio := sdk.NewDecFromInt(unbonding.UnbondAmount.Amount.Sub(loss)).QuoInt(unbonding.UnbondAmount.Amount)
+
+	userUnbondings := k.FilterUserUnbondings(
+		ctx,
+		func(u types.UserUnbonding) bool {
+			return u.ChainId == unbonding.ChainId && u.EpochNumber == unbonding.EpochNumber
+		},
+	)
+	for _, userUnbonding := range userUnbondings {
+		userUnbonding.UnbondAmount.Amount = sdk.NewDecFromInt(userUnbonding.UnbondAmount.Amount).Mul(ratio).TruncateInt()
+		k.SetUserUnbonding(ctx, userUnbonding)
+	}
+
+	unbonding.UnbondAmount = unbonding.UnbondAmount.SubAmount(loss)
+	k.SetUnbonding(ctx, unbonding)
+}
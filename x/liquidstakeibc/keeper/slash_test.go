@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestHandleValidatorSlash() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	// two validators with half of the delegations each
+	hc.ZeroWeightOnSlash = true
+	hc.CircuitBreaker = hc.GetOrInitCircuitBreaker()
+	hc.CircuitBreaker.MaxSlashFraction = decFromStr("0.04")
+	hc.Validators = hc.Validators[:2]
+	for _, validator := range hc.Validators {
+		validator.Weight = decFromStr("0.5")
+		validator.DelegatedAmount = sdk.NewInt(1000)
+	}
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	epoch := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, types.DelegationEpoch).CurrentEpoch
+
+	suite.app.LiquidStakeIBCKeeper.SetUnbonding(
+		suite.ctx,
+		&types.Unbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  epoch,
+			BurnAmount:   sdk.NewCoin(MintDenom, sdk.NewInt(1000)),
+			UnbondAmount: sdk.NewCoin(HostDenom, sdk.NewInt(1000)),
+			State:        types.Unbonding_UNBONDING_MATURING,
+		},
+	)
+	suite.app.LiquidStakeIBCKeeper.SetUserUnbonding(
+		suite.ctx,
+		&types.UserUnbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  epoch,
+			Address:      TestAddress,
+			StkAmount:    sdk.NewCoin(MintDenom, sdk.NewInt(600)),
+			UnbondAmount: sdk.NewCoin(HostDenom, sdk.NewInt(600)),
+		},
+	)
+	suite.app.LiquidStakeIBCKeeper.SetUserUnbonding(
+		suite.ctx,
+		&types.UserUnbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  epoch,
+			Address:      "persistence10khgeppewe4rgfrcy809r9h00aquwxxxrk6glr",
+			StkAmount:    sdk.NewCoin(MintDenom, sdk.NewInt(400)),
+			UnbondAmount: sdk.NewCoin(HostDenom, sdk.NewInt(400)),
+		},
+	)
+	suite.app.LiquidStakeIBCKeeper.SetValidatorUnbonding(
+		suite.ctx,
+		&types.ValidatorUnbonding{
+			ChainId:          hc.ChainId,
+			EpochNumber:      epoch,
+			ValidatorAddress: hc.Validators[0].OperatorAddress,
+			Amount:           sdk.NewCoin(HostDenom, sdk.NewInt(500)),
+		},
+	)
+
+	// slash 10% of the first validator delegation
+	suite.app.LiquidStakeIBCKeeper.HandleValidatorSlash(suite.ctx, hc, hc.Validators[0], sdk.NewInt(100))
+
+	// the unbonding loses 10% of the half delegated to the slashed validator
+	unbonding, found := suite.app.LiquidStakeIBCKeeper.GetUnbonding(suite.ctx, hc.ChainId, epoch)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt(950), unbonding.UnbondAmount.Amount)
+
+	userUnbondings := suite.app.LiquidStakeIBCKeeper.FilterUserUnbondings(
+		suite.ctx,
+		func(u types.UserUnbonding) bool { return u.ChainId == hc.ChainId && u.EpochNumber == epoch },
+	)
+	total := sdk.ZeroInt()
+	for _, userUnbonding := range userUnbondings {
+		total = total.Add(userUnbonding.UnbondAmount.Amount)
+	}
+	suite.Require().True(total.LTE(unbonding.UnbondAmount.Amount))
+	suite.Require().Equal(sdk.NewInt(950), total)
+
+	validatorUnbonding, found := suite.app.LiquidStakeIBCKeeper.GetValidatorUnbonding(
+		suite.ctx,
+		hc.ChainId,
+		hc.Validators[0].OperatorAddress,
+		epoch,
+	)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt(450), validatorUnbonding.Amount.Amount)
+
+	slashes := suite.app.LiquidStakeIBCKeeper.FilterSlashes(
+		suite.ctx,
+		func(s types.Slash) bool { return s.ChainId == hc.ChainId },
+	)
+	suite.Require().Equal(1, len(slashes))
+	suite.Require().Equal(decFromStr("0.1"), slashes[0].Fraction)
+	suite.Require().Equal(sdk.NewInt(100), slashes[0].UnbondingLoss.Amount)
+
+	// the slashed validator weight is redistributed
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(sdk.ZeroDec(), hc.Validators[0].Weight)
+	suite.Require().Equal(sdk.OneDec(), hc.Validators[1].Weight)
+
+	// the slash is 5% of the delegations, so the deposits are paused while the stk holders can still exit
+	suite.Require().Equal(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED, hc.CircuitBreaker.State)
+	suite.Require().Equal(types.TripReasonSlash, hc.CircuitBreaker.Reason)
+	suite.Require().Equal(false, hc.IsPaused(types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED))
+}
@@ -0,0 +1,702 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// StartHostChainWindDown stops the host chain deposits and starts undelegating all its delegations
+func (k *Keeper) StartHostChainWindDown(ctx sdk.Context, hc *types.HostChain) {
+	hc.Active = false
+	hc.WindDownState = types.HostChain_WIND_DOWN_UNDELEGATING
+	k.SetHostChain(ctx, hc)
+
+	// the pending unbondings won't be undelegated anymore, fail them so the stk tokens are returned to
+	// their owners, who will get their share of the host chain tokens once the wind down finishes
+	unbondings := k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.ChainId == hc.ChainId && u.State == types.Unbonding_UNBONDING_PENDING
+		},
+	)
+	for _, unbonding := range unbondings {
+		unbonding.State = types.Unbonding_UNBONDING_FAILED
+		k.SetUnbonding(ctx, unbonding)
+	}
+
+	k.emitWindDownEvent(ctx, hc)
+}
+
+// DoWindDown moves forward the wind down of a host chain that is being deregistered
+func (k *Keeper) DoWindDown(ctx sdk.Context, hc *types.HostChain) {
+	switch hc.WindDownState {
+	case types.HostChain_WIND_DOWN_UNDELEGATING:
+		// keep processing the operations that were in flight when the chain was deregistered,
+		// the delegated deposits will be undelegated with the next batch
+		k.DoRecreateICA(ctx, hc)
+		k.DoRetryICATxs(ctx, hc)
+		k.DoDelegate(ctx, hc)
+		k.DoRedeemLSMTokens(ctx, hc)
+		k.DoClaim(ctx, hc)
+		k.DoProcessMaturedUndelegations(ctx, hc)
+
+		// once every token is back on Persistence, start converting the stk supply into claimable unbondings
+		if k.IsHostChainUndelegated(ctx, hc) {
+			cacheCtx, writeCache := ctx.CacheContext()
+			if err := k.ConvertStkSupply(cacheCtx, hc); err != nil {
+				k.Logger(ctx).Error(
+					"Could not convert the stk supply of the host chain.",
+					"host_chain",
+					hc.ChainId,
+					"error",
+					err.Error(),
+				)
+				return
+			}
+			writeCache()
+		}
+	case types.HostChain_WIND_DOWN_CLAIMABLE:
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.ConvertStkHolders(cacheCtx, hc)
+		if err != nil {
+			k.Logger(ctx).Error(
+				"Could not convert the stk balances of the host chain holders.",
+				"host_chain",
+				hc.ChainId,
+				"error",
+				err.Error(),
+			)
+		} else {
+			writeCache()
+		}
+
+		k.DoClaim(ctx, hc)
+
+		if err == nil && k.IsHostChainWoundDown(ctx, hc) {
+			k.RemoveHostChain(ctx, hc)
+		}
+	}
+}
+
+// WindDownWorkflow undelegates, every undelegation epoch, all the delegations of one of the delegation accounts
+// of the host chains being deregistered
+func (k *Keeper) WindDownWorkflow(ctx sdk.Context, epoch int64) {
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if hc.WindDownState != types.HostChain_WIND_DOWN_UNDELEGATING ||
+			hc.GetHostChainTotalDelegations().IsZero() {
+			continue
+		}
+
+		k.Logger(ctx).Info("Running wind down workflow.", "host_chain", hc.ChainId, "epoch", epoch)
+
+		// collect the rewards withdrawn by the undelegations, so they are also returned to the stk holders
+		if hc.RewardsAccount != nil && hc.RewardsAccount.ChannelState == types.ICAAccount_ICA_CHANNEL_CREATED {
+			if err := k.QueryRewardsHostChainAccountBalance(ctx, hc); err != nil {
+				k.Logger(ctx).Error(
+					"Could not send rewards account balance ICQ",
+					"host_chain",
+					hc.ChainId,
+				)
+			}
+		}
+
+		// wait for the previous batch and the delegations in flight to be acknowledged
+		initiated := k.FilterUnbondings(
+			ctx,
+			func(u types.Unbonding) bool {
+				return u.ChainId == hc.ChainId && u.State == types.Unbonding_UNBONDING_INITIATED
+			},
+		)
+		if len(initiated) > 0 || len(k.GetDelegatingDepositsForChain(ctx, hc.ChainId)) > 0 {
+			continue
+		}
+
+		// the unbonding for this epoch is still being claimed
+		if _, found := k.GetUnbonding(ctx, hc.ChainId, epoch); found {
+			continue
+		}
+
+		for _, account := range hc.GetActiveDelegationAccounts() {
+			amount := hc.GetAccountTotalDelegations(account)
+			if amount.IsZero() {
+				continue
+			}
+
+			messages := make([]proto.Message, 0)
+			for _, validator := range hc.Validators {
+				delegated := hc.GetAccountDelegatedAmount(account, validator.OperatorAddress)
+				if delegated.IsPositive() {
+					messages = append(messages, &stakingtypes.MsgUndelegate{
+						DelegatorAddress: account.Address,
+						ValidatorAddress: validator.OperatorAddress,
+						Amount:           sdk.NewCoin(hc.HostDenom, delegated),
+					})
+				}
+			}
+
+			sequenceID, err := k.GenerateAndExecuteICATx(ctx, hc.ConnectionId, account.Owner, messages)
+			if err != nil {
+				k.Logger(ctx).Error(
+					"Could not send ICA wind down undelegate txs",
+					"host_chain",
+					hc.ChainId,
+					"delegation_account",
+					account.Owner,
+				)
+				break
+			}
+
+			// the undelegated tokens aren't owed to any user yet, so there is nothing to burn
+			k.SetUnbonding(ctx, &types.Unbonding{
+				ChainId:                hc.ChainId,
+				EpochNumber:            epoch,
+				BurnAmount:             sdk.NewCoin(hc.MintDenom(), sdk.ZeroInt()),
+				UnbondAmount:           sdk.NewCoin(hc.HostDenom, amount),
+				IbcSequenceId:          sequenceID,
+				State:                  types.Unbonding_UNBONDING_INITIATED,
+				DelegationAccountOwner: account.Owner,
+			})
+
+			// only one delegation account is undelegated per batch
+			break
+		}
+	}
+}
+
+// IsHostChainUndelegated checks if all the host chain tokens have been undelegated and transferred back
+func (k *Keeper) IsHostChainUndelegated(ctx sdk.Context, hc *types.HostChain) bool {
+	if !hc.GetHostChainTotalDelegations().IsZero() {
+		return false
+	}
+
+	for _, deposit := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+		if deposit.State != types.Deposit_DEPOSIT_PENDING {
+			return false
+		}
+	}
+
+	lsmDeposits := k.FilterLSMDeposits(ctx, func(d types.LSMDeposit) bool { return d.ChainId == hc.ChainId })
+	if len(lsmDeposits) > 0 {
+		return false
+	}
+
+	validatorUnbondings := k.FilterValidatorUnbondings(
+		ctx,
+		func(u types.ValidatorUnbonding) bool { return u.ChainId == hc.ChainId },
+	)
+	if len(validatorUnbondings) > 0 {
+		return false
+	}
+
+	unbondings := k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.ChainId == hc.ChainId && u.State != types.Unbonding_UNBONDING_CLAIMABLE
+		},
+	)
+	if len(unbondings) > 0 {
+		return false
+	}
+
+	icaTxs := k.FilterICATxs(
+		ctx,
+		func(t types.ICATx) bool {
+			return t.ChainId == hc.ChainId &&
+				(t.Status == types.ICATx_ICA_TX_PENDING || t.Status == types.ICATx_ICA_TX_TIMED_OUT)
+		},
+	)
+
+	return len(icaTxs) == 0
+}
+
+// ConvertStkSupply starts distributing the tokens returned from the host chain between all the stk holders. It
+// gathers the proceeds of the wind down in the undelegation module account and fixes the final c value, the stk
+// balances are then converted into claimable unbondings, a page of holders every block, by ConvertStkHolders.
+func (k *Keeper) ConvertStkSupply(ctx sdk.Context, hc *types.HostChain) error {
+	// the claimable unbondings nobody is waiting for hold the tokens returned by the wind down
+	proceeds := sdk.ZeroInt()
+	windDownUnbondings := k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.ChainId == hc.ChainId && u.State == types.Unbonding_UNBONDING_CLAIMABLE &&
+				len(k.FilterUserUnbondings(
+					ctx,
+					func(uu types.UserUnbonding) bool {
+						return uu.ChainId == hc.ChainId && uu.EpochNumber == u.EpochNumber
+					},
+				)) == 0
+		},
+	)
+	for _, unbonding := range windDownUnbondings {
+		proceeds = proceeds.Add(unbonding.UnbondAmount.Amount)
+	}
+
+	// the deposits that never left Persistence are also returned
+	deposits := k.GetDepositsForHostChain(ctx, hc.ChainId)
+	depositsAmount := sdk.ZeroInt()
+	for _, deposit := range deposits {
+		depositsAmount = depositsAmount.Add(deposit.Amount.Amount)
+	}
+	if depositsAmount.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			types.DepositModuleAccount,
+			types.UndelegationModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), depositsAmount)),
+		); err != nil {
+			return err
+		}
+		proceeds = proceeds.Add(depositsAmount)
+	}
+
+	for _, unbonding := range windDownUnbondings {
+		k.DeleteUnbonding(ctx, unbonding)
+	}
+	for _, deposit := range deposits {
+		deposit.Amount.Amount = sdk.ZeroInt()
+		k.SetDeposit(ctx, deposit)
+	}
+
+	// every stk token is worth the same share of the proceeds, a zero c value means there is nothing to return
+	supply := k.bankKeeper.GetSupply(ctx, hc.MintDenom()).Amount
+	if supply.IsPositive() {
+		hc.CValue = sdk.ZeroDec()
+		if proceeds.IsPositive() {
+			hc.CValue = sdk.NewDecFromInt(supply).Quo(sdk.NewDecFromInt(proceeds))
+		}
+	}
+
+	hc.WindDownState = types.HostChain_WIND_DOWN_CLAIMABLE
+	k.SetHostChain(ctx, hc)
+
+	k.emitWindDownEvent(ctx, hc)
+
+	return nil
+}
+
+// ConvertStkHolders converts the stk balances of a page of holders into claimable unbondings at the final c value,
+// burning their stk tokens. The stk tokens that can't be converted, like the ones held by the IBC transfer escrow
+// accounts or by other modules, are left untouched: the modules keep their own books of the tokens they hold, which
+// would break if the stk tokens were replaced by host tokens. Their host tokens stay in the undelegation module
+// account, reserved for them by a wind down reserve once the host chain is removed.
+func (k *Keeper) ConvertStkHolders(ctx sdk.Context, hc *types.HostChain) error {
+	epoch := k.getWindDownConversionEpoch(ctx, hc)
+	unbondAmount := sdk.ZeroInt()
+	burnAmount, err := k.convertStkHolders(
+		ctx,
+		hc.ChainId,
+		hc.MintDenom(),
+		func(holder sdk.AccAddress, stkAmount sdk.Coin) error {
+			amount := sdk.ZeroInt()
+			if hc.CValue.IsPositive() {
+				amount = sdk.NewDecFromInt(stkAmount.Amount).Quo(hc.CValue).TruncateInt()
+			}
+
+			k.IncreaseUserUnbondingAmountForEpoch(
+				ctx,
+				hc.ChainId,
+				holder.String(),
+				epoch,
+				stkAmount,
+				sdk.NewCoin(hc.HostDenom, amount),
+			)
+
+			unbondAmount = unbondAmount.Add(amount)
+			return nil
+		},
+	)
+	if err != nil || burnAmount.IsZero() {
+		return err
+	}
+
+	// the rounding dust is left in the undelegation module account
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+	if !found {
+		unbonding = &types.Unbonding{
+			ChainId:      hc.ChainId,
+			EpochNumber:  epoch,
+			MatureTime:   ctx.BlockTime(),
+			BurnAmount:   sdk.NewCoin(hc.MintDenom(), sdk.ZeroInt()),
+			UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.ZeroInt()),
+			State:        types.Unbonding_UNBONDING_CLAIMABLE,
+		}
+	}
+	unbonding.BurnAmount = unbonding.BurnAmount.AddAmount(burnAmount)
+	unbonding.UnbondAmount = unbonding.UnbondAmount.AddAmount(unbondAmount)
+	k.SetUnbonding(ctx, unbonding)
+
+	return nil
+}
+
+// convertStkHolders moves the spendable stk balance of a page of holders to the undelegation module account,
+// converting each of them with the convert function, and burns them. The pages are walked over and over, the
+// holders that can't be converted yet are retried with the next pass. It returns the amount of stk tokens burnt.
+func (k *Keeper) convertStkHolders(
+	ctx sdk.Context,
+	chainID string,
+	mintDenom string,
+	convert func(holder sdk.AccAddress, stkAmount sdk.Coin) error,
+) (sdk.Int, error) {
+	res, err := k.bankKeeper.DenomOwners(
+		sdk.WrapSDKContext(ctx),
+		&banktypes.QueryDenomOwnersRequest{
+			Denom: mintDenom,
+			Pagination: &query.PageRequest{
+				Key:   k.GetWindDownConversionKey(ctx, chainID),
+				Limit: types.WindDownConversionLimit,
+			},
+		},
+	)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	skipped := k.GetTransferEscrowAddresses(ctx)
+	skipped[k.GetUndelegationModuleAccount(ctx).GetAddress().String()] = true
+
+	burnAmount := sdk.ZeroInt()
+	for _, owner := range res.DenomOwners {
+		holder, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil || skipped[owner.Address] {
+			continue
+		}
+
+		// the liquidity pool is the only module whose stk tokens are owed to its users
+		if _, ok := k.accountKeeper.GetAccount(ctx, holder).(authtypes.ModuleAccountI); ok &&
+			!holder.Equals(k.GetLiquidityPoolAddress()) {
+			continue
+		}
+
+		stkAmount := sdk.NewCoin(mintDenom, k.bankKeeper.SpendableCoins(ctx, holder).AmountOf(mintDenom))
+		if !stkAmount.IsPositive() {
+			continue
+		}
+
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			holder,
+			types.UndelegationModuleAccount,
+			sdk.NewCoins(stkAmount),
+		); err != nil {
+			k.Logger(ctx).Error(
+				"Could not convert the stk balance of the holder.",
+				"host_chain",
+				chainID,
+				"address",
+				holder.String(),
+				"error",
+				err.Error(),
+			)
+			continue
+		}
+
+		if err = convert(holder, stkAmount); err != nil {
+			return sdk.ZeroInt(), err
+		}
+
+		burnAmount = burnAmount.Add(stkAmount.Amount)
+	}
+
+	if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+		k.DeleteWindDownConversionKey(ctx, chainID)
+	} else {
+		k.SetWindDownConversionKey(ctx, chainID, res.Pagination.NextKey)
+	}
+
+	if burnAmount.IsZero() {
+		return burnAmount, nil
+	}
+
+	if err = k.bankKeeper.BurnCoins(
+		ctx,
+		types.UndelegationModuleAccount,
+		sdk.NewCoins(sdk.NewCoin(mintDenom, burnAmount)),
+	); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	return burnAmount, nil
+}
+
+// getWindDownConversionEpoch returns the epoch of the claimable unbonding holding the converted stk balances,
+// or the first free epoch if all of them have been claimed
+func (k *Keeper) getWindDownConversionEpoch(ctx sdk.Context, hc *types.HostChain) int64 {
+	unbondings := k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.ChainId == hc.ChainId && u.State == types.Unbonding_UNBONDING_CLAIMABLE
+		},
+	)
+	if len(unbondings) > 0 {
+		return unbondings[0].EpochNumber
+	}
+
+	epoch := k.GetEpochNumber(ctx, types.UndelegationEpoch)
+	for {
+		if _, found := k.GetUnbonding(ctx, hc.ChainId, epoch); !found {
+			return epoch
+		}
+		epoch++
+	}
+}
+
+// GetTransferEscrowAddresses returns the escrow accounts of all the transfer channels
+func (k *Keeper) GetTransferEscrowAddresses(ctx sdk.Context) map[string]bool {
+	addresses := make(map[string]bool)
+	for _, channel := range k.ibcKeeper.ChannelKeeper.GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID) {
+		addresses[transfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId).String()] = true
+	}
+
+	return addresses
+}
+
+// GetWindDownConversionKey returns the key of the next page of stk holders to convert
+func (k *Keeper) GetWindDownConversionKey(ctx sdk.Context, chainID string) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WindDownConversionKey)
+	return store.Get([]byte(chainID))
+}
+
+func (k *Keeper) SetWindDownConversionKey(ctx sdk.Context, chainID string, key []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WindDownConversionKey)
+	store.Set([]byte(chainID), key)
+}
+
+func (k *Keeper) DeleteWindDownConversionKey(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WindDownConversionKey)
+	store.Delete([]byte(chainID))
+}
+
+// IsHostChainWoundDown checks if every stk holder and liquidity provider has been paid out. The stk supply doesn't
+// need to be burnt, the stk tokens left after a full pass over the holders can't be converted and are paid out from
+// the wind down reserve of the host chain once it is removed.
+func (k *Keeper) IsHostChainWoundDown(ctx sdk.Context, hc *types.HostChain) bool {
+	if len(k.GetWindDownConversionKey(ctx, hc.ChainId)) > 0 {
+		return false
+	}
+
+	unbondings := k.FilterUnbondings(ctx, func(u types.Unbonding) bool { return u.ChainId == hc.ChainId })
+	if len(unbondings) > 0 {
+		return false
+	}
+
+	userUnbondings := k.FilterUserUnbondings(ctx, func(u types.UserUnbonding) bool { return u.ChainId == hc.ChainId })
+	if len(userUnbondings) > 0 {
+		return false
+	}
+
+	pool, _ := k.GetLiquidityPool(ctx, hc.ChainId)
+	return pool.TotalShares.IsZero()
+}
+
+// RemoveHostChain deletes a wound down host chain alongside all its remaining records, reserving the host tokens
+// left in the undelegation module account for the stk tokens that couldn't be converted. The ICA channels can't be
+// closed by the controller, but they won't be recreated nor used anymore once the host chain is removed.
+func (k *Keeper) RemoveHostChain(ctx sdk.Context, hc *types.HostChain) {
+	if k.bankKeeper.GetSupply(ctx, hc.MintDenom()).IsPositive() {
+		k.SetWindDownReserve(ctx, &types.WindDownReserve{
+			ChainId:   hc.ChainId,
+			MintDenom: hc.MintDenom(),
+			CValue:    hc.CValue,
+			Amount: k.bankKeeper.GetBalance(
+				ctx,
+				k.GetUndelegationModuleAccount(ctx).GetAddress(),
+				hc.IBCDenom(),
+			),
+		})
+	}
+
+	for _, deposit := range k.GetDepositsForHostChain(ctx, hc.ChainId) {
+		k.DeleteDeposit(ctx, deposit)
+	}
+
+	lsmDeposits := k.FilterLSMDeposits(ctx, func(d types.LSMDeposit) bool { return d.ChainId == hc.ChainId })
+	for _, deposit := range lsmDeposits {
+		k.DeleteLSMDeposit(ctx, deposit)
+	}
+
+	icaTxs := k.FilterICATxs(ctx, func(t types.ICATx) bool { return t.ChainId == hc.ChainId })
+	for _, icaTx := range icaTxs {
+		k.DeleteICATx(ctx, icaTx)
+	}
+
+	redelegations := k.FilterRedelegations(ctx, func(r types.Redelegation) bool { return r.ChainId == hc.ChainId })
+	for _, redelegation := range redelegations {
+		k.DeleteRedelegation(ctx, redelegation)
+	}
+
+	proposals := k.FilterHostChainProposals(ctx, func(p types.HostChainProposal) bool { return p.ChainId == hc.ChainId })
+	for _, proposal := range proposals {
+		for _, vote := range k.GetVotesForProposal(ctx, hc.ChainId, proposal.ProposalId) {
+			k.DeleteProposalVote(ctx, vote)
+		}
+		k.DeleteHostChainProposal(ctx, proposal)
+	}
+
+	// the history of the host chain can't be kept either, its records can only be imported alongside the host chain
+	rewardsRecords := k.FilterRewardsRecords(ctx, func(r types.RewardsRecord) bool { return r.ChainId == hc.ChainId })
+	for _, record := range rewardsRecords {
+		k.DeleteRewardsRecord(ctx, record)
+	}
+
+	slashes := k.FilterSlashes(ctx, func(s types.Slash) bool { return s.ChainId == hc.ChainId })
+	for _, slash := range slashes {
+		k.DeleteSlash(ctx, slash)
+	}
+
+	exchangeRates := k.FilterExchangeRateRecords(
+		ctx,
+		func(r types.ExchangeRateRecord) bool { return r.ChainId == hc.ChainId },
+	)
+	for _, record := range exchangeRates {
+		k.DeleteExchangeRateRecord(ctx, record)
+	}
+
+	ledgerEntries := k.FilterLedgerEntries(ctx, func(e types.LedgerEntry) bool { return e.ChainId == hc.ChainId })
+	for _, entry := range ledgerEntries {
+		k.DeleteLedgerEntry(ctx, entry)
+	}
+
+	providers := k.FilterLiquidityProviders(ctx, func(p types.LiquidityProvider) bool { return p.ChainId == hc.ChainId })
+	for _, provider := range providers {
+		k.DeleteLiquidityProvider(ctx, provider)
+	}
+	if pool, found := k.GetLiquidityPool(ctx, hc.ChainId); found {
+		k.DeleteLiquidityPool(ctx, pool)
+	}
+
+	k.DeleteWindDownConversionKey(ctx, hc.ChainId)
+	k.DeleteHostChain(ctx, hc)
+
+	k.Logger(ctx).Info("Host chain deregistered.", "host_chain", hc.ChainId)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChainDeregistered,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+		),
+	)
+}
+
+// DoConvertWindDownReserves pays out, a page of holders every block, the stk tokens of the removed host chains that
+// reached an account that can be converted
+func (k *Keeper) DoConvertWindDownReserves(ctx sdk.Context) {
+	for _, reserve := range k.GetAllWindDownReserves(ctx) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.ConvertWindDownReserve(cacheCtx, reserve); err != nil {
+			k.Logger(ctx).Error(
+				"Could not convert the stk balances of the removed host chain holders.",
+				"host_chain",
+				reserve.ChainId,
+				"error",
+				err.Error(),
+			)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// ConvertWindDownReserve burns the stk balances of a page of holders of a removed host chain, sending them the host
+// tokens they are worth at the final c value out of the wind down reserve. The reserve is deleted once the whole
+// stk supply has been burnt, the rounding dust is left in the undelegation module account.
+func (k *Keeper) ConvertWindDownReserve(ctx sdk.Context, reserve *types.WindDownReserve) error {
+	_, err := k.convertStkHolders(
+		ctx,
+		reserve.ChainId,
+		reserve.MintDenom,
+		func(holder sdk.AccAddress, stkAmount sdk.Coin) error {
+			amount := sdk.ZeroInt()
+			if reserve.CValue.IsPositive() {
+				amount = sdk.NewDecFromInt(stkAmount.Amount).Quo(reserve.CValue).TruncateInt()
+			}
+			amount = sdk.MinInt(amount, reserve.Amount.Amount)
+			if !amount.IsPositive() {
+				return nil
+			}
+
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+				ctx,
+				types.UndelegationModuleAccount,
+				holder,
+				sdk.NewCoins(sdk.NewCoin(reserve.Amount.Denom, amount)),
+			); err != nil {
+				return err
+			}
+
+			reserve.Amount = reserve.Amount.SubAmount(amount)
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if !k.bankKeeper.GetSupply(ctx, reserve.MintDenom).IsPositive() {
+		k.DeleteWindDownConversionKey(ctx, reserve.ChainId)
+		k.DeleteWindDownReserve(ctx, reserve.ChainId)
+		return nil
+	}
+
+	k.SetWindDownReserve(ctx, reserve)
+	return nil
+}
+
+// SetWindDownReserve sets the reserve of a removed host chain in the store
+func (k *Keeper) SetWindDownReserve(ctx sdk.Context, reserve *types.WindDownReserve) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WindDownReserveKey)
+	bytes := k.cdc.MustMarshal(reserve)
+	store.Set([]byte(reserve.ChainId), bytes)
+}
+
+// GetWindDownReserve returns the reserve of a removed host chain
+func (k *Keeper) GetWindDownReserve(ctx sdk.Context, chainID string) (*types.WindDownReserve, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WindDownReserveKey)
+	bz := store.Get([]byte(chainID))
+	if bz == nil {
+		return nil, false
+	}
+
+	var reserve types.WindDownReserve
+	k.cdc.MustUnmarshal(bz, &reserve)
+	return &reserve, true
+}
+
+// DeleteWindDownReserve removes the reserve of a removed host chain from the store
+func (k *Keeper) DeleteWindDownReserve(ctx sdk.Context, chainID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WindDownReserveKey)
+	store.Delete([]byte(chainID))
+}
+
+// GetAllWindDownReserves returns the reserves of all the removed host chains
+func (k *Keeper) GetAllWindDownReserves(ctx sdk.Context) []*types.WindDownReserve {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WindDownReserveKey)
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	reserves := make([]*types.WindDownReserve, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		reserve := types.WindDownReserve{}
+		k.cdc.MustUnmarshal(iterator.Value(), &reserve)
+		reserves = append(reserves, &reserve)
+	}
+
+	return reserves
+}
+
+func (k *Keeper) emitWindDownEvent(ctx sdk.Context, hc *types.HostChain) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChainWindDown,
+			sdk.NewAttribute(types.AttributeChainID, hc.ChainId),
+			sdk.NewAttribute(types.AttributeWindDownState, hc.WindDownState.String()),
+			sdk.NewAttribute(types.AttributeCValue, hc.CValue.String()),
+		),
+	)
+}
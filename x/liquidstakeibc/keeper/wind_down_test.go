@@ -0,0 +1,516 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func (suite *IntegrationTestSuite) TestStartHostChainWindDown() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	hc.Active = true
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	unbondings := []*types.Unbonding{
+		{
+			ChainId:      hc.ChainId,
+			EpochNumber:  4,
+			BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 100),
+			UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 100),
+			State:        types.Unbonding_UNBONDING_PENDING,
+		},
+		{
+			ChainId:      hc.ChainId,
+			EpochNumber:  8,
+			BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 100),
+			UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 100),
+			State:        types.Unbonding_UNBONDING_MATURING,
+		},
+	}
+	for _, unbonding := range unbondings {
+		suite.app.LiquidStakeIBCKeeper.SetUnbonding(suite.ctx, unbonding)
+	}
+
+	suite.app.LiquidStakeIBCKeeper.StartHostChainWindDown(suite.ctx, hc)
+
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(false, hc.Active)
+	suite.Require().Equal(types.HostChain_WIND_DOWN_UNDELEGATING, hc.WindDownState)
+
+	// the pending unbondings are failed, the ones in flight are left untouched
+	unbonding, _ := suite.app.LiquidStakeIBCKeeper.GetUnbonding(suite.ctx, hc.ChainId, 4)
+	suite.Require().Equal(types.Unbonding_UNBONDING_FAILED, unbonding.State)
+	unbonding, _ = suite.app.LiquidStakeIBCKeeper.GetUnbonding(suite.ctx, hc.ChainId, 8)
+	suite.Require().Equal(types.Unbonding_UNBONDING_MATURING, unbonding.State)
+	suite.Require().Equal(false, suite.app.LiquidStakeIBCKeeper.IsHostChainUndelegated(suite.ctx, hc))
+}
+
+func (suite *IntegrationTestSuite) TestConvertStkSupply() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	suite.openTransferChannel()
+	suite.app.LiquidStakeIBCKeeper.StartHostChainWindDown(suite.ctx, hc)
+
+	// tokens returned by the wind down undelegations
+	suite.app.LiquidStakeIBCKeeper.SetUnbonding(suite.ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  1,
+		BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 0),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 780),
+		State:        types.Unbonding_UNBONDING_CLAIMABLE,
+	})
+
+	// tokens that never left Persistence
+	suite.app.LiquidStakeIBCKeeper.SetDeposit(suite.ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 100),
+		Epoch:   sdk.NewInt(1),
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			types.DepositModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 100)),
+		),
+	)
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			types.UndelegationModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 780)),
+		),
+	)
+
+	holders := []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(TestAddress),
+		sdk.AccAddress("test_stk_holder_____"),
+	}
+	for i, holder := range holders {
+		suite.Require().NoError(
+			testutil.FundAccount(
+				suite.app.BankKeeper,
+				suite.ctx,
+				holder,
+				sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), int64(300*(i+1)))),
+			),
+		)
+	}
+
+	// stk tokens held in the escrow of a transfer channel and by another module can't be converted
+	escrowAddress := ibctransfertypes.GetEscrowAddress(TransferPort, TransferChannel)
+	suite.Require().NoError(
+		testutil.FundAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			escrowAddress,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+		),
+	)
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			govtypes.ModuleName,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+		),
+	)
+	suite.Require().Equal(true, suite.app.LiquidStakeIBCKeeper.IsHostChainUndelegated(suite.ctx, hc))
+
+	suite.app.LiquidStakeIBCKeeper.DoWindDown(suite.ctx, hc)
+
+	hc, _ = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(types.HostChain_WIND_DOWN_CLAIMABLE, hc.WindDownState)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.25"), hc.CValue)
+
+	// the holders are converted and paid out at the final c value
+	suite.app.LiquidStakeIBCKeeper.DoWindDown(suite.ctx, hc)
+
+	for i, holder := range holders {
+		suite.Require().Equal(
+			sdk.NewInt(int64(240*(i+1))),
+			suite.app.BankKeeper.GetBalance(suite.ctx, holder, hc.IBCDenom()).Amount,
+		)
+		suite.Require().Equal(true, suite.app.BankKeeper.GetBalance(suite.ctx, holder, hc.MintDenom()).IsZero())
+	}
+
+	// the host tokens of the unconverted stk stay in the undelegation module account
+	suite.Require().Equal(sdk.NewInt(200), suite.app.BankKeeper.GetSupply(suite.ctx, hc.MintDenom()).Amount)
+	suite.Require().Equal(
+		sdk.NewInt(160),
+		suite.app.BankKeeper.GetBalance(
+			suite.ctx,
+			suite.app.LiquidStakeIBCKeeper.GetUndelegationModuleAccount(suite.ctx).GetAddress(),
+			hc.IBCDenom(),
+		).Amount,
+	)
+
+	// every holder has been visited, so the host chain is removed and the host tokens of the unconverted stk are
+	// reserved for them
+	_, found = suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(false, found)
+	reserve, found := suite.app.LiquidStakeIBCKeeper.GetWindDownReserve(suite.ctx, hc.ChainId)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(hc.MintDenom(), reserve.MintDenom)
+	suite.Require().Equal(hc.CValue, reserve.CValue)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 160), reserve.Amount)
+
+	// once the stk tokens reach a regular account they are paid out of the reserve
+	returned := sdk.AccAddress("test_stk_returned___")
+	suite.Require().NoError(
+		suite.app.BankKeeper.SendCoins(
+			suite.ctx,
+			escrowAddress,
+			returned,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+		),
+	)
+
+	suite.app.LiquidStakeIBCKeeper.DoConvertWindDownReserves(suite.ctx)
+
+	suite.Require().Equal(sdk.NewInt(80), suite.app.BankKeeper.GetBalance(suite.ctx, returned, hc.IBCDenom()).Amount)
+	reserve, found = suite.app.LiquidStakeIBCKeeper.GetWindDownReserve(suite.ctx, hc.ChainId)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.IBCDenom(), 80), reserve.Amount)
+
+	suite.Require().NoError(
+		suite.app.BankKeeper.SendCoinsFromModuleToAccount(
+			suite.ctx,
+			govtypes.ModuleName,
+			returned,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+		),
+	)
+
+	suite.app.LiquidStakeIBCKeeper.DoConvertWindDownReserves(suite.ctx)
+
+	// the whole stk supply is burnt, the reserve is done
+	suite.Require().Equal(sdk.NewInt(160), suite.app.BankKeeper.GetBalance(suite.ctx, returned, hc.IBCDenom()).Amount)
+	suite.Require().Equal(true, suite.app.BankKeeper.GetSupply(suite.ctx, hc.MintDenom()).IsZero())
+	_, found = suite.app.LiquidStakeIBCKeeper.GetWindDownReserve(suite.ctx, hc.ChainId)
+	suite.Require().Equal(false, found)
+}
+
+func (suite *IntegrationTestSuite) TestConvertStkHolders() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	suite.openTransferChannel()
+	hc.Active = false
+	hc.WindDownState = types.HostChain_WIND_DOWN_CLAIMABLE
+	hc.CValue = sdk.MustNewDecFromStr("1.25")
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	// more holders than fit in a page
+	holders := make([]sdk.AccAddress, 0)
+	for i := 0; i < int(types.WindDownConversionLimit)+5; i++ {
+		holder := sdk.AccAddress(fmt.Sprintf("test_stk_holder_%04d", i))
+		suite.Require().NoError(
+			testutil.FundAccount(
+				suite.app.BankKeeper,
+				suite.ctx,
+				holder,
+				sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 301)),
+			),
+		)
+		holders = append(holders, holder)
+	}
+
+	// stk tokens in a transfer escrow and held by another module are skipped
+	escrowAddress := ibctransfertypes.GetEscrowAddress(TransferPort, TransferChannel)
+	suite.Require().NoError(
+		testutil.FundAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			escrowAddress,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+		),
+	)
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			govtypes.ModuleName,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+		),
+	)
+
+	// only the vested stk tokens of a vesting account can be converted
+	vestingAddress := sdk.AccAddress("test_stk_vesting____")
+	baseAccount := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, vestingAddress).(*authtypes.BaseAccount)
+	suite.app.AccountKeeper.SetAccount(
+		suite.ctx,
+		vestingtypes.NewDelayedVestingAccount(
+			baseAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+			suite.ctx.BlockTime().Add(time.Hour).Unix(),
+		),
+	)
+	suite.Require().NoError(
+		testutil.FundAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			vestingAddress,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 150)),
+		),
+	)
+
+	epoch := suite.app.LiquidStakeIBCKeeper.GetEpochNumber(suite.ctx, types.UndelegationEpoch)
+	userUnbondings := func() []*types.UserUnbonding {
+		return suite.app.LiquidStakeIBCKeeper.FilterUserUnbondings(
+			suite.ctx,
+			func(u types.UserUnbonding) bool { return u.ChainId == hc.ChainId },
+		)
+	}
+
+	// the first page is converted, the next one is resumed from the stored key
+	suite.Require().NoError(suite.app.LiquidStakeIBCKeeper.ConvertStkHolders(suite.ctx, hc))
+	suite.Require().NotEmpty(suite.app.LiquidStakeIBCKeeper.GetWindDownConversionKey(suite.ctx, hc.ChainId))
+	converted := len(userUnbondings())
+	suite.Require().Greater(converted, 0)
+	suite.Require().Less(converted, len(holders)+1)
+
+	suite.Require().NoError(suite.app.LiquidStakeIBCKeeper.ConvertStkHolders(suite.ctx, hc))
+	suite.Require().Empty(suite.app.LiquidStakeIBCKeeper.GetWindDownConversionKey(suite.ctx, hc.ChainId))
+	suite.Require().Len(userUnbondings(), len(holders)+1)
+
+	// the holders are converted at the final c value, rounding down
+	for _, holder := range holders {
+		suite.Require().Equal(true, suite.app.BankKeeper.GetBalance(suite.ctx, holder, hc.MintDenom()).IsZero())
+
+		userUnbonding, found := suite.app.LiquidStakeIBCKeeper.GetUserUnbonding(
+			suite.ctx,
+			hc.ChainId,
+			holder.String(),
+			epoch,
+		)
+		suite.Require().Equal(true, found)
+		suite.Require().Equal(sdk.NewInt64Coin(hc.MintDenom(), 301), userUnbonding.StkAmount)
+		suite.Require().Equal(sdk.NewInt64Coin(hc.HostDenom, 240), userUnbonding.UnbondAmount)
+	}
+
+	userUnbonding, found := suite.app.LiquidStakeIBCKeeper.GetUserUnbonding(
+		suite.ctx,
+		hc.ChainId,
+		vestingAddress.String(),
+		epoch,
+	)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.MintDenom(), 50), userUnbonding.StkAmount)
+	suite.Require().Equal(sdk.NewInt64Coin(hc.HostDenom, 40), userUnbonding.UnbondAmount)
+
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, vestingAddress, hc.MintDenom()).Amount)
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, escrowAddress, hc.MintDenom()).Amount)
+	suite.Require().Equal(
+		sdk.NewInt(100),
+		suite.app.BankKeeper.GetBalance(
+			suite.ctx,
+			suite.app.AccountKeeper.GetModuleAddress(govtypes.ModuleName),
+			hc.MintDenom(),
+		).Amount,
+	)
+	suite.Require().Equal(sdk.NewInt(300), suite.app.BankKeeper.GetSupply(suite.ctx, hc.MintDenom()).Amount)
+
+	unbonding, found := suite.app.LiquidStakeIBCKeeper.GetUnbonding(suite.ctx, hc.ChainId, epoch)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(types.Unbonding_UNBONDING_CLAIMABLE, unbonding.State)
+	suite.Require().Equal(sdk.NewInt(int64(301*len(holders)+50)), unbonding.BurnAmount.Amount)
+	suite.Require().Equal(sdk.NewInt(int64(240*len(holders)+40)), unbonding.UnbondAmount.Amount)
+
+	// a new pass doesn't convert anything twice
+	suite.Require().NoError(suite.app.LiquidStakeIBCKeeper.ConvertStkHolders(suite.ctx, hc))
+	suite.Require().NoError(suite.app.LiquidStakeIBCKeeper.ConvertStkHolders(suite.ctx, hc))
+	unbonding, _ = suite.app.LiquidStakeIBCKeeper.GetUnbonding(suite.ctx, hc.ChainId, epoch)
+	suite.Require().Equal(sdk.NewInt(int64(301*len(holders)+50)), unbonding.BurnAmount.Amount)
+}
+
+func (suite *IntegrationTestSuite) TestIsHostChainWoundDown() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	hc.Active = false
+	hc.WindDownState = types.HostChain_WIND_DOWN_CLAIMABLE
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	// stk tokens that can't be converted don't keep the host chain from being wound down
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			govtypes.ModuleName,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+		),
+	)
+	suite.Require().Equal(true, suite.app.LiquidStakeIBCKeeper.IsHostChainWoundDown(suite.ctx, hc))
+
+	// a pass over the holders is ongoing
+	suite.app.LiquidStakeIBCKeeper.SetWindDownConversionKey(suite.ctx, hc.ChainId, []byte("next"))
+	suite.Require().Equal(false, suite.app.LiquidStakeIBCKeeper.IsHostChainWoundDown(suite.ctx, hc))
+	suite.app.LiquidStakeIBCKeeper.DeleteWindDownConversionKey(suite.ctx, hc.ChainId)
+
+	// the converted holders haven't been paid out yet
+	unbonding := &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  1,
+		BurnAmount:   sdk.NewInt64Coin(hc.MintDenom(), 100),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 80),
+		State:        types.Unbonding_UNBONDING_CLAIMABLE,
+	}
+	suite.app.LiquidStakeIBCKeeper.SetUnbonding(suite.ctx, unbonding)
+	suite.Require().Equal(false, suite.app.LiquidStakeIBCKeeper.IsHostChainWoundDown(suite.ctx, hc))
+	suite.app.LiquidStakeIBCKeeper.DeleteUnbonding(suite.ctx, unbonding)
+
+	userUnbonding := &types.UserUnbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  1,
+		Address:      TestAddress,
+		StkAmount:    sdk.NewInt64Coin(hc.MintDenom(), 100),
+		UnbondAmount: sdk.NewInt64Coin(hc.HostDenom, 80),
+	}
+	suite.app.LiquidStakeIBCKeeper.SetUserUnbonding(suite.ctx, userUnbonding)
+	suite.Require().Equal(false, suite.app.LiquidStakeIBCKeeper.IsHostChainWoundDown(suite.ctx, hc))
+	suite.app.LiquidStakeIBCKeeper.DeleteUserUnbonding(suite.ctx, userUnbonding)
+
+	// the liquidity providers haven't withdrawn their shares
+	suite.app.LiquidStakeIBCKeeper.SetLiquidityPool(
+		suite.ctx,
+		&types.LiquidityPool{ChainId: hc.ChainId, TotalShares: sdk.NewInt(100)},
+	)
+	suite.Require().Equal(false, suite.app.LiquidStakeIBCKeeper.IsHostChainWoundDown(suite.ctx, hc))
+	suite.app.LiquidStakeIBCKeeper.SetLiquidityPool(
+		suite.ctx,
+		&types.LiquidityPool{ChainId: hc.ChainId, TotalShares: sdk.ZeroInt()},
+	)
+	suite.Require().Equal(true, suite.app.LiquidStakeIBCKeeper.IsHostChainWoundDown(suite.ctx, hc))
+}
+
+func (suite *IntegrationTestSuite) TestRemoveHostChain() {
+	hc, found := suite.app.LiquidStakeIBCKeeper.GetHostChain(suite.ctx, suite.path.EndpointB.Chain.ChainID)
+	suite.Require().Equal(true, found)
+
+	hc.CValue = sdk.MustNewDecFromStr("1.25")
+	suite.app.LiquidStakeIBCKeeper.SetHostChain(suite.ctx, hc)
+
+	k := suite.app.LiquidStakeIBCKeeper
+	for _, chainID := range []string{hc.ChainId, "other-chain"} {
+		k.SetDeposit(suite.ctx, &types.Deposit{
+			ChainId: chainID,
+			Amount:  sdk.NewInt64Coin(hc.IBCDenom(), 0),
+			Epoch:   sdk.NewInt(1),
+		})
+		k.SetLSMDeposit(suite.ctx, &types.LSMDeposit{
+			ChainId:          chainID,
+			DelegatorAddress: TestAddress,
+			Denom:            "valoper1/1",
+		})
+		k.SetICATx(suite.ctx, &types.ICATx{ChainId: chainID, SequenceId: chainID + "-1"})
+		k.SetRedelegation(suite.ctx, &types.Redelegation{
+			ChainId:             chainID,
+			SrcValidatorAddress: "valoper1",
+			DstValidatorAddress: "valoper2",
+		})
+		k.SetHostChainProposal(suite.ctx, &types.HostChainProposal{ChainId: chainID, ProposalId: 1})
+		k.SetProposalVote(suite.ctx, &types.ProposalVote{ChainId: chainID, ProposalId: 1, Voter: TestAddress})
+		k.SetRewardsRecord(suite.ctx, &types.RewardsRecord{ChainId: chainID, Epoch: 1})
+		k.SetSlash(suite.ctx, &types.Slash{ChainId: chainID, ValidatorAddress: "valoper1", Height: 1})
+		k.SetExchangeRateRecord(suite.ctx, &types.ExchangeRateRecord{ChainId: chainID, EpochNumber: 1})
+		k.SetLiquidityPool(suite.ctx, &types.LiquidityPool{ChainId: chainID, TotalShares: sdk.ZeroInt()})
+		k.SetLiquidityProvider(suite.ctx, &types.LiquidityProvider{
+			ChainId: chainID,
+			Address: TestAddress,
+			Shares:  sdk.ZeroInt(),
+		})
+		k.SetWindDownConversionKey(suite.ctx, chainID, []byte("next"))
+	}
+	k.SetLedgerEntry(suite.ctx, &types.LedgerEntry{Id: 1, ChainId: hc.ChainId, Address: TestAddress, Height: 1})
+	k.SetLedgerEntry(suite.ctx, &types.LedgerEntry{Id: 2, ChainId: "other-chain", Address: TestAddress, Height: 1})
+
+	// stk tokens left unconverted and the host tokens they are worth
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			govtypes.ModuleName,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.MintDenom(), 100)),
+		),
+	)
+	suite.Require().NoError(
+		testutil.FundModuleAccount(
+			suite.app.BankKeeper,
+			suite.ctx,
+			types.UndelegationModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(hc.IBCDenom(), 81)),
+		),
+	)
+
+	k.RemoveHostChain(suite.ctx, hc)
+
+	_, found = k.GetHostChain(suite.ctx, hc.ChainId)
+	suite.Require().Equal(false, found)
+
+	reserve, found := k.GetWindDownReserve(suite.ctx, hc.ChainId)
+	suite.Require().Equal(true, found)
+	suite.Require().Equal(
+		types.WindDownReserve{
+			ChainId:   hc.ChainId,
+			MintDenom: hc.MintDenom(),
+			CValue:    hc.CValue,
+			Amount:    sdk.NewInt64Coin(hc.IBCDenom(), 81),
+		},
+		*reserve,
+	)
+
+	// every record of the removed host chain is deleted, the ones of other host chains are kept
+	for chainID, count := range map[string]int{hc.ChainId: 0, "other-chain": 1} {
+		suite.Require().Len(k.GetDepositsForHostChain(suite.ctx, chainID), count)
+		suite.Require().Len(
+			k.FilterLSMDeposits(suite.ctx, func(d types.LSMDeposit) bool { return d.ChainId == chainID }),
+			count,
+		)
+		suite.Require().Len(k.FilterICATxs(suite.ctx, func(t types.ICATx) bool { return t.ChainId == chainID }), count)
+		suite.Require().Len(
+			k.FilterRedelegations(suite.ctx, func(r types.Redelegation) bool { return r.ChainId == chainID }),
+			count,
+		)
+		suite.Require().Len(
+			k.FilterHostChainProposals(suite.ctx, func(p types.HostChainProposal) bool { return p.ChainId == chainID }),
+			count,
+		)
+		suite.Require().Len(k.GetVotesForProposal(suite.ctx, chainID, 1), count)
+		suite.Require().Len(
+			k.FilterRewardsRecords(suite.ctx, func(r types.RewardsRecord) bool { return r.ChainId == chainID }),
+			count,
+		)
+		suite.Require().Len(k.FilterSlashes(suite.ctx, func(s types.Slash) bool { return s.ChainId == chainID }), count)
+		suite.Require().Len(
+			k.FilterExchangeRateRecords(suite.ctx, func(r types.ExchangeRateRecord) bool { return r.ChainId == chainID }),
+			count,
+		)
+		suite.Require().Len(
+			k.FilterLedgerEntries(suite.ctx, func(e types.LedgerEntry) bool { return e.ChainId == chainID }),
+			count,
+		)
+		suite.Require().Len(
+			k.FilterLiquidityPools(suite.ctx, func(p types.LiquidityPool) bool { return p.ChainId == chainID }),
+			count,
+		)
+		suite.Require().Len(
+			k.FilterLiquidityProviders(suite.ctx, func(p types.LiquidityProvider) bool { return p.ChainId == chainID }),
+			count,
+		)
+		suite.Require().Equal(count > 0, len(k.GetWindDownConversionKey(suite.ctx, chainID)) > 0)
+	}
+}
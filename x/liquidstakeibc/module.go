@@ -75,13 +75,23 @@ func (a AppModuleBasic) GetQueryCmd() *cobra.Command {
 
 type AppModule struct {
 	AppModuleBasic
+	cdc           codec.Codec
 	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 	keeper        keeper.Keeper
 }
 
-func NewAppModule(keeper keeper.Keeper) AppModule {
+func NewAppModule(
+	cdc codec.Codec,
+	keeper keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
+		cdc:            cdc,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 		keeper:         keeper,
 	}
 }
@@ -109,7 +119,9 @@ func (a AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Valida
 	return []abci.ValidatorUpdate{}
 }
 
-func (a AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {}
+func (a AppModule) RegisterInvariants(registry sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(registry, a.keeper)
+}
 
 // Deprecated: QuerierRoute
 func (a AppModule) QuerierRoute() string {
@@ -125,13 +137,16 @@ func (a AppModule) ConsensusVersion() uint64 {
 	return 1
 }
 
-// TODO simulations
-func (a AppModule) GenerateGenesisState(input *module.SimulationState) {}
+func (a AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
 
-func (a AppModule) RegisterStoreDecoder(registry sdk.StoreDecoderRegistry) {}
+func (a AppModule) RegisterStoreDecoder(registry sdk.StoreDecoderRegistry) {
+	registry[types.StoreKey] = simulation.NewDecodeStore(a.cdc)
+}
 
 func (a AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	return simulation.WeightedOperations(
-		simState.AppParams, simState.Cdc, a.accountKeeper,
+		simState.AppParams, simState.Cdc, a.accountKeeper, a.bankKeeper, a.keeper,
 	)
 }
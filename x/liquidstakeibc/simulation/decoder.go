@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding liquidstakeibc type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.HostChainKey):
+			var hcA, hcB types.HostChain
+			cdc.MustUnmarshal(kvA.Value, &hcA)
+			cdc.MustUnmarshal(kvB.Value, &hcB)
+			return fmt.Sprintf("%v\n%v", hcA, hcB)
+
+		case bytes.Equal(kvA.Key[:1], types.DepositKey):
+			var depositA, depositB types.Deposit
+			cdc.MustUnmarshal(kvA.Value, &depositA)
+			cdc.MustUnmarshal(kvB.Value, &depositB)
+			return fmt.Sprintf("%v\n%v", depositA, depositB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnbondingKey):
+			var unbondingA, unbondingB types.Unbonding
+			cdc.MustUnmarshal(kvA.Value, &unbondingA)
+			cdc.MustUnmarshal(kvB.Value, &unbondingB)
+			return fmt.Sprintf("%v\n%v", unbondingA, unbondingB)
+
+		case bytes.Equal(kvA.Key[:1], types.UserUnbondingKey):
+			var userUnbondingA, userUnbondingB types.UserUnbonding
+			cdc.MustUnmarshal(kvA.Value, &userUnbondingA)
+			cdc.MustUnmarshal(kvB.Value, &userUnbondingB)
+			return fmt.Sprintf("%v\n%v", userUnbondingA, userUnbondingB)
+
+		case bytes.Equal(kvA.Key[:1], types.ValidatorUnbondingKey):
+			var validatorUnbondingA, validatorUnbondingB types.ValidatorUnbonding
+			cdc.MustUnmarshal(kvA.Value, &validatorUnbondingA)
+			cdc.MustUnmarshal(kvB.Value, &validatorUnbondingB)
+			return fmt.Sprintf("%v\n%v", validatorUnbondingA, validatorUnbondingB)
+
+		default:
+			panic(fmt.Sprintf("invalid liquidstakeibc key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
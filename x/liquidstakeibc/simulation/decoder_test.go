@@ -0,0 +1,82 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	modtestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/simulation"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestDecodeLiquidStakeIBCStore(t *testing.T) {
+	cdc := modtestutil.MakeTestEncodingConfig()
+	dec := simulation.NewDecodeStore(cdc.Codec)
+
+	hcA := types.HostChain{ChainId: "chain-a", CValue: sdk.OneDec(), LastCValue: sdk.OneDec(), MinimumDeposit: sdk.OneInt()}
+	hcB := types.HostChain{ChainId: "chain-b", CValue: sdk.OneDec(), LastCValue: sdk.OneDec(), MinimumDeposit: sdk.OneInt()}
+	deposit := types.Deposit{ChainId: "chain-a", Amount: sdk.NewInt64Coin("uatom", 100), Epoch: sdk.OneInt()}
+	unbonding := types.Unbonding{ChainId: "chain-a", EpochNumber: 1, UnbondAmount: sdk.NewInt64Coin("uatom", 100)}
+	userUnbonding := types.UserUnbonding{ChainId: "chain-a", Address: "persistence1", EpochNumber: 1}
+	validatorUnbonding := types.ValidatorUnbonding{ChainId: "chain-a", ValidatorAddress: "cosmosvaloper1", EpochNumber: 1}
+
+	tests := []struct {
+		name        string
+		kvA         kv.Pair
+		kvB         kv.Pair
+		expectedLog string
+	}{
+		{
+			"HostChain",
+			kv.Pair{Key: types.HostChainKey, Value: cdc.Codec.MustMarshal(&hcA)},
+			kv.Pair{Key: types.HostChainKey, Value: cdc.Codec.MustMarshal(&hcB)},
+			fmt.Sprintf("%v\n%v", hcA, hcB),
+		},
+		{
+			"Deposit",
+			kv.Pair{Key: types.DepositKey, Value: cdc.Codec.MustMarshal(&deposit)},
+			kv.Pair{Key: types.DepositKey, Value: cdc.Codec.MustMarshal(&deposit)},
+			fmt.Sprintf("%v\n%v", deposit, deposit),
+		},
+		{
+			"Unbonding",
+			kv.Pair{Key: types.UnbondingKey, Value: cdc.Codec.MustMarshal(&unbonding)},
+			kv.Pair{Key: types.UnbondingKey, Value: cdc.Codec.MustMarshal(&unbonding)},
+			fmt.Sprintf("%v\n%v", unbonding, unbonding),
+		},
+		{
+			"UserUnbonding",
+			kv.Pair{Key: types.UserUnbondingKey, Value: cdc.Codec.MustMarshal(&userUnbonding)},
+			kv.Pair{Key: types.UserUnbondingKey, Value: cdc.Codec.MustMarshal(&userUnbonding)},
+			fmt.Sprintf("%v\n%v", userUnbonding, userUnbonding),
+		},
+		{
+			"ValidatorUnbonding",
+			kv.Pair{Key: types.ValidatorUnbondingKey, Value: cdc.Codec.MustMarshal(&validatorUnbonding)},
+			kv.Pair{Key: types.ValidatorUnbondingKey, Value: cdc.Codec.MustMarshal(&validatorUnbonding)},
+			fmt.Sprintf("%v\n%v", validatorUnbonding, validatorUnbonding),
+		},
+		{
+			"other",
+			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+			"",
+		},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(tt.kvA, tt.kvB) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(tt.kvA, tt.kvB), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// DONTCOVER
+
+// Simulation parameter constants
+const (
+	feeAddress    = "fee_address"
+	ledgerEnabled = "ledger_enabled"
+)
+
+// maxHostChainValidators is the max number of validators of a simulated host chain
+const maxHostChainValidators = 5
+
+func genFeeAddress(r *rand.Rand, accs []simtypes.Account) string {
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc.Address.String()
+}
+
+func genLedgerEnabled(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// genHostChains returns randomized active host chains. Their connections and channels don't exist, the host chain
+// side of their operations is played by the SimulateHostChain operation.
+func genHostChains(r *rand.Rand) []*types.HostChain {
+	hcs := make([]*types.HostChain, simtypes.RandIntBetween(r, 1, 4))
+	for i := range hcs {
+		chainID := fmt.Sprintf("simhost-%d", i)
+		hostDenom := fmt.Sprintf("usim%d", i)
+
+		hcs[i] = &types.HostChain{
+			ChainId:      chainID,
+			ConnectionId: fmt.Sprintf("connection-%d", i),
+			Params: &types.HostChainLSParams{
+				DepositFee:    simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)),
+				RestakeFee:    simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(5, 2)),
+				UnstakeFee:    simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)),
+				RedemptionFee: simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)),
+			},
+			HostDenom: hostDenom,
+			ChannelId: fmt.Sprintf("channel-%d", i),
+			PortId:    ibctransfertypes.PortID,
+			DelegationAccount: &types.ICAAccount{
+				Address:      genHostAddress(r, "cosmos"),
+				Balance:      sdk.NewCoin(hostDenom, sdk.ZeroInt()),
+				Owner:        types.DefaultDelegateAccountPortOwner(chainID),
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+			},
+			RewardsAccount: &types.ICAAccount{
+				Address:      genHostAddress(r, "cosmos"),
+				Balance:      sdk.NewCoin(hostDenom, sdk.ZeroInt()),
+				Owner:        types.DefaultRewardsAccountPortOwner(chainID),
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+			},
+			Validators:      genHostChainValidators(r),
+			MinimumDeposit:  sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 10000))),
+			CValue:          sdk.OneDec(),
+			LastCValue:      sdk.OneDec(),
+			UnbondingFactor: int64(simtypes.RandIntBetween(r, 1, 5)),
+			Active:          true,
+		}
+	}
+
+	return hcs
+}
+
+// genHostChainValidators returns bonded validators whose weights add up to one.
+func genHostChainValidators(r *rand.Rand) []*types.Validator {
+	validators := make([]*types.Validator, simtypes.RandIntBetween(r, 1, maxHostChainValidators+1))
+
+	remaining := sdk.OneDec()
+	for i := range validators {
+		weight := remaining
+		if i < len(validators)-1 {
+			weight = simtypes.RandomDecAmount(r, remaining)
+		}
+		remaining = remaining.Sub(weight)
+
+		totalAmount := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1000000, 1000000000)))
+		validators[i] = &types.Validator{
+			OperatorAddress: genHostAddress(r, "cosmosvaloper"),
+			Status:          stakingtypes.BondStatusBonded,
+			Weight:          weight,
+			DelegatedAmount: sdk.ZeroInt(),
+			TotalAmount:     totalAmount,
+			DelegatorShares: sdk.NewDecFromInt(totalAmount),
+			CommissionRate:  simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(2, 1)),
+		}
+	}
+
+	return validators
+}
+
+// genHostAddress returns a random host chain address with the bech32 prefix.
+func genHostAddress(r *rand.Rand, prefix string) string {
+	address, err := bech32.ConvertAndEncode(prefix, simtypes.RandomAccounts(r, 1)[0].Address)
+	if err != nil {
+		panic(err)
+	}
+	return address
+}
+
+// RandomizedGenState generates a random GenesisState for liquidstakeibc.
+func RandomizedGenState(simState *module.SimulationState) {
+	genesis := types.DefaultGenesisState()
+
+	// the admin signs the host chain updates, so it needs to be a simulation account
+	genesis.Params.AdminAddress = simState.Accounts[0].Address.String()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, feeAddress, &genesis.Params.FeeAddress, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.FeeAddress = genFeeAddress(r, simState.Accounts) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ledgerEnabled, &genesis.Params.LedgerEnabled, simState.Rand,
+		func(r *rand.Rand) { genesis.Params.LedgerEnabled = genLedgerEnabled(r) },
+	)
+
+	genesis.HostChains = genHostChains(simState.Rand)
+
+	bz, _ := json.MarshalIndent(&genesis.Params, "", " ")
+	fmt.Printf("Selected randomly generated liquidstakeibc parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,433 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	icqtypes "github.com/persistenceOne/persistence-sdk/v2/x/interchainquery/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// OpTypeHostChainProgress is the operation type of the simulated host chain
+const OpTypeHostChainProgress = "host_chain_progress"
+
+// simICAChannel is the channel the simulated host chains acknowledge the interchain account transactions on
+const simICAChannel = "channel-sim-ica"
+
+// SimulateHostChain plays the host chain side of the simulated host chains, which have no ibc connection. The
+// simulated chains can't send packets, so the operation records what the workflows send as they would have after
+// a successful send, and then drives them with the acknowledgements, transfers and query responses a host chain
+// would return through the keeper entry points.
+func SimulateHostChain(bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		h := &simulatedHost{k: k, bk: bk, sequence: uint64(ctx.BlockHeight()) << 20}
+
+		delegated, unbonded := 0, 0
+		for _, hc := range k.GetAllHostChains(ctx) {
+			if !hc.Active || hc.IsHalted() || hc.IsWindingDown() {
+				continue
+			}
+
+			// the tokens the host chain validators gained or lost from the module delegations
+			tokens := make(map[string]sdk.Int)
+
+			n, err := h.delegateDeposits(ctx, hc.ChainId, tokens)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, OpTypeHostChainProgress, err.Error()), nil, err
+			}
+			delegated += n
+
+			n, err = h.undelegateUnbondings(ctx, hc.ChainId, tokens)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, OpTypeHostChainProgress, err.Error()), nil, err
+			}
+			unbonded += n
+
+			if err = h.transferMaturedUnbondings(ctx, hc.ChainId); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, OpTypeHostChainProgress, err.Error()), nil, err
+			}
+
+			if err = h.answerQueries(ctx, hc.ChainId, tokens); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, OpTypeHostChainProgress, err.Error()), nil, err
+			}
+		}
+
+		comment := fmt.Sprintf("delegated %d deposits, undelegated %d unbondings", delegated, unbonded)
+		return simtypes.NewOperationMsgBasic(types.ModuleName, OpTypeHostChainProgress, comment, true, nil), nil, nil
+	}
+}
+
+// simulatedHost relays the packets between the module and the simulated host chains.
+type simulatedHost struct {
+	k        keeper.Keeper
+	bk       types.BankKeeper
+	sequence uint64
+}
+
+// nextSequence returns a packet sequence that no tracked interchain account transaction uses.
+func (h *simulatedHost) nextSequence(ctx sdk.Context, channel string) uint64 {
+	for {
+		h.sequence++
+		if _, found := h.k.GetICATx(ctx, h.k.GetTransactionSequenceID(channel, h.sequence)); !found {
+			return h.sequence
+		}
+	}
+}
+
+// delegateDeposits transfers the pending deposits of the past delegation epochs to the host chain and delegates
+// the received ones, acknowledging the transfers and the delegations.
+func (h *simulatedHost) delegateDeposits(ctx sdk.Context, chainID string, tokens map[string]sdk.Int) (int, error) {
+	epoch := h.k.GetEpochNumber(ctx, types.DelegationEpoch)
+
+	for _, deposit := range h.k.GetPendingDepositsBeforeEpoch(ctx, epoch) {
+		if deposit.ChainId != chainID || deposit.Epoch.Int64() >= epoch || !deposit.Amount.IsPositive() {
+			continue
+		}
+
+		hc, _ := h.k.GetHostChain(ctx, chainID)
+		account := hc.RotateDelegationAccount(epoch)
+		depositAddress := authtypes.NewModuleAddress(types.DepositModuleAccount).String()
+
+		// the transfer module burns the vouchers going back to the host chain when sending them
+		coins := sdk.NewCoins(deposit.Amount)
+		err := h.bk.SendCoinsFromModuleToModule(ctx, types.DepositModuleAccount, ibctransfertypes.ModuleName, coins)
+		if err != nil {
+			return 0, err
+		}
+		if err = h.bk.BurnCoins(ctx, ibctransfertypes.ModuleName, coins); err != nil {
+			return 0, err
+		}
+
+		sequence := h.nextSequence(ctx, hc.ChannelId)
+		deposit.State = types.Deposit_DEPOSIT_SENT
+		deposit.DelegationAccountOwner = account.Owner
+		deposit.IbcSequenceId = h.k.GetTransactionSequenceID(hc.ChannelId, sequence)
+		h.k.SetDeposit(ctx, deposit)
+
+		data := ibctransfertypes.NewFungibleTokenPacketData(
+			hc.HostDenom, deposit.Amount.Amount.String(), depositAddress, account.Address, "",
+		)
+		packet := channeltypes.Packet{
+			Sequence:      sequence,
+			SourcePort:    hc.PortId,
+			SourceChannel: hc.ChannelId,
+			Data:          data.GetBytes(),
+		}
+		ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		if err = h.k.OnAcknowledgementIBCTransferPacket(ctx, packet, ack.Acknowledgement(), nil, nil); err != nil {
+			return 0, err
+		}
+	}
+
+	hc, _ := h.k.GetHostChain(ctx, chainID)
+	deposits := h.k.GetDelegableDepositsForChain(ctx, chainID)
+
+	delegated := 0
+	for _, account := range hc.GetActiveDelegationAccounts() {
+		accountDeposits := make([]*types.Deposit, 0)
+		amount := sdk.ZeroInt()
+		for _, deposit := range deposits {
+			owner, found := hc.GetDelegationAccountByOwner(deposit.DelegationAccountOwner)
+			if found && owner.Owner == account.Owner {
+				accountDeposits = append(accountDeposits, deposit)
+				amount = amount.Add(deposit.Amount.Amount)
+			}
+		}
+		if len(accountDeposits) == 0 {
+			continue
+		}
+
+		messages, err := h.k.GenerateDelegateMessages(hc.DelegationAccountView(account), amount)
+		if err != nil {
+			continue
+		}
+
+		sequenceID, sequence, err := h.recordICATx(ctx, hc, account.Owner, messages)
+		if err != nil {
+			return delegated, err
+		}
+		for _, deposit := range accountDeposits {
+			deposit.IbcSequenceId = sequenceID
+			deposit.State = types.Deposit_DEPOSIT_DELEGATING
+			h.k.SetDeposit(ctx, deposit)
+		}
+
+		responses := make([]proto.Message, 0, len(messages))
+		for _, message := range messages {
+			msg := message.(*stakingtypes.MsgDelegate)
+			addTokens(tokens, msg.ValidatorAddress, msg.Amount.Amount)
+			responses = append(responses, &stakingtypes.MsgDelegateResponse{})
+		}
+		if err = h.acknowledgeICATx(ctx, sequence, messages, responses); err != nil {
+			return delegated, err
+		}
+		delegated += len(accountDeposits)
+	}
+
+	return delegated, nil
+}
+
+// undelegateUnbondings undelegates the pending unbondings of the past undelegation epochs and acknowledges them
+// with an immediate completion time, so they mature right away.
+func (h *simulatedHost) undelegateUnbondings(ctx sdk.Context, chainID string, tokens map[string]sdk.Int) (int, error) {
+	epoch := h.k.GetEpochNumber(ctx, types.UndelegationEpoch)
+
+	unbondings := h.k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.ChainId == chainID && u.State == types.Unbonding_UNBONDING_PENDING && u.EpochNumber < epoch &&
+				u.UnbondAmount.IsPositive()
+		},
+	)
+
+	unbonded := 0
+	for _, unbonding := range unbondings {
+		hc, _ := h.k.GetHostChain(ctx, chainID)
+
+		// like the undelegation workflow, leave the unbonding pending if no account can undelegate it
+		account, found := h.k.GetUndelegationAccount(hc, unbonding.EpochNumber, unbonding.UnbondAmount.Amount)
+		if !found {
+			continue
+		}
+		messages, err := h.k.GenerateUndelegateMessages(hc.DelegationAccountView(account), unbonding.UnbondAmount.Amount)
+		if err != nil {
+			continue
+		}
+
+		sequenceID, sequence, err := h.recordICATx(ctx, hc, account.Owner, messages)
+		if err != nil {
+			return unbonded, err
+		}
+		unbonding.IbcSequenceId = sequenceID
+		unbonding.State = types.Unbonding_UNBONDING_INITIATED
+		unbonding.DelegationAccountOwner = account.Owner
+		h.k.SetUnbonding(ctx, unbonding)
+
+		responses := make([]proto.Message, 0, len(messages))
+		for _, message := range messages {
+			msg := message.(*stakingtypes.MsgUndelegate)
+			addTokens(tokens, msg.ValidatorAddress, msg.Amount.Amount.Neg())
+			responses = append(responses, &stakingtypes.MsgUndelegateResponse{CompletionTime: ctx.BlockTime()})
+		}
+		if err = h.acknowledgeICATx(ctx, sequence, messages, responses); err != nil {
+			return unbonded, err
+		}
+		unbonded++
+	}
+
+	return unbonded, nil
+}
+
+// transferMaturedUnbondings sends the matured unbondings back from the delegation accounts, acknowledging the
+// interchain account transfer and delivering it to the undelegation module account.
+func (h *simulatedHost) transferMaturedUnbondings(ctx sdk.Context, chainID string) error {
+	unbondings := h.k.FilterUnbondings(
+		ctx,
+		func(u types.Unbonding) bool {
+			return u.ChainId == chainID && u.State == types.Unbonding_UNBONDING_MATURING &&
+				ctx.BlockTime().After(u.MatureTime)
+		},
+	)
+
+	undelegationAddress := authtypes.NewModuleAddress(types.UndelegationModuleAccount)
+	for _, unbonding := range unbondings {
+		hc, _ := h.k.GetHostChain(ctx, chainID)
+		account, found := hc.GetDelegationAccountByOwner(unbonding.DelegationAccountOwner)
+		if !found {
+			continue
+		}
+
+		msg := ibctransfertypes.NewMsgTransfer(
+			hc.PortId,
+			hc.ChannelId,
+			unbonding.UnbondAmount,
+			account.Address,
+			undelegationAddress.String(),
+			clienttypes.NewHeight(
+				clienttypes.GetSelfHeight(ctx).GetRevisionNumber(),
+				clienttypes.GetSelfHeight(ctx).GetRevisionHeight()+types.IBCTimeoutHeightIncrement,
+			),
+			0,
+			"",
+		)
+		messages := []proto.Message{msg}
+
+		sequenceID, sequence, err := h.recordICATx(ctx, hc, account.Owner, messages)
+		if err != nil {
+			return err
+		}
+		unbonding.IbcSequenceId = sequenceID
+		unbonding.State = types.Unbonding_UNBONDING_MATURED
+		h.k.SetUnbonding(ctx, unbonding)
+
+		transferSequence := h.nextSequence(ctx, hc.ChannelId)
+		responses := []proto.Message{&ibctransfertypes.MsgTransferResponse{Sequence: transferSequence}}
+		if err = h.acknowledgeICATx(ctx, sequence, messages, responses); err != nil {
+			return err
+		}
+
+		// the transfer module mints the vouchers of the received tokens
+		coins := sdk.NewCoins(sdk.NewCoin(hc.IBCDenom(), unbonding.UnbondAmount.Amount))
+		if err = h.bk.MintCoins(ctx, ibctransfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+		err = h.bk.SendCoinsFromModuleToAccount(ctx, ibctransfertypes.ModuleName, undelegationAddress, coins)
+		if err != nil {
+			return err
+		}
+
+		data := ibctransfertypes.NewFungibleTokenPacketData(
+			hc.HostDenom, unbonding.UnbondAmount.Amount.String(), account.Address, undelegationAddress.String(), "",
+		)
+		packet := channeltypes.Packet{
+			Sequence:           transferSequence,
+			DestinationPort:    hc.PortId,
+			DestinationChannel: hc.ChannelId,
+			Data:               data.GetBytes(),
+		}
+		ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		if err = h.k.OnRecvIBCTransferPacket(ctx, packet, nil, ack); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordICATx tracks an interchain account transaction as if it had been sent, returning its sequence.
+func (h *simulatedHost) recordICATx(
+	ctx sdk.Context,
+	hc *types.HostChain,
+	owner string,
+	messages []proto.Message,
+) (string, uint64, error) {
+	sequence := h.nextSequence(ctx, simICAChannel)
+	sequenceID := h.k.GetTransactionSequenceID(simICAChannel, sequence)
+	if err := h.k.RecordICATx(ctx, hc.ChainId, hc.ConnectionId, owner, sequenceID, messages); err != nil {
+		return "", 0, err
+	}
+
+	return sequenceID, sequence, nil
+}
+
+// acknowledgeICATx runs the acknowledgement the host chain returns after executing an interchain account
+// transaction with the given message responses.
+func (h *simulatedHost) acknowledgeICATx(
+	ctx sdk.Context,
+	sequence uint64,
+	messages []proto.Message,
+	responses []proto.Message,
+) error {
+	tx := icatypes.CosmosTx{Messages: make([]*codectypes.Any, 0, len(messages))}
+	for _, message := range messages {
+		anyMsg, err := codectypes.NewAnyWithValue(message)
+		if err != nil {
+			return err
+		}
+		tx.Messages = append(tx.Messages, anyMsg)
+	}
+	txData, err := tx.Marshal()
+	if err != nil {
+		return err
+	}
+
+	txMsgData := sdk.TxMsgData{MsgResponses: make([]*codectypes.Any, 0, len(responses))}
+	for _, response := range responses {
+		anyResponse, err := codectypes.NewAnyWithValue(response)
+		if err != nil {
+			return err
+		}
+		txMsgData.MsgResponses = append(txMsgData.MsgResponses, anyResponse)
+	}
+	result, err := txMsgData.Marshal()
+	if err != nil {
+		return err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: txData}
+	packet := channeltypes.Packet{
+		Sequence:      sequence,
+		SourceChannel: simICAChannel,
+		Data:          packetData.GetBytes(),
+	}
+	ack := channeltypes.NewResultAcknowledgement(result)
+
+	return h.k.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil)
+}
+
+// answerQueries runs the validator callbacks with the tokens the host chain validators hold after the module
+// delegations, and the delegation callbacks with the delegations the host chain holds, which always match the
+// tracked ones as the simulated validators are never slashed. The simulated validators issue one share per token.
+func (h *simulatedHost) answerQueries(ctx sdk.Context, chainID string, tokens map[string]sdk.Int) error {
+	callbacks := h.k.CallbackHandler().RegisterCallbacks()
+	query := icqtypes.Query{ChainId: chainID}
+
+	hc, _ := h.k.GetHostChain(ctx, chainID)
+	for _, validator := range hc.Validators {
+		amount, found := tokens[validator.OperatorAddress]
+		if !found {
+			continue
+		}
+
+		hostValidator := stakingtypes.Validator{
+			OperatorAddress: validator.OperatorAddress,
+			Status:          stakingtypes.Bonded,
+			Tokens:          validator.TotalAmount.Add(amount),
+			DelegatorShares: validator.DelegatorShares.Add(sdk.NewDecFromInt(amount)),
+			Commission:      stakingtypes.NewCommission(validator.CommissionRate, sdk.OneDec(), sdk.ZeroDec()),
+			Jailed:          validator.Jailed,
+		}
+		data, err := hostValidator.Marshal()
+		if err != nil {
+			return err
+		}
+
+		if err = callbacks.Call(ctx, keeper.Validator, data, query); err != nil {
+			return err
+		}
+	}
+
+	hc, _ = h.k.GetHostChain(ctx, chainID)
+	for _, account := range hc.GetActiveDelegationAccounts() {
+		for _, validator := range hc.Validators {
+			delegation := stakingtypes.Delegation{
+				DelegatorAddress: account.Address,
+				ValidatorAddress: validator.OperatorAddress,
+				Shares:           sdk.NewDecFromInt(hc.GetAccountDelegatedAmount(account, validator.OperatorAddress)),
+			}
+			data, err := delegation.Marshal()
+			if err != nil {
+				return err
+			}
+
+			if err = callbacks.Call(ctx, keeper.Delegation, data, query); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// addTokens adds an amount to the tokens gained by a validator.
+func addTokens(tokens map[string]sdk.Int, validator string, amount sdk.Int) {
+	if current, found := tokens[validator]; found {
+		amount = current.Add(amount)
+	}
+	tokens[validator] = amount
+}
@@ -0,0 +1,127 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	testhelpers "github.com/persistenceOne/pstake-native/v2/app/helpers"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/simulation"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestSimulateHostChain(t *testing.T) {
+	app := testhelpers.Setup(t, false, 5)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.LiquidStakeIBCKeeper
+
+	hc := &types.HostChain{
+		ChainId:      "simhost-0",
+		ConnectionId: "connection-0",
+		Params: &types.HostChainLSParams{
+			DepositFee:    sdk.ZeroDec(),
+			RestakeFee:    sdk.ZeroDec(),
+			UnstakeFee:    sdk.ZeroDec(),
+			RedemptionFee: sdk.ZeroDec(),
+		},
+		HostDenom: "usim0",
+		ChannelId: "channel-0",
+		PortId:    ibctransfertypes.PortID,
+		DelegationAccount: &types.ICAAccount{
+			Address:      "cosmos1sy63lffevueudvvlvh2lf6s387xh9xq72n3fsy6n2gr5hm6u2szs2v0ujm",
+			Balance:      sdk.NewCoin("usim0", sdk.ZeroInt()),
+			Owner:        types.DefaultDelegateAccountPortOwner("simhost-0"),
+			ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+		},
+		RewardsAccount: &types.ICAAccount{
+			Address:      "cosmos1fm7mgfvz5nvyy2y2mj8xmlf9mhzlvr62aqs7y3fkkeyr6mlcfcqsjdw0ha",
+			Balance:      sdk.NewCoin("usim0", sdk.ZeroInt()),
+			Owner:        types.DefaultRewardsAccountPortOwner("simhost-0"),
+			ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+		},
+		Validators: []*types.Validator{
+			{
+				OperatorAddress: "cosmosvaloper1hcqg5wj9t42zawqkqucs7la85ffyv08le09ljt",
+				Status:          stakingtypes.BondStatusBonded,
+				Weight:          sdk.OneDec(),
+				DelegatedAmount: sdk.ZeroInt(),
+				TotalAmount:     sdk.NewInt(1000),
+				DelegatorShares: sdk.NewDec(1000),
+			},
+		},
+		MinimumDeposit:  sdk.OneInt(),
+		CValue:          sdk.OneDec(),
+		LastCValue:      sdk.OneDec(),
+		UnbondingFactor: 1,
+		Active:          true,
+	}
+	k.SetHostChain(ctx, hc)
+
+	// a deposit and an unbonding the workflows of the last epoch couldn't send to the host chain
+	epoch := k.GetEpochNumber(ctx, types.DelegationEpoch) - 1
+	depositAmount := sdk.NewCoin(hc.IBCDenom(), sdk.NewInt(1000))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.DepositModuleAccount, sdk.NewCoins(depositAmount)))
+	k.SetDeposit(ctx, &types.Deposit{
+		ChainId: hc.ChainId,
+		Amount:  depositAmount,
+		Epoch:   sdk.NewInt(epoch),
+		State:   types.Deposit_DEPOSIT_PENDING,
+	})
+
+	burnAmount := sdk.NewCoin(hc.MintDenom(), sdk.NewInt(300))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, types.UndelegationModuleAccount, sdk.NewCoins(burnAmount)))
+	k.SetUnbonding(ctx, &types.Unbonding{
+		ChainId:      hc.ChainId,
+		EpochNumber:  epoch,
+		BurnAmount:   burnAmount,
+		UnbondAmount: sdk.NewCoin(hc.HostDenom, sdk.NewInt(300)),
+		State:        types.Unbonding_UNBONDING_PENDING,
+	})
+
+	op := simulation.SimulateHostChain(app.BankKeeper, k)
+	operationMsg, futureOperations, err := op(rand.New(rand.NewSource(1)), app.BaseApp, ctx, nil, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+	require.Equal(t, simulation.OpTypeHostChainProgress, operationMsg.Name)
+	require.Len(t, futureOperations, 0)
+
+	// the deposit was transferred and delegated, and the unbonding undelegated, through the acknowledgements
+	hc, found := k.GetHostChain(ctx, hc.ChainId)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(700), hc.Validators[0].DelegatedAmount)
+	require.Equal(t, sdk.NewInt(1700), hc.Validators[0].TotalAmount)
+	require.True(t, hc.DelegationAccount.Balance.IsZero())
+	require.Len(t, k.GetAllDeposits(ctx), 0)
+	depositAddress := authtypes.NewModuleAddress(types.DepositModuleAccount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, depositAddress, hc.IBCDenom()).IsZero())
+	icaTxs := k.FilterICATxs(ctx, func(t types.ICATx) bool { return true })
+	require.Len(t, icaTxs, 2)
+	for _, icaTx := range icaTxs {
+		require.Equal(t, types.ICATx_ICA_TX_SUCCEEDED, icaTx.Status)
+	}
+
+	unbonding, found := k.GetUnbonding(ctx, hc.ChainId, epoch)
+	require.True(t, found)
+	require.Equal(t, types.Unbonding_UNBONDING_MATURING, unbonding.State)
+	undelegationAddress := authtypes.NewModuleAddress(types.UndelegationModuleAccount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, undelegationAddress, hc.MintDenom()).IsZero())
+
+	// once mature, the unbonded tokens are transferred back to the undelegation account
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	operationMsg, _, err = op(rand.New(rand.NewSource(1)), app.BaseApp, ctx, nil, "")
+	require.NoError(t, err)
+	require.True(t, operationMsg.OK)
+
+	unbonding, found = k.GetUnbonding(ctx, hc.ChainId, epoch)
+	require.True(t, found)
+	require.Equal(t, types.Unbonding_UNBONDING_CLAIMABLE, unbonding.State)
+	require.Equal(t, sdk.NewInt(300), app.BankKeeper.GetBalance(ctx, undelegationAddress, hc.IBCDenom()).Amount)
+}
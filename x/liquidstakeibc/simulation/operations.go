@@ -0,0 +1,312 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	modtestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	appparams "github.com/persistenceOne/pstake-native/v2/app/params"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+// Simulation operation weights constants.
+//
+//nolint:gosec
+const (
+	OpWeightMsgLiquidStake     = "op_weight_msg_liquidstakeibc_liquid_stake"
+	OpWeightMsgLiquidUnstake   = "op_weight_msg_liquidstakeibc_liquid_unstake"
+	OpWeightMsgRedeem          = "op_weight_msg_liquidstakeibc_redeem"
+	OpWeightMsgUpdateHostChain = "op_weight_msg_liquidstakeibc_update_host_chain"
+	OpWeightHostChainProgress  = "op_weight_liquidstakeibc_host_chain_progress"
+)
+
+var (
+	Gas  = uint64(20000000)
+	Fees = sdk.Coins{
+		{
+			Denom:  "stake",
+			Amount: sdk.NewInt(0),
+		},
+	}
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgLiquidStake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgLiquidStake, &weightMsgLiquidStake, nil,
+		func(_ *rand.Rand) {
+			weightMsgLiquidStake = appparams.DefaultWeightMsgLiquidStake
+		},
+	)
+
+	var weightMsgLiquidUnstake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgLiquidUnstake, &weightMsgLiquidUnstake, nil,
+		func(_ *rand.Rand) {
+			weightMsgLiquidUnstake = appparams.DefaultWeightMsgLiquidUnstake
+		},
+	)
+
+	var weightMsgRedeem int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRedeem, &weightMsgRedeem, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedeem = appparams.DefaultWeightMsgRedeem
+		},
+	)
+
+	var weightMsgUpdateHostChain int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateHostChain, &weightMsgUpdateHostChain, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateHostChain = appparams.DefaultWeightMsgUpdateHostChain
+		},
+	)
+
+	var weightHostChainProgress int
+	appParams.GetOrGenerate(cdc, OpWeightHostChainProgress, &weightHostChainProgress, nil,
+		func(_ *rand.Rand) {
+			weightHostChainProgress = appparams.DefaultWeightHostChainProgress
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgLiquidStake,
+			SimulateMsgLiquidStake(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgLiquidUnstake,
+			SimulateMsgLiquidUnstake(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedeem,
+			SimulateMsgRedeem(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateHostChain,
+			SimulateMsgUpdateHostChain(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightHostChainProgress,
+			SimulateHostChain(bk, k),
+		),
+	}
+}
+
+// SimulateMsgLiquidStake generates a MsgLiquidStake with random values
+func SimulateMsgLiquidStake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		hc, found := randomHostChain(r, ctx, k, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidStake, "no host chain accepts deposits"), nil, nil
+		}
+
+		// the deposit for the epoch is only created once the delegation epoch starts
+		epoch := k.GetEpochNumber(ctx, types.DelegationEpoch)
+		if _, found = k.GetDepositForChainAndEpoch(ctx, hc.ChainId, epoch); !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidStake, "no deposit for the current epoch"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		delegator := account.GetAddress()
+
+		stakingAmt := hc.MinimumDeposit.Add(sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000_000))))
+		stakingCoin := sdk.NewCoin(hc.IBCDenom(), stakingAmt)
+
+		// the host chain tokens would be transferred over ibc, mint them instead
+		spendable := bk.SpendableCoins(ctx, delegator)
+		if spendable.AmountOf(hc.IBCDenom()).LT(stakingAmt) {
+			if err := bk.MintCoins(ctx, types.ModuleName, sdk.NewCoins(stakingCoin)); err != nil {
+				panic(err)
+			}
+			if err := bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegator, sdk.NewCoins(stakingCoin)); err != nil {
+				panic(err)
+			}
+			spendable = bk.SpendableCoins(ctx, delegator)
+		}
+
+		msg := types.NewMsgLiquidStake(stakingCoin, delegator)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, spendable)
+	}
+}
+
+// SimulateMsgLiquidUnstake generates a MsgLiquidUnstake with random values
+func SimulateMsgLiquidUnstake(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		hc, found := randomHostChain(r, ctx, k, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "no host chain accepts unstakes"), nil, nil
+		}
+
+		simAccount, spendable, found := randomAccountWithBalance(r, ctx, bk, accs, hc.MintDenom())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "insufficient funds"), nil, nil
+		}
+
+		unstakeAmt := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, int(spendable.AmountOf(hc.MintDenom()).Int64())+1)))
+		unstakeCoin := sdk.NewCoin(hc.MintDenom(), unstakeAmt)
+
+		// the epoch unbonding can't be larger than what is delegated on the host chain
+		fee := hc.Params.UnstakeFee.MulInt(unstakeAmt).TruncateInt()
+		unbondAmount := sdk.NewDecFromInt(unstakeAmt.Sub(fee)).Quo(hc.CValue).TruncateInt()
+		if hc.GetHostChainTotalDelegations().LT(unbondAmount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeLiquidUnstake, "not enough delegations"), nil, nil
+		}
+
+		msg := types.NewMsgLiquidUnstake(unstakeCoin, simAccount.Address)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, spendable)
+	}
+}
+
+// SimulateMsgRedeem generates a MsgRedeem with random values
+func SimulateMsgRedeem(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		hc, found := randomHostChain(r, ctx, k, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_REDEMPTIONS_PAUSED)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "no host chain accepts redemptions"), nil, nil
+		}
+
+		simAccount, spendable, found := randomAccountWithBalance(r, ctx, bk, accs, hc.MintDenom())
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "insufficient funds"), nil, nil
+		}
+
+		redeemAmt := sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, int(spendable.AmountOf(hc.MintDenom()).Int64())+1)))
+		redeemCoin := sdk.NewCoin(hc.MintDenom(), redeemAmt)
+
+		// only redeem from the deposits, the liquidity pool is not simulated
+		fee := hc.Params.RedemptionFee.MulInt(redeemAmt).TruncateInt()
+		redeemToken := sdk.NewDecFromInt(redeemAmt.Sub(fee)).Mul(hc.CValue).TruncateInt()
+		depositBalance := bk.GetBalance(ctx, authtypes.NewModuleAddress(types.DepositModuleAccount), hc.IBCDenom())
+		if redeemToken.GTE(depositBalance.Amount) {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeRedeem, "not enough deposits to redeem from"), nil, nil
+		}
+
+		msg := types.NewMsgRedeem(redeemCoin, simAccount.Address)
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, spendable)
+	}
+}
+
+// SimulateMsgUpdateHostChain generates a MsgUpdateHostChain signed by the module admin with random fees and
+// minimum deposit
+func SimulateMsgUpdateHostChain(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation { //nolint: interfacer
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, err := sdk.AccAddressFromBech32(k.GetParams(ctx).AdminAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeUpdateHostChain, "invalid admin address"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, admin)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeUpdateHostChain, "admin is not a simulation account"), nil, nil
+		}
+
+		hc, found := randomHostChain(r, ctx, k, types.HostChainCircuitBreaker_CIRCUIT_BREAKER_HALTED)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgTypeUpdateHostChain, "no active host chain"), nil, nil
+		}
+
+		var update *types.KVUpdate
+		switch r.Intn(5) {
+		case 0:
+			update = &types.KVUpdate{Key: keeper.KeyDepositFee, Value: simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)).String()}
+		case 1:
+			update = &types.KVUpdate{Key: keeper.KeyRestakeFee, Value: simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(5, 2)).String()}
+		case 2:
+			update = &types.KVUpdate{Key: keeper.KeyUnstakeFee, Value: simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)).String()}
+		case 3:
+			update = &types.KVUpdate{Key: keeper.KeyRedemptionFee, Value: simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 2)).String()}
+		default:
+			update = &types.KVUpdate{Key: keeper.KeyMinimumDeposit, Value: sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 10000))).String()}
+		}
+
+		msg := types.NewMsgUpdateHostChain(hc.ChainId, admin.String(), []*types.KVUpdate{update})
+		return deliverTx(r, app, ctx, ak, bk, simAccount, msg, bk.SpendableCoins(ctx, admin))
+	}
+}
+
+// randomHostChain returns a random host chain that is active and not paused by its circuit breaker at the state
+func randomHostChain(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	state types.HostChainCircuitBreaker_State,
+) (*types.HostChain, bool) {
+	hcs := make([]*types.HostChain, 0)
+	for _, hc := range k.GetAllHostChains(ctx) {
+		if hc.Active && !hc.IsWindingDown() && keeper.CheckCircuitBreaker(hc, state) == nil {
+			hcs = append(hcs, hc)
+		}
+	}
+
+	if len(hcs) == 0 {
+		return nil, false
+	}
+
+	return hcs[r.Intn(len(hcs))], true
+}
+
+// randomAccountWithBalance returns a random simulation account with a positive balance of the denom
+func randomAccountWithBalance(
+	r *rand.Rand,
+	ctx sdk.Context,
+	bk types.BankKeeper,
+	accs []simtypes.Account,
+	denom string,
+) (simtypes.Account, sdk.Coins, bool) {
+	for _, i := range r.Perm(len(accs)) {
+		spendable := bk.SpendableCoins(ctx, accs[i].Address)
+		if spendable.AmountOf(denom).IsPositive() {
+			return accs[i], spendable, true
+		}
+	}
+
+	return simtypes.Account{}, nil, false
+}
+
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+	spendable sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           modtestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         sdk.MsgTypeURL(msg),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: spendable,
+	}
+	return simulation.GenAndDeliverTx(txCtx, Fees)
+}
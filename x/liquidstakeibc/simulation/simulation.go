@@ -1,18 +0,0 @@
-package simulation
-
-import (
-	"github.com/cosmos/cosmos-sdk/codec"
-	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	"github.com/cosmos/cosmos-sdk/x/simulation"
-
-	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
-)
-
-// WeightedOperations returns all the operations from the module with their respective weights
-func WeightedOperations(
-	appParams simtypes.AppParams,
-	cdc codec.JSONCodec,
-	ak types.AccountKeeper,
-) simulation.WeightedOperations {
-	return simulation.WeightedOperations{}
-}
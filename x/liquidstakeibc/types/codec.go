@@ -13,9 +13,15 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterHostChain{}, "pstake/MsgRegisterHostChain", nil)
 	cdc.RegisterConcrete(&MsgUpdateHostChain{}, "pstake/MsgUpdateHostChain", nil)
+	cdc.RegisterConcrete(&MsgDeregisterHostChain{}, "pstake/MsgDeregisterHostChain", nil)
+	cdc.RegisterConcrete(&MsgResetCircuitBreaker{}, "pstake/MsgResetCircuitBreaker", nil)
 	cdc.RegisterConcrete(&MsgLiquidStake{}, "pstake/MsgLiquidStake", nil)
+	cdc.RegisterConcrete(&MsgLiquidStakeLSM{}, "pstake/MsgLiquidStakeLSM", nil)
 	cdc.RegisterConcrete(&MsgLiquidUnstake{}, "pstake/MsgLiquidUnstake", nil)
 	cdc.RegisterConcrete(&MsgRedeem{}, "pstake/MsgRedeem", nil)
+	cdc.RegisterConcrete(&MsgDepositLiquidity{}, "pstake/MsgDepositLiquidity", nil)
+	cdc.RegisterConcrete(&MsgWithdrawLiquidity{}, "pstake/MsgWithdrawLiquidity", nil)
+	cdc.RegisterConcrete(&MsgVoteWeighted{}, "pstake/MsgVoteWeighted", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "pstake/MsgUpdateParams", nil)
 }
 
@@ -23,9 +29,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRegisterHostChain{},
 		&MsgUpdateHostChain{},
+		&MsgDeregisterHostChain{},
+		&MsgResetCircuitBreaker{},
 		&MsgLiquidStake{},
+		&MsgLiquidStakeLSM{},
 		&MsgLiquidUnstake{},
 		&MsgRedeem{},
+		&MsgDepositLiquidity{},
+		&MsgWithdrawLiquidity{},
+		&MsgVoteWeighted{},
 		&MsgUpdateParams{},
 	)
 
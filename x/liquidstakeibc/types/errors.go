@@ -22,4 +22,17 @@ var (
 	ErrBurnFailed           = errorsmod.Register(ModuleName, 2014, "burn failed")
 	ErrParsingAmount        = errorsmod.Register(ModuleName, 2015, "could not parse message amount")
 	ErrHostChainInactive    = errorsmod.Register(ModuleName, 2016, "host chain is not active")
+	ErrInvalidLSMDenom      = errorsmod.Register(ModuleName, 2017, "invalid lsm token denom")
+	ErrLSMDepositProcessing = errorsmod.Register(ModuleName, 2018, "lsm deposit is being processed")
+	ErrLSMValidatorInvalid  = errorsmod.Register(ModuleName, 2019, "lsm validator is not eligible for deposits")
+	ErrICATxTimeout         = errorsmod.Register(ModuleName, 2020, "ica tx timed out")
+	ErrNotEnoughLiquidity   = errorsmod.Register(ModuleName, 2021, "not enough liquidity in the pool")
+	ErrNotEnoughShares      = errorsmod.Register(ModuleName, 2022, "not enough liquidity pool shares")
+	ErrProposalNotFound     = errorsmod.Register(ModuleName, 2023, "host chain proposal not found")
+	ErrVotingClosed         = errorsmod.Register(ModuleName, 2024, "host chain proposal voting window is closed")
+	ErrNoVotingPower        = errorsmod.Register(ModuleName, 2025, "voter has no stk voting power")
+	ErrHostChainWindingDown = errorsmod.Register(ModuleName, 2026, "host chain is being deregistered")
+	ErrCircuitBreakerPaused = errorsmod.Register(ModuleName, 2027, "host chain operation paused by the circuit breaker")
+	ErrInvalidIBCMemo       = errorsmod.Register(ModuleName, 2028, "invalid ibc transfer memo")
+	ErrValidatorIneligible  = errorsmod.Register(ModuleName, 2029, "validator is not eligible for delegations")
 )
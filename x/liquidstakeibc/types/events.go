@@ -1,33 +1,56 @@
 package types
 
 const (
-	EventTypeLiquidStake   = "liquid-stake"
-	EventTypeLiquidUnstake = "liquid-unstake"
-	EventTypeRedeem        = "redeem"
-	EventTypePacket        = "ics27_packet"
-	EventTypeTimeout       = "timeout"
-	EventTypeSlashing      = "slashing"
-	EventTypeUpdateParams  = "update_params"
-	EventTypeChainDisabled = "chain_disabled"
+	EventTypeLiquidStake         = "liquid-stake"
+	EventTypeLiquidStakeLSM      = "liquid-stake-lsm"
+	EventTypeLiquidUnstake       = "liquid-unstake"
+	EventTypeRedeem              = "redeem"
+	EventTypeDepositLiquidity    = "deposit-liquidity"
+	EventTypeWithdrawLiquidity   = "withdraw-liquidity"
+	EventTypeVoteWeighted        = "vote-weighted"
+	EventTypeProposalVoted       = "proposal-voted"
+	EventTypePacket              = "ics27_packet"
+	EventTypeTimeout             = "timeout"
+	EventTypeSlashing            = "slashing"
+	EventTypeUpdateParams        = "update_params"
+	EventTypeChainDeregistered   = "chain_deregistered"
+	EventTypeChainWindDown       = "chain_wind_down"
+	EventTypeValidatorIneligible = "validator-ineligible"
+	EventTypeValidatorWeightKept = "validator-weight-kept"
+	EventTypeCircuitBreakerTrip  = "circuit-breaker-trip"
+	EventTypeCircuitBreakerReset = "circuit-breaker-reset"
+	EventTypeIBCMemoForward      = "ibc-memo-forward"
+	EventTypeIBCMemoRefund       = "ibc-memo-refund"
 
-	AttributeAmount             = "amount"
-	AttributeAmountReceived     = "received"
-	AttributeDelegatorAddress   = "address"
-	AttributePstakeDepositFee   = "pstake-deposit-fee"
-	AttributePstakeUnstakeFee   = "pstake-unstake-fee"
-	AttributePstakeRedeemFee    = "pstake-redeem-fee"
-	AttributeChainID            = "chain-id"
-	AttributeCValue             = "c-value"
-	AttributeUnstakeAmount      = "undelegation-amount"
-	AttributeUnstakeEpoch       = "undelegation-epoch"
-	AttributeValidatorAddress   = "validator-address"
-	AttributeExistingDelegation = "existing-delegation"
-	AttributeUpdatedDelegation  = "updated-delegation"
-	AttributeSlashedAmount      = "slashed-amount"
-	AttributeKeyAuthority       = "authority"
-	AttributeKeyUpdatedParams   = "updated_params"
-	AttributeKeyAck             = "acknowledgement"
-	AttributeKeyAckSuccess      = "success"
-	AttributeKeyAckError        = "error"
-	AttributeValueCategory      = ModuleName
+	AttributeAmount              = "amount"
+	AttributeAmountReceived      = "received"
+	AttributeDelegatorAddress    = "address"
+	AttributePstakeDepositFee    = "pstake-deposit-fee"
+	AttributePstakeUnstakeFee    = "pstake-unstake-fee"
+	AttributePstakeRedeemFee     = "pstake-redeem-fee"
+	AttributeChainID             = "chain-id"
+	AttributeCValue              = "c-value"
+	AttributeWindDownState       = "wind-down-state"
+	AttributeUnstakeAmount       = "undelegation-amount"
+	AttributeUnstakeEpoch        = "undelegation-epoch"
+	AttributeValidatorAddress    = "validator-address"
+	AttributeExistingDelegation  = "existing-delegation"
+	AttributeUpdatedDelegation   = "updated-delegation"
+	AttributeSlashedAmount       = "slashed-amount"
+	AttributeShares              = "shares"
+	AttributeProposalID          = "proposal-id"
+	AttributeVoteOptions         = "options"
+	AttributeVotingPower         = "voting-power"
+	AttributeIneligibilityReason = "reason"
+	AttributeCircuitBreakerState = "circuit-breaker-state"
+	AttributeTripReason          = "trip-reason"
+	AttributeReceiverAddress     = "receiver"
+	AttributeChannelID           = "channel-id"
+	AttributeRefundReason        = "refund-reason"
+	AttributeKeyAuthority        = "authority"
+	AttributeKeyUpdatedParams    = "updated_params"
+	AttributeKeyAck              = "acknowledgement"
+	AttributeKeyAckSuccess       = "success"
+	AttributeKeyAckError         = "error"
+	AttributeValueCategory       = ModuleName
 )
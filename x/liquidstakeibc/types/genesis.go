@@ -40,6 +40,112 @@ func (gs *GenesisState) Validate() error {
 			)
 		}
 	}
+	for _, deposit := range gs.LsmDeposits {
+		if err := deposit.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[deposit.ChainId]; !ok {
+			return fmt.Errorf("lsm deposit for chain %s doesnt have a valid chain id", deposit.ChainId)
+		}
+	}
+
+	for _, redelegation := range gs.Redelegations {
+		if err := redelegation.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[redelegation.ChainId]; !ok {
+			return fmt.Errorf("redelegation for chain %s doesnt have a valid chain id", redelegation.ChainId)
+		}
+	}
+
+	for _, record := range gs.RewardsRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[record.ChainId]; !ok {
+			return fmt.Errorf("rewards record for chain %s doesnt have a valid chain id", record.ChainId)
+		}
+	}
+
+	for _, slash := range gs.Slashes {
+		if err := slash.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[slash.ChainId]; !ok {
+			return fmt.Errorf("slash for chain %s doesnt have a valid chain id", slash.ChainId)
+		}
+	}
+
+	for _, icaTx := range gs.IcaTxs {
+		if err := icaTx.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[icaTx.ChainId]; !ok {
+			return fmt.Errorf("ica tx for chain %s doesnt have a valid chain id", icaTx.ChainId)
+		}
+	}
+	for _, pool := range gs.LiquidityPools {
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[pool.ChainId]; !ok {
+			return fmt.Errorf("liquidity pool for chain %s doesnt have a valid chain id", pool.ChainId)
+		}
+	}
+	for _, provider := range gs.LiquidityProviders {
+		if err := provider.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[provider.ChainId]; !ok {
+			return fmt.Errorf("liquidity provider for chain %s doesnt have a valid chain id", provider.ChainId)
+		}
+	}
+	for _, proposal := range gs.HostChainProposals {
+		if err := proposal.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[proposal.ChainId]; !ok {
+			return fmt.Errorf("host chain proposal for chain %s doesnt have a valid chain id", proposal.ChainId)
+		}
+	}
+	for _, vote := range gs.ProposalVotes {
+		if err := vote.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[vote.ChainId]; !ok {
+			return fmt.Errorf("proposal vote for chain %s doesnt have a valid chain id", vote.ChainId)
+		}
+	}
+	ledgerIDs := make(map[uint64]bool)
+	for _, entry := range gs.LedgerEntries {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[entry.ChainId]; !ok {
+			return fmt.Errorf("ledger entry %d for chain %s doesnt have a valid chain id", entry.Id, entry.ChainId)
+		}
+		if ledgerIDs[entry.Id] {
+			return fmt.Errorf("duplicated ledger entry id: %d", entry.Id)
+		}
+		ledgerIDs[entry.Id] = true
+	}
+	for _, record := range gs.ExchangeRateRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[record.ChainId]; !ok {
+			return fmt.Errorf("exchange rate record for chain %s doesnt have a valid chain id", record.ChainId)
+		}
+	}
+	// the reserves belong to host chains that have already been removed
+	for _, reserve := range gs.WindDownReserves {
+		if err := reserve.Validate(); err != nil {
+			return err
+		}
+		if _, ok := hostChainMap[reserve.ChainId]; ok {
+			return fmt.Errorf("wind down reserve for chain %s belongs to a registered host chain", reserve.ChainId)
+		}
+	}
 	for _, unbonding := range gs.Unbondings {
 		hc, ok := hostChainMap[unbonding.ChainId]
 		if !ok {
@@ -121,5 +227,16 @@ func DefaultGenesisState() *GenesisState {
 		Unbondings:          []*Unbonding{},
 		UserUnbondings:      []*UserUnbonding{},
 		ValidatorUnbondings: []*ValidatorUnbonding{},
+		LsmDeposits:         []*LSMDeposit{},
+		Redelegations:       []*Redelegation{},
+		RewardsRecords:      []*RewardsRecord{},
+		Slashes:             []*Slash{},
+		IcaTxs:              []*ICATx{},
+		LiquidityPools:      []*LiquidityPool{},
+		LiquidityProviders:  []*LiquidityProvider{},
+		HostChainProposals:  []*HostChainProposal{},
+		ProposalVotes:       []*ProposalVote{},
+		LedgerEntries:       []*LedgerEntry{},
+		ExchangeRateRecords: []*ExchangeRateRecord{},
 	}
 }
@@ -36,6 +36,30 @@ type GenesisState struct {
 	UserUnbondings []*UserUnbonding `protobuf:"bytes,5,rep,name=user_unbondings,json=userUnbondings,proto3" json:"user_unbondings,omitempty"`
 	// validator unbondings
 	ValidatorUnbondings []*ValidatorUnbonding `protobuf:"bytes,6,rep,name=validator_unbondings,json=validatorUnbondings,proto3" json:"validator_unbondings,omitempty"`
+	// initial lsm deposit list
+	LsmDeposits []*LSMDeposit `protobuf:"bytes,7,rep,name=lsm_deposits,json=lsmDeposits,proto3" json:"lsm_deposits,omitempty"`
+	// in-flight redelegations
+	Redelegations []*Redelegation `protobuf:"bytes,8,rep,name=redelegations,proto3" json:"redelegations,omitempty"`
+	// rewards records
+	RewardsRecords []*RewardsRecord `protobuf:"bytes,9,rep,name=rewards_records,json=rewardsRecords,proto3" json:"rewards_records,omitempty"`
+	// validator slashes
+	Slashes []*Slash `protobuf:"bytes,10,rep,name=slashes,proto3" json:"slashes,omitempty"`
+	// ica transactions
+	IcaTxs []*ICATx `protobuf:"bytes,11,rep,name=ica_txs,json=icaTxs,proto3" json:"ica_txs,omitempty"`
+	// instant redemption liquidity pools
+	LiquidityPools []*LiquidityPool `protobuf:"bytes,12,rep,name=liquidity_pools,json=liquidityPools,proto3" json:"liquidity_pools,omitempty"`
+	// liquidity pool providers
+	LiquidityProviders []*LiquidityProvider `protobuf:"bytes,13,rep,name=liquidity_providers,json=liquidityProviders,proto3" json:"liquidity_providers,omitempty"`
+	// tracked host chain governance proposals
+	HostChainProposals []*HostChainProposal `protobuf:"bytes,14,rep,name=host_chain_proposals,json=hostChainProposals,proto3" json:"host_chain_proposals,omitempty"`
+	// stk holder votes on the tracked proposals
+	ProposalVotes []*ProposalVote `protobuf:"bytes,15,rep,name=proposal_votes,json=proposalVotes,proto3" json:"proposal_votes,omitempty"`
+	// per address ledger of liquid staking operations
+	LedgerEntries []*LedgerEntry `protobuf:"bytes,16,rep,name=ledger_entries,json=ledgerEntries,proto3" json:"ledger_entries,omitempty"`
+	// c value history of the host chains
+	ExchangeRateRecords []*ExchangeRateRecord `protobuf:"bytes,17,rep,name=exchange_rate_records,json=exchangeRateRecords,proto3" json:"exchange_rate_records,omitempty"`
+	// host chain tokens reserved for the stk left by the removed host chains
+	WindDownReserves []*WindDownReserve `protobuf:"bytes,18,rep,name=wind_down_reserves,json=windDownReserves,proto3" json:"wind_down_reserves,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -113,6 +137,90 @@ func (m *GenesisState) GetValidatorUnbondings() []*ValidatorUnbonding {
 	return nil
 }
 
+func (m *GenesisState) GetLsmDeposits() []*LSMDeposit {
+	if m != nil {
+		return m.LsmDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegations() []*Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardsRecords() []*RewardsRecord {
+	if m != nil {
+		return m.RewardsRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashes() []*Slash {
+	if m != nil {
+		return m.Slashes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIcaTxs() []*ICATx {
+	if m != nil {
+		return m.IcaTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidityPools() []*LiquidityPool {
+	if m != nil {
+		return m.LiquidityPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidityProviders() []*LiquidityProvider {
+	if m != nil {
+		return m.LiquidityProviders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostChainProposals() []*HostChainProposal {
+	if m != nil {
+		return m.HostChainProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalVotes() []*ProposalVote {
+	if m != nil {
+		return m.ProposalVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLedgerEntries() []*LedgerEntry {
+	if m != nil {
+		return m.LedgerEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExchangeRateRecords() []*ExchangeRateRecord {
+	if m != nil {
+		return m.ExchangeRateRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWindDownReserves() []*WindDownReserve {
+	if m != nil {
+		return m.WindDownReserves
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "pstake.liquidstakeibc.v1beta1.GenesisState")
 }
@@ -122,31 +230,50 @@ func init() {
 }
 
 var fileDescriptor_1d650226665335af = []byte{
-	// 373 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x4b, 0xe3, 0x40,
-	0x18, 0xc7, 0x93, 0x6d, 0xb7, 0x2c, 0xd3, 0x65, 0x17, 0xb2, 0x3d, 0x84, 0xc2, 0xc6, 0x22, 0x28,
-	0xc5, 0x97, 0x0c, 0x8d, 0x9f, 0xc0, 0x56, 0xb0, 0x9e, 0x94, 0x48, 0x3d, 0xe8, 0xa1, 0x4c, 0x92,
-	0x21, 0x19, 0x6c, 0x67, 0x62, 0x9e, 0x49, 0xd0, 0x6f, 0xe1, 0xc7, 0xea, 0xb1, 0x47, 0x4f, 0x22,
-	0xed, 0xc9, 0x6f, 0x21, 0x9d, 0xb4, 0xf6, 0x45, 0x68, 0xbc, 0x3d, 0x33, 0xfc, 0x7f, 0xbf, 0xff,
-	0x73, 0x78, 0xd0, 0x61, 0x0c, 0x92, 0xdc, 0x53, 0x3c, 0x60, 0x0f, 0x29, 0x0b, 0xd4, 0xcc, 0x3c,
-	0x1f, 0x67, 0x2d, 0x8f, 0x4a, 0xd2, 0xc2, 0x21, 0xe5, 0x14, 0x18, 0xd8, 0x71, 0x22, 0xa4, 0x30,
-	0xfe, 0xe7, 0x61, 0x7b, 0x3d, 0x6c, 0xcf, 0xc3, 0xf5, 0x5a, 0x28, 0x42, 0xa1, 0x92, 0x78, 0x36,
-	0xe5, 0x50, 0xfd, 0x60, 0x7b, 0x43, 0x4c, 0x12, 0x32, 0x9c, 0x17, 0xd4, 0x9d, 0xed, 0xd9, 0x8d,
-	0x5e, 0xc5, 0xec, 0xbe, 0x97, 0xd0, 0xef, 0xf3, 0x7c, 0xcd, 0x6b, 0x49, 0x24, 0x35, 0x3a, 0xa8,
-	0x92, 0x4b, 0x4d, 0xbd, 0xa1, 0x37, 0xab, 0xce, 0x9e, 0xbd, 0x75, 0x6d, 0xfb, 0x4a, 0x85, 0xdb,
-	0xe5, 0xd1, 0xeb, 0x8e, 0xe6, 0xce, 0x51, 0xe3, 0x02, 0x55, 0x23, 0x01, 0xb2, 0xef, 0x47, 0x84,
-	0x71, 0x30, 0x7f, 0x34, 0x4a, 0xcd, 0xaa, 0xd3, 0x2c, 0x30, 0x75, 0x05, 0xc8, 0xce, 0x0c, 0x70,
-	0x51, 0xb4, 0x18, 0xc1, 0x68, 0xa3, 0x5f, 0x01, 0x8d, 0x05, 0x30, 0x09, 0x66, 0x49, 0x79, 0xf6,
-	0x0b, 0x3c, 0x67, 0x79, 0xdc, 0xfd, 0xe4, 0x8c, 0x2e, 0x42, 0x29, 0xf7, 0x04, 0x0f, 0x18, 0x0f,
-	0xc1, 0x2c, 0x7f, 0x6b, 0x9b, 0xde, 0x02, 0x70, 0x57, 0x58, 0xa3, 0x87, 0xfe, 0xa6, 0x40, 0x93,
-	0xfe, 0x8a, 0xee, 0xa7, 0xd2, 0x1d, 0x15, 0xe9, 0x80, 0x26, 0x4b, 0xe5, 0x9f, 0x74, 0xf5, 0x09,
-	0x46, 0x80, 0x6a, 0x19, 0x19, 0xb0, 0x80, 0x48, 0xb1, 0xe6, 0xae, 0x28, 0x77, 0xab, 0xc0, 0x7d,
-	0xb3, 0x40, 0x97, 0x05, 0xff, 0xb2, 0x2f, 0x7f, 0xd0, 0xbe, 0x1b, 0x4d, 0x2c, 0x7d, 0x3c, 0xb1,
-	0xf4, 0xb7, 0x89, 0xa5, 0x3f, 0x4f, 0x2d, 0x6d, 0x3c, 0xb5, 0xb4, 0x97, 0xa9, 0xa5, 0xdd, 0x9e,
-	0x86, 0x4c, 0x46, 0xa9, 0x67, 0xfb, 0x62, 0x88, 0x63, 0x9a, 0x00, 0x03, 0x49, 0xb9, 0x4f, 0x2f,
-	0x39, 0xc5, 0x79, 0xf5, 0x31, 0x27, 0x92, 0x65, 0x14, 0x67, 0x0e, 0x7e, 0xdc, 0xbc, 0x2f, 0xf9,
-	0x14, 0x53, 0xf0, 0x2a, 0xea, 0x9e, 0x4e, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x9d, 0xaf,
-	0x0f, 0x13, 0x03, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xdd, 0x4e, 0xe3, 0x46,
+	0x14, 0xc7, 0x93, 0x42, 0x03, 0x9d, 0x90, 0x40, 0x07, 0x2a, 0x59, 0x48, 0x4d, 0x51, 0xd5, 0x56,
+	0x14, 0xda, 0xb8, 0xa4, 0xd7, 0xad, 0xc4, 0x97, 0x0a, 0x12, 0xd5, 0xb2, 0xc3, 0xc7, 0x4a, 0xbb,
+	0x2b, 0x59, 0x13, 0xfb, 0xc8, 0x19, 0xad, 0x33, 0xe3, 0x9d, 0x33, 0x71, 0xc2, 0x5b, 0xec, 0x63,
+	0x71, 0xc9, 0xe5, 0x5e, 0xad, 0x56, 0x70, 0xb7, 0x4f, 0xb1, 0xca, 0x38, 0x0e, 0x4e, 0x90, 0xb0,
+	0xef, 0x66, 0x8e, 0xce, 0xef, 0xf7, 0x1f, 0x1f, 0xcd, 0xc8, 0x64, 0x37, 0x46, 0xc3, 0xdf, 0x81,
+	0x1b, 0x89, 0xf7, 0x03, 0x11, 0xd8, 0xb5, 0xe8, 0xfa, 0x6e, 0xb2, 0xd7, 0x05, 0xc3, 0xf7, 0xdc,
+	0x10, 0x24, 0xa0, 0xc0, 0x76, 0xac, 0x95, 0x51, 0xf4, 0xc7, 0xb4, 0xb9, 0x3d, 0xdb, 0xdc, 0x9e,
+	0x34, 0x6f, 0x6e, 0x84, 0x2a, 0x54, 0xb6, 0xd3, 0x1d, 0xaf, 0x52, 0x68, 0x73, 0xe7, 0xf9, 0x84,
+	0x98, 0x6b, 0xde, 0x9f, 0x04, 0x6c, 0x76, 0x9e, 0xef, 0x9d, 0xcb, 0xb5, 0xcc, 0xcf, 0x5f, 0xea,
+	0x64, 0xe5, 0xbf, 0xf4, 0x98, 0x17, 0x86, 0x1b, 0xa0, 0x87, 0xa4, 0x96, 0x4a, 0x9d, 0xea, 0x56,
+	0x75, 0xbb, 0xde, 0xf9, 0xb5, 0xfd, 0xec, 0xb1, 0xdb, 0xe7, 0xb6, 0xf9, 0x60, 0xf1, 0xf6, 0xd3,
+	0x4f, 0x15, 0x36, 0x41, 0xe9, 0x29, 0xa9, 0xf7, 0x14, 0x1a, 0xcf, 0xef, 0x71, 0x21, 0xd1, 0xf9,
+	0x66, 0x6b, 0x61, 0xbb, 0xde, 0xd9, 0x2e, 0x30, 0x9d, 0x28, 0x34, 0x87, 0x63, 0x80, 0x91, 0x5e,
+	0xb6, 0x44, 0x7a, 0x40, 0x96, 0x03, 0x88, 0x15, 0x0a, 0x83, 0xce, 0x82, 0xf5, 0xfc, 0x56, 0xe0,
+	0x39, 0x4a, 0xdb, 0xd9, 0x94, 0xa3, 0x27, 0x84, 0x0c, 0x64, 0x57, 0xc9, 0x40, 0xc8, 0x10, 0x9d,
+	0xc5, 0x52, 0xa7, 0xb9, 0xca, 0x00, 0x96, 0x63, 0xe9, 0x15, 0x59, 0x1d, 0x20, 0x68, 0x2f, 0xa7,
+	0xfb, 0xd6, 0xea, 0xfe, 0x28, 0xd2, 0x21, 0xe8, 0x47, 0x65, 0x73, 0x90, 0xdf, 0x22, 0x0d, 0xc8,
+	0x46, 0xc2, 0x23, 0x11, 0x70, 0xa3, 0x66, 0xdc, 0x35, 0xeb, 0xde, 0x2b, 0x70, 0x5f, 0x67, 0xe8,
+	0x63, 0xc0, 0x7a, 0xf2, 0xa4, 0x86, 0xf4, 0x8c, 0xac, 0x44, 0xd8, 0xf7, 0xa6, 0xe3, 0x5c, 0xb2,
+	0xf6, 0xdf, 0x0b, 0xec, 0x67, 0x17, 0xff, 0x67, 0x13, 0xad, 0x47, 0xd8, 0x3f, 0xca, 0x86, 0xfa,
+	0x92, 0x34, 0x34, 0x04, 0x10, 0x41, 0xc8, 0x8d, 0x50, 0x12, 0x9d, 0x65, 0xab, 0xdb, 0x2d, 0xd0,
+	0xb1, 0x1c, 0xc3, 0x66, 0x0d, 0xe3, 0xe9, 0x6a, 0x18, 0x72, 0x1d, 0xa0, 0xa7, 0xc1, 0x57, 0x3a,
+	0x40, 0xe7, 0xbb, 0x52, 0xd3, 0x65, 0x29, 0xc5, 0x2c, 0xc4, 0x9a, 0x3a, 0xbf, 0x45, 0xfa, 0x2f,
+	0x59, 0xc2, 0x88, 0x63, 0x0f, 0xd0, 0x21, 0x56, 0xf7, 0x4b, 0x81, 0xee, 0x62, 0xdc, 0xcd, 0x32,
+	0x88, 0xfe, 0x43, 0x96, 0x84, 0xcf, 0x3d, 0x33, 0x42, 0xa7, 0x5e, 0x8a, 0x3f, 0x3d, 0xdc, 0xbf,
+	0x1c, 0xb1, 0x9a, 0xf0, 0xf9, 0xe5, 0xc8, 0x7e, 0x55, 0xda, 0x27, 0xcc, 0x8d, 0x17, 0x2b, 0x15,
+	0xa1, 0xb3, 0x52, 0xea, 0xab, 0xce, 0x32, 0xea, 0x5c, 0xa9, 0x88, 0x35, 0xa3, 0xfc, 0x16, 0x29,
+	0x27, 0xeb, 0x39, 0xad, 0x56, 0x89, 0x08, 0x40, 0xa3, 0xd3, 0xb0, 0xea, 0xbf, 0x4a, 0xab, 0x27,
+	0x20, 0xa3, 0xd1, 0x7c, 0x09, 0x69, 0x97, 0x6c, 0x3c, 0x3e, 0xe3, 0x71, 0x46, 0xac, 0x90, 0x47,
+	0xe8, 0x34, 0x4b, 0x65, 0x4c, 0xdf, 0xf3, 0xf9, 0x04, 0x64, 0xb4, 0x37, 0x5f, 0x42, 0xca, 0x48,
+	0x33, 0x13, 0x7b, 0x89, 0x32, 0x80, 0xce, 0x6a, 0xa9, 0x7b, 0x94, 0x19, 0xae, 0x95, 0x01, 0xd6,
+	0x88, 0x73, 0xbb, 0xf1, 0xd5, 0x6c, 0x46, 0x10, 0x84, 0xa0, 0x3d, 0x90, 0x46, 0x0b, 0x40, 0x67,
+	0xcd, 0x3a, 0x77, 0x8a, 0xa6, 0x62, 0xa1, 0x63, 0x69, 0xf4, 0x0d, 0x6b, 0x44, 0xd3, 0x8d, 0x00,
+	0xa4, 0x40, 0x7e, 0x80, 0x91, 0xdf, 0xe3, 0x32, 0x04, 0x4f, 0x73, 0x03, 0xd3, 0x0b, 0xfa, 0x7d,
+	0xa9, 0x27, 0x7a, 0x3c, 0x61, 0x19, 0x37, 0x30, 0xb9, 0xa5, 0xeb, 0xf0, 0xa4, 0x86, 0xf4, 0x2d,
+	0xa1, 0x43, 0x21, 0x03, 0x2f, 0x50, 0x43, 0xe9, 0x69, 0x40, 0xd0, 0x09, 0xa0, 0x43, 0x6d, 0x46,
+	0xbb, 0x20, 0xe3, 0x95, 0x90, 0xc1, 0x91, 0x1a, 0x4a, 0x96, 0x62, 0x6c, 0x6d, 0x38, 0x5b, 0xc0,
+	0x83, 0x37, 0xb7, 0xf7, 0xad, 0xea, 0xdd, 0x7d, 0xab, 0xfa, 0xf9, 0xbe, 0x55, 0xfd, 0xf0, 0xd0,
+	0xaa, 0xdc, 0x3d, 0xb4, 0x2a, 0x1f, 0x1f, 0x5a, 0x95, 0xd7, 0xfb, 0xa1, 0x30, 0xbd, 0x41, 0xb7,
+	0xed, 0xab, 0xbe, 0x1b, 0x83, 0x46, 0x81, 0x06, 0xa4, 0x0f, 0x2f, 0x24, 0xb8, 0x69, 0xe8, 0x9f,
+	0x92, 0x1b, 0x91, 0x80, 0x9b, 0x74, 0xdc, 0xd1, 0xfc, 0x0f, 0xc6, 0xdc, 0xc4, 0x80, 0xdd, 0x9a,
+	0xfd, 0xa1, 0xfc, 0xfd, 0x75, 0x00, 0x03, 0x07, 0xe7, 0x2c, 0x14, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -169,6 +296,180 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WindDownReserves) > 0 {
+		for iNdEx := len(m.WindDownReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindDownReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ExchangeRateRecords) > 0 {
+		for iNdEx := len(m.ExchangeRateRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.LedgerEntries) > 0 {
+		for iNdEx := len(m.LedgerEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LedgerEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ProposalVotes) > 0 {
+		for iNdEx := len(m.ProposalVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.HostChainProposals) > 0 {
+		for iNdEx := len(m.HostChainProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostChainProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.LiquidityProviders) > 0 {
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.LiquidityPools) > 0 {
+		for iNdEx := len(m.LiquidityPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.IcaTxs) > 0 {
+		for iNdEx := len(m.IcaTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RewardsRecords) > 0 {
+		for iNdEx := len(m.RewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LsmDeposits) > 0 {
+		for iNdEx := len(m.LsmDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LsmDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ValidatorUnbondings) > 0 {
 		for iNdEx := len(m.ValidatorUnbondings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -301,6 +602,78 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LsmDeposits) > 0 {
+		for _, e := range m.LsmDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardsRecords) > 0 {
+		for _, e := range m.RewardsRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IcaTxs) > 0 {
+		for _, e := range m.IcaTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidityPools) > 0 {
+		for _, e := range m.LiquidityPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostChainProposals) > 0 {
+		for _, e := range m.HostChainProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalVotes) > 0 {
+		for _, e := range m.ProposalVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LedgerEntries) > 0 {
+		for _, e := range m.LedgerEntries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExchangeRateRecords) > 0 {
+		for _, e := range m.ExchangeRateRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WindDownReserves) > 0 {
+		for _, e := range m.WindDownReserves {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -542,6 +915,414 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LsmDeposits = append(m.LsmDeposits, &LSMDeposit{})
+			if err := m.LsmDeposits[len(m.LsmDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, &Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsRecords = append(m.RewardsRecords, &RewardsRecord{})
+			if err := m.RewardsRecords[len(m.RewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, &Slash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaTxs = append(m.IcaTxs, &ICATx{})
+			if err := m.IcaTxs[len(m.IcaTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityPools = append(m.LiquidityPools, &LiquidityPool{})
+			if err := m.LiquidityPools[len(m.LiquidityPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChainProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostChainProposals = append(m.HostChainProposals, &HostChainProposal{})
+			if err := m.HostChainProposals[len(m.HostChainProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalVotes = append(m.ProposalVotes, &ProposalVote{})
+			if err := m.ProposalVotes[len(m.ProposalVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LedgerEntries = append(m.LedgerEntries, &LedgerEntry{})
+			if err := m.LedgerEntries[len(m.LedgerEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateRecords = append(m.ExchangeRateRecords, &ExchangeRateRecord{})
+			if err := m.ExchangeRateRecords[len(m.ExchangeRateRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDownReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindDownReserves = append(m.WindDownReserves, &WindDownReserve{})
+			if err := m.WindDownReserves[len(m.WindDownReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,8 +1,13 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	ibctfrtypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/persistenceOne/pstake-native/v2/delegation"
 )
 
 func (hc *HostChain) IBCDenom() string {
@@ -31,3 +36,300 @@ func (hc *HostChain) GetHostChainTotalDelegations() sdk.Int { //nolint:staticche
 
 	return totalDelegations
 }
+
+// GetDelegationAccounts returns the main delegation account followed by all the additional ones
+func (hc *HostChain) GetDelegationAccounts() []*ICAAccount {
+	accounts := make([]*ICAAccount, 0, len(hc.AdditionalDelegationAccounts)+1)
+	if hc.DelegationAccount != nil {
+		accounts = append(accounts, hc.DelegationAccount)
+	}
+
+	return append(accounts, hc.AdditionalDelegationAccounts...)
+}
+
+// GetActiveDelegationAccounts returns the delegation accounts whose ICA channel has been created
+func (hc *HostChain) GetActiveDelegationAccounts() []*ICAAccount {
+	accounts := make([]*ICAAccount, 0)
+	for _, account := range hc.GetDelegationAccounts() {
+		if account.ChannelState == ICAAccount_ICA_CHANNEL_CREATED {
+			accounts = append(accounts, account)
+		}
+	}
+
+	return accounts
+}
+
+// RotateDelegationAccount returns the active delegation account for a rotation index, falling back to the main
+// delegation account if there is none active
+func (hc *HostChain) RotateDelegationAccount(index int64) *ICAAccount {
+	accounts := hc.GetActiveDelegationAccounts()
+	if len(accounts) == 0 {
+		return hc.DelegationAccount
+	}
+
+	return accounts[index%int64(len(accounts))]
+}
+
+// GetDelegationAccountByOwner returns a delegation account given its owner, an empty owner refers to the main account
+func (hc *HostChain) GetDelegationAccountByOwner(owner string) (*ICAAccount, bool) {
+	if owner == "" {
+		return hc.DelegationAccount, hc.DelegationAccount != nil
+	}
+
+	for _, account := range hc.GetDelegationAccounts() {
+		if account.Owner == owner {
+			return account, true
+		}
+	}
+
+	return nil, false
+}
+
+// IsAdditionalDelegationAccountOwner checks if an ICA owner belongs to one of the additional delegation accounts
+func (hc *HostChain) IsAdditionalDelegationAccountOwner(owner string) bool {
+	for _, account := range hc.AdditionalDelegationAccounts {
+		if account.Owner == owner {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetDelegationAccountByAddress returns a delegation account given its address on the host chain
+func (hc *HostChain) GetDelegationAccountByAddress(address string) (*ICAAccount, bool) {
+	for _, account := range hc.GetDelegationAccounts() {
+		if account.Address != "" && account.Address == address {
+			return account, true
+		}
+	}
+
+	return nil, false
+}
+
+// IsMainDelegationAccount checks if an account is the main delegation account of the host chain
+func (hc *HostChain) IsMainDelegationAccount(account *ICAAccount) bool {
+	return hc.DelegationAccount != nil && account.Owner == hc.DelegationAccount.Owner
+}
+
+// GetAccountDelegatedAmount returns the amount delegated by a delegation account to a validator. Delegations are
+// only tracked for the additional accounts, the main account owns whatever is not delegated by them.
+func (hc *HostChain) GetAccountDelegatedAmount(account *ICAAccount, validatorAddress string) sdk.Int { //nolint:staticcheck
+	if !hc.IsMainDelegationAccount(account) {
+		return account.GetDelegatedAmount(validatorAddress)
+	}
+
+	validator, found := hc.GetValidator(validatorAddress)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	amount := validator.DelegatedAmount
+	for _, additional := range hc.AdditionalDelegationAccounts {
+		amount = amount.Sub(additional.GetDelegatedAmount(validatorAddress))
+	}
+
+	return sdk.MaxInt(amount, sdk.ZeroInt())
+}
+
+// GetAccountTotalDelegations returns the total amount delegated by a delegation account
+func (hc *HostChain) GetAccountTotalDelegations(account *ICAAccount) sdk.Int { //nolint:staticcheck
+	total := sdk.ZeroInt()
+	for _, validator := range hc.Validators {
+		total = total.Add(hc.GetAccountDelegatedAmount(account, validator.OperatorAddress))
+	}
+
+	return total
+}
+
+// DelegationAccountView returns a copy of the host chain as seen from one of its delegation accounts: the
+// account is used as the delegation account and the validators only hold the delegations of that account
+func (hc *HostChain) DelegationAccountView(account *ICAAccount) *HostChain {
+	view := *hc
+	view.DelegationAccount = account
+	view.Validators = make([]*Validator, 0, len(hc.Validators))
+	for _, validator := range hc.Validators {
+		accountValidator := *validator
+		accountValidator.DelegatedAmount = hc.GetAccountDelegatedAmount(account, validator.OperatorAddress)
+		view.Validators = append(view.Validators, &accountValidator)
+	}
+
+	return &view
+}
+
+// GetDelegatedAmount returns the tracked delegation of the account to a validator
+func (account *ICAAccount) GetDelegatedAmount(validatorAddress string) sdk.Int { //nolint:staticcheck
+	for _, delegation := range account.Delegations {
+		if delegation.ValidatorAddress == validatorAddress {
+			return delegation.Amount
+		}
+	}
+
+	return sdk.ZeroInt()
+}
+
+// AddDelegatedAmount updates the tracked delegation of the account to a validator, the amount can be negative
+func (account *ICAAccount) AddDelegatedAmount(validatorAddress string, amount sdk.Int) { //nolint:staticcheck
+	for i, delegation := range account.Delegations {
+		if delegation.ValidatorAddress == validatorAddress {
+			account.Delegations[i].Amount = sdk.MaxInt(delegation.Amount.Add(amount), sdk.ZeroInt())
+			return
+		}
+	}
+
+	if amount.IsPositive() {
+		account.Delegations = append(account.Delegations, &AccountDelegation{
+			ValidatorAddress: validatorAddress,
+			Amount:           amount,
+		})
+	}
+}
+
+// GetLSMTokenDenom parses a tokenized share denom trace path coming from the host chain
+// and returns the denom of the token on the host chain (validator/record)
+func (hc *HostChain) GetLSMTokenDenom(path string) (string, bool) {
+	prefix := ibctfrtypes.GetDenomPrefix(hc.PortId, hc.ChannelId)
+	if !strings.HasPrefix(path, prefix) {
+		return "", false
+	}
+	denom := strings.TrimPrefix(path, prefix)
+
+	// the host denom is a tokenized share if it is composed of a validator address and a record id
+	validatorAddress, recordID, found := strings.Cut(denom, "/")
+	if !found || validatorAddress == "" || recordID == "" {
+		return "", false
+	}
+
+	return denom, true
+}
+
+// IsWindingDown returns whether the host chain is being deregistered
+func (hc *HostChain) IsWindingDown() bool {
+	return hc.WindDownState != HostChain_WIND_DOWN_NONE
+}
+
+// GetOrInitRewardsParams returns the host chain rewards params, initialised with the defaults if not set
+func (hc *HostChain) GetOrInitRewardsParams() *HostChainRewardsParams {
+	if hc.RewardsParams == nil {
+		return &HostChainRewardsParams{MinClaimThreshold: sdk.ZeroInt()}
+	}
+
+	return hc.RewardsParams
+}
+
+// GetRewardsEpochIdentifier returns the epoch in which the host chain rewards are withdrawn
+func (hc *HostChain) GetRewardsEpochIdentifier() string {
+	if hc.RewardsParams == nil || hc.RewardsParams.EpochIdentifier == "" {
+		return RewardsEpochIdentifier
+	}
+
+	return hc.RewardsParams.EpochIdentifier
+}
+
+// GetRewardsClaimThreshold returns the minimum rewards a delegation needs to accrue to be withdrawn
+func (hc *HostChain) GetRewardsClaimThreshold() sdk.Int { //nolint:staticcheck
+	if hc.RewardsParams == nil || hc.RewardsParams.MinClaimThreshold.IsNil() {
+		return sdk.ZeroInt()
+	}
+
+	return hc.RewardsParams.MinClaimThreshold
+}
+
+// GetRewardsMaxMsgsPerTx returns the max amount of withdraw messages per ICA tx, zero means no limit
+func (hc *HostChain) GetRewardsMaxMsgsPerTx() uint64 {
+	if hc.RewardsParams == nil {
+		return 0
+	}
+
+	return hc.RewardsParams.MaxMsgsPerTx
+}
+
+// GetOrInitDelegationParams returns the host chain delegation params, initialised with the defaults if not set
+func (hc *HostChain) GetOrInitDelegationParams() *HostChainDelegationParams {
+	if hc.DelegationParams == nil {
+		return &HostChainDelegationParams{Strategy: delegation.WeightProportional, ValidatorCap: sdk.OneDec()}
+	}
+
+	return hc.DelegationParams
+}
+
+// GetDelegationStrategy returns the strategy used to split the host chain delegations and undelegations
+func (hc *HostChain) GetDelegationStrategy() (delegation.Strategy, error) {
+	if hc.DelegationParams == nil {
+		return delegation.NewStrategy(delegation.WeightProportional, sdk.OneDec())
+	}
+
+	return delegation.NewStrategy(hc.DelegationParams.Strategy, hc.DelegationParams.ValidatorCap)
+}
+
+// GetOrInitCircuitBreaker returns the host chain circuit breaker, initialised with every trigger disabled if not set
+func (hc *HostChain) GetOrInitCircuitBreaker() *HostChainCircuitBreaker {
+	if hc.CircuitBreaker == nil {
+		return &HostChainCircuitBreaker{MaxCValueDeviation: sdk.ZeroDec(), MaxSlashFraction: sdk.ZeroDec()}
+	}
+
+	return hc.CircuitBreaker
+}
+
+// IsPaused returns true if the circuit breaker of the host chain is at the state or above, pausing its operations
+func (hc *HostChain) IsPaused(state HostChainCircuitBreaker_State) bool {
+	return hc.CircuitBreaker != nil && hc.CircuitBreaker.State >= state
+}
+
+// IsHalted returns true if every operation of the host chain is halted by the circuit breaker
+func (hc *HostChain) IsHalted() bool {
+	return hc.IsPaused(HostChainCircuitBreaker_CIRCUIT_BREAKER_HALTED)
+}
+
+// GetOrInitEligibilityParams returns the host chain eligibility params, initialised with the defaults if not set
+func (hc *HostChain) GetOrInitEligibilityParams() *HostChainEligibilityParams {
+	if hc.EligibilityParams == nil {
+		return &HostChainEligibilityParams{MaxCommission: sdk.ZeroDec(), MaxVotingPowerShare: sdk.ZeroDec()}
+	}
+
+	return hc.EligibilityParams
+}
+
+// GetBondedTokens returns the total tokens of the host chain validators in the active set
+func (hc *HostChain) GetBondedTokens() sdk.Int { //nolint:staticcheck
+	total := sdk.ZeroInt()
+	for _, validator := range hc.Validators {
+		if validator.Status == stakingtypes.BondStatusBonded && !validator.TotalAmount.IsNil() {
+			total = total.Add(validator.TotalAmount)
+		}
+	}
+
+	return total
+}
+
+// GetValidatorIneligibility returns the reason why a validator can't be delegated to, empty if it is eligible
+func (hc *HostChain) GetValidatorIneligibility(validator *Validator) string {
+	params := hc.EligibilityParams
+	if params == nil {
+		return ""
+	}
+
+	for _, address := range params.Denylist {
+		if address == validator.OperatorAddress {
+			return IneligibleDenylisted
+		}
+	}
+
+	if !params.MaxCommission.IsNil() && params.MaxCommission.IsPositive() &&
+		!validator.CommissionRate.IsNil() && validator.CommissionRate.GT(params.MaxCommission) {
+		return IneligibleCommission
+	}
+
+	if params.MaxJailedCount > 0 && validator.JailedCount > params.MaxJailedCount {
+		return IneligibleJailedCount
+	}
+
+	bondedTokens := hc.GetBondedTokens()
+	if !params.MaxVotingPowerShare.IsNil() && params.MaxVotingPowerShare.IsPositive() &&
+		validator.Status == stakingtypes.BondStatusBonded && bondedTokens.IsPositive() &&
+		sdk.NewDecFromInt(validator.TotalAmount).QuoInt(bondedTokens).GT(params.MaxVotingPowerShare) {
+		return IneligibleVotingPower
+	}
+
+	return ""
+}
@@ -0,0 +1,347 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/delegation"
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func testMultiAccountHostChain() *types.HostChain {
+	return &types.HostChain{
+		ChainId: "chain-1",
+		DelegationAccount: &types.ICAAccount{
+			Address:      "delegator0",
+			Owner:        types.DelegateAccountPortOwner("chain-1", 0),
+			ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+		},
+		AdditionalDelegationAccounts: []*types.ICAAccount{
+			{
+				Address:      "delegator1",
+				Owner:        types.DelegateAccountPortOwner("chain-1", 1),
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATED,
+				Delegations: []*types.AccountDelegation{
+					{ValidatorAddress: "valoper1", Amount: sdk.NewInt(30)},
+				},
+			},
+			{
+				Owner:        types.DelegateAccountPortOwner("chain-1", 2),
+				ChannelState: types.ICAAccount_ICA_CHANNEL_CREATING,
+			},
+		},
+		Validators: []*types.Validator{
+			{OperatorAddress: "valoper1", DelegatedAmount: sdk.NewInt(100), Weight: sdk.NewDecWithPrec(5, 1)},
+			{OperatorAddress: "valoper2", DelegatedAmount: sdk.NewInt(50), Weight: sdk.NewDecWithPrec(5, 1)},
+		},
+	}
+}
+
+func TestHostChain_GetAccountDelegatedAmount(t *testing.T) {
+	hc := testMultiAccountHostChain()
+
+	for _, tc := range []struct {
+		name      string
+		account   *types.ICAAccount
+		validator string
+		result    sdk.Int
+	}{
+		{
+			name:      "MainAccount",
+			account:   hc.DelegationAccount,
+			validator: "valoper1",
+			result:    sdk.NewInt(70),
+		},
+		{
+			name:      "AdditionalAccount",
+			account:   hc.AdditionalDelegationAccounts[0],
+			validator: "valoper1",
+			result:    sdk.NewInt(30),
+		},
+		{
+			name:      "NoDelegation",
+			account:   hc.AdditionalDelegationAccounts[0],
+			validator: "valoper2",
+			result:    sdk.ZeroInt(),
+		},
+		{
+			name:      "UnknownValidator",
+			account:   hc.DelegationAccount,
+			validator: "valoper3",
+			result:    sdk.ZeroInt(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.result, hc.GetAccountDelegatedAmount(tc.account, tc.validator))
+		})
+	}
+}
+
+func TestHostChain_DelegationAccountView(t *testing.T) {
+	hc := testMultiAccountHostChain()
+
+	view := hc.DelegationAccountView(hc.AdditionalDelegationAccounts[0])
+	require.Equal(t, hc.AdditionalDelegationAccounts[0], view.DelegationAccount)
+	require.Equal(t, sdk.NewInt(30), view.Validators[0].DelegatedAmount)
+	require.Equal(t, sdk.ZeroInt(), view.Validators[1].DelegatedAmount)
+
+	// the host chain itself is left untouched
+	require.Equal(t, "delegator0", hc.DelegationAccount.Address)
+	require.Equal(t, sdk.NewInt(100), hc.Validators[0].DelegatedAmount)
+}
+
+func TestHostChain_RotateDelegationAccount(t *testing.T) {
+	hc := testMultiAccountHostChain()
+
+	// only the accounts with an open channel are rotated
+	require.Equal(t, "delegator0", hc.RotateDelegationAccount(0).Address)
+	require.Equal(t, "delegator1", hc.RotateDelegationAccount(1).Address)
+	require.Equal(t, "delegator0", hc.RotateDelegationAccount(2).Address)
+
+	account, found := hc.GetDelegationAccountByOwner("")
+	require.True(t, found)
+	require.Equal(t, hc.DelegationAccount, account)
+
+	account, found = hc.GetDelegationAccountByAddress("delegator1")
+	require.True(t, found)
+	require.Equal(t, hc.AdditionalDelegationAccounts[0], account)
+}
+
+func TestICAAccount_AddDelegatedAmount(t *testing.T) {
+	account := &types.ICAAccount{}
+
+	account.AddDelegatedAmount("valoper1", sdk.NewInt(10))
+	account.AddDelegatedAmount("valoper1", sdk.NewInt(5))
+	require.Equal(t, sdk.NewInt(15), account.GetDelegatedAmount("valoper1"))
+
+	account.AddDelegatedAmount("valoper1", sdk.NewInt(-20))
+	require.Equal(t, sdk.ZeroInt(), account.GetDelegatedAmount("valoper1"))
+
+	account.AddDelegatedAmount("valoper2", sdk.NewInt(-5))
+	require.Len(t, account.Delegations, 1)
+}
+
+func TestHostChain_RewardsParams(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		params    *types.HostChainRewardsParams
+		epoch     string
+		threshold sdk.Int
+		maxMsgs   uint64
+	}{
+		{
+			name:      "Defaults",
+			params:    nil,
+			epoch:     types.RewardsEpochIdentifier,
+			threshold: sdk.ZeroInt(),
+			maxMsgs:   0,
+		},
+		{
+			name:      "EmptyEpoch",
+			params:    &types.HostChainRewardsParams{MinClaimThreshold: sdk.NewInt(1000), MaxMsgsPerTx: 5},
+			epoch:     types.RewardsEpochIdentifier,
+			threshold: sdk.NewInt(1000),
+			maxMsgs:   5,
+		},
+		{
+			name: "Custom",
+			params: &types.HostChainRewardsParams{
+				EpochIdentifier:   "week",
+				MinClaimThreshold: sdk.NewInt(10),
+				MaxMsgsPerTx:      2,
+			},
+			epoch:     "week",
+			threshold: sdk.NewInt(10),
+			maxMsgs:   2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			hc := &types.HostChain{RewardsParams: tc.params}
+			require.Equal(t, tc.epoch, hc.GetRewardsEpochIdentifier())
+			require.Equal(t, tc.threshold, hc.GetRewardsClaimThreshold())
+			require.Equal(t, tc.maxMsgs, hc.GetRewardsMaxMsgsPerTx())
+		})
+	}
+}
+
+func TestHostChain_GetDelegationStrategy(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		params   *types.HostChainDelegationParams
+		strategy string
+		valid    bool
+	}{
+		{
+			name:     "Defaults",
+			params:   nil,
+			strategy: delegation.WeightProportional,
+			valid:    true,
+		},
+		{
+			name:     "EmptyStrategy",
+			params:   &types.HostChainDelegationParams{ValidatorCap: sdk.OneDec()},
+			strategy: delegation.WeightProportional,
+			valid:    true,
+		},
+		{
+			name:     "Capped",
+			params:   &types.HostChainDelegationParams{Strategy: delegation.CappedPerValidator, ValidatorCap: sdk.NewDecWithPrec(2, 1)},
+			strategy: delegation.CappedPerValidator,
+			valid:    true,
+		},
+		{
+			name:   "CappedWithoutCap",
+			params: &types.HostChainDelegationParams{Strategy: delegation.CappedPerValidator},
+		},
+		{
+			name:   "Unknown",
+			params: &types.HostChainDelegationParams{Strategy: "unknown", ValidatorCap: sdk.OneDec()},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			hc := &types.HostChain{DelegationParams: tc.params}
+			strategy, err := hc.GetDelegationStrategy()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.strategy, strategy.Name())
+		})
+	}
+}
+
+func TestHostChain_GetValidatorIneligibility(t *testing.T) {
+	validators := []*types.Validator{
+		{
+			OperatorAddress: "valoper1",
+			Status:          stakingtypes.BondStatusBonded,
+			TotalAmount:     sdk.NewInt(600),
+			CommissionRate:  sdk.NewDecWithPrec(5, 2),
+		},
+		{
+			OperatorAddress: "valoper2",
+			Status:          stakingtypes.BondStatusBonded,
+			TotalAmount:     sdk.NewInt(400),
+			CommissionRate:  sdk.NewDecWithPrec(20, 2),
+			JailedCount:     3,
+		},
+		{
+			OperatorAddress: "valoper3",
+			Status:          stakingtypes.BondStatusUnbonded,
+			TotalAmount:     sdk.NewInt(1000),
+			CommissionRate:  sdk.NewDecWithPrec(5, 2),
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		params   *types.HostChainEligibilityParams
+		expected []string
+	}{
+		{
+			name:     "NoParams",
+			params:   nil,
+			expected: []string{"", "", ""},
+		},
+		{
+			name:     "Disabled",
+			params:   &types.HostChainEligibilityParams{MaxCommission: sdk.ZeroDec(), MaxVotingPowerShare: sdk.ZeroDec()},
+			expected: []string{"", "", ""},
+		},
+		{
+			name: "MaxCommission",
+			params: &types.HostChainEligibilityParams{
+				MaxCommission:       sdk.NewDecWithPrec(10, 2),
+				MaxVotingPowerShare: sdk.ZeroDec(),
+			},
+			expected: []string{"", types.IneligibleCommission, ""},
+		},
+		{
+			name: "MaxJailedCount",
+			params: &types.HostChainEligibilityParams{
+				MaxCommission:       sdk.ZeroDec(),
+				MaxJailedCount:      2,
+				MaxVotingPowerShare: sdk.ZeroDec(),
+			},
+			expected: []string{"", types.IneligibleJailedCount, ""},
+		},
+		{
+			name: "MaxVotingPowerShare",
+			params: &types.HostChainEligibilityParams{
+				MaxCommission:       sdk.ZeroDec(),
+				MaxVotingPowerShare: sdk.NewDecWithPrec(5, 1),
+			},
+			expected: []string{types.IneligibleVotingPower, "", ""},
+		},
+		{
+			name: "Denylist",
+			params: &types.HostChainEligibilityParams{
+				MaxCommission:       sdk.NewDecWithPrec(10, 2),
+				MaxVotingPowerShare: sdk.ZeroDec(),
+				Denylist:            []string{"valoper2", "valoper3"},
+			},
+			expected: []string{"", types.IneligibleDenylisted, types.IneligibleDenylisted},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			hc := &types.HostChain{EligibilityParams: tc.params, Validators: validators}
+			for i, validator := range validators {
+				require.Equal(t, tc.expected[i], hc.GetValidatorIneligibility(validator))
+			}
+		})
+	}
+}
+
+func TestHostChain_IsPaused(t *testing.T) {
+	states := []types.HostChainCircuitBreaker_State{
+		types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED,
+		types.HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED,
+		types.HostChainCircuitBreaker_CIRCUIT_BREAKER_REDEMPTIONS_PAUSED,
+		types.HostChainCircuitBreaker_CIRCUIT_BREAKER_HALTED,
+	}
+
+	for _, tc := range []struct {
+		name     string
+		breaker  *types.HostChainCircuitBreaker
+		expected []bool
+	}{
+		{
+			name:     "NoCircuitBreaker",
+			breaker:  nil,
+			expected: []bool{false, false, false, false},
+		},
+		{
+			name:     "Operational",
+			breaker:  &types.HostChainCircuitBreaker{State: types.HostChainCircuitBreaker_CIRCUIT_BREAKER_OPERATIONAL},
+			expected: []bool{false, false, false, false},
+		},
+		{
+			name:     "DepositsPaused",
+			breaker:  &types.HostChainCircuitBreaker{State: types.HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED},
+			expected: []bool{true, false, false, false},
+		},
+		{
+			name:     "RedemptionsPaused",
+			breaker:  &types.HostChainCircuitBreaker{State: types.HostChainCircuitBreaker_CIRCUIT_BREAKER_REDEMPTIONS_PAUSED},
+			expected: []bool{true, true, true, false},
+		},
+		{
+			name:     "Halted",
+			breaker:  &types.HostChainCircuitBreaker{State: types.HostChainCircuitBreaker_CIRCUIT_BREAKER_HALTED},
+			expected: []bool{true, true, true, true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			hc := &types.HostChain{CircuitBreaker: tc.breaker}
+			for i, state := range states {
+				require.Equal(t, tc.expected[i], hc.IsPaused(state))
+			}
+			require.Equal(t, tc.expected[3], hc.IsHalted())
+		})
+	}
+}
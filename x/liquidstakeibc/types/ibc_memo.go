@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// Memo keys of the incoming transfers that trigger a module operation on the received tokens
+const (
+	IBCMemoLiquidStakeKey = "liquidstake"
+	IBCMemoUnstakeKey     = "unstake"
+	IBCMemoRedeemKey      = "redeem"
+)
+
+// IBCMemo is the memo of the incoming ics-20 transfers that trigger a module operation on the received tokens, only
+// one of the operations can be set
+type IBCMemo struct {
+	LiquidStake *LiquidStakeMemo `json:"liquidstake,omitempty"`
+	Unstake     *UnstakeMemo     `json:"unstake,omitempty"`
+	Redeem      *RedeemMemo      `json:"redeem,omitempty"`
+}
+
+// LiquidStakeMemo liquid stakes the received tokens on behalf of the receiver, e.g.
+// {"liquidstake":{"receiver":"persistence1...","forward":{"receiver":"cosmos1..."}}}
+type LiquidStakeMemo struct {
+	// Receiver is the Persistence address that gets the stk tokens, it needs to be the transfer receiver
+	Receiver string `json:"receiver"`
+	// Forward optionally sends the stk tokens to another chain
+	Forward *ForwardMemo `json:"forward,omitempty"`
+}
+
+// UnstakeMemo liquid unstakes the received stk tokens on behalf of the receiver, e.g.
+// {"unstake":{"receiver":"persistence1..."}}
+type UnstakeMemo struct {
+	// Receiver is the Persistence address that gets the unbonded tokens, it needs to be the transfer receiver
+	Receiver string `json:"receiver"`
+}
+
+// RedeemMemo instantly redeems the received stk tokens on behalf of the receiver, e.g.
+// {"redeem":{"receiver":"persistence1...","forward":{"receiver":"cosmos1...","channel":"channel-0"}}}
+type RedeemMemo struct {
+	// Receiver is the Persistence address that gets the host chain tokens, it needs to be the transfer receiver
+	Receiver string `json:"receiver"`
+	// Forward optionally sends the host chain tokens to another chain
+	Forward *ForwardMemo `json:"forward,omitempty"`
+}
+
+// ForwardMemo sends the tokens resulting from the memo operation to another chain
+type ForwardMemo struct {
+	// Receiver is the address that gets the forwarded tokens
+	Receiver string `json:"receiver"`
+	// Channel is the Persistence channel the tokens are sent through, empty sends them back to the source chain
+	Channel string `json:"channel,omitempty"`
+}
+
+// ParseIBCMemo parses the memo of an incoming transfer. Memos that are empty, aren't json objects or don't have any
+// module key belong to other applications and return a nil memo.
+func ParseIBCMemo(memo string) (*IBCMemo, error) {
+	if strings.TrimSpace(memo) == "" {
+		return nil, nil
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &keys); err != nil {
+		return nil, nil
+	}
+
+	moduleKeys := 0
+	for _, key := range []string{IBCMemoLiquidStakeKey, IBCMemoUnstakeKey, IBCMemoRedeemKey} {
+		if _, ok := keys[key]; ok {
+			moduleKeys++
+		}
+	}
+	if moduleKeys == 0 {
+		return nil, nil
+	}
+	if moduleKeys > 1 {
+		return nil, errorsmod.Wrap(ErrInvalidIBCMemo, "memo can only have one operation")
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(memo))
+	decoder.DisallowUnknownFields()
+
+	var ibcMemo IBCMemo
+	if err := decoder.Decode(&ibcMemo); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidIBCMemo, "could not parse memo: %s", err)
+	}
+
+	return &ibcMemo, ibcMemo.Validate()
+}
+
+func (m *IBCMemo) Validate() error {
+	switch {
+	case m.LiquidStake != nil:
+		return m.LiquidStake.Validate()
+	case m.Unstake != nil:
+		return m.Unstake.Validate()
+	case m.Redeem != nil:
+		return m.Redeem.Validate()
+	default:
+		return errorsmod.Wrap(ErrInvalidIBCMemo, "memo operation is empty")
+	}
+}
+
+func (m *LiquidStakeMemo) Validate() error {
+	if err := validateIBCMemoReceiver(m.Receiver); err != nil {
+		return err
+	}
+
+	if m.Forward != nil {
+		return m.Forward.Validate()
+	}
+
+	return nil
+}
+
+func (m *UnstakeMemo) Validate() error {
+	return validateIBCMemoReceiver(m.Receiver)
+}
+
+func (m *RedeemMemo) Validate() error {
+	if err := validateIBCMemoReceiver(m.Receiver); err != nil {
+		return err
+	}
+
+	if m.Forward != nil {
+		return m.Forward.Validate()
+	}
+
+	return nil
+}
+
+func (m *ForwardMemo) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidIBCMemo, "forward receiver can't be empty")
+	}
+
+	if m.Channel != "" {
+		if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+			return errorsmod.Wrapf(ErrInvalidIBCMemo, "invalid forward channel %s: %s", m.Channel, err)
+		}
+	}
+
+	return nil
+}
+
+func validateIBCMemoReceiver(receiver string) error {
+	if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+		return errorsmod.Wrapf(ErrInvalidIBCMemo, "invalid receiver %s: %s", receiver, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestParseIBCMemo(t *testing.T) {
+	receiver := sdk.AccAddress("receiver____________").String()
+
+	tc := []struct {
+		name     string
+		memo     string
+		expected *types.IBCMemo
+		valid    bool
+	}{
+		{
+			name:  "empty memo",
+			memo:  "",
+			valid: true,
+		},
+		{
+			name:  "text memo",
+			memo:  "sent from my wallet",
+			valid: true,
+		},
+		{
+			name:  "memo of another application",
+			memo:  `{"forward":{"receiver":"cosmos1hsqr8ln5aakv3x3fxsvdnxh7qs3qz2gdpfne3s"}}`,
+			valid: true,
+		},
+		{
+			name: "liquid stake memo",
+			memo: fmt.Sprintf(`{"liquidstake":{"receiver":"%s"}}`, receiver),
+			expected: &types.IBCMemo{
+				LiquidStake: &types.LiquidStakeMemo{Receiver: receiver},
+			},
+			valid: true,
+		},
+		{
+			name: "liquid stake memo with forward",
+			memo: fmt.Sprintf(
+				`{"liquidstake":{"receiver":"%s","forward":{"receiver":"cosmos1hsqr8ln5aakv3x3fxsvdnxh7qs3qz2gdpfne3s"}}}`,
+				receiver,
+			),
+			expected: &types.IBCMemo{
+				LiquidStake: &types.LiquidStakeMemo{
+					Receiver: receiver,
+					Forward:  &types.ForwardMemo{Receiver: "cosmos1hsqr8ln5aakv3x3fxsvdnxh7qs3qz2gdpfne3s"},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "unstake memo",
+			memo: fmt.Sprintf(`{"unstake":{"receiver":"%s"}}`, receiver),
+			expected: &types.IBCMemo{
+				Unstake: &types.UnstakeMemo{Receiver: receiver},
+			},
+			valid: true,
+		},
+		{
+			name: "redeem memo with forward through a channel",
+			memo: fmt.Sprintf(
+				`{"redeem":{"receiver":"%s","forward":{"receiver":"cosmos1hsqr8ln5aakv3x3fxsvdnxh7qs3qz2gdpfne3s","channel":"channel-0"}}}`,
+				receiver,
+			),
+			expected: &types.IBCMemo{
+				Redeem: &types.RedeemMemo{
+					Receiver: receiver,
+					Forward: &types.ForwardMemo{
+						Receiver: "cosmos1hsqr8ln5aakv3x3fxsvdnxh7qs3qz2gdpfne3s",
+						Channel:  "channel-0",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "empty liquid stake memo",
+			memo: `{"liquidstake":null}`,
+		},
+		{
+			name: "invalid receiver",
+			memo: `{"liquidstake":{"receiver":"persistence1invalid"}}`,
+		},
+		{
+			name: "empty forward receiver",
+			memo: fmt.Sprintf(`{"liquidstake":{"receiver":"%s","forward":{}}}`, receiver),
+		},
+		{
+			name: "invalid forward channel",
+			memo: fmt.Sprintf(`{"redeem":{"receiver":"%s","forward":{"receiver":"cosmos1","channel":"ch"}}}`, receiver),
+		},
+		{
+			name: "more than one operation",
+			memo: fmt.Sprintf(`{"unstake":{"receiver":"%s"},"redeem":{"receiver":"%s"}}`, receiver, receiver),
+		},
+		{
+			name: "unknown field",
+			memo: fmt.Sprintf(`{"liquidstake":{"receiver":"%s","amount":"1"}}`, receiver),
+		},
+	}
+
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			memo, err := types.ParseIBCMemo(tt.memo)
+			if !tt.valid {
+				require.ErrorIs(t, err, types.ErrInvalidIBCMemo)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, memo)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"strconv"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -21,26 +23,69 @@ const (
 	// UndelegationModuleAccount UndelegationModuleAccountName
 	UndelegationModuleAccount = ModuleName + "_undelegation_account"
 
+	// LiquidityPoolModuleAccount LiquidityPoolModuleAccountName
+	LiquidityPoolModuleAccount = ModuleName + "_liquidity_pool_account"
+
 	// Epoch identifiers
 	DelegationEpoch        = "day"
 	UndelegationEpoch      = "day"
 	RewardsEpochIdentifier = "day"
 	CValueEpoch            = "hour"
+	ProposalEpoch          = "hour"
 
 	// ICA types
 	DelegateICAType = "delegate"
 	RewardsICAType  = "rewards"
 
+	// Validator ineligibility reasons
+	IneligibleDenylisted  = "denylisted"
+	IneligibleCommission  = "commission-too-high"
+	IneligibleJailedCount = "jailed-too-often"
+	IneligibleVotingPower = "voting-power-too-high"
+
+	// Circuit breaker trip reasons
+	TripReasonCValueLimits     = "c-value-out-of-limits"
+	TripReasonCValueDeviation  = "c-value-deviation"
+	TripReasonICAChannelClosed = "ica-channel-closed"
+	TripReasonICQStale         = "icq-stale"
+	TripReasonSlash            = "slash-above-threshold"
+
 	// ICQ query types
 	// /key is required for proof generation
 	StakingStoreQuery = "store/staking/key"
 	BankStoreQuery    = "store/bank/key"
+	GovStoreQuery     = "store/gov/key"
 
 	IBCTimeoutHeightIncrement uint64 = 1000
 
 	ICATimeoutTimestamp = 15 * time.Minute
 
+	IBCMemoTransferTimeoutTimestamp = 15 * time.Minute
+
 	UnbondingStateEpochLimit = 4
+
+	// MaxICATxRetries is the number of times a timed out ICA tx is sent again
+	MaxICATxRetries uint32 = 3
+
+	// WindDownConversionLimit is the number of stk holders converted every block during a host chain wind down
+	WindDownConversionLimit uint64 = 100
+
+	// LiquidityPoolMinimumShares is the amount of shares of the first deposit of a liquidity pool that are locked in
+	// the pool, so its share price can't be inflated by donating tokens to it
+	LiquidityPoolMinimumShares int64 = 1000
+
+	// ICATxRetentionBlocks is the number of blocks a finished ICA tx is kept in the store
+	ICATxRetentionBlocks int64 = 100000
+
+	// ProposalVoteSubmissionWindow is the time before the host chain voting end time at which the stk holders
+	// voting window closes and the tallied vote is submitted
+	ProposalVoteSubmissionWindow = 6 * time.Hour
+
+	// ProposalRetentionPeriod is the time a proposal is kept in the store after its host chain voting period ends
+	ProposalRetentionPeriod = 7 * 24 * time.Hour
+
+	// DefaultAprWindow is the number of c value epochs the APR is computed over if no window is requested
+	DefaultAprWindow uint32 = 168
 )
 
 var (
@@ -50,6 +95,21 @@ var (
 	UserUnbondingKey      = []byte{0x04}
 	ValidatorUnbondingKey = []byte{0x05}
 	ParamsKey             = []byte{0x06}
+	LSMDepositKey         = []byte{0x07}
+	RedelegationKey       = []byte{0x08}
+	RewardsRecordKey      = []byte{0x09}
+	SlashKey              = []byte{0x0A}
+	ICATxKey              = []byte{0x0B}
+	LiquidityPoolKey      = []byte{0x0C}
+	LiquidityProviderKey  = []byte{0x0D}
+	HostChainProposalKey  = []byte{0x0E}
+	ProposalVoteKey       = []byte{0x0F}
+	LedgerEntryKey        = []byte{0x10}
+	LedgerSequenceKey     = []byte{0x11}
+	ExchangeRateKey       = []byte{0x12}
+	WindDownConversionKey = []byte{0x13}
+	LedgerHeightIndexKey  = []byte{0x14}
+	WindDownReserveKey    = []byte{0x15}
 )
 
 func GetUnbondingStoreKey(chainID string, epochNumber int64) []byte {
@@ -60,6 +120,57 @@ func GetUserUnbondingStoreKey(chainID, delegatorAddress string, epochNumber int6
 	return append([]byte(chainID), append([]byte(delegatorAddress), []byte(strconv.FormatInt(epochNumber, 10))...)...)
 }
 
+func GetRewardsRecordStoreKey(chainID string, epochNumber int64) []byte {
+	return append([]byte(chainID), []byte(strconv.FormatInt(epochNumber, 10))...)
+}
+
+func GetSlashStoreKey(chainID, validatorAddress string, height int64) []byte {
+	return append([]byte(chainID), append([]byte(validatorAddress), []byte(strconv.FormatInt(height, 10))...)...)
+}
+
+func GetLiquidityProviderStoreKey(chainID, address string) []byte {
+	return append([]byte(chainID), []byte(address)...)
+}
+
+func GetHostChainProposalStoreKey(chainID string, proposalID uint64) []byte {
+	return append([]byte(chainID), []byte(strconv.FormatUint(proposalID, 10))...)
+}
+
+func GetProposalVoteStoreKey(chainID string, proposalID uint64, voter string) []byte {
+	return append([]byte(chainID), append([]byte(strconv.FormatUint(proposalID, 10)), []byte(voter)...)...)
+}
+
+func GetLSMDepositStoreKey(chainID, delegatorAddress, denom string) []byte {
+	return append([]byte(chainID), append([]byte(delegatorAddress), []byte(denom)...)...)
+}
+
+func GetRedelegationStoreKey(chainID, srcValidatorAddress, dstValidatorAddress string) []byte {
+	return append([]byte(chainID), append([]byte(srcValidatorAddress), []byte(dstValidatorAddress)...)...)
+}
+
 func GetValidatorUnbondingStoreKey(chainID, validatorAddress string, epochNumber int64) []byte {
 	return append([]byte(chainID), append([]byte(validatorAddress), []byte(strconv.FormatInt(epochNumber, 10))...)...)
 }
+
+func GetLedgerEntryStoreKey(address string, id uint64) []byte {
+	return append([]byte(address), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLedgerHeightIndexStoreKey orders the ledger entries by height, so the oldest ones can be pruned first
+func GetLedgerHeightIndexStoreKey(height int64, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetExchangeRateStoreKey(chainID string, epochNumber int64) []byte {
+	return append([]byte(chainID), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+var (
+	// CValueInvariantTolerance is the max relative difference between the stk supply of a host chain and the
+	// amount it is backed by at the current c value
+	CValueInvariantTolerance = sdk.NewDecWithPrec(5, 2)
+
+	// WeightInvariantTolerance is the max difference from one of the sum of the host chain validator weights,
+	// left by the rounding of the weight redistributions
+	WeightInvariantTolerance = sdk.NewDecWithPrec(1, 12)
+)
@@ -2,10 +2,14 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -28,6 +32,15 @@ func DefaultDelegateAccountPortOwner(chainID string) string {
 	return chainID + "." + DelegateICAType
 }
 
+// DelegateAccountPortOwner generates the port owner of an additional delegate ICA given the chain id and
+// the account index, index 0 being the main delegation account
+func DelegateAccountPortOwner(chainID string, index int) string {
+	if index == 0 {
+		return DefaultDelegateAccountPortOwner(chainID)
+	}
+	return DefaultDelegateAccountPortOwner(chainID) + "." + strconv.Itoa(index)
+}
+
 // DefaultRewardsAccountPortOwner generates a rewards ICA port owner given the chain id
 // Only Use this function while registering a new chain
 func DefaultRewardsAccountPortOwner(chainID string) string {
@@ -50,6 +63,264 @@ func (deposit *Deposit) Validate() error {
 	return nil
 }
 
+func (deposit *LSMDeposit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(deposit.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if deposit.State != LSMDeposit_DEPOSIT_PENDING &&
+		deposit.State != LSMDeposit_DEPOSIT_SENT &&
+		deposit.State != LSMDeposit_DEPOSIT_RECEIVED &&
+		deposit.State != LSMDeposit_DEPOSIT_UNTOKENIZING {
+		return fmt.Errorf(
+			"host chain %s lsm deposit has an invalid state: %s",
+			deposit.ChainId,
+			deposit.State,
+		)
+	}
+	if !deposit.Amount.IsPositive() {
+		return fmt.Errorf("lsm deposit for chain %s has non positive amount", deposit.ChainId)
+	}
+	return nil
+}
+
+// GetValidatorAddress returns the validator the tokenized shares of the deposit belong to
+func (deposit *LSMDeposit) GetValidatorAddress() string {
+	validatorAddress, _, _ := strings.Cut(deposit.Denom, "/")
+	return validatorAddress
+}
+
+func (redelegation *Redelegation) Validate() error {
+	if redelegation.State != Redelegation_REDELEGATION_INITIATED &&
+		redelegation.State != Redelegation_REDELEGATION_MATURING {
+		return fmt.Errorf(
+			"host chain %s redelegation has an invalid state: %s",
+			redelegation.ChainId,
+			redelegation.State,
+		)
+	}
+	if redelegation.SrcValidatorAddress == redelegation.DstValidatorAddress {
+		return fmt.Errorf(
+			"host chain %s redelegation has the same source and destination validator: %s",
+			redelegation.ChainId,
+			redelegation.SrcValidatorAddress,
+		)
+	}
+	if err := redelegation.Amount.Validate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (record *RewardsRecord) Validate() error {
+	if record.GrossRewards.IsNegative() {
+		return fmt.Errorf("rewards record for chain %s has negative gross rewards", record.ChainId)
+	}
+	if record.RestakeFee.IsNegative() {
+		return fmt.Errorf("rewards record for chain %s has negative restake fee", record.ChainId)
+	}
+	if record.FeeMinted.IsNegative() {
+		return fmt.Errorf("rewards record for chain %s has negative fee minted", record.ChainId)
+	}
+	if record.RestakedAmount.IsNegative() {
+		return fmt.Errorf("rewards record for chain %s has negative restaked amount", record.ChainId)
+	}
+	return nil
+}
+
+func (slash *Slash) Validate() error {
+	if _, _, err := bech32.DecodeAndConvert(slash.ValidatorAddress); err != nil {
+		return err
+	}
+	if slash.Amount.IsNegative() {
+		return fmt.Errorf("slash for chain %s has negative amount", slash.ChainId)
+	}
+	if slash.Fraction.IsNegative() || slash.Fraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash for chain %s has fraction out of bounds: %s", slash.ChainId, slash.Fraction)
+	}
+	if slash.UnbondingLoss.IsNegative() {
+		return fmt.Errorf("slash for chain %s has negative unbonding loss", slash.ChainId)
+	}
+	return nil
+}
+
+func (icaTx *ICATx) Validate() error {
+	if icaTx.SequenceId == "" {
+		return fmt.Errorf("ica tx for chain %s has an empty sequence id", icaTx.ChainId)
+	}
+	if _, ok := ICATx_ICATxStatus_name[int32(icaTx.Status)]; !ok {
+		return fmt.Errorf("ica tx %s has an invalid status: %s", icaTx.SequenceId, icaTx.Status)
+	}
+	return nil
+}
+
+func (pool *LiquidityPool) Validate() error {
+	if pool.TotalShares.IsNegative() {
+		return fmt.Errorf("liquidity pool for chain %s has negative total shares", pool.ChainId)
+	}
+	return nil
+}
+
+func (lp *LiquidityProvider) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(lp.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if !lp.Shares.IsPositive() {
+		return fmt.Errorf("liquidity provider %s for chain %s has non positive shares", lp.Address, lp.ChainId)
+	}
+	return nil
+}
+
+func (proposal *HostChainProposal) Validate() error {
+	if _, ok := HostChainProposal_ProposalState_name[int32(proposal.State)]; !ok {
+		return fmt.Errorf("proposal %d for chain %s has an invalid state: %s", proposal.ProposalId, proposal.ChainId, proposal.State)
+	}
+	if proposal.TotalPower.IsNegative() {
+		return fmt.Errorf("proposal %d for chain %s has negative voting power", proposal.ProposalId, proposal.ChainId)
+	}
+	return nil
+}
+
+func (entry *LedgerEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if _, ok := LedgerEntry_EntryType_name[int32(entry.Type)]; !ok {
+		return fmt.Errorf("ledger entry %d has an invalid type: %s", entry.Id, entry.Type)
+	}
+	if err := entry.Amount.Validate(); err != nil {
+		return fmt.Errorf("ledger entry %d has an invalid amount: %w", entry.Id, err)
+	}
+	if err := entry.StkAmount.Validate(); err != nil {
+		return fmt.Errorf("ledger entry %d has an invalid stk amount: %w", entry.Id, err)
+	}
+	if err := entry.Fee.Validate(); err != nil {
+		return fmt.Errorf("ledger entry %d has an invalid fee: %w", entry.Id, err)
+	}
+	return nil
+}
+
+func (record *ExchangeRateRecord) Validate() error {
+	if record.CValue.IsNil() || !record.CValue.IsPositive() {
+		return fmt.Errorf("exchange rate record %d for chain %s has a non positive c value", record.EpochNumber, record.ChainId)
+	}
+	if record.TotalStaked.IsNil() || record.TotalStaked.IsNegative() {
+		return fmt.Errorf("exchange rate record %d for chain %s has negative total staked", record.EpochNumber, record.ChainId)
+	}
+	if record.MintedAmount.IsNil() || record.MintedAmount.IsNegative() {
+		return fmt.Errorf("exchange rate record %d for chain %s has negative minted amount", record.EpochNumber, record.ChainId)
+	}
+	return nil
+}
+
+func (reserve *WindDownReserve) Validate() error {
+	if reserve.ChainId == "" {
+		return fmt.Errorf("wind down reserve chain id can't be empty")
+	}
+	if err := sdk.ValidateDenom(reserve.MintDenom); err != nil {
+		return fmt.Errorf("wind down reserve for chain %s has an invalid mint denom: %w", reserve.ChainId, err)
+	}
+	if reserve.CValue.IsNil() || reserve.CValue.IsNegative() {
+		return fmt.Errorf("wind down reserve for chain %s has a negative c value", reserve.ChainId)
+	}
+	if err := reserve.Amount.Validate(); err != nil {
+		return fmt.Errorf("wind down reserve for chain %s has an invalid amount: %w", reserve.ChainId, err)
+	}
+	return nil
+}
+
+// ComputeApr annualises the growth of the host token value of the stk token between two exchange rate records
+func ComputeApr(from, to *ExchangeRateRecord) (sdk.Dec, error) {
+	elapsed := to.Time.Sub(from.Time)
+	if elapsed <= 0 {
+		return sdk.Dec{}, fmt.Errorf("exchange rate records must be ordered in time, got %s and %s", from.Time, to.Time)
+	}
+	if !from.CValue.IsPositive() || !to.CValue.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("exchange rate records must have positive c values")
+	}
+
+	// the c value is the stk amount per host token, so the stk token value grows as the c value drops
+	growth := from.CValue.Quo(to.CValue).Sub(sdk.OneDec())
+	year := int64(365 * 24 * time.Hour)
+
+	return growth.MulInt64(year).QuoInt64(elapsed.Nanoseconds()), nil
+}
+
+func (vote *ProposalVote) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(vote.Voter); err != nil {
+		return sdkerrors.ErrInvalidAddress
+	}
+	return ValidateWeightedVoteOptions(vote.Options)
+}
+
+// ValidateWeightedVoteOptions checks that the options are valid, not duplicated and that their weights add up to 1
+func ValidateWeightedVoteOptions(options govv1beta1.WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return fmt.Errorf("vote options cannot be empty")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	usedOptions := make(map[govv1beta1.VoteOption]bool)
+	for _, option := range options {
+		if !govv1beta1.ValidWeightedVoteOption(option) {
+			return fmt.Errorf("invalid vote option: %s", option.String())
+		}
+		if usedOptions[option.Option] {
+			return fmt.Errorf("duplicated vote option: %s", option.Option)
+		}
+
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("total vote options weight must be 1, got %s", totalWeight)
+	}
+
+	return nil
+}
+
+func (breaker *HostChainCircuitBreaker) Validate() error {
+	if _, ok := HostChainCircuitBreaker_State_name[int32(breaker.State)]; !ok {
+		return fmt.Errorf("invalid state: %s", breaker.State)
+	}
+	if breaker.MaxCValueDeviation.IsNil() || breaker.MaxCValueDeviation.IsNegative() {
+		return fmt.Errorf("max c value deviation can't be negative")
+	}
+	if breaker.MaxIcqStaleness < 0 {
+		return fmt.Errorf("max icq staleness can't be negative")
+	}
+	if breaker.MaxSlashFraction.IsNil() || breaker.MaxSlashFraction.IsNegative() ||
+		breaker.MaxSlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("max slash fraction must be between zero and one, got %s", breaker.MaxSlashFraction)
+	}
+
+	return nil
+}
+
+func (params *HostChainEligibilityParams) Validate() error {
+	if params.MaxCommission.IsNil() || params.MaxCommission.IsNegative() || params.MaxCommission.GT(sdk.OneDec()) {
+		return fmt.Errorf("max commission must be between zero and one, got %s", params.MaxCommission)
+	}
+	if params.MaxVotingPowerShare.IsNil() || params.MaxVotingPowerShare.IsNegative() ||
+		params.MaxVotingPowerShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("max voting power share must be between zero and one, got %s", params.MaxVotingPowerShare)
+	}
+
+	denylisted := make(map[string]bool)
+	for _, address := range params.Denylist {
+		if _, _, err := bech32.DecodeAndConvert(address); err != nil {
+			return fmt.Errorf("invalid denylisted validator address %s: %w", address, err)
+		}
+		if denylisted[address] {
+			return fmt.Errorf("duplicated denylisted validator address %s", address)
+		}
+		denylisted[address] = true
+	}
+
+	return nil
+}
+
 func (hc *HostChain) Validate() error {
 	if hc.Params.DepositFee.LT(sdk.ZeroDec()) {
 		return fmt.Errorf("host chain %s has negative deposit fee", hc.ChainId)
@@ -64,12 +335,32 @@ func (hc *HostChain) Validate() error {
 		return fmt.Errorf("host chain %s has negative unstake fee", hc.ChainId)
 	}
 
+	if hc.RebalanceFactor < 0 {
+		return fmt.Errorf("host chain %s has negative rebalance factor", hc.ChainId)
+	}
+
 	if hc.MinimumDeposit.LT(sdk.ZeroInt()) {
 		return fmt.Errorf("host chain %s has negative minimum deposit", hc.ChainId)
 	}
 	if hc.CValue.LT(sdk.ZeroDec()) || hc.CValue.GT(sdk.OneDec()) {
 		return fmt.Errorf("host chain %s has c value out of bounds: %d", hc.ChainId, hc.CValue)
 	}
+	if hc.GetRewardsClaimThreshold().IsNegative() {
+		return fmt.Errorf("host chain %s has negative rewards claim threshold", hc.ChainId)
+	}
+	if _, err := hc.GetDelegationStrategy(); err != nil {
+		return fmt.Errorf("host chain %s has invalid delegation params: %w", hc.ChainId, err)
+	}
+	if hc.CircuitBreaker != nil {
+		if err := hc.CircuitBreaker.Validate(); err != nil {
+			return fmt.Errorf("host chain %s has an invalid circuit breaker: %w", hc.ChainId, err)
+		}
+	}
+	if hc.EligibilityParams != nil {
+		if err := hc.EligibilityParams.Validate(); err != nil {
+			return fmt.Errorf("host chain %s has invalid eligibility params: %w", hc.ChainId, err)
+		}
+	}
 
 	for _, validator := range hc.Validators {
 		if validator.Status != stakingtypes.Unspecified.String() &&
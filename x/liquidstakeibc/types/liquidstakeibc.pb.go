@@ -6,8 +6,10 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -30,6 +32,76 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type HostChain_WindDownState int32
+
+const (
+	// the host chain is not being deregistered
+	HostChain_WIND_DOWN_NONE HostChain_WindDownState = 0
+	// deposits are stopped and every delegation is being undelegated
+	HostChain_WIND_DOWN_UNDELEGATING HostChain_WindDownState = 1
+	// the stk supply has been converted into claimable unbondings
+	HostChain_WIND_DOWN_CLAIMABLE HostChain_WindDownState = 2
+)
+
+var HostChain_WindDownState_name = map[int32]string{
+	0: "WIND_DOWN_NONE",
+	1: "WIND_DOWN_UNDELEGATING",
+	2: "WIND_DOWN_CLAIMABLE",
+}
+
+var HostChain_WindDownState_value = map[string]int32{
+	"WIND_DOWN_NONE":         0,
+	"WIND_DOWN_UNDELEGATING": 1,
+	"WIND_DOWN_CLAIMABLE":    2,
+}
+
+func (x HostChain_WindDownState) String() string {
+	return proto.EnumName(HostChain_WindDownState_name, int32(x))
+}
+
+func (HostChain_WindDownState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{0, 0}
+}
+
+type HostChainCircuitBreaker_State int32
+
+const (
+	// every operation is running
+	HostChainCircuitBreaker_CIRCUIT_BREAKER_OPERATIONAL HostChainCircuitBreaker_State = 0
+	// liquid stakes and liquidity deposits are paused
+	HostChainCircuitBreaker_CIRCUIT_BREAKER_DEPOSITS_PAUSED HostChainCircuitBreaker_State = 1
+	// unstakes are paused as well
+	HostChainCircuitBreaker_CIRCUIT_BREAKER_UNSTAKES_PAUSED HostChainCircuitBreaker_State = 2
+	// instant redemptions and liquidity withdrawals are paused as well
+	HostChainCircuitBreaker_CIRCUIT_BREAKER_REDEMPTIONS_PAUSED HostChainCircuitBreaker_State = 3
+	// every operation is halted, including the host chain workflows, except the claims of matured unbondings
+	HostChainCircuitBreaker_CIRCUIT_BREAKER_HALTED HostChainCircuitBreaker_State = 4
+)
+
+var HostChainCircuitBreaker_State_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_OPERATIONAL",
+	1: "CIRCUIT_BREAKER_DEPOSITS_PAUSED",
+	2: "CIRCUIT_BREAKER_UNSTAKES_PAUSED",
+	3: "CIRCUIT_BREAKER_REDEMPTIONS_PAUSED",
+	4: "CIRCUIT_BREAKER_HALTED",
+}
+
+var HostChainCircuitBreaker_State_value = map[string]int32{
+	"CIRCUIT_BREAKER_OPERATIONAL":        0,
+	"CIRCUIT_BREAKER_DEPOSITS_PAUSED":    1,
+	"CIRCUIT_BREAKER_UNSTAKES_PAUSED":    2,
+	"CIRCUIT_BREAKER_REDEMPTIONS_PAUSED": 3,
+	"CIRCUIT_BREAKER_HALTED":             4,
+}
+
+func (x HostChainCircuitBreaker_State) String() string {
+	return proto.EnumName(HostChainCircuitBreaker_State_name, int32(x))
+}
+
+func (HostChainCircuitBreaker_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{4, 0}
+}
+
 type ICAAccount_ChannelState int32
 
 const (
@@ -54,7 +126,7 @@ func (x ICAAccount_ChannelState) String() string {
 }
 
 func (ICAAccount_ChannelState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{2, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{6, 0}
 }
 
 type Deposit_DepositState int32
@@ -89,7 +161,7 @@ func (x Deposit_DepositState) String() string {
 }
 
 func (Deposit_DepositState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{4, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{9, 0}
 }
 
 type Unbonding_UnbondingState int32
@@ -132,7 +204,186 @@ func (x Unbonding_UnbondingState) String() string {
 }
 
 func (Unbonding_UnbondingState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_71a9a61e676043b6, []int{5, 0}
+	return fileDescriptor_71a9a61e676043b6, []int{10, 0}
+}
+
+type LSMDeposit_LSMDepositState int32
+
+const (
+	// no action has been initiated on the deposit
+	LSMDeposit_DEPOSIT_PENDING LSMDeposit_LSMDepositState = 0
+	// deposit sent to the host chain delegator address
+	LSMDeposit_DEPOSIT_SENT LSMDeposit_LSMDepositState = 1
+	// deposit received by the host chain delegator address
+	LSMDeposit_DEPOSIT_RECEIVED LSMDeposit_LSMDepositState = 2
+	// deposit shares are being redeemed into a delegation on the host chain
+	LSMDeposit_DEPOSIT_UNTOKENIZING LSMDeposit_LSMDepositState = 3
+)
+
+var LSMDeposit_LSMDepositState_name = map[int32]string{
+	0: "DEPOSIT_PENDING",
+	1: "DEPOSIT_SENT",
+	2: "DEPOSIT_RECEIVED",
+	3: "DEPOSIT_UNTOKENIZING",
+}
+
+var LSMDeposit_LSMDepositState_value = map[string]int32{
+	"DEPOSIT_PENDING":      0,
+	"DEPOSIT_SENT":         1,
+	"DEPOSIT_RECEIVED":     2,
+	"DEPOSIT_UNTOKENIZING": 3,
+}
+
+func (x LSMDeposit_LSMDepositState) String() string {
+	return proto.EnumName(LSMDeposit_LSMDepositState_name, int32(x))
+}
+
+func (LSMDeposit_LSMDepositState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{14, 0}
+}
+
+type Redelegation_RedelegationState int32
+
+const (
+	// redelegation sent to the host chain
+	Redelegation_REDELEGATION_INITIATED Redelegation_RedelegationState = 0
+	// redelegation ack received, entry maturing on the host chain
+	Redelegation_REDELEGATION_MATURING Redelegation_RedelegationState = 1
+)
+
+var Redelegation_RedelegationState_name = map[int32]string{
+	0: "REDELEGATION_INITIATED",
+	1: "REDELEGATION_MATURING",
+}
+
+var Redelegation_RedelegationState_value = map[string]int32{
+	"REDELEGATION_INITIATED": 0,
+	"REDELEGATION_MATURING":  1,
+}
+
+func (x Redelegation_RedelegationState) String() string {
+	return proto.EnumName(Redelegation_RedelegationState_name, int32(x))
+}
+
+func (Redelegation_RedelegationState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{15, 0}
+}
+
+type ICATx_ICATxStatus int32
+
+const (
+	// tx sent to the host chain, waiting for the ack
+	ICATx_ICA_TX_PENDING ICATx_ICATxStatus = 0
+	// tx executed successfully on the host chain
+	ICATx_ICA_TX_SUCCEEDED ICATx_ICATxStatus = 1
+	// tx failed on the host chain
+	ICATx_ICA_TX_FAILED ICATx_ICATxStatus = 2
+	// tx timed out, waiting to be retried
+	ICATx_ICA_TX_TIMED_OUT ICATx_ICATxStatus = 3
+	// tx timed out and has been sent again with a new sequence
+	ICATx_ICA_TX_RETRIED ICATx_ICATxStatus = 4
+)
+
+var ICATx_ICATxStatus_name = map[int32]string{
+	0: "ICA_TX_PENDING",
+	1: "ICA_TX_SUCCEEDED",
+	2: "ICA_TX_FAILED",
+	3: "ICA_TX_TIMED_OUT",
+	4: "ICA_TX_RETRIED",
+}
+
+var ICATx_ICATxStatus_value = map[string]int32{
+	"ICA_TX_PENDING":   0,
+	"ICA_TX_SUCCEEDED": 1,
+	"ICA_TX_FAILED":    2,
+	"ICA_TX_TIMED_OUT": 3,
+	"ICA_TX_RETRIED":   4,
+}
+
+func (x ICATx_ICATxStatus) String() string {
+	return proto.EnumName(ICATx_ICATxStatus_name, int32(x))
+}
+
+func (ICATx_ICATxStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{18, 0}
+}
+
+type HostChainProposal_ProposalState int32
+
+const (
+	// proposal in its deposit period on the host chain
+	HostChainProposal_PROPOSAL_DEPOSIT HostChainProposal_ProposalState = 0
+	// proposal in its voting period, stk holders can cast their votes
+	HostChainProposal_PROPOSAL_VOTING HostChainProposal_ProposalState = 1
+	// the tallied vote has been sent to the host chain, waiting for the ack
+	HostChainProposal_PROPOSAL_SUBMITTING HostChainProposal_ProposalState = 2
+	// the tallied vote has been cast on the host chain
+	HostChainProposal_PROPOSAL_SUBMITTED HostChainProposal_ProposalState = 3
+	// the tallied vote could not be cast on the host chain
+	HostChainProposal_PROPOSAL_FAILED HostChainProposal_ProposalState = 4
+	// the voting window closed without any voting power
+	HostChainProposal_PROPOSAL_NO_VOTES HostChainProposal_ProposalState = 5
+)
+
+var HostChainProposal_ProposalState_name = map[int32]string{
+	0: "PROPOSAL_DEPOSIT",
+	1: "PROPOSAL_VOTING",
+	2: "PROPOSAL_SUBMITTING",
+	3: "PROPOSAL_SUBMITTED",
+	4: "PROPOSAL_FAILED",
+	5: "PROPOSAL_NO_VOTES",
+}
+
+var HostChainProposal_ProposalState_value = map[string]int32{
+	"PROPOSAL_DEPOSIT":    0,
+	"PROPOSAL_VOTING":     1,
+	"PROPOSAL_SUBMITTING": 2,
+	"PROPOSAL_SUBMITTED":  3,
+	"PROPOSAL_FAILED":     4,
+	"PROPOSAL_NO_VOTES":   5,
+}
+
+func (x HostChainProposal_ProposalState) String() string {
+	return proto.EnumName(HostChainProposal_ProposalState_name, int32(x))
+}
+
+func (HostChainProposal_ProposalState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{21, 0}
+}
+
+type LedgerEntry_EntryType int32
+
+const (
+	// host tokens liquid staked for stk tokens
+	LedgerEntry_LEDGER_ENTRY_LIQUID_STAKE LedgerEntry_EntryType = 0
+	// stk tokens unstaked, waiting for the host tokens to unbond
+	LedgerEntry_LEDGER_ENTRY_LIQUID_UNSTAKE LedgerEntry_EntryType = 1
+	// stk tokens instantly redeemed for host tokens
+	LedgerEntry_LEDGER_ENTRY_REDEEM LedgerEntry_EntryType = 2
+	// unbonded host tokens (or stk tokens of a failed unbonding) claimed
+	LedgerEntry_LEDGER_ENTRY_CLAIM LedgerEntry_EntryType = 3
+)
+
+var LedgerEntry_EntryType_name = map[int32]string{
+	0: "LEDGER_ENTRY_LIQUID_STAKE",
+	1: "LEDGER_ENTRY_LIQUID_UNSTAKE",
+	2: "LEDGER_ENTRY_REDEEM",
+	3: "LEDGER_ENTRY_CLAIM",
+}
+
+var LedgerEntry_EntryType_value = map[string]int32{
+	"LEDGER_ENTRY_LIQUID_STAKE":   0,
+	"LEDGER_ENTRY_LIQUID_UNSTAKE": 1,
+	"LEDGER_ENTRY_REDEEM":         2,
+	"LEDGER_ENTRY_CLAIM":          3,
+}
+
+func (x LedgerEntry_EntryType) String() string {
+	return proto.EnumName(LedgerEntry_EntryType_name, int32(x))
+}
+
+func (LedgerEntry_EntryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{23, 0}
 }
 
 type HostChain struct {
@@ -166,6 +417,26 @@ type HostChain struct {
 	UnbondingFactor int64 `protobuf:"varint,14,opt,name=unbonding_factor,json=unbondingFactor,proto3" json:"unbonding_factor,omitempty"`
 	// whether the chain is ready to accept delegations or not
 	Active bool `protobuf:"varint,15,opt,name=active,proto3" json:"active,omitempty"`
+	// rebalancing epoch factor, 0 disables the rebalancing
+	RebalanceFactor int64 `protobuf:"varint,16,opt,name=rebalance_factor,json=rebalanceFactor,proto3" json:"rebalance_factor,omitempty"`
+	// whether to remove the weight of a validator when it gets slashed
+	ZeroWeightOnSlash bool `protobuf:"varint,18,opt,name=zero_weight_on_slash,json=zeroWeightOnSlash,proto3" json:"zero_weight_on_slash,omitempty"`
+	// delegation accounts used alongside the main one to spread the undelegation entries
+	AdditionalDelegationAccounts []*ICAAccount `protobuf:"bytes,19,rep,name=additional_delegation_accounts,json=additionalDelegationAccounts,proto3" json:"additional_delegation_accounts,omitempty"`
+	// id of the next host chain governance proposal to track, 0 disables the tracking
+	NextProposalId uint64 `protobuf:"varint,20,opt,name=next_proposal_id,json=nextProposalId,proto3" json:"next_proposal_id,omitempty"`
+	// auto-compounding settings, nil uses the module defaults
+	RewardsParams *HostChainRewardsParams `protobuf:"bytes,21,opt,name=rewards_params,json=rewardsParams,proto3" json:"rewards_params,omitempty"`
+	// state of the host chain deregistration
+	WindDownState HostChain_WindDownState `protobuf:"varint,22,opt,name=wind_down_state,json=windDownState,proto3,enum=pstake.liquidstakeibc.v1beta1.HostChain_WindDownState" json:"wind_down_state,omitempty"`
+	// validator allocation settings, nil uses the weight proportional strategy
+	DelegationParams *HostChainDelegationParams `protobuf:"bytes,23,opt,name=delegation_params,json=delegationParams,proto3" json:"delegation_params,omitempty"`
+	// validator delegation eligibility rules, nil makes every validator eligible
+	EligibilityParams *HostChainEligibilityParams `protobuf:"bytes,24,opt,name=eligibility_params,json=eligibilityParams,proto3" json:"eligibility_params,omitempty"`
+	// graded pause of the host chain operations, nil leaves them all running
+	CircuitBreaker *HostChainCircuitBreaker `protobuf:"bytes,25,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	// whether the stk tokens transferred to Persistence can be unstaked or redeemed with the transfer memo
+	IbcMemoExits bool `protobuf:"varint,26,opt,name=ibc_memo_exits,json=ibcMemoExits,proto3" json:"ibc_memo_exits,omitempty"`
 }
 
 func (m *HostChain) Reset()         { *m = HostChain{} }
@@ -285,6 +556,76 @@ func (m *HostChain) GetActive() bool {
 	return false
 }
 
+func (m *HostChain) GetRebalanceFactor() int64 {
+	if m != nil {
+		return m.RebalanceFactor
+	}
+	return 0
+}
+
+func (m *HostChain) GetZeroWeightOnSlash() bool {
+	if m != nil {
+		return m.ZeroWeightOnSlash
+	}
+	return false
+}
+
+func (m *HostChain) GetAdditionalDelegationAccounts() []*ICAAccount {
+	if m != nil {
+		return m.AdditionalDelegationAccounts
+	}
+	return nil
+}
+
+func (m *HostChain) GetNextProposalId() uint64 {
+	if m != nil {
+		return m.NextProposalId
+	}
+	return 0
+}
+
+func (m *HostChain) GetRewardsParams() *HostChainRewardsParams {
+	if m != nil {
+		return m.RewardsParams
+	}
+	return nil
+}
+
+func (m *HostChain) GetWindDownState() HostChain_WindDownState {
+	if m != nil {
+		return m.WindDownState
+	}
+	return HostChain_WIND_DOWN_NONE
+}
+
+func (m *HostChain) GetDelegationParams() *HostChainDelegationParams {
+	if m != nil {
+		return m.DelegationParams
+	}
+	return nil
+}
+
+func (m *HostChain) GetEligibilityParams() *HostChainEligibilityParams {
+	if m != nil {
+		return m.EligibilityParams
+	}
+	return nil
+}
+
+func (m *HostChain) GetCircuitBreaker() *HostChainCircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return nil
+}
+
+func (m *HostChain) GetIbcMemoExits() bool {
+	if m != nil {
+		return m.IbcMemoExits
+	}
+	return false
+}
+
 type HostChainLSParams struct {
 	DepositFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=deposit_fee,json=depositFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deposit_fee"`
 	RestakeFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=restake_fee,json=restakeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"restake_fee"`
@@ -325,28 +666,29 @@ func (m *HostChainLSParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HostChainLSParams proto.InternalMessageInfo
 
-type ICAAccount struct {
-	// address of the ica on the controller chain
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// token balance of the ica
-	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance"`
-	// owner string
-	Owner        string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	ChannelState ICAAccount_ChannelState `protobuf:"varint,4,opt,name=channel_state,json=channelState,proto3,enum=pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState" json:"channel_state,omitempty"`
-}
-
-func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
-func (m *ICAAccount) String() string { return proto.CompactTextString(m) }
-func (*ICAAccount) ProtoMessage()    {}
-func (*ICAAccount) Descriptor() ([]byte, []int) {
+type HostChainRewardsParams struct {
+	// epoch identifier in which the rewards are withdrawn, empty uses the
+	// module default
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// minimum estimated rewards (in host denom) a delegation needs to accrue to
+	// be withdrawn, zero withdraws every delegation
+	MinClaimThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_claim_threshold,json=minClaimThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_claim_threshold"`
+	// max amount of withdraw messages per ICA tx, zero means no limit
+	MaxMsgsPerTx uint64 `protobuf:"varint,3,opt,name=max_msgs_per_tx,json=maxMsgsPerTx,proto3" json:"max_msgs_per_tx,omitempty"`
+}
+
+func (m *HostChainRewardsParams) Reset()         { *m = HostChainRewardsParams{} }
+func (m *HostChainRewardsParams) String() string { return proto.CompactTextString(m) }
+func (*HostChainRewardsParams) ProtoMessage()    {}
+func (*HostChainRewardsParams) Descriptor() ([]byte, []int) {
 	return fileDescriptor_71a9a61e676043b6, []int{2}
 }
-func (m *ICAAccount) XXX_Unmarshal(b []byte) error {
+func (m *HostChainRewardsParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ICAAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HostChainRewardsParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ICAAccount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HostChainRewardsParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -356,75 +698,53 @@ func (m *ICAAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ICAAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ICAAccount.Merge(m, src)
+func (m *HostChainRewardsParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostChainRewardsParams.Merge(m, src)
 }
-func (m *ICAAccount) XXX_Size() int {
+func (m *HostChainRewardsParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *ICAAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_ICAAccount.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ICAAccount proto.InternalMessageInfo
-
-func (m *ICAAccount) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
+func (m *HostChainRewardsParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostChainRewardsParams.DiscardUnknown(m)
 }
 
-func (m *ICAAccount) GetBalance() types.Coin {
-	if m != nil {
-		return m.Balance
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_HostChainRewardsParams proto.InternalMessageInfo
 
-func (m *ICAAccount) GetOwner() string {
+func (m *HostChainRewardsParams) GetEpochIdentifier() string {
 	if m != nil {
-		return m.Owner
+		return m.EpochIdentifier
 	}
 	return ""
 }
 
-func (m *ICAAccount) GetChannelState() ICAAccount_ChannelState {
+func (m *HostChainRewardsParams) GetMaxMsgsPerTx() uint64 {
 	if m != nil {
-		return m.ChannelState
+		return m.MaxMsgsPerTx
 	}
-	return ICAAccount_ICA_CHANNEL_CREATING
+	return 0
 }
 
-type Validator struct {
-	// valoper address
-	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
-	// validator status
-	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	// validator weight in the set
-	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
-	// amount delegated by the module to the validator
-	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
-	// total amount delegated to the validator (including amount not delegated by the module)
-	TotalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_amount"`
-	// total number of shares issued by the validator to its delegators
-	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares"`
-	// the unbonding epoch number when the validator transitioned into the state
-	UnbondingEpoch int64 `protobuf:"varint,7,opt,name=unbonding_epoch,json=unbondingEpoch,proto3" json:"unbonding_epoch,omitempty"`
+type HostChainDelegationParams struct {
+	// name of the strategy splitting the delegations and undelegations amongst
+	// the validators, empty uses the weight proportional one
+	Strategy string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// max share of the total delegations a validator can hold, only used by the
+	// capped per validator strategy
+	ValidatorCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=validator_cap,json=validatorCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_cap"`
 }
 
-func (m *Validator) Reset()         { *m = Validator{} }
-func (m *Validator) String() string { return proto.CompactTextString(m) }
-func (*Validator) ProtoMessage()    {}
-func (*Validator) Descriptor() ([]byte, []int) {
+func (m *HostChainDelegationParams) Reset()         { *m = HostChainDelegationParams{} }
+func (m *HostChainDelegationParams) String() string { return proto.CompactTextString(m) }
+func (*HostChainDelegationParams) ProtoMessage()    {}
+func (*HostChainDelegationParams) Descriptor() ([]byte, []int) {
 	return fileDescriptor_71a9a61e676043b6, []int{3}
 }
-func (m *Validator) XXX_Unmarshal(b []byte) error {
+func (m *HostChainDelegationParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HostChainDelegationParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HostChainDelegationParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -434,63 +754,58 @@ func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Validator) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Validator.Merge(m, src)
+func (m *HostChainDelegationParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostChainDelegationParams.Merge(m, src)
 }
-func (m *Validator) XXX_Size() int {
+func (m *HostChainDelegationParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *Validator) XXX_DiscardUnknown() {
-	xxx_messageInfo_Validator.DiscardUnknown(m)
+func (m *HostChainDelegationParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostChainDelegationParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Validator proto.InternalMessageInfo
-
-func (m *Validator) GetOperatorAddress() string {
-	if m != nil {
-		return m.OperatorAddress
-	}
-	return ""
-}
+var xxx_messageInfo_HostChainDelegationParams proto.InternalMessageInfo
 
-func (m *Validator) GetStatus() string {
+func (m *HostChainDelegationParams) GetStrategy() string {
 	if m != nil {
-		return m.Status
+		return m.Strategy
 	}
 	return ""
 }
 
-func (m *Validator) GetUnbondingEpoch() int64 {
-	if m != nil {
-		return m.UnbondingEpoch
-	}
-	return 0
-}
-
-type Deposit struct {
-	// deposit target chain
-	ChainId string     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
-	// epoch number of the deposit
-	Epoch github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch"`
-	// state
-	State Deposit_DepositState `protobuf:"varint,4,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Deposit_DepositState" json:"state,omitempty"`
-	// sequence id of the ibc transaction
-	IbcSequenceId string `protobuf:"bytes,5,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
-}
-
-func (m *Deposit) Reset()         { *m = Deposit{} }
-func (m *Deposit) String() string { return proto.CompactTextString(m) }
-func (*Deposit) ProtoMessage()    {}
-func (*Deposit) Descriptor() ([]byte, []int) {
+type HostChainCircuitBreaker struct {
+	// current state, only moved up by the triggers and reset by the authorities
+	State HostChainCircuitBreaker_State `protobuf:"varint,1,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.HostChainCircuitBreaker_State" json:"state,omitempty"`
+	// trigger that set the current state
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// block height at which the current state was set
+	TrippedHeight int64 `protobuf:"varint,3,opt,name=tripped_height,json=trippedHeight,proto3" json:"tripped_height,omitempty"`
+	// max relative change of the c value between two epochs, trips
+	// REDEMPTIONS_PAUSED, zero disables it
+	MaxCValueDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_c_value_deviation,json=maxCValueDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_c_value_deviation"`
+	// trip DEPOSITS_PAUSED when a delegation ica channel closes
+	TripOnIcaClose bool `protobuf:"varint,5,opt,name=trip_on_ica_close,json=tripOnIcaClose,proto3" json:"trip_on_ica_close,omitempty"`
+	// max blocks without an icq callback, trips UNSTAKES_PAUSED, zero disables it
+	MaxIcqStaleness int64 `protobuf:"varint,6,opt,name=max_icq_staleness,json=maxIcqStaleness,proto3" json:"max_icq_staleness,omitempty"`
+	// max share of the host chain delegations lost in a single slash, trips
+	// REDEMPTIONS_PAUSED, zero disables it
+	MaxSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_slash_fraction,json=maxSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slash_fraction"`
+	// block height of the last icq callback received
+	LastIcqHeight int64 `protobuf:"varint,8,opt,name=last_icq_height,json=lastIcqHeight,proto3" json:"last_icq_height,omitempty"`
+}
+
+func (m *HostChainCircuitBreaker) Reset()         { *m = HostChainCircuitBreaker{} }
+func (m *HostChainCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*HostChainCircuitBreaker) ProtoMessage()    {}
+func (*HostChainCircuitBreaker) Descriptor() ([]byte, []int) {
 	return fileDescriptor_71a9a61e676043b6, []int{4}
 }
-func (m *Deposit) XXX_Unmarshal(b []byte) error {
+func (m *HostChainCircuitBreaker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HostChainCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HostChainCircuitBreaker.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -500,75 +815,85 @@ func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Deposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Deposit.Merge(m, src)
+func (m *HostChainCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostChainCircuitBreaker.Merge(m, src)
 }
-func (m *Deposit) XXX_Size() int {
+func (m *HostChainCircuitBreaker) XXX_Size() int {
 	return m.Size()
 }
-func (m *Deposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_Deposit.DiscardUnknown(m)
+func (m *HostChainCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostChainCircuitBreaker.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Deposit proto.InternalMessageInfo
+var xxx_messageInfo_HostChainCircuitBreaker proto.InternalMessageInfo
 
-func (m *Deposit) GetChainId() string {
+func (m *HostChainCircuitBreaker) GetState() HostChainCircuitBreaker_State {
 	if m != nil {
-		return m.ChainId
+		return m.State
+	}
+	return HostChainCircuitBreaker_CIRCUIT_BREAKER_OPERATIONAL
+}
+
+func (m *HostChainCircuitBreaker) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *Deposit) GetAmount() types.Coin {
+func (m *HostChainCircuitBreaker) GetTrippedHeight() int64 {
 	if m != nil {
-		return m.Amount
+		return m.TrippedHeight
 	}
-	return types.Coin{}
+	return 0
 }
 
-func (m *Deposit) GetState() Deposit_DepositState {
+func (m *HostChainCircuitBreaker) GetTripOnIcaClose() bool {
 	if m != nil {
-		return m.State
+		return m.TripOnIcaClose
 	}
-	return Deposit_DEPOSIT_PENDING
+	return false
 }
 
-func (m *Deposit) GetIbcSequenceId() string {
+func (m *HostChainCircuitBreaker) GetMaxIcqStaleness() int64 {
 	if m != nil {
-		return m.IbcSequenceId
+		return m.MaxIcqStaleness
 	}
-	return ""
+	return 0
 }
 
-type Unbonding struct {
-	// unbonding target chain
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// epoch number of the unbonding record
-	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	// time when the unbonding matures and can be collected
-	MatureTime time.Time `protobuf:"bytes,3,opt,name=mature_time,json=matureTime,proto3,stdtime" json:"mature_time"`
-	// stk token amount that is burned with the unbonding
-	BurnAmount types.Coin `protobuf:"bytes,4,opt,name=burn_amount,json=burnAmount,proto3" json:"burn_amount"`
-	// host token amount that is being unbonded
-	UnbondAmount types.Coin `protobuf:"bytes,5,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount"`
-	// sequence id of the ibc transaction
-	IbcSequenceId string `protobuf:"bytes,6,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
-	// state of the unbonding during the process
-	State Unbonding_UnbondingState `protobuf:"varint,7,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState" json:"state,omitempty"`
+func (m *HostChainCircuitBreaker) GetLastIcqHeight() int64 {
+	if m != nil {
+		return m.LastIcqHeight
+	}
+	return 0
 }
 
-func (m *Unbonding) Reset()         { *m = Unbonding{} }
-func (m *Unbonding) String() string { return proto.CompactTextString(m) }
-func (*Unbonding) ProtoMessage()    {}
-func (*Unbonding) Descriptor() ([]byte, []int) {
+type HostChainEligibilityParams struct {
+	// max commission rate of a validator, zero disables the rule
+	MaxCommission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_commission,json=maxCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission"`
+	// max times a validator can have been jailed while tracked, zero disables
+	// the rule
+	MaxJailedCount uint32 `protobuf:"varint,2,opt,name=max_jailed_count,json=maxJailedCount,proto3" json:"max_jailed_count,omitempty"`
+	// max share of the host chain bonded tokens a validator can hold, zero
+	// disables the rule
+	MaxVotingPowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_voting_power_share,json=maxVotingPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_voting_power_share"`
+	// validators that can't be delegated to
+	Denylist []string `protobuf:"bytes,4,rep,name=denylist,proto3" json:"denylist,omitempty"`
+}
+
+func (m *HostChainEligibilityParams) Reset()         { *m = HostChainEligibilityParams{} }
+func (m *HostChainEligibilityParams) String() string { return proto.CompactTextString(m) }
+func (*HostChainEligibilityParams) ProtoMessage()    {}
+func (*HostChainEligibilityParams) Descriptor() ([]byte, []int) {
 	return fileDescriptor_71a9a61e676043b6, []int{5}
 }
-func (m *Unbonding) XXX_Unmarshal(b []byte) error {
+func (m *HostChainEligibilityParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Unbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HostChainEligibilityParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Unbonding.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HostChainEligibilityParams.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -578,92 +903,56 @@ func (m *Unbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Unbonding) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Unbonding.Merge(m, src)
+func (m *HostChainEligibilityParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostChainEligibilityParams.Merge(m, src)
 }
-func (m *Unbonding) XXX_Size() int {
+func (m *HostChainEligibilityParams) XXX_Size() int {
 	return m.Size()
 }
-func (m *Unbonding) XXX_DiscardUnknown() {
-	xxx_messageInfo_Unbonding.DiscardUnknown(m)
+func (m *HostChainEligibilityParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostChainEligibilityParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Unbonding proto.InternalMessageInfo
-
-func (m *Unbonding) GetChainId() string {
-	if m != nil {
-		return m.ChainId
-	}
-	return ""
-}
+var xxx_messageInfo_HostChainEligibilityParams proto.InternalMessageInfo
 
-func (m *Unbonding) GetEpochNumber() int64 {
+func (m *HostChainEligibilityParams) GetMaxJailedCount() uint32 {
 	if m != nil {
-		return m.EpochNumber
+		return m.MaxJailedCount
 	}
 	return 0
 }
 
-func (m *Unbonding) GetMatureTime() time.Time {
-	if m != nil {
-		return m.MatureTime
-	}
-	return time.Time{}
-}
-
-func (m *Unbonding) GetBurnAmount() types.Coin {
-	if m != nil {
-		return m.BurnAmount
-	}
-	return types.Coin{}
-}
-
-func (m *Unbonding) GetUnbondAmount() types.Coin {
-	if m != nil {
-		return m.UnbondAmount
-	}
-	return types.Coin{}
-}
-
-func (m *Unbonding) GetIbcSequenceId() string {
-	if m != nil {
-		return m.IbcSequenceId
-	}
-	return ""
-}
-
-func (m *Unbonding) GetState() Unbonding_UnbondingState {
+func (m *HostChainEligibilityParams) GetDenylist() []string {
 	if m != nil {
-		return m.State
+		return m.Denylist
 	}
-	return Unbonding_UNBONDING_PENDING
+	return nil
 }
 
-type UserUnbonding struct {
-	// unbonding target chain
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// epoch when the unbonding started
-	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	// address which requested the unbonding
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	// stk token amount that is being unbonded
-	StkAmount types.Coin `protobuf:"bytes,4,opt,name=stk_amount,json=stkAmount,proto3" json:"stk_amount"`
-	// host token amount that is being unbonded
-	UnbondAmount types.Coin `protobuf:"bytes,5,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount"`
+type ICAAccount struct {
+	// address of the ica on the controller chain
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// token balance of the ica
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coin" json:"balance"`
+	// owner string
+	Owner        string                  `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	ChannelState ICAAccount_ChannelState `protobuf:"varint,4,opt,name=channel_state,json=channelState,proto3,enum=pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState" json:"channel_state,omitempty"`
+	// delegations of the account, only tracked for additional delegation accounts
+	Delegations []*AccountDelegation `protobuf:"bytes,5,rep,name=delegations,proto3" json:"delegations,omitempty"`
 }
 
-func (m *UserUnbonding) Reset()         { *m = UserUnbonding{} }
-func (m *UserUnbonding) String() string { return proto.CompactTextString(m) }
-func (*UserUnbonding) ProtoMessage()    {}
-func (*UserUnbonding) Descriptor() ([]byte, []int) {
+func (m *ICAAccount) Reset()         { *m = ICAAccount{} }
+func (m *ICAAccount) String() string { return proto.CompactTextString(m) }
+func (*ICAAccount) ProtoMessage()    {}
+func (*ICAAccount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_71a9a61e676043b6, []int{6}
 }
-func (m *UserUnbonding) XXX_Unmarshal(b []byte) error {
+func (m *ICAAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UserUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ICAAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UserUnbonding.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ICAAccount.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -673,80 +962,72 @@ func (m *UserUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *UserUnbonding) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserUnbonding.Merge(m, src)
+func (m *ICAAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICAAccount.Merge(m, src)
 }
-func (m *UserUnbonding) XXX_Size() int {
+func (m *ICAAccount) XXX_Size() int {
 	return m.Size()
 }
-func (m *UserUnbonding) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserUnbonding.DiscardUnknown(m)
+func (m *ICAAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICAAccount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UserUnbonding proto.InternalMessageInfo
+var xxx_messageInfo_ICAAccount proto.InternalMessageInfo
 
-func (m *UserUnbonding) GetChainId() string {
+func (m *ICAAccount) GetAddress() string {
 	if m != nil {
-		return m.ChainId
+		return m.Address
 	}
 	return ""
 }
 
-func (m *UserUnbonding) GetEpochNumber() int64 {
+func (m *ICAAccount) GetBalance() types.Coin {
 	if m != nil {
-		return m.EpochNumber
+		return m.Balance
 	}
-	return 0
+	return types.Coin{}
 }
 
-func (m *UserUnbonding) GetAddress() string {
+func (m *ICAAccount) GetOwner() string {
 	if m != nil {
-		return m.Address
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *UserUnbonding) GetStkAmount() types.Coin {
+func (m *ICAAccount) GetChannelState() ICAAccount_ChannelState {
 	if m != nil {
-		return m.StkAmount
+		return m.ChannelState
 	}
-	return types.Coin{}
+	return ICAAccount_ICA_CHANNEL_CREATING
 }
 
-func (m *UserUnbonding) GetUnbondAmount() types.Coin {
+func (m *ICAAccount) GetDelegations() []*AccountDelegation {
 	if m != nil {
-		return m.UnbondAmount
+		return m.Delegations
 	}
-	return types.Coin{}
+	return nil
 }
 
-type ValidatorUnbonding struct {
-	// unbonding target chain
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// epoch when the unbonding started
-	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
-	// time when the unbonding matures and can be collected
-	MatureTime time.Time `protobuf:"bytes,3,opt,name=mature_time,json=matureTime,proto3,stdtime" json:"mature_time"`
-	// address of the validator that is being unbonded
-	ValidatorAddress string `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
-	// amount unbonded from the validator
-	Amount types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
-	// sequence id of the ibc transaction
-	IbcSequenceId string `protobuf:"bytes,6,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+type AccountDelegation struct {
+	// valoper address
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount delegated by the account to the validator
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
 }
 
-func (m *ValidatorUnbonding) Reset()         { *m = ValidatorUnbonding{} }
-func (m *ValidatorUnbonding) String() string { return proto.CompactTextString(m) }
-func (*ValidatorUnbonding) ProtoMessage()    {}
-func (*ValidatorUnbonding) Descriptor() ([]byte, []int) {
+func (m *AccountDelegation) Reset()         { *m = AccountDelegation{} }
+func (m *AccountDelegation) String() string { return proto.CompactTextString(m) }
+func (*AccountDelegation) ProtoMessage()    {}
+func (*AccountDelegation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_71a9a61e676043b6, []int{7}
 }
-func (m *ValidatorUnbonding) XXX_Unmarshal(b []byte) error {
+func (m *AccountDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ValidatorUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ValidatorUnbonding.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -756,77 +1037,60 @@ func (m *ValidatorUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ValidatorUnbonding) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidatorUnbonding.Merge(m, src)
+func (m *AccountDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountDelegation.Merge(m, src)
 }
-func (m *ValidatorUnbonding) XXX_Size() int {
+func (m *AccountDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *ValidatorUnbonding) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidatorUnbonding.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ValidatorUnbonding proto.InternalMessageInfo
-
-func (m *ValidatorUnbonding) GetChainId() string {
-	if m != nil {
-		return m.ChainId
-	}
-	return ""
-}
-
-func (m *ValidatorUnbonding) GetEpochNumber() int64 {
-	if m != nil {
-		return m.EpochNumber
-	}
-	return 0
+func (m *AccountDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountDelegation.DiscardUnknown(m)
 }
 
-func (m *ValidatorUnbonding) GetMatureTime() time.Time {
-	if m != nil {
-		return m.MatureTime
-	}
-	return time.Time{}
-}
+var xxx_messageInfo_AccountDelegation proto.InternalMessageInfo
 
-func (m *ValidatorUnbonding) GetValidatorAddress() string {
+func (m *AccountDelegation) GetValidatorAddress() string {
 	if m != nil {
 		return m.ValidatorAddress
 	}
 	return ""
 }
 
-func (m *ValidatorUnbonding) GetAmount() types.Coin {
-	if m != nil {
-		return m.Amount
-	}
-	return types.Coin{}
-}
-
-func (m *ValidatorUnbonding) GetIbcSequenceId() string {
-	if m != nil {
-		return m.IbcSequenceId
-	}
-	return ""
-}
-
-type KVUpdate struct {
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+type Validator struct {
+	// valoper address
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// validator status
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// validator weight in the set
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+	// amount delegated by the module to the validator
+	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
+	// total amount delegated to the validator (including amount not delegated by the module)
+	TotalAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_amount"`
+	// total number of shares issued by the validator to its delegators
+	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares"`
+	// the unbonding epoch number when the validator transitioned into the state
+	UnbondingEpoch int64 `protobuf:"varint,7,opt,name=unbonding_epoch,json=unbondingEpoch,proto3" json:"unbonding_epoch,omitempty"`
+	// validator commission rate
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate"`
+	// whether the validator is jailed
+	Jailed bool `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// times the validator has been jailed since it is tracked
+	JailedCount uint32 `protobuf:"varint,10,opt,name=jailed_count,json=jailedCount,proto3" json:"jailed_count,omitempty"`
 }
 
-func (m *KVUpdate) Reset()         { *m = KVUpdate{} }
-func (m *KVUpdate) String() string { return proto.CompactTextString(m) }
-func (*KVUpdate) ProtoMessage()    {}
-func (*KVUpdate) Descriptor() ([]byte, []int) {
+func (m *Validator) Reset()         { *m = Validator{} }
+func (m *Validator) String() string { return proto.CompactTextString(m) }
+func (*Validator) ProtoMessage()    {}
+func (*Validator) Descriptor() ([]byte, []int) {
 	return fileDescriptor_71a9a61e676043b6, []int{8}
 }
-func (m *KVUpdate) XXX_Unmarshal(b []byte) error {
+func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *KVUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Validator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_KVUpdate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Validator.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -836,1072 +1100,8143 @@ func (m *KVUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *KVUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KVUpdate.Merge(m, src)
+func (m *Validator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Validator.Merge(m, src)
 }
-func (m *KVUpdate) XXX_Size() int {
+func (m *Validator) XXX_Size() int {
 	return m.Size()
 }
-func (m *KVUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_KVUpdate.DiscardUnknown(m)
+func (m *Validator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Validator.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KVUpdate proto.InternalMessageInfo
+var xxx_messageInfo_Validator proto.InternalMessageInfo
 
-func (m *KVUpdate) GetKey() string {
+func (m *Validator) GetOperatorAddress() string {
 	if m != nil {
-		return m.Key
+		return m.OperatorAddress
 	}
 	return ""
 }
 
-func (m *KVUpdate) GetValue() string {
+func (m *Validator) GetStatus() string {
 	if m != nil {
-		return m.Value
+		return m.Status
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState", ICAAccount_ChannelState_name, ICAAccount_ChannelState_value)
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Deposit_DepositState", Deposit_DepositState_name, Deposit_DepositState_value)
-	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState", Unbonding_UnbondingState_name, Unbonding_UnbondingState_value)
-	proto.RegisterType((*HostChain)(nil), "pstake.liquidstakeibc.v1beta1.HostChain")
-	proto.RegisterType((*HostChainLSParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainLSParams")
-	proto.RegisterType((*ICAAccount)(nil), "pstake.liquidstakeibc.v1beta1.ICAAccount")
-	proto.RegisterType((*Validator)(nil), "pstake.liquidstakeibc.v1beta1.Validator")
-	proto.RegisterType((*Deposit)(nil), "pstake.liquidstakeibc.v1beta1.Deposit")
-	proto.RegisterType((*Unbonding)(nil), "pstake.liquidstakeibc.v1beta1.Unbonding")
-	proto.RegisterType((*UserUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.UserUnbonding")
-	proto.RegisterType((*ValidatorUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorUnbonding")
-	proto.RegisterType((*KVUpdate)(nil), "pstake.liquidstakeibc.v1beta1.KVUpdate")
-}
-
-func init() {
-	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/liquidstakeibc.proto", fileDescriptor_71a9a61e676043b6)
+func (m *Validator) GetUnbondingEpoch() int64 {
+	if m != nil {
+		return m.UnbondingEpoch
+	}
+	return 0
 }
 
-var fileDescriptor_71a9a61e676043b6 = []byte{
-	// 1384 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0x13, 0xc7,
-	0x1b, 0xcf, 0xc6, 0x8e, 0x9d, 0x3c, 0x7e, 0xdb, 0x0c, 0xf9, 0x83, 0x41, 0xc2, 0xc9, 0xdf, 0x95,
-	0xc0, 0x1c, 0x62, 0x17, 0x23, 0x15, 0xa9, 0xaa, 0xaa, 0x3a, 0xf6, 0x86, 0xac, 0x1a, 0x0c, 0x5a,
-	0x3b, 0x51, 0x55, 0xd4, 0xae, 0xc6, 0xbb, 0x83, 0xbd, 0x8a, 0xbd, 0x6b, 0x76, 0xc6, 0x01, 0x3e,
-	0x41, 0xd5, 0x1b, 0x97, 0x4a, 0x55, 0x0f, 0x55, 0xcf, 0x3d, 0xf5, 0xc0, 0x17, 0xe8, 0x8d, 0x23,
-	0xe2, 0x54, 0x55, 0x15, 0x54, 0xf0, 0x19, 0x7a, 0xaf, 0xe6, 0x65, 0xd7, 0xe6, 0x45, 0xc4, 0x11,
-	0x3e, 0xf4, 0xe4, 0x99, 0xdf, 0xb3, 0xcf, 0xef, 0x99, 0x79, 0x5e, 0xc7, 0x50, 0x1f, 0x53, 0x86,
-	0x8f, 0x48, 0x6d, 0xe8, 0xdd, 0x9b, 0x78, 0xae, 0x58, 0x7b, 0x3d, 0xa7, 0x76, 0x7c, 0xb5, 0x47,
-	0x18, 0xbe, 0xfa, 0x06, 0x5c, 0x1d, 0x87, 0x01, 0x0b, 0xd0, 0x45, 0xa9, 0x53, 0x7d, 0x43, 0xa8,
-	0x74, 0x2e, 0x6c, 0xf4, 0x83, 0x7e, 0x20, 0xbe, 0xac, 0xf1, 0x95, 0x54, 0xba, 0x70, 0xde, 0x09,
-	0xe8, 0x28, 0xa0, 0xb6, 0x14, 0xc8, 0x8d, 0x12, 0x95, 0xe4, 0xae, 0xd6, 0xc3, 0x94, 0xc4, 0x96,
-	0x9d, 0xc0, 0xf3, 0x95, 0x7c, 0xb3, 0x1f, 0x04, 0xfd, 0x21, 0xa9, 0x89, 0x5d, 0x6f, 0x72, 0xb7,
-	0xc6, 0xbc, 0x11, 0xa1, 0x0c, 0x8f, 0xc6, 0xf2, 0x83, 0xf2, 0x3f, 0x29, 0x58, 0xdb, 0x0b, 0x28,
-	0x6b, 0x0e, 0xb0, 0xe7, 0xa3, 0xf3, 0xb0, 0xea, 0xf0, 0x85, 0xed, 0xb9, 0x45, 0x6d, 0x4b, 0xab,
-	0xac, 0x59, 0x69, 0xb1, 0x37, 0x5d, 0xf4, 0x11, 0xe4, 0x9c, 0xc0, 0xf7, 0x89, 0xc3, 0xbc, 0x40,
-	0xc8, 0x97, 0x85, 0x3c, 0x3b, 0x05, 0x4d, 0x17, 0xed, 0x41, 0x6a, 0x8c, 0x43, 0x3c, 0xa2, 0xc5,
-	0xc4, 0x96, 0x56, 0xc9, 0xd4, 0x3f, 0xae, 0xbe, 0xf7, 0xbe, 0xd5, 0xd8, 0xf2, 0x7e, 0xe7, 0xb6,
-	0xd0, 0xb3, 0x94, 0x3e, 0xba, 0x08, 0x30, 0x08, 0x28, 0xb3, 0x5d, 0xe2, 0x07, 0xa3, 0x62, 0x52,
-	0xd8, 0x5a, 0xe3, 0x48, 0x8b, 0x03, 0x5c, 0xec, 0x0c, 0xb0, 0xef, 0x93, 0x21, 0x3f, 0xca, 0x8a,
-	0x14, 0x2b, 0xc4, 0x74, 0xd1, 0x39, 0x48, 0x8f, 0x83, 0x90, 0x71, 0x59, 0x4a, 0xc8, 0x52, 0x7c,
-	0x6b, 0xba, 0xe8, 0x2b, 0x40, 0x2e, 0x19, 0x92, 0x3e, 0x16, 0xb7, 0xc0, 0x8e, 0x13, 0x4c, 0x7c,
-	0x56, 0x4c, 0x8b, 0xc3, 0x5e, 0x39, 0xe1, 0xb0, 0x66, 0xb3, 0xd1, 0x90, 0x0a, 0xd6, 0xfa, 0x94,
-	0x44, 0x41, 0xc8, 0x82, 0x42, 0x48, 0xee, 0xe3, 0xd0, 0xa5, 0x31, 0xed, 0xea, 0x69, 0x69, 0xf3,
-	0x8a, 0x21, 0xe2, 0xdc, 0x03, 0x38, 0xc6, 0x43, 0xcf, 0xc5, 0x2c, 0x08, 0x69, 0x71, 0x6d, 0x2b,
-	0x51, 0xc9, 0xd4, 0x2b, 0x27, 0xd0, 0x1d, 0x46, 0x0a, 0xd6, 0x8c, 0x2e, 0x22, 0x50, 0x18, 0x79,
-	0xbe, 0x37, 0x9a, 0x8c, 0x6c, 0x97, 0x8c, 0x03, 0xea, 0xb1, 0x22, 0x70, 0xc7, 0xec, 0x7c, 0xf6,
-	0xe4, 0xf9, 0xe6, 0xd2, 0x9f, 0xcf, 0x37, 0x2f, 0xf5, 0x3d, 0x36, 0x98, 0xf4, 0xaa, 0x4e, 0x30,
-	0x52, 0x19, 0xa6, 0x7e, 0xb6, 0xa9, 0x7b, 0x54, 0x63, 0x0f, 0xc7, 0x84, 0x56, 0x4d, 0x9f, 0x3d,
-	0x7b, 0xbc, 0x0d, 0x2a, 0x01, 0x4d, 0x7e, 0x60, 0x45, 0xda, 0x92, 0x9c, 0xe8, 0x00, 0xd2, 0x8e,
-	0x7d, 0x8c, 0x87, 0x13, 0x52, 0xcc, 0x9c, 0x9a, 0xbe, 0x45, 0x9c, 0x19, 0xfa, 0x16, 0x71, 0xac,
-	0x94, 0x73, 0xc8, 0xb9, 0xd0, 0xb7, 0x90, 0x1d, 0x62, 0xca, 0xec, 0x88, 0x3b, 0xbb, 0x00, 0x6e,
-	0xe0, 0x8c, 0x4d, 0xc9, 0x5f, 0x01, 0xdd, 0x27, 0x0f, 0x18, 0x67, 0xa7, 0x84, 0xd9, 0x03, 0x4c,
-	0x07, 0xc5, 0xdc, 0x96, 0x56, 0xc9, 0x5a, 0x79, 0x8e, 0x1f, 0x0a, 0x78, 0x0f, 0xd3, 0x01, 0xba,
-	0x02, 0xfa, 0xc4, 0xef, 0x05, 0xbe, 0xeb, 0xf9, 0x7d, 0xfb, 0x2e, 0x76, 0x58, 0x10, 0x16, 0xf3,
-	0x5b, 0x5a, 0x25, 0x61, 0x15, 0x62, 0x7c, 0x57, 0xc0, 0xe8, 0x2c, 0xa4, 0xb0, 0xc3, 0xbc, 0x63,
-	0x52, 0x2c, 0x6c, 0x69, 0x95, 0x55, 0x4b, 0xed, 0x3e, 0x4d, 0xfe, 0xf8, 0xcb, 0xa6, 0x56, 0xfe,
-	0x21, 0x01, 0xeb, 0x6f, 0x65, 0x3f, 0xfa, 0x06, 0x32, 0x2a, 0x3c, 0xf6, 0x5d, 0x42, 0x64, 0x09,
-	0x7e, 0xe8, 0x3d, 0x15, 0xe1, 0x2e, 0x21, 0x9c, 0x3e, 0x24, 0x22, 0x63, 0x04, 0xfd, 0xf2, 0x22,
-	0xe8, 0x15, 0xa1, 0xa2, 0x9f, 0xf8, 0x53, 0xfa, 0xc4, 0x22, 0xe8, 0x15, 0x21, 0xa7, 0x77, 0x20,
-	0x1f, 0x12, 0x97, 0x8c, 0xc6, 0xa2, 0x76, 0xb9, 0x85, 0xe4, 0x02, 0x2c, 0xe4, 0xa6, 0x9c, 0xbb,
-	0x84, 0x94, 0xff, 0x5a, 0x06, 0x98, 0x56, 0x24, 0xaa, 0x43, 0x1a, 0xbb, 0x6e, 0x48, 0x28, 0x55,
-	0xc1, 0x28, 0x3e, 0x7b, 0xbc, 0xbd, 0xa1, 0xd4, 0x1b, 0x52, 0xd2, 0x61, 0xa1, 0xe7, 0xf7, 0xad,
-	0xe8, 0x43, 0xe4, 0x42, 0xba, 0x87, 0x87, 0xd8, 0x77, 0xa4, 0x87, 0x33, 0xf5, 0xf3, 0x55, 0xa5,
-	0xc0, 0xbb, 0x74, 0x5c, 0xa8, 0xcd, 0xc0, 0xf3, 0x77, 0x6a, 0xfc, 0xec, 0xbf, 0xbe, 0xd8, 0xbc,
-	0x3c, 0xc7, 0xd9, 0xb9, 0x82, 0x15, 0x51, 0xa3, 0x0d, 0x58, 0x09, 0xee, 0xfb, 0x24, 0x94, 0x6e,
-	0xb6, 0xe4, 0x06, 0xdd, 0x81, 0x5c, 0xd4, 0x17, 0x29, 0xc3, 0x4c, 0xba, 0x28, 0x5f, 0xff, 0x64,
-	0xee, 0x1e, 0x54, 0x6d, 0x4a, 0xf5, 0x0e, 0xd7, 0xb6, 0xb2, 0xce, 0xcc, 0xae, 0xdc, 0x80, 0xec,
-	0xac, 0x14, 0x15, 0x61, 0xc3, 0x6c, 0x36, 0xec, 0xe6, 0x5e, 0xa3, 0xdd, 0x36, 0xf6, 0xed, 0xa6,
-	0x65, 0x34, 0xba, 0x66, 0xfb, 0x86, 0xbe, 0x84, 0xce, 0xc1, 0x99, 0xb7, 0x24, 0x46, 0x4b, 0xd7,
-	0xca, 0x3f, 0x25, 0x61, 0x2d, 0xee, 0x50, 0xa8, 0x09, 0x7a, 0x30, 0x26, 0x21, 0x5f, 0xdb, 0xf3,
-	0xba, 0xb9, 0x10, 0x69, 0x28, 0x98, 0xd7, 0x19, 0xbf, 0xea, 0x84, 0xaa, 0x89, 0xa4, 0x76, 0xa8,
-	0x0b, 0xa9, 0xfb, 0xc4, 0xeb, 0x0f, 0xd8, 0x42, 0x12, 0x51, 0x71, 0xa1, 0x3e, 0xe8, 0xaa, 0xf7,
-	0x13, 0xd7, 0xc6, 0x23, 0xd1, 0xe7, 0x93, 0x0b, 0xe8, 0xa4, 0x85, 0x98, 0xb5, 0x21, 0x48, 0x91,
-	0x0d, 0x59, 0x16, 0x30, 0x3c, 0x8c, 0x8c, 0xac, 0x2c, 0xc0, 0x48, 0x46, 0x30, 0x2a, 0x03, 0xd3,
-	0x9b, 0x04, 0xa1, 0x4d, 0x07, 0x38, 0x24, 0x54, 0x0e, 0xcb, 0x0f, 0xf4, 0x54, 0x21, 0x66, 0xed,
-	0x08, 0x52, 0x74, 0x19, 0xa6, 0xbd, 0xd1, 0x26, 0xe3, 0xc0, 0x19, 0x88, 0x81, 0x9b, 0xb0, 0xf2,
-	0x31, 0x6c, 0x70, 0xb4, 0xfc, 0x7d, 0x02, 0xd2, 0xd1, 0x24, 0x79, 0xcf, 0x4b, 0xe4, 0x3a, 0xa4,
-	0x94, 0x4f, 0x4e, 0x2c, 0xaf, 0x24, 0xbf, 0x89, 0xa5, 0x3e, 0x47, 0x16, 0xac, 0x48, 0xf3, 0x89,
-	0x05, 0xf8, 0x52, 0x52, 0x21, 0x13, 0x56, 0x66, 0x0b, 0xed, 0xda, 0x09, 0x85, 0xa6, 0xae, 0x17,
-	0xfd, 0xca, 0x2a, 0x93, 0x0c, 0xe8, 0x12, 0x14, 0xbc, 0x9e, 0x63, 0x53, 0x72, 0x6f, 0x42, 0x7c,
-	0x87, 0x4c, 0x1f, 0x36, 0x39, 0xaf, 0xe7, 0x74, 0x14, 0x6a, 0xba, 0x65, 0x07, 0xb2, 0xb3, 0xea,
-	0xe8, 0x0c, 0x14, 0x5a, 0xc6, 0xed, 0x5b, 0x1d, 0xb3, 0x6b, 0xdf, 0x36, 0xda, 0x2d, 0x59, 0x81,
-	0x3a, 0x64, 0x23, 0xb0, 0x63, 0xb4, 0xbb, 0xba, 0x86, 0x36, 0x40, 0x8f, 0x10, 0xcb, 0x68, 0x1a,
-	0xe6, 0xa1, 0xd1, 0xd2, 0x97, 0xd1, 0x59, 0x40, 0x11, 0xda, 0x32, 0xf6, 0x8d, 0x1b, 0xb2, 0x82,
-	0x13, 0xe5, 0xdf, 0x92, 0xb0, 0x76, 0x10, 0x85, 0xe7, 0x7d, 0xd1, 0xf8, 0x3f, 0x64, 0x85, 0x27,
-	0x6c, 0x7f, 0x32, 0xea, 0x91, 0x50, 0xc4, 0x24, 0x61, 0x65, 0x04, 0xd6, 0x16, 0x10, 0x32, 0x20,
-	0x33, 0xc2, 0x6c, 0x12, 0x12, 0x9b, 0xbf, 0x3e, 0xd5, 0xd3, 0xf0, 0x42, 0x55, 0x3e, 0x4d, 0xab,
-	0xd1, 0xd3, 0xb4, 0xda, 0x8d, 0x9e, 0xa6, 0x3b, 0xab, 0x3c, 0x32, 0x8f, 0x5e, 0x6c, 0x6a, 0x16,
-	0x48, 0x45, 0x2e, 0x42, 0x5f, 0x40, 0xa6, 0x37, 0x09, 0xfd, 0xd9, 0xaa, 0x9b, 0x23, 0xf8, 0xc0,
-	0x75, 0x54, 0xca, 0xb7, 0x20, 0x27, 0x53, 0x6e, 0xb6, 0xa8, 0xe6, 0xe0, 0xc8, 0x4a, 0x2d, 0xc5,
-	0xf2, 0x8e, 0x38, 0xa5, 0xde, 0x11, 0x27, 0x74, 0x33, 0x4a, 0x8d, 0xb4, 0x48, 0x8d, 0xeb, 0x27,
-	0xa4, 0x46, 0xec, 0xed, 0xe9, 0x6a, 0x36, 0x3d, 0xca, 0x3f, 0x6b, 0x90, 0x7f, 0x5d, 0x82, 0xfe,
-	0x07, 0xeb, 0x07, 0xed, 0x9d, 0x5b, 0x22, 0xe6, 0x33, 0xb1, 0x3f, 0x07, 0x67, 0xa6, 0xb0, 0xd9,
-	0x36, 0xbb, 0xa6, 0xec, 0xbe, 0x3c, 0xd8, 0x53, 0xc1, 0xcd, 0x46, 0xf7, 0xc0, 0xe2, 0x0a, 0xcb,
-	0xaf, 0xf3, 0x08, 0xdc, 0x68, 0xe9, 0x89, 0xd7, 0x79, 0x9a, 0xfb, 0x0d, 0xf3, 0x66, 0x63, 0x67,
-	0xdf, 0xd0, 0x93, 0x3c, 0x95, 0xa6, 0x82, 0xdd, 0x86, 0xb9, 0x6f, 0xb4, 0xf4, 0x95, 0xf2, 0x77,
-	0xcb, 0x90, 0x3b, 0xa0, 0x24, 0x5c, 0x54, 0xda, 0xcc, 0xcc, 0xde, 0xc4, 0xbc, 0xb3, 0xf7, 0x73,
-	0x00, 0xca, 0x8e, 0x4e, 0x99, 0x22, 0x6b, 0x94, 0x1d, 0x2d, 0x32, 0x43, 0xca, 0xbf, 0x2f, 0x03,
-	0x8a, 0xa7, 0xdc, 0x7f, 0xac, 0x8a, 0x0c, 0x58, 0x8f, 0xff, 0x17, 0xc4, 0x43, 0x37, 0x79, 0x82,
-	0x7f, 0xf5, 0x58, 0x25, 0x9a, 0xba, 0xd3, 0x26, 0xbc, 0x72, 0xba, 0x26, 0x3c, 0x67, 0xf5, 0x94,
-	0xeb, 0xb0, 0xfa, 0xe5, 0xe1, 0xc1, 0xd8, 0xe5, 0x79, 0xae, 0x43, 0xe2, 0x88, 0x3c, 0x54, 0x3e,
-	0xe3, 0x4b, 0xfe, 0xfa, 0x91, 0x7f, 0x05, 0xe4, 0xcc, 0x97, 0x9b, 0x9d, 0x3b, 0x4f, 0x5e, 0x96,
-	0xb4, 0xa7, 0x2f, 0x4b, 0xda, 0xdf, 0x2f, 0x4b, 0xda, 0xa3, 0x57, 0xa5, 0xa5, 0xa7, 0xaf, 0x4a,
-	0x4b, 0x7f, 0xbc, 0x2a, 0x2d, 0x7d, 0xdd, 0x98, 0xe9, 0xf1, 0x63, 0x12, 0x52, 0x8f, 0x32, 0x6e,
-	0xe7, 0x96, 0x4f, 0x6a, 0xb2, 0x2a, 0xb7, 0x7d, 0xcc, 0x5f, 0xe7, 0xb5, 0xe3, 0x7a, 0xed, 0xc1,
-	0x9b, 0xff, 0xe8, 0xc5, 0x08, 0xe8, 0xa5, 0x84, 0x8b, 0xaf, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0xaa, 0xb3, 0xfc, 0xe7, 0xf7, 0x0f, 0x00, 0x00,
+func (m *Validator) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
 }
 
-func (m *HostChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Validator) GetJailedCount() uint32 {
+	if m != nil {
+		return m.JailedCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *HostChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Deposit struct {
+	// deposit target chain
+	ChainId string     `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// epoch number of the deposit
+	Epoch github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch"`
+	// state
+	State Deposit_DepositState `protobuf:"varint,4,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Deposit_DepositState" json:"state,omitempty"`
+	// sequence id of the ibc transaction
+	IbcSequenceId string `protobuf:"bytes,5,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+	// owner of the delegation account the deposit is sent to
+	DelegationAccountOwner string `protobuf:"bytes,6,opt,name=delegation_account_owner,json=delegationAccountOwner,proto3" json:"delegation_account_owner,omitempty"`
 }
 
-func (m *HostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *Deposit) Reset()         { *m = Deposit{} }
+func (m *Deposit) String() string { return proto.CompactTextString(m) }
+func (*Deposit) ProtoMessage()    {}
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{9}
+}
+func (m *Deposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x78
+		return b[:n], nil
 	}
-	if m.UnbondingFactor != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingFactor))
-		i--
-		dAtA[i] = 0x70
+}
+func (m *Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit.Merge(m, src)
+}
+func (m *Deposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deposit proto.InternalMessageInfo
+
+func (m *Deposit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.NextValsetHash) > 0 {
-		i -= len(m.NextValsetHash)
-		copy(dAtA[i:], m.NextValsetHash)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.NextValsetHash)))
-		i--
-		dAtA[i] = 0x6a
+	return ""
+}
+
+func (m *Deposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	{
-		size := m.LastCValue.Size()
-		i -= size
-		if _, err := m.LastCValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *Deposit) GetState() Deposit_DepositState {
+	if m != nil {
+		return m.State
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.CValue.Size()
-		i -= size
-		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return Deposit_DEPOSIT_PENDING
+}
+
+func (m *Deposit) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.MinimumDeposit.Size()
-		i -= size
-		if _, err := m.MinimumDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *Deposit) GetDelegationAccountOwner() string {
+	if m != nil {
+		return m.DelegationAccountOwner
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	return ""
+}
+
+type Unbonding struct {
+	// unbonding target chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch number of the unbonding record
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// time when the unbonding matures and can be collected
+	MatureTime time.Time `protobuf:"bytes,3,opt,name=mature_time,json=matureTime,proto3,stdtime" json:"mature_time"`
+	// stk token amount that is burned with the unbonding
+	BurnAmount types.Coin `protobuf:"bytes,4,opt,name=burn_amount,json=burnAmount,proto3" json:"burn_amount"`
+	// host token amount that is being unbonded
+	UnbondAmount types.Coin `protobuf:"bytes,5,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount"`
+	// sequence id of the ibc transaction
+	IbcSequenceId string `protobuf:"bytes,6,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+	// state of the unbonding during the process
+	State Unbonding_UnbondingState `protobuf:"varint,7,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState" json:"state,omitempty"`
+	// owner of the delegation account that undelegated the tokens
+	DelegationAccountOwner string `protobuf:"bytes,8,opt,name=delegation_account_owner,json=delegationAccountOwner,proto3" json:"delegation_account_owner,omitempty"`
+}
+
+func (m *Unbonding) Reset()         { *m = Unbonding{} }
+func (m *Unbonding) String() string { return proto.CompactTextString(m) }
+func (*Unbonding) ProtoMessage()    {}
+func (*Unbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{10}
+}
+func (m *Unbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Unbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Unbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.RewardsAccount != nil {
-		{
-			size, err := m.RewardsAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+}
+func (m *Unbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Unbonding.Merge(m, src)
+}
+func (m *Unbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *Unbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Unbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Unbonding proto.InternalMessageInfo
+
+func (m *Unbonding) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.DelegationAccount != nil {
-		{
-			size, err := m.DelegationAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.PortId) > 0 {
-		i -= len(m.PortId)
-		copy(dAtA[i:], m.PortId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.PortId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.HostDenom) > 0 {
-		i -= len(m.HostDenom)
-		copy(dAtA[i:], m.HostDenom)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.HostDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *HostChainLSParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Unbonding) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *HostChainLSParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Unbonding) GetMatureTime() time.Time {
+	if m != nil {
+		return m.MatureTime
+	}
+	return time.Time{}
 }
 
-func (m *HostChainLSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RedemptionFee.Size()
-		i -= size
-		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+func (m *Unbonding) GetBurnAmount() types.Coin {
+	if m != nil {
+		return m.BurnAmount
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.UnstakeFee.Size()
-		i -= size
-		if _, err := m.UnstakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *Unbonding) GetUnbondAmount() types.Coin {
+	if m != nil {
+		return m.UnbondAmount
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.RestakeFee.Size()
-		i -= size
-		if _, err := m.RestakeFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *Unbonding) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.DepositFee.Size()
-		i -= size
-		if _, err := m.DepositFee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *Unbonding) GetState() Unbonding_UnbondingState {
+	if m != nil {
+		return m.State
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return Unbonding_UNBONDING_PENDING
 }
 
-func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Unbonding) GetDelegationAccountOwner() string {
+	if m != nil {
+		return m.DelegationAccountOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UserUnbonding struct {
+	// unbonding target chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch when the unbonding started
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// address which requested the unbonding
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// stk token amount that is being unbonded
+	StkAmount types.Coin `protobuf:"bytes,4,opt,name=stk_amount,json=stkAmount,proto3" json:"stk_amount"`
+	// host token amount that is being unbonded
+	UnbondAmount types.Coin `protobuf:"bytes,5,opt,name=unbond_amount,json=unbondAmount,proto3" json:"unbond_amount"`
 }
 
-func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChannelState != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ChannelState))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+func (m *UserUnbonding) Reset()         { *m = UserUnbonding{} }
+func (m *UserUnbonding) String() string { return proto.CompactTextString(m) }
+func (*UserUnbonding) ProtoMessage()    {}
+func (*UserUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{11}
+}
+func (m *UserUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *UserUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserUnbonding.Merge(m, src)
+}
+func (m *UserUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserUnbonding proto.InternalMessageInfo
+
+func (m *UserUnbonding) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Validator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UserUnbonding) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UserUnbonding) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UnbondingEpoch != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingEpoch))
-		i--
-		dAtA[i] = 0x38
+func (m *UserUnbonding) GetStkAmount() types.Coin {
+	if m != nil {
+		return m.StkAmount
 	}
-	{
-		size := m.DelegatorShares.Size()
-		i -= size
-		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.TotalAmount.Size()
-		i -= size
-		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.DelegatedAmount.Size()
-		i -= size
-		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Weight.Size()
-		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UserUnbonding) GetUnbondAmount() types.Coin {
+	if m != nil {
+		return m.UnbondAmount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ValidatorUnbonding struct {
+	// unbonding target chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// epoch when the unbonding started
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// time when the unbonding matures and can be collected
+	MatureTime time.Time `protobuf:"bytes,3,opt,name=mature_time,json=matureTime,proto3,stdtime" json:"mature_time"`
+	// address of the validator that is being unbonded
+	ValidatorAddress string `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount unbonded from the validator
+	Amount types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	// sequence id of the ibc transaction
+	IbcSequenceId string `protobuf:"bytes,6,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+	// owner of the delegation account that undelegated the tokens
+	DelegationAccountOwner string `protobuf:"bytes,7,opt,name=delegation_account_owner,json=delegationAccountOwner,proto3" json:"delegation_account_owner,omitempty"`
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.State != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.Epoch.Size()
-		i -= size
-		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *ValidatorUnbonding) Reset()         { *m = ValidatorUnbonding{} }
+func (m *ValidatorUnbonding) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUnbonding) ProtoMessage()    {}
+func (*ValidatorUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{12}
+}
+func (m *ValidatorUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUnbonding.Merge(m, src)
+}
+func (m *ValidatorUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUnbonding.DiscardUnknown(m)
 }
 
-func (m *Unbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorUnbonding proto.InternalMessageInfo
+
+func (m *ValidatorUnbonding) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorUnbonding) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
 }
 
-func (m *Unbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.State != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	{
-		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.BurnAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+func (m *ValidatorUnbonding) GetMatureTime() time.Time {
+	if m != nil {
+		return m.MatureTime
 	}
-	i--
-	dAtA[i] = 0x22
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
-	if err8 != nil {
-		return 0, err8
+	return time.Time{}
+}
+
+func (m *ValidatorUnbonding) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	i -= n8
-	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x1a
-	if m.EpochNumber != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *ValidatorUnbonding) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return types.Coin{}
+}
+
+func (m *ValidatorUnbonding) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UserUnbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorUnbonding) GetDelegationAccountOwner() string {
+	if m != nil {
+		return m.DelegationAccountOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UserUnbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type KVUpdate struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *UserUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *KVUpdate) Reset()         { *m = KVUpdate{} }
+func (m *KVUpdate) String() string { return proto.CompactTextString(m) }
+func (*KVUpdate) ProtoMessage()    {}
+func (*KVUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{13}
+}
+func (m *KVUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KVUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KVUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.StkAmount.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *KVUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KVUpdate.Merge(m, src)
+}
+func (m *KVUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *KVUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_KVUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KVUpdate proto.InternalMessageInfo
+
+func (m *KVUpdate) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *KVUpdate) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type LSMDeposit struct {
+	// deposit target chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// amount of tokenized shares deposited
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// tokenized share denom on the host chain (validator/record)
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// tokenized share ibc denom on Persistence
+	IbcDenom string `protobuf:"bytes,4,opt,name=ibc_denom,json=ibcDenom,proto3" json:"ibc_denom,omitempty"`
+	// address of the delegator that made the deposit
+	DelegatorAddress string `protobuf:"bytes,5,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// state
+	State LSMDeposit_LSMDepositState `protobuf:"varint,6,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.LSMDeposit_LSMDepositState" json:"state,omitempty"`
+	// sequence id of the ibc transaction
+	IbcSequenceId string `protobuf:"bytes,7,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+}
+
+func (m *LSMDeposit) Reset()         { *m = LSMDeposit{} }
+func (m *LSMDeposit) String() string { return proto.CompactTextString(m) }
+func (*LSMDeposit) ProtoMessage()    {}
+func (*LSMDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{14}
+}
+func (m *LSMDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LSMDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LSMDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *LSMDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LSMDeposit.Merge(m, src)
+}
+func (m *LSMDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *LSMDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_LSMDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LSMDeposit proto.InternalMessageInfo
+
+func (m *LSMDeposit) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.EpochNumber != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *LSMDeposit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *LSMDeposit) GetIbcDenom() string {
+	if m != nil {
+		return m.IbcDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ValidatorUnbonding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LSMDeposit) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ValidatorUnbonding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LSMDeposit) GetState() LSMDeposit_LSMDepositState {
+	if m != nil {
+		return m.State
+	}
+	return LSMDeposit_DEPOSIT_PENDING
 }
 
-func (m *ValidatorUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IbcSequenceId) > 0 {
-		i -= len(m.IbcSequenceId)
-		copy(dAtA[i:], m.IbcSequenceId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
-		i--
-		dAtA[i] = 0x32
+func (m *LSMDeposit) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
 	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+type Redelegation struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// validator the delegation is moved from
+	SrcValidatorAddress string `protobuf:"bytes,2,opt,name=src_validator_address,json=srcValidatorAddress,proto3" json:"src_validator_address,omitempty"`
+	// validator the delegation is moved to
+	DstValidatorAddress string `protobuf:"bytes,3,opt,name=dst_validator_address,json=dstValidatorAddress,proto3" json:"dst_validator_address,omitempty"`
+	// amount being redelegated
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// time at which the redelegation entry completes on the host chain
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// sequence id of the ibc transaction
+	IbcSequenceId string `protobuf:"bytes,6,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+	// state of the redelegation
+	State Redelegation_RedelegationState `protobuf:"varint,7,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.Redelegation_RedelegationState" json:"state,omitempty"`
+}
+
+func (m *Redelegation) Reset()         { *m = Redelegation{} }
+func (m *Redelegation) String() string { return proto.CompactTextString(m) }
+func (*Redelegation) ProtoMessage()    {}
+func (*Redelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{15}
+}
+func (m *Redelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Redelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Redelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
-	if err12 != nil {
-		return 0, err12
+		return b[:n], nil
 	}
-	i -= n12
-	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n12))
-	i--
-	dAtA[i] = 0x1a
-	if m.EpochNumber != 0 {
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *Redelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redelegation.Merge(m, src)
+}
+func (m *Redelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Redelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redelegation proto.InternalMessageInfo
+
+func (m *Redelegation) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Redelegation) GetSrcValidatorAddress() string {
+	if m != nil {
+		return m.SrcValidatorAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *KVUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Redelegation) GetDstValidatorAddress() string {
+	if m != nil {
+		return m.DstValidatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *KVUpdate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Redelegation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *KVUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+func (m *Redelegation) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	return time.Time{}
+}
+
+func (m *Redelegation) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintLiquidstakeibc(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidstakeibc(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Redelegation) GetState() Redelegation_RedelegationState {
+	if m != nil {
+		return m.State
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return Redelegation_REDELEGATION_INITIATED
 }
-func (m *HostChain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+
+type RewardsRecord struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// rewards epoch number
+	Epoch int64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// gross rewards withdrawn from the host chain
+	GrossRewards types.Coin `protobuf:"bytes,3,opt,name=gross_rewards,json=grossRewards,proto3" json:"gross_rewards"`
+	// restake fee taken, in host tokens
+	RestakeFee types.Coin `protobuf:"bytes,4,opt,name=restake_fee,json=restakeFee,proto3" json:"restake_fee"`
+	// stk tokens minted to the fee address for the restake fee
+	FeeMinted types.Coin `protobuf:"bytes,5,opt,name=fee_minted,json=feeMinted,proto3" json:"fee_minted"`
+	// amount of rewards restaked
+	RestakedAmount types.Coin `protobuf:"bytes,6,opt,name=restaked_amount,json=restakedAmount,proto3" json:"restaked_amount"`
+}
+
+func (m *RewardsRecord) Reset()         { *m = RewardsRecord{} }
+func (m *RewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*RewardsRecord) ProtoMessage()    {}
+func (*RewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{16}
+}
+func (m *RewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *RewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsRecord.Merge(m, src)
+}
+func (m *RewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsRecord proto.InternalMessageInfo
+
+func (m *RewardsRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *RewardsRecord) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *RewardsRecord) GetGrossRewards() types.Coin {
+	if m != nil {
+		return m.GrossRewards
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return types.Coin{}
+}
+
+func (m *RewardsRecord) GetRestakeFee() types.Coin {
+	if m != nil {
+		return m.RestakeFee
 	}
-	if m.DelegationAccount != nil {
-		l = m.DelegationAccount.Size()
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return types.Coin{}
+}
+
+func (m *RewardsRecord) GetFeeMinted() types.Coin {
+	if m != nil {
+		return m.FeeMinted
 	}
-	if m.RewardsAccount != nil {
-		l = m.RewardsAccount.Size()
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return types.Coin{}
+}
+
+func (m *RewardsRecord) GetRestakedAmount() types.Coin {
+	if m != nil {
+		return m.RestakedAmount
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return types.Coin{}
+}
+
+type Slash struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// address of the slashed validator
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// block height at which the slash was detected
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// block time at which the slash was detected
+	Time time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// delegated amount lost with the slash
+	Amount types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	// fraction of the validator delegation slashed
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// loss pro-rated across the in-flight unbondings
+	UnbondingLoss types.Coin `protobuf:"bytes,7,opt,name=unbonding_loss,json=unbondingLoss,proto3" json:"unbonding_loss"`
+}
+
+func (m *Slash) Reset()         { *m = Slash{} }
+func (m *Slash) String() string { return proto.CompactTextString(m) }
+func (*Slash) ProtoMessage()    {}
+func (*Slash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{17}
+}
+func (m *Slash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Slash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Slash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.MinimumDeposit.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.CValue.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.LastCValue.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.NextValsetHash)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	if m.UnbondingFactor != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingFactor))
-	}
-	if m.Active {
-		n += 2
+}
+func (m *Slash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Slash.Merge(m, src)
+}
+func (m *Slash) XXX_Size() int {
+	return m.Size()
+}
+func (m *Slash) XXX_DiscardUnknown() {
+	xxx_messageInfo_Slash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Slash proto.InternalMessageInfo
+
+func (m *Slash) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *HostChainLSParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Slash) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	var l int
-	_ = l
-	l = m.DepositFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.RestakeFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UnstakeFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.RedemptionFee.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	return n
+	return ""
 }
 
-func (m *ICAAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Slash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *Slash) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	l = m.Balance.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return time.Time{}
+}
+
+func (m *Slash) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if m.ChannelState != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.ChannelState))
+	return types.Coin{}
+}
+
+func (m *Slash) GetUnbondingLoss() types.Coin {
+	if m != nil {
+		return m.UnbondingLoss
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+type ICATx struct {
+	// sequence id of the ibc transaction
+	SequenceId string `protobuf:"bytes,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
+	// host chain id
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// ibc connection id
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// owner of the ica that sent the tx
+	Owner string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// messages executed by the tx
+	Messages []*types1.Any `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty"`
+	// block height at which the tx was sent
+	SentHeight int64 `protobuf:"varint,6,opt,name=sent_height,json=sentHeight,proto3" json:"sent_height,omitempty"`
+	// status of the tx
+	Status ICATx_ICATxStatus `protobuf:"varint,7,opt,name=status,proto3,enum=pstake.liquidstakeibc.v1beta1.ICATx_ICATxStatus" json:"status,omitempty"`
+	// number of times the tx messages have been retried
+	RetryCount uint32 `protobuf:"varint,8,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// last error returned for the tx
+	LastError string `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (m *ICATx) Reset()         { *m = ICATx{} }
+func (m *ICATx) String() string { return proto.CompactTextString(m) }
+func (*ICATx) ProtoMessage()    {}
+func (*ICATx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{18}
+}
+func (m *ICATx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ICATx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ICATx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *ICATx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ICATx.Merge(m, src)
+}
+func (m *ICATx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ICATx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ICATx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ICATx proto.InternalMessageInfo
+
+func (m *ICATx) GetSequenceId() string {
+	if m != nil {
+		return m.SequenceId
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *ICATx) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.DelegatedAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.TotalAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.UnbondingEpoch != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingEpoch))
+	return ""
+}
+
+func (m *ICATx) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
 	}
-	return n
+	return ""
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ICATx) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *ICATx) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.Epoch.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	if m.State != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	return nil
+}
+
+func (m *ICATx) GetSentHeight() int64 {
+	if m != nil {
+		return m.SentHeight
 	}
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *ICATx) GetStatus() ICATx_ICATxStatus {
+	if m != nil {
+		return m.Status
 	}
-	return n
+	return ICATx_ICA_TX_PENDING
 }
 
-func (m *Unbonding) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ICATx) GetRetryCount() uint32 {
+	if m != nil {
+		return m.RetryCount
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *ICATx) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	return ""
+}
+
+type LiquidityPool struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// total amount of shares issued to the liquidity providers
+	TotalShares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_shares"`
+}
+
+func (m *LiquidityPool) Reset()         { *m = LiquidityPool{} }
+func (m *LiquidityPool) String() string { return proto.CompactTextString(m) }
+func (*LiquidityPool) ProtoMessage()    {}
+func (*LiquidityPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{19}
+}
+func (m *LiquidityPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.BurnAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UnbondAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *LiquidityPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityPool.Merge(m, src)
+}
+func (m *LiquidityPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidityPool proto.InternalMessageInfo
+
+func (m *LiquidityPool) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	if m.State != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	return ""
+}
+
+type LiquidityProvider struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// address of the liquidity provider
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount of pool shares owned by the provider
+	Shares github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares"`
+}
+
+func (m *LiquidityProvider) Reset()         { *m = LiquidityProvider{} }
+func (m *LiquidityProvider) String() string { return proto.CompactTextString(m) }
+func (*LiquidityProvider) ProtoMessage()    {}
+func (*LiquidityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{20}
+}
+func (m *LiquidityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *LiquidityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityProvider.Merge(m, src)
+}
+func (m *LiquidityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityProvider.DiscardUnknown(m)
 }
 
-func (m *UserUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_LiquidityProvider proto.InternalMessageInfo
+
+func (m *LiquidityProvider) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return ""
+}
+
+func (m *LiquidityProvider) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	return ""
+}
+
+type HostChainProposal struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// id of the proposal on the host chain
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// time at which the proposal voting period ends on the host chain
+	VotingEndTime time.Time `protobuf:"bytes,3,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time"`
+	// state of the proposal vote pass-through
+	State HostChainProposal_ProposalState `protobuf:"varint,4,opt,name=state,proto3,enum=pstake.liquidstakeibc.v1beta1.HostChainProposal_ProposalState" json:"state,omitempty"`
+	// block height at which the vote was tallied and submitted, the stk balances
+	// of the voters are read at this height and not when the voting opened
+	TallyHeight int64 `protobuf:"varint,5,opt,name=tally_height,json=tallyHeight,proto3" json:"tally_height,omitempty"`
+	// weighted options submitted to the host chain
+	Tally []v1beta1.WeightedVoteOption `protobuf:"bytes,6,rep,name=tally,proto3" json:"tally"`
+	// total stk voting power of the tally
+	TotalPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_power,json=totalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_power"`
+	// sequence id of the vote ica transaction
+	IbcSequenceId string `protobuf:"bytes,8,opt,name=ibc_sequence_id,json=ibcSequenceId,proto3" json:"ibc_sequence_id,omitempty"`
+}
+
+func (m *HostChainProposal) Reset()         { *m = HostChainProposal{} }
+func (m *HostChainProposal) String() string { return proto.CompactTextString(m) }
+func (*HostChainProposal) ProtoMessage()    {}
+func (*HostChainProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{21}
+}
+func (m *HostChainProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostChainProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostChainProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+}
+func (m *HostChainProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostChainProposal.Merge(m, src)
+}
+func (m *HostChainProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostChainProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostChainProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostChainProposal proto.InternalMessageInfo
+
+func (m *HostChainProposal) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	l = m.StkAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = m.UnbondAmount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	return n
+	return ""
 }
 
-func (m *ValidatorUnbonding) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HostChainProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *HostChainProposal) GetVotingEndTime() time.Time {
+	if m != nil {
+		return m.VotingEndTime
 	}
-	if m.EpochNumber != 0 {
-		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	return time.Time{}
+}
+
+func (m *HostChainProposal) GetState() HostChainProposal_ProposalState {
+	if m != nil {
+		return m.State
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return HostChainProposal_PROPOSAL_DEPOSIT
+}
+
+func (m *HostChainProposal) GetTallyHeight() int64 {
+	if m != nil {
+		return m.TallyHeight
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovLiquidstakeibc(uint64(l))
-	l = len(m.IbcSequenceId)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return 0
+}
+
+func (m *HostChainProposal) GetTally() []v1beta1.WeightedVoteOption {
+	if m != nil {
+		return m.Tally
+	}
+	return nil
+}
+
+func (m *HostChainProposal) GetIbcSequenceId() string {
+	if m != nil {
+		return m.IbcSequenceId
+	}
+	return ""
+}
+
+type ProposalVote struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// id of the proposal on the host chain
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// stk holder address
+	Voter string `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty"`
+	// weighted vote options of the voter
+	Options []v1beta1.WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+}
+
+func (m *ProposalVote) Reset()         { *m = ProposalVote{} }
+func (m *ProposalVote) String() string { return proto.CompactTextString(m) }
+func (*ProposalVote) ProtoMessage()    {}
+func (*ProposalVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{22}
+}
+func (m *ProposalVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalVote.Merge(m, src)
+}
+func (m *ProposalVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalVote proto.InternalMessageInfo
+
+func (m *ProposalVote) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ProposalVote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalVote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *ProposalVote) GetOptions() []v1beta1.WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type LedgerEntry struct {
+	// sequential id of the entry
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address that performed the operation
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// host chain id
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// type of the operation
+	Type LedgerEntry_EntryType `protobuf:"varint,4,opt,name=type,proto3,enum=pstake.liquidstakeibc.v1beta1.LedgerEntry_EntryType" json:"type,omitempty"`
+	// host tokens deposited, unbonded, redeemed or claimed
+	Amount types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount"`
+	// stk tokens minted to or taken from the address
+	StkAmount types.Coin `protobuf:"bytes,6,opt,name=stk_amount,json=stkAmount,proto3" json:"stk_amount"`
+	// protocol fee charged, in stk tokens
+	Fee types.Coin `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
+	// host chain c value at the time of the operation
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// block height of the operation
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	// block time of the operation
+	Time time.Time `protobuf:"bytes,10,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *LedgerEntry) Reset()         { *m = LedgerEntry{} }
+func (m *LedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*LedgerEntry) ProtoMessage()    {}
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{23}
+}
+func (m *LedgerEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LedgerEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LedgerEntry.Merge(m, src)
+}
+func (m *LedgerEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LedgerEntry proto.InternalMessageInfo
+
+func (m *LedgerEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *LedgerEntry) GetType() LedgerEntry_EntryType {
+	if m != nil {
+		return m.Type
+	}
+	return LedgerEntry_LEDGER_ENTRY_LIQUID_STAKE
+}
+
+func (m *LedgerEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *LedgerEntry) GetStkAmount() types.Coin {
+	if m != nil {
+		return m.StkAmount
+	}
+	return types.Coin{}
+}
+
+func (m *LedgerEntry) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *LedgerEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LedgerEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+type ExchangeRateRecord struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// c value epoch number at which the c value was computed
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// block time at which the c value was computed
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// computed c value
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// total host tokens liquid staked
+	TotalStaked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_staked,json=totalStaked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_staked"`
+	// total stk tokens minted
+	MintedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=minted_amount,json=mintedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted_amount"`
+}
+
+func (m *ExchangeRateRecord) Reset()         { *m = ExchangeRateRecord{} }
+func (m *ExchangeRateRecord) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateRecord) ProtoMessage()    {}
+func (*ExchangeRateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{24}
+}
+func (m *ExchangeRateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateRecord.Merge(m, src)
+}
+func (m *ExchangeRateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateRecord proto.InternalMessageInfo
+
+func (m *ExchangeRateRecord) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ExchangeRateRecord) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *ExchangeRateRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// host chain tokens reserved for the stk tokens that could not be converted
+// when their host chain was removed, like the ones held by other modules, they
+// are paid out once the stk tokens reach an account that can be converted
+type WindDownReserve struct {
+	// id of the removed host chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// stk denom of the removed host chain
+	MintDenom string `protobuf:"bytes,2,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
+	// final c value of the removed host chain
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// host chain tokens left in the undelegation module account for the stk
+	// supply
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *WindDownReserve) Reset()         { *m = WindDownReserve{} }
+func (m *WindDownReserve) String() string { return proto.CompactTextString(m) }
+func (*WindDownReserve) ProtoMessage()    {}
+func (*WindDownReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a9a61e676043b6, []int{25}
+}
+func (m *WindDownReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindDownReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindDownReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindDownReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindDownReserve.Merge(m, src)
+}
+func (m *WindDownReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindDownReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindDownReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindDownReserve proto.InternalMessageInfo
+
+func (m *WindDownReserve) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *WindDownReserve) GetMintDenom() string {
+	if m != nil {
+		return m.MintDenom
+	}
+	return ""
+}
+
+func (m *WindDownReserve) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.HostChain_WindDownState", HostChain_WindDownState_name, HostChain_WindDownState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.HostChainCircuitBreaker_State", HostChainCircuitBreaker_State_name, HostChainCircuitBreaker_State_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.ICAAccount_ChannelState", ICAAccount_ChannelState_name, ICAAccount_ChannelState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Deposit_DepositState", Deposit_DepositState_name, Deposit_DepositState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Unbonding_UnbondingState", Unbonding_UnbondingState_name, Unbonding_UnbondingState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.LSMDeposit_LSMDepositState", LSMDeposit_LSMDepositState_name, LSMDeposit_LSMDepositState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.Redelegation_RedelegationState", Redelegation_RedelegationState_name, Redelegation_RedelegationState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.ICATx_ICATxStatus", ICATx_ICATxStatus_name, ICATx_ICATxStatus_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.HostChainProposal_ProposalState", HostChainProposal_ProposalState_name, HostChainProposal_ProposalState_value)
+	proto.RegisterEnum("pstake.liquidstakeibc.v1beta1.LedgerEntry_EntryType", LedgerEntry_EntryType_name, LedgerEntry_EntryType_value)
+	proto.RegisterType((*HostChain)(nil), "pstake.liquidstakeibc.v1beta1.HostChain")
+	proto.RegisterType((*HostChainLSParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainLSParams")
+	proto.RegisterType((*HostChainRewardsParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainRewardsParams")
+	proto.RegisterType((*HostChainDelegationParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainDelegationParams")
+	proto.RegisterType((*HostChainCircuitBreaker)(nil), "pstake.liquidstakeibc.v1beta1.HostChainCircuitBreaker")
+	proto.RegisterType((*HostChainEligibilityParams)(nil), "pstake.liquidstakeibc.v1beta1.HostChainEligibilityParams")
+	proto.RegisterType((*ICAAccount)(nil), "pstake.liquidstakeibc.v1beta1.ICAAccount")
+	proto.RegisterType((*AccountDelegation)(nil), "pstake.liquidstakeibc.v1beta1.AccountDelegation")
+	proto.RegisterType((*Validator)(nil), "pstake.liquidstakeibc.v1beta1.Validator")
+	proto.RegisterType((*Deposit)(nil), "pstake.liquidstakeibc.v1beta1.Deposit")
+	proto.RegisterType((*Unbonding)(nil), "pstake.liquidstakeibc.v1beta1.Unbonding")
+	proto.RegisterType((*UserUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.UserUnbonding")
+	proto.RegisterType((*ValidatorUnbonding)(nil), "pstake.liquidstakeibc.v1beta1.ValidatorUnbonding")
+	proto.RegisterType((*KVUpdate)(nil), "pstake.liquidstakeibc.v1beta1.KVUpdate")
+	proto.RegisterType((*LSMDeposit)(nil), "pstake.liquidstakeibc.v1beta1.LSMDeposit")
+	proto.RegisterType((*Redelegation)(nil), "pstake.liquidstakeibc.v1beta1.Redelegation")
+	proto.RegisterType((*RewardsRecord)(nil), "pstake.liquidstakeibc.v1beta1.RewardsRecord")
+	proto.RegisterType((*Slash)(nil), "pstake.liquidstakeibc.v1beta1.Slash")
+	proto.RegisterType((*ICATx)(nil), "pstake.liquidstakeibc.v1beta1.ICATx")
+	proto.RegisterType((*LiquidityPool)(nil), "pstake.liquidstakeibc.v1beta1.LiquidityPool")
+	proto.RegisterType((*LiquidityProvider)(nil), "pstake.liquidstakeibc.v1beta1.LiquidityProvider")
+	proto.RegisterType((*HostChainProposal)(nil), "pstake.liquidstakeibc.v1beta1.HostChainProposal")
+	proto.RegisterType((*ProposalVote)(nil), "pstake.liquidstakeibc.v1beta1.ProposalVote")
+	proto.RegisterType((*LedgerEntry)(nil), "pstake.liquidstakeibc.v1beta1.LedgerEntry")
+	proto.RegisterType((*ExchangeRateRecord)(nil), "pstake.liquidstakeibc.v1beta1.ExchangeRateRecord")
+	proto.RegisterType((*WindDownReserve)(nil), "pstake.liquidstakeibc.v1beta1.WindDownReserve")
+}
+
+func init() {
+	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/liquidstakeibc.proto", fileDescriptor_71a9a61e676043b6)
+}
+
+var fileDescriptor_71a9a61e676043b6 = []byte{
+	// 3378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xd9, 0x17, 0xb9, 0xa4, 0x44, 0x3d, 0x22, 0x45, 0x6a, 0x24, 0xcb, 0xb4, 0x13, 0x4b, 0x0a, 0xf3,
+	0xc6, 0x51, 0x5e, 0xc0, 0x54, 0xa2, 0xbc, 0x6f, 0x93, 0x16, 0x69, 0x50, 0x8a, 0x5c, 0x47, 0x1b,
+	0x53, 0xa4, 0xba, 0xa4, 0x64, 0x37, 0x69, 0xb3, 0x58, 0xee, 0x8e, 0xa9, 0x8d, 0xb9, 0xbb, 0xf4,
+	0xce, 0xea, 0xab, 0xe8, 0xbd, 0x28, 0x7a, 0xc9, 0xa5, 0x1f, 0xe8, 0xa1, 0xe8, 0xad, 0x40, 0x4f,
+	0x39, 0x04, 0x45, 0x51, 0xf4, 0xd2, 0xf6, 0x92, 0x63, 0x9a, 0x53, 0x90, 0x02, 0x49, 0xe1, 0xdc,
+	0x7a, 0xe9, 0x1f, 0x90, 0x4b, 0x31, 0x1f, 0xfb, 0x41, 0x49, 0xa6, 0x48, 0x9b, 0x05, 0x7a, 0x91,
+	0x38, 0xcf, 0xcc, 0xf3, 0x9b, 0x99, 0x67, 0x9e, 0xaf, 0x79, 0x66, 0x61, 0xb3, 0x4f, 0x7c, 0xfd,
+	0x01, 0xde, 0xe8, 0x59, 0x0f, 0x0f, 0x2d, 0x93, 0xfd, 0xb6, 0x3a, 0xc6, 0xc6, 0xd1, 0x2b, 0x1d,
+	0xec, 0xeb, 0xaf, 0x9c, 0x21, 0x97, 0xfb, 0x9e, 0xeb, 0xbb, 0xe8, 0x06, 0xe7, 0x29, 0x9f, 0xe9,
+	0x14, 0x3c, 0xd7, 0x97, 0xba, 0x6e, 0xd7, 0x65, 0x23, 0x37, 0xe8, 0x2f, 0xce, 0x74, 0xfd, 0x9a,
+	0xe1, 0x12, 0xdb, 0x25, 0x1a, 0xef, 0xe0, 0x0d, 0xd1, 0xb5, 0xc2, 0x5b, 0x1b, 0x1d, 0x9d, 0xe0,
+	0x70, 0x66, 0xc3, 0xb5, 0x1c, 0xd1, 0xbf, 0xda, 0x75, 0xdd, 0x6e, 0x0f, 0x6f, 0xb0, 0x56, 0xe7,
+	0xf0, 0xfe, 0x86, 0x6f, 0xd9, 0x98, 0xf8, 0xba, 0xdd, 0x0f, 0xb0, 0xcf, 0x0e, 0xd0, 0x9d, 0x53,
+	0xd1, 0xf5, 0xac, 0xc0, 0xee, 0xba, 0x47, 0x21, 0x74, 0xd7, 0x3d, 0xe2, 0xbd, 0xa5, 0x7f, 0xe5,
+	0x60, 0x76, 0xdb, 0x25, 0x7e, 0xf5, 0x40, 0xb7, 0x1c, 0x74, 0x0d, 0x32, 0x06, 0xfd, 0xa1, 0x59,
+	0x66, 0x31, 0xb1, 0x96, 0x58, 0x9f, 0x55, 0x67, 0x58, 0x5b, 0x31, 0xd1, 0xf3, 0x90, 0x33, 0x5c,
+	0xc7, 0xc1, 0x86, 0x6f, 0xb9, 0xac, 0x3f, 0xc9, 0xfa, 0xb3, 0x11, 0x51, 0x31, 0xd1, 0x36, 0x4c,
+	0xf7, 0x75, 0x4f, 0xb7, 0x49, 0x51, 0x5a, 0x4b, 0xac, 0xcf, 0x6d, 0xbe, 0x5c, 0x1e, 0x2a, 0xa8,
+	0x72, 0x38, 0x73, 0xbd, 0xb5, 0xcb, 0xf8, 0x54, 0xc1, 0x8f, 0x6e, 0x00, 0x1c, 0xb8, 0xc4, 0xd7,
+	0x4c, 0xec, 0xb8, 0x76, 0x31, 0xc5, 0xe6, 0x9a, 0xa5, 0x94, 0x1a, 0x25, 0xd0, 0x6e, 0xe3, 0x40,
+	0x77, 0x1c, 0xdc, 0xa3, 0x4b, 0x49, 0xf3, 0x6e, 0x41, 0x51, 0x4c, 0x74, 0x15, 0x66, 0xfa, 0xae,
+	0xe7, 0xd3, 0xbe, 0x69, 0xd6, 0x37, 0x4d, 0x9b, 0x8a, 0x89, 0xee, 0x01, 0x32, 0x71, 0x0f, 0x77,
+	0x75, 0xb6, 0x0b, 0xdd, 0x30, 0xdc, 0x43, 0xc7, 0x2f, 0xce, 0xb0, 0xc5, 0xbe, 0x74, 0xc9, 0x62,
+	0x95, 0x6a, 0xa5, 0xc2, 0x19, 0xd4, 0x85, 0x08, 0x44, 0x90, 0x90, 0x0a, 0x79, 0x0f, 0x1f, 0xeb,
+	0x9e, 0x49, 0x42, 0xd8, 0xcc, 0xb8, 0xb0, 0xf3, 0x02, 0x21, 0xc0, 0xdc, 0x06, 0x38, 0xd2, 0x7b,
+	0x96, 0xa9, 0xfb, 0xae, 0x47, 0x8a, 0xb3, 0x6b, 0xd2, 0xfa, 0xdc, 0xe6, 0xfa, 0x25, 0x70, 0xfb,
+	0x01, 0x83, 0x1a, 0xe3, 0x45, 0x18, 0xf2, 0xb6, 0xe5, 0x58, 0xf6, 0xa1, 0xad, 0x99, 0xb8, 0xef,
+	0x12, 0xcb, 0x2f, 0x02, 0x15, 0xcc, 0xd6, 0x1b, 0x1f, 0x7f, 0xb1, 0x3a, 0xf5, 0xf9, 0x17, 0xab,
+	0x37, 0xbb, 0x96, 0x7f, 0x70, 0xd8, 0x29, 0x1b, 0xae, 0x2d, 0x54, 0x53, 0xfc, 0xbb, 0x45, 0xcc,
+	0x07, 0x1b, 0xfe, 0x69, 0x1f, 0x93, 0xb2, 0xe2, 0xf8, 0x9f, 0x7e, 0x74, 0x0b, 0x38, 0x9d, 0xb6,
+	0xd4, 0x79, 0x01, 0x5a, 0xe3, 0x98, 0x68, 0x0f, 0x66, 0x0c, 0xed, 0x48, 0xef, 0x1d, 0xe2, 0xe2,
+	0xdc, 0xd8, 0xf0, 0x35, 0x6c, 0xc4, 0xe0, 0x6b, 0xd8, 0x50, 0xa7, 0x8d, 0x7d, 0x8a, 0x85, 0xde,
+	0x83, 0x6c, 0x4f, 0x27, 0xbe, 0x16, 0x60, 0x67, 0x27, 0x80, 0x0d, 0x14, 0xb1, 0xca, 0xf1, 0xd7,
+	0xa1, 0xe0, 0xe0, 0x13, 0x9f, 0xa2, 0x13, 0xec, 0x6b, 0x07, 0x3a, 0x39, 0x28, 0xe6, 0xd6, 0x12,
+	0xeb, 0x59, 0x75, 0x9e, 0xd2, 0xf7, 0x19, 0x79, 0x5b, 0x27, 0x07, 0xe8, 0x25, 0x28, 0x1c, 0x3a,
+	0x1d, 0xd7, 0x31, 0x2d, 0xa7, 0xab, 0xdd, 0xd7, 0x0d, 0xdf, 0xf5, 0x8a, 0xf3, 0x6b, 0x89, 0x75,
+	0x49, 0xcd, 0x87, 0xf4, 0xdb, 0x8c, 0x8c, 0x96, 0x61, 0x5a, 0x37, 0x7c, 0xeb, 0x08, 0x17, 0xf3,
+	0x6b, 0x89, 0xf5, 0x8c, 0x2a, 0x5a, 0x14, 0xc2, 0xc3, 0x1d, 0xbd, 0xa7, 0x3b, 0x06, 0x0e, 0x20,
+	0x0a, 0x1c, 0x22, 0xa4, 0x0b, 0x88, 0x0d, 0x58, 0xfa, 0x21, 0xf6, 0x5c, 0xed, 0x18, 0x5b, 0xdd,
+	0x03, 0x5f, 0x73, 0x1d, 0x8d, 0xf4, 0xe8, 0xda, 0x10, 0x03, 0x5c, 0xa0, 0x7d, 0x77, 0x59, 0x57,
+	0xd3, 0x69, 0xd1, 0x0e, 0xe4, 0xc2, 0x8a, 0x6e, 0x9a, 0x16, 0xd5, 0x4b, 0xbd, 0xa7, 0x9d, 0xd7,
+	0x74, 0x52, 0x5c, 0x5c, 0x93, 0xc6, 0xd3, 0xc9, 0x67, 0x23, 0xc0, 0xda, 0x59, 0xa5, 0x27, 0xa1,
+	0xe4, 0xfa, 0x9e, 0xdb, 0x77, 0x89, 0xce, 0xac, 0x71, 0x69, 0x2d, 0xb1, 0x9e, 0xe2, 0x92, 0xdb,
+	0x15, 0x64, 0xc5, 0x44, 0xdf, 0x87, 0x40, 0xbb, 0x35, 0xe1, 0x22, 0xae, 0x30, 0xf3, 0xf8, 0xff,
+	0x51, 0x5d, 0x84, 0xca, 0xb9, 0x85, 0x9f, 0xc8, 0x79, 0xf1, 0x26, 0x7a, 0x0f, 0xf2, 0xc7, 0x96,
+	0x63, 0x6a, 0xa6, 0x7b, 0xec, 0x68, 0xc4, 0xd7, 0x7d, 0x5c, 0x5c, 0x5e, 0x4b, 0xac, 0xcf, 0x6f,
+	0x7e, 0x63, 0x54, 0xf8, 0xf2, 0x5d, 0xcb, 0x31, 0x6b, 0xee, 0xb1, 0xd3, 0xa2, 0xdc, 0x6a, 0xee,
+	0x38, 0xde, 0x44, 0x18, 0x62, 0x26, 0x1f, 0x6c, 0xe0, 0x2a, 0xdb, 0xc0, 0xeb, 0xa3, 0xce, 0x10,
+	0x89, 0x4f, 0xec, 0xa1, 0x60, 0x9e, 0xa1, 0xa0, 0x03, 0x40, 0xb8, 0x67, 0x75, 0xad, 0x8e, 0xd5,
+	0xb3, 0xfc, 0xd3, 0x60, 0x9e, 0x22, 0x9b, 0xe7, 0x9b, 0xa3, 0xce, 0x23, 0x47, 0x08, 0x62, 0xa2,
+	0x05, 0x7c, 0x96, 0x84, 0x34, 0xc8, 0x1b, 0x96, 0x67, 0x1c, 0x5a, 0xbe, 0xd6, 0xf1, 0xb0, 0xfe,
+	0x00, 0x7b, 0xc5, 0x6b, 0x6c, 0x9a, 0x91, 0x05, 0x56, 0xe5, 0xec, 0x5b, 0x9c, 0x5b, 0x9d, 0x37,
+	0x06, 0xda, 0xe8, 0x7f, 0x60, 0xde, 0xea, 0x18, 0x9a, 0x8d, 0x6d, 0x57, 0xc3, 0x27, 0x96, 0x4f,
+	0x8a, 0xd7, 0x99, 0xd6, 0x66, 0xad, 0x8e, 0xb1, 0x83, 0x6d, 0x57, 0xa6, 0xb4, 0xd2, 0x3d, 0xc8,
+	0x0d, 0xc8, 0x1d, 0x21, 0x98, 0xbf, 0xab, 0x34, 0x6a, 0x5a, 0xad, 0x79, 0xb7, 0xa1, 0x35, 0x9a,
+	0x0d, 0xb9, 0x30, 0x85, 0xae, 0xc3, 0x72, 0x44, 0xdb, 0x6b, 0xd4, 0xe4, 0xba, 0xfc, 0x56, 0xa5,
+	0xad, 0x34, 0xde, 0x2a, 0x24, 0xd0, 0x55, 0x58, 0x8c, 0xfa, 0xaa, 0xf5, 0x8a, 0xb2, 0x53, 0xd9,
+	0xaa, 0xcb, 0x85, 0xe4, 0xb7, 0x52, 0xbf, 0xfc, 0xcd, 0x6a, 0xe2, 0xed, 0x54, 0x66, 0xa1, 0x80,
+	0xd4, 0x39, 0x5b, 0x3f, 0xd1, 0xb0, 0xe3, 0x7b, 0x16, 0x26, 0xa5, 0x9f, 0x49, 0xb0, 0x70, 0x2e,
+	0xee, 0xa0, 0x1f, 0xc0, 0x9c, 0x70, 0x8c, 0xda, 0x7d, 0x8c, 0x8b, 0x89, 0x49, 0x78, 0x18, 0x01,
+	0x78, 0x1b, 0x63, 0x0a, 0xef, 0x61, 0x26, 0x4b, 0x06, 0x9f, 0x9c, 0x04, 0xbc, 0x00, 0x14, 0xf0,
+	0x87, 0x4e, 0x04, 0x2f, 0x4d, 0x02, 0xfe, 0xd0, 0x09, 0xe1, 0x0d, 0x6a, 0xbb, 0x26, 0xb6, 0xfb,
+	0x4c, 0xfb, 0xe9, 0x0c, 0xa9, 0x09, 0xcc, 0x90, 0x8b, 0x30, 0x6f, 0x63, 0x5c, 0xfa, 0x2c, 0x01,
+	0xcb, 0x17, 0x1b, 0x3b, 0x75, 0x99, 0xb8, 0xef, 0x1a, 0x07, 0x9a, 0x65, 0x62, 0xc7, 0xb7, 0xee,
+	0x5b, 0xd8, 0x13, 0xe9, 0x49, 0x9e, 0xd1, 0x95, 0x90, 0x8c, 0x7a, 0xb0, 0x68, 0x5b, 0x8e, 0x66,
+	0xf4, 0x74, 0xcb, 0xd6, 0xfc, 0x03, 0x0f, 0x93, 0x03, 0xb7, 0x67, 0x16, 0x93, 0x13, 0x08, 0x76,
+	0x0b, 0xb6, 0xe5, 0x54, 0x29, 0x6e, 0x3b, 0x80, 0x45, 0x2f, 0x40, 0x9e, 0xaa, 0x96, 0x4d, 0xba,
+	0x44, 0xeb, 0x63, 0x4f, 0xf3, 0x4f, 0x98, 0xec, 0x53, 0x6a, 0xd6, 0xd6, 0x4f, 0x76, 0x48, 0x97,
+	0xec, 0x62, 0xaf, 0x7d, 0x52, 0xfa, 0x55, 0x02, 0xae, 0x3d, 0xd6, 0x0d, 0xa0, 0xeb, 0x90, 0x21,
+	0xbe, 0xa7, 0xfb, 0xb8, 0x7b, 0x2a, 0x76, 0x15, 0xb6, 0x91, 0x0e, 0xb9, 0x30, 0x8a, 0x6b, 0x86,
+	0xde, 0x9f, 0x88, 0xe6, 0x64, 0x43, 0xc8, 0xaa, 0xde, 0x2f, 0xfd, 0x39, 0x0d, 0x57, 0x1f, 0x63,
+	0xd4, 0x48, 0x85, 0x34, 0x77, 0xa6, 0x09, 0xe6, 0x4c, 0xdf, 0x78, 0x32, 0xdf, 0x50, 0xe6, 0x2e,
+	0x95, 0x43, 0xd1, 0xb8, 0xe8, 0x61, 0x9d, 0xb8, 0x8e, 0xc8, 0x20, 0x45, 0x0b, 0xbd, 0x00, 0xf3,
+	0xbe, 0x67, 0xf5, 0xfb, 0xd8, 0xd4, 0x0e, 0x58, 0x50, 0x63, 0xa2, 0x94, 0xd4, 0x9c, 0xa0, 0x6e,
+	0x33, 0x22, 0x72, 0xe1, 0x0a, 0x15, 0xb9, 0x48, 0x05, 0x34, 0x13, 0x1f, 0x59, 0x4c, 0x98, 0x13,
+	0x51, 0x49, 0x64, 0xeb, 0x27, 0x3c, 0x27, 0xa8, 0x05, 0xb8, 0xe8, 0x25, 0x58, 0xa0, 0x2b, 0xa0,
+	0xd1, 0xd7, 0x32, 0x74, 0xcd, 0xe8, 0xb9, 0x04, 0xb3, 0x8c, 0x33, 0xa3, 0xb2, 0x05, 0x37, 0x1d,
+	0xc5, 0xd0, 0xab, 0x94, 0x8a, 0xfe, 0x17, 0x16, 0xe8, 0xda, 0x2c, 0xe3, 0x21, 0x8d, 0x41, 0x3d,
+	0xec, 0x60, 0x42, 0x58, 0x02, 0x2a, 0xa9, 0x54, 0x4f, 0x14, 0xe3, 0x61, 0x2b, 0x20, 0xa3, 0xf7,
+	0x81, 0x4e, 0xc6, 0x03, 0xba, 0x76, 0xdf, 0xd3, 0x59, 0x0a, 0x5d, 0x9c, 0x99, 0xc0, 0x26, 0x0a,
+	0xb6, 0x7e, 0xc2, 0xd2, 0x81, 0xdb, 0x02, 0x15, 0xdd, 0x84, 0x3c, 0xcb, 0x9f, 0xe8, 0xc2, 0x84,
+	0x6c, 0x33, 0x5c, 0xb6, 0x94, 0xac, 0x18, 0x0f, 0xb9, 0x6c, 0x4b, 0xbf, 0x4f, 0x40, 0x9a, 0xbb,
+	0xe1, 0x55, 0x78, 0xa6, 0xaa, 0xa8, 0xd5, 0x3d, 0xa5, 0xad, 0x6d, 0xa9, 0x72, 0xe5, 0x8e, 0xac,
+	0x6a, 0xcd, 0x5d, 0x59, 0xad, 0xb4, 0x95, 0x66, 0xa3, 0x52, 0x2f, 0x4c, 0xa1, 0xe7, 0x61, 0xf5,
+	0xec, 0x80, 0x9a, 0xbc, 0xdb, 0x6c, 0x29, 0xed, 0x96, 0xb6, 0x5b, 0xd9, 0x6b, 0xc9, 0xb5, 0x42,
+	0xe2, 0xa2, 0x41, 0x7b, 0x8d, 0x56, 0xbb, 0x72, 0x47, 0x0e, 0x07, 0x25, 0xd1, 0x4d, 0x28, 0x9d,
+	0x1d, 0xa4, 0xca, 0x35, 0x79, 0x67, 0x97, 0xce, 0x15, 0x8e, 0x93, 0x68, 0x14, 0x38, 0x3b, 0x6e,
+	0xbb, 0x52, 0x6f, 0xcb, 0xb5, 0x42, 0xaa, 0xf4, 0xc7, 0x24, 0x5c, 0x7f, 0x7c, 0xfc, 0xa3, 0xfe,
+	0x8b, 0xe9, 0x8c, 0x6b, 0xdb, 0x16, 0x21, 0x54, 0xce, 0x93, 0xf0, 0xef, 0x39, 0xaa, 0x2c, 0x21,
+	0x24, 0x4d, 0x85, 0xe8, 0x24, 0xef, 0xeb, 0x56, 0x0f, 0x9b, 0x1a, 0xbf, 0x01, 0x50, 0x0d, 0xcf,
+	0xa9, 0x74, 0xf2, 0xb7, 0x19, 0xb9, 0x4a, 0xa9, 0xe8, 0x21, 0x2c, 0xd3, 0x91, 0x47, 0xae, 0x4f,
+	0xb3, 0xc8, 0xbe, 0x7b, 0x8c, 0x3d, 0x8d, 0x1c, 0xe8, 0xde, 0x64, 0x1c, 0xf7, 0xa2, 0xad, 0x9f,
+	0xec, 0x33, 0xe8, 0x5d, 0x8a, 0xdc, 0xa2, 0xc0, 0xd4, 0xc7, 0x98, 0xd8, 0x39, 0xed, 0x59, 0xc4,
+	0x2f, 0xa6, 0xd6, 0x24, 0xea, 0x63, 0x82, 0x76, 0xe9, 0x43, 0x09, 0x20, 0x4a, 0xf8, 0xd0, 0x26,
+	0xcc, 0xe8, 0xa6, 0xe9, 0x51, 0xd5, 0xe5, 0x52, 0x2a, 0x7e, 0xfa, 0xd1, 0xad, 0x25, 0x31, 0x41,
+	0x85, 0xf7, 0xb4, 0x7c, 0xcf, 0x72, 0xba, 0x6a, 0x30, 0x10, 0x99, 0x30, 0x23, 0x32, 0x57, 0xb6,
+	0xe5, 0xb9, 0xcd, 0x6b, 0x65, 0xc1, 0x40, 0x6f, 0xb4, 0xa1, 0x7f, 0xa8, 0xba, 0x96, 0xb3, 0xb5,
+	0x41, 0x77, 0xf7, 0xbb, 0x2f, 0x57, 0x5f, 0x1c, 0x61, 0x77, 0x94, 0x41, 0x0d, 0xa0, 0xd1, 0x12,
+	0xa4, 0xdd, 0x63, 0x07, 0x7b, 0x5c, 0x4c, 0x2a, 0x6f, 0xa0, 0x77, 0x21, 0x17, 0x5c, 0x05, 0xb9,
+	0xaf, 0x4a, 0x8d, 0x94, 0xf8, 0x45, 0x3b, 0x2e, 0x57, 0x39, 0x3b, 0xf7, 0x52, 0x59, 0x23, 0xd6,
+	0x42, 0x2a, 0x4d, 0x0b, 0x02, 0x7f, 0x4d, 0x8a, 0xe9, 0x35, 0x69, 0x84, 0x5b, 0xad, 0xc0, 0x8d,
+	0x1c, 0xbd, 0x1a, 0x07, 0x29, 0x55, 0x20, 0x1b, 0x9f, 0x11, 0x15, 0x61, 0x49, 0xa9, 0x56, 0xb4,
+	0xea, 0x76, 0xa5, 0xd1, 0x90, 0xeb, 0x5a, 0x55, 0x95, 0x79, 0x72, 0x33, 0x45, 0x93, 0x9b, 0x73,
+	0x3d, 0xd4, 0xb0, 0x4a, 0x1f, 0x26, 0x60, 0xe1, 0xdc, 0x2c, 0x48, 0x86, 0x85, 0x28, 0x58, 0x8c,
+	0x7a, 0x86, 0x85, 0x90, 0x45, 0xd0, 0x51, 0x1b, 0xa6, 0x75, 0x3b, 0x54, 0xdf, 0xa7, 0x8d, 0x9a,
+	0x02, 0xab, 0xf4, 0xa7, 0x34, 0xcc, 0x86, 0x77, 0x53, 0x54, 0x85, 0x82, 0xdb, 0xc7, 0xde, 0x58,
+	0x2b, 0xcd, 0x07, 0x1c, 0xc1, 0x42, 0x97, 0x61, 0x9a, 0x9e, 0xf8, 0x21, 0x09, 0x22, 0x09, 0x6f,
+	0xd1, 0x0d, 0x1c, 0x47, 0x11, 0xe4, 0xa9, 0x2f, 0xa1, 0x1c, 0x0b, 0x75, 0x21, 0xc8, 0xd7, 0xb1,
+	0xa9, 0x09, 0x01, 0xa5, 0x26, 0x20, 0xa0, 0x7c, 0x88, 0x5a, 0x61, 0xa0, 0x48, 0x83, 0xac, 0xef,
+	0xfa, 0x7a, 0x2f, 0x98, 0x24, 0x3d, 0x81, 0x49, 0xe6, 0x18, 0xa2, 0x98, 0x20, 0xda, 0x89, 0x2b,
+	0x1c, 0x0f, 0x8f, 0x52, 0x4f, 0x2b, 0xa9, 0x7c, 0x88, 0xca, 0x9c, 0x0e, 0x41, 0x2f, 0x42, 0x74,
+	0x2b, 0xd6, 0x58, 0xa6, 0xc6, 0x02, 0x9c, 0xa4, 0xce, 0x87, 0x64, 0x99, 0x52, 0x69, 0x79, 0x22,
+	0x72, 0xce, 0x1a, 0xcd, 0x7d, 0x8a, 0x99, 0x09, 0x2c, 0x68, 0x3e, 0x02, 0x55, 0x45, 0xea, 0xc1,
+	0xdd, 0x73, 0x71, 0x96, 0x5f, 0xc9, 0x79, 0x0b, 0x3d, 0x07, 0xd9, 0x01, 0xb7, 0x0d, 0xcc, 0x6d,
+	0xcf, 0xbd, 0x1f, 0xf9, 0xec, 0xd2, 0x5f, 0x25, 0x98, 0x09, 0xaa, 0x1c, 0x43, 0xaa, 0x64, 0xaf,
+	0x0d, 0xd8, 0xce, 0x50, 0x3f, 0x98, 0xa2, 0x5b, 0x0b, 0xcc, 0x83, 0x66, 0x5a, 0x5c, 0x40, 0xd2,
+	0x04, 0x4e, 0x9b, 0x43, 0x21, 0x25, 0xc8, 0xde, 0xb8, 0x47, 0x7c, 0xf5, 0x12, 0xb7, 0x25, 0xb6,
+	0x17, 0xfc, 0x1f, 0x48, 0xda, 0x6e, 0x42, 0x9e, 0xde, 0xe6, 0x08, 0x7e, 0x78, 0x88, 0x69, 0xdd,
+	0x22, 0x2c, 0xba, 0xe5, 0xac, 0x8e, 0xd1, 0x12, 0x54, 0xc5, 0x44, 0xaf, 0x43, 0xf1, 0x7c, 0xd5,
+	0x41, 0xe3, 0x5e, 0x9b, 0x57, 0xe2, 0x96, 0xcf, 0x95, 0xce, 0x9a, 0xb4, 0xb7, 0x64, 0x40, 0x36,
+	0x3e, 0x31, 0x5a, 0x84, 0xbc, 0x48, 0x28, 0xb4, 0x5d, 0xb9, 0x51, 0xe3, 0x0e, 0xb1, 0x00, 0xd9,
+	0x80, 0xd8, 0x92, 0x1b, 0xed, 0x42, 0x02, 0x2d, 0x41, 0x21, 0xa0, 0xa8, 0x72, 0x55, 0x56, 0xf6,
+	0x59, 0x4e, 0xb1, 0x0c, 0x28, 0xa0, 0xc6, 0x6e, 0x8b, 0x52, 0xe9, 0x9f, 0x29, 0x98, 0xdd, 0x0b,
+	0x54, 0x6f, 0xd8, 0x39, 0x3e, 0x07, 0x59, 0x7e, 0xe3, 0x70, 0x0e, 0xed, 0x0e, 0xf6, 0xd8, 0x69,
+	0x4a, 0xea, 0x1c, 0xa3, 0x35, 0x18, 0x09, 0xc9, 0x30, 0x67, 0xeb, 0xfe, 0xa1, 0x87, 0x35, 0xdf,
+	0xb2, 0xb1, 0x28, 0x78, 0x5e, 0x2f, 0xf3, 0x42, 0x6c, 0x39, 0x28, 0xc4, 0x96, 0xdb, 0x41, 0xa5,
+	0x76, 0x2b, 0x43, 0xcf, 0xf4, 0x83, 0x2f, 0x57, 0x13, 0x2a, 0x70, 0x46, 0xda, 0x85, 0xbe, 0x03,
+	0x73, 0x9d, 0x43, 0xcf, 0x89, 0x7b, 0x94, 0x11, 0xd4, 0x06, 0x28, 0x8f, 0x30, 0xe7, 0x1a, 0xe4,
+	0xb8, 0x39, 0xc5, 0x1d, 0xc6, 0x08, 0x18, 0x59, 0xce, 0x25, 0x50, 0x2e, 0x38, 0xe1, 0xe9, 0x8b,
+	0x4e, 0x78, 0x27, 0x50, 0xaa, 0x19, 0xa6, 0x54, 0xaf, 0x5d, 0xa2, 0x54, 0xa1, 0xb4, 0xa3, 0x5f,
+	0x03, 0x8a, 0x35, 0x4c, 0x61, 0x32, 0x43, 0x15, 0xe6, 0xd7, 0x09, 0x98, 0x1f, 0xc4, 0x44, 0x57,
+	0x60, 0x61, 0xaf, 0xb1, 0xd5, 0x64, 0xda, 0x12, 0xd3, 0x9a, 0xab, 0xb0, 0x18, 0x91, 0x95, 0x86,
+	0xd2, 0x56, 0x78, 0x18, 0xa5, 0x6a, 0x12, 0x75, 0xec, 0x54, 0xda, 0x7b, 0x2a, 0x65, 0x48, 0x0e,
+	0xe2, 0x30, 0x3a, 0xcb, 0x40, 0x07, 0x70, 0xa2, 0x5a, 0x43, 0x8a, 0x2a, 0x61, 0xd4, 0x71, 0xbb,
+	0xa2, 0xd4, 0xe5, 0x5a, 0x21, 0x5d, 0xfa, 0x71, 0x12, 0x72, 0x7b, 0x04, 0x7b, 0x93, 0x52, 0xb8,
+	0x58, 0x62, 0x26, 0x8d, 0x9a, 0x98, 0xbd, 0x09, 0x40, 0xfc, 0x07, 0x63, 0x2a, 0xd7, 0x2c, 0xf1,
+	0x1f, 0x4c, 0x52, 0xb7, 0x4a, 0x5f, 0x27, 0x01, 0x85, 0xb1, 0xff, 0xbf, 0xcc, 0xfe, 0x2e, 0x4c,
+	0x9a, 0x52, 0x63, 0x27, 0x4d, 0x91, 0xe3, 0x4f, 0x8f, 0xe7, 0xf8, 0x47, 0xb5, 0xbb, 0x61, 0x86,
+	0x32, 0x33, 0xd4, 0x50, 0x36, 0x21, 0x73, 0x67, 0x7f, 0xaf, 0x6f, 0x52, 0x0b, 0x29, 0x80, 0xf4,
+	0x00, 0x07, 0x65, 0x06, 0xfa, 0x93, 0x26, 0xd5, 0xbc, 0xa8, 0xce, 0x73, 0x28, 0xde, 0x28, 0x7d,
+	0x2e, 0x01, 0xd4, 0x5b, 0x3b, 0x23, 0x44, 0xbc, 0xff, 0x48, 0xb6, 0x48, 0x57, 0xc5, 0x5f, 0x7e,
+	0x44, 0xaa, 0xcf, 0x1a, 0xe8, 0x19, 0x98, 0xa5, 0xb2, 0x8a, 0xbf, 0x09, 0x65, 0xac, 0x8e, 0xc1,
+	0x9f, 0x84, 0xe4, 0xb0, 0x44, 0x1b, 0x3b, 0xc8, 0xf4, 0x65, 0x07, 0x19, 0xb2, 0x04, 0x07, 0xd9,
+	0x0c, 0xfc, 0xdb, 0x34, 0xf3, 0x6f, 0x97, 0x55, 0x5d, 0x23, 0x21, 0xc5, 0x7e, 0x5e, 0x16, 0x3a,
+	0x67, 0x2e, 0x38, 0xe0, 0xd2, 0x01, 0xe4, 0xcf, 0x20, 0x3c, 0x5d, 0x0c, 0x2c, 0xc2, 0x52, 0x40,
+	0xdd, 0x6b, 0xb4, 0x9b, 0x77, 0xe4, 0x86, 0xf2, 0x0e, 0x8f, 0x82, 0x8f, 0x24, 0xc8, 0xaa, 0x38,
+	0xd2, 0x96, 0x61, 0xc7, 0xbb, 0x09, 0x57, 0x88, 0x67, 0x68, 0xa1, 0xbe, 0x87, 0x92, 0xe5, 0xea,
+	0xb2, 0x48, 0x3c, 0x63, 0xff, 0xac, 0x2d, 0x6c, 0xc2, 0x15, 0x93, 0xf8, 0x17, 0xf0, 0xf0, 0xc3,
+	0x5c, 0x34, 0x89, 0xbf, 0xff, 0x78, 0xfb, 0x49, 0x8d, 0x67, 0x3f, 0x3b, 0x2c, 0x75, 0xec, 0xf7,
+	0x30, 0xb3, 0x0b, 0xe6, 0x0a, 0xd2, 0x63, 0xb8, 0x82, 0xf9, 0x88, 0x99, 0x76, 0x8f, 0x6c, 0x8e,
+	0xad, 0xc1, 0x30, 0xf8, 0xed, 0x4b, 0xd4, 0x24, 0x2e, 0xee, 0x81, 0x46, 0x5c, 0x55, 0x4a, 0x6f,
+	0xc3, 0xc2, 0xb9, 0x3e, 0x5a, 0xf7, 0x50, 0xe5, 0x20, 0x8b, 0x69, 0x36, 0x62, 0x01, 0x6c, 0x0a,
+	0x5d, 0x83, 0x2b, 0x03, 0x7d, 0x61, 0x0c, 0x4b, 0x94, 0x3e, 0x4d, 0x42, 0x4e, 0x54, 0x51, 0x55,
+	0x6c, 0xb8, 0x9e, 0x39, 0xec, 0x94, 0x97, 0x82, 0xec, 0x93, 0xfb, 0x59, 0xde, 0xa0, 0xce, 0xbf,
+	0xeb, 0xb9, 0x84, 0x68, 0xe2, 0xad, 0xa5, 0x28, 0x8d, 0x76, 0x34, 0x59, 0xc6, 0x25, 0x26, 0xa7,
+	0x09, 0x4e, 0xbc, 0xf4, 0x3d, 0x6a, 0x82, 0x13, 0xab, 0x6e, 0xbf, 0x09, 0x70, 0x1f, 0x63, 0xcd,
+	0xb6, 0x1c, 0x1f, 0x9b, 0xa3, 0xfa, 0xd7, 0xd9, 0xfb, 0x18, 0xef, 0x30, 0x0e, 0xb4, 0x0d, 0x79,
+	0x81, 0x16, 0x86, 0xb1, 0xe9, 0xd1, 0x40, 0xe6, 0x03, 0x3e, 0x11, 0xc8, 0x7e, 0x2e, 0x41, 0x9a,
+	0xbf, 0xb4, 0x0d, 0x11, 0xe6, 0x85, 0x11, 0x25, 0x39, 0x76, 0x44, 0x59, 0x86, 0xe9, 0x81, 0x3a,
+	0xa8, 0x68, 0xa1, 0xd7, 0x21, 0xc5, 0xb4, 0x3c, 0x35, 0x86, 0x96, 0x33, 0x8e, 0x27, 0x8f, 0x51,
+	0xf7, 0x20, 0x13, 0x56, 0x28, 0x27, 0x71, 0x51, 0x0c, 0xd1, 0xd0, 0x6d, 0x88, 0xae, 0x82, 0x5a,
+	0xcf, 0x25, 0xa4, 0x38, 0x33, 0xda, 0xd2, 0x72, 0x21, 0x5b, 0xdd, 0x25, 0xa4, 0xf4, 0x37, 0x09,
+	0xd2, 0x4a, 0xb5, 0xd2, 0x3e, 0x41, 0xab, 0x30, 0x17, 0x37, 0x5e, 0x7e, 0x36, 0x40, 0x22, 0xcb,
+	0x8d, 0x9f, 0x5c, 0xf2, 0x92, 0x6f, 0x1c, 0xa4, 0x0b, 0xbe, 0x71, 0x08, 0xab, 0x50, 0xa9, 0x78,
+	0x15, 0xea, 0x65, 0xc8, 0xd8, 0x98, 0x10, 0xbd, 0x8b, 0x83, 0x2a, 0xd1, 0xd2, 0xb9, 0x93, 0xa9,
+	0x38, 0xa7, 0x6a, 0x38, 0x8a, 0x2f, 0xd4, 0xf1, 0x83, 0x82, 0x2c, 0x2f, 0x13, 0x03, 0x25, 0x89,
+	0x4a, 0xf7, 0x76, 0x58, 0xde, 0xe0, 0x3e, 0xe6, 0xe5, 0xcb, 0x2b, 0x5a, 0xed, 0x13, 0xfe, 0xb7,
+	0xc5, 0xf8, 0xc2, 0x82, 0xc8, 0x2a, 0x35, 0x41, 0xdf, 0x3b, 0xd5, 0xa2, 0xef, 0x12, 0x72, 0xd4,
+	0xc2, 0x7c, 0xef, 0x94, 0x57, 0x24, 0x6f, 0x00, 0x7b, 0x0e, 0xd7, 0xb0, 0xe7, 0xb9, 0x1e, 0xbb,
+	0x1c, 0xcf, 0xaa, 0xb3, 0x94, 0x22, 0x53, 0x42, 0xc9, 0x87, 0xb9, 0x18, 0x2c, 0x7d, 0xa3, 0xa3,
+	0x65, 0xa9, 0xf6, 0xbd, 0x58, 0x54, 0x5a, 0x82, 0x82, 0xa0, 0xb5, 0xf6, 0xaa, 0x55, 0x59, 0xae,
+	0xb1, 0x04, 0x7b, 0x01, 0x72, 0x82, 0x2a, 0xb2, 0xe2, 0x64, 0x6c, 0x60, 0x5b, 0xd9, 0x91, 0x6b,
+	0x5a, 0x73, 0xaf, 0x5d, 0x90, 0x62, 0x90, 0xaa, 0xdc, 0x56, 0x15, 0x56, 0xd4, 0xfd, 0x69, 0x02,
+	0x72, 0x75, 0xb6, 0x55, 0x5a, 0xc9, 0x75, 0xdd, 0xde, 0x30, 0xa3, 0x0b, 0x8b, 0x26, 0xa2, 0x9e,
+	0x91, 0x9c, 0x58, 0xd1, 0x84, 0xd7, 0x32, 0x4a, 0x7f, 0x48, 0xc0, 0x42, 0xb4, 0x1a, 0xcf, 0x3d,
+	0xb2, 0x4c, 0xec, 0x0d, 0x8f, 0x9c, 0x33, 0xa3, 0x1a, 0x7f, 0x30, 0x90, 0x26, 0x53, 0x62, 0xfd,
+	0x93, 0x28, 0x03, 0x08, 0xac, 0xd2, 0xd7, 0xa9, 0xd8, 0x8b, 0x67, 0xf0, 0x24, 0x3f, 0x6c, 0xe9,
+	0xab, 0x30, 0x17, 0x7f, 0xd0, 0x4f, 0xb2, 0x27, 0x2d, 0xe8, 0x47, 0x8f, 0xf9, 0x75, 0xc8, 0x8b,
+	0xea, 0x35, 0x76, 0xcc, 0xf1, 0xf3, 0xef, 0x1c, 0x67, 0x96, 0x1d, 0x93, 0xf6, 0xa2, 0xf6, 0x60,
+	0x9d, 0xe2, 0xcd, 0x51, 0x5f, 0x99, 0x82, 0xad, 0x94, 0x83, 0x1f, 0x03, 0x79, 0xd7, 0x73, 0x90,
+	0xf5, 0xf5, 0x5e, 0xef, 0x34, 0x30, 0xb0, 0x34, 0xbf, 0x42, 0x30, 0x9a, 0xb0, 0xb0, 0x2d, 0x48,
+	0xb3, 0x66, 0x71, 0x9a, 0x59, 0xec, 0xcd, 0xc0, 0xe9, 0xd0, 0xcf, 0xa3, 0x82, 0xd9, 0xf8, 0x07,
+	0x16, 0xd8, 0xdc, 0x77, 0x7d, 0xdc, 0x64, 0x8f, 0x95, 0xc2, 0x03, 0x71, 0x56, 0xfa, 0xf4, 0xca,
+	0x15, 0x8f, 0xd5, 0xf1, 0x8b, 0x33, 0x13, 0x38, 0x37, 0x60, 0x80, 0xac, 0x7a, 0x7f, 0x51, 0x3e,
+	0x92, 0xb9, 0x28, 0x7b, 0xfc, 0x45, 0x02, 0x72, 0x03, 0x62, 0xa0, 0x86, 0xb6, 0xab, 0x36, 0x77,
+	0x9b, 0xad, 0x4a, 0x3d, 0x78, 0x9a, 0x29, 0x4c, 0xd1, 0x94, 0x32, 0xa4, 0xee, 0x37, 0xa3, 0x47,
+	0xf4, 0x90, 0xd8, 0xda, 0xdb, 0xda, 0x51, 0xda, 0x6d, 0x7e, 0x11, 0x5e, 0x06, 0x74, 0xb6, 0x83,
+	0xdd, 0x84, 0xe3, 0x28, 0xc2, 0xb2, 0x53, 0xf4, 0xd6, 0x1c, 0x12, 0x1b, 0x4d, 0x8a, 0x2e, 0xb7,
+	0x0a, 0xe9, 0xd2, 0x5f, 0x12, 0x90, 0x0d, 0x56, 0x46, 0x85, 0xf8, 0x54, 0x8a, 0x57, 0x86, 0xf4,
+	0x91, 0xeb, 0x07, 0x4f, 0x00, 0x43, 0x4c, 0x8a, 0x0f, 0x43, 0xb7, 0x61, 0xc6, 0xed, 0xf3, 0xda,
+	0x7d, 0xea, 0x09, 0xce, 0x38, 0x60, 0x2e, 0xfd, 0x24, 0x0d, 0x73, 0x75, 0x6c, 0x76, 0xb1, 0x27,
+	0x3b, 0xbe, 0x77, 0x8a, 0xe6, 0x21, 0x29, 0x56, 0x9f, 0x52, 0x93, 0xd6, 0x93, 0x19, 0x7b, 0x5c,
+	0x0e, 0xd2, 0xa0, 0x1c, 0xb6, 0x21, 0x45, 0x95, 0x43, 0x18, 0xc4, 0xff, 0x5d, 0x76, 0x07, 0x89,
+	0x16, 0x56, 0x66, 0x7f, 0xdb, 0xa7, 0x7d, 0xac, 0x32, 0x84, 0x27, 0x8f, 0xf9, 0x83, 0x95, 0x83,
+	0xe9, 0xb1, 0x2b, 0x07, 0xaf, 0x80, 0x44, 0xd3, 0xbd, 0x11, 0xc3, 0x39, 0x1d, 0x1b, 0xff, 0x7a,
+	0x2c, 0x33, 0xc1, 0xaf, 0xc7, 0xa2, 0x44, 0x6a, 0xf6, 0xc2, 0x44, 0x0a, 0xc6, 0x4d, 0xa4, 0x4a,
+	0x3f, 0x82, 0xd9, 0x50, 0xce, 0xe8, 0x06, 0x5c, 0xab, 0xcb, 0xb5, 0xb7, 0x64, 0x55, 0x93, 0x1b,
+	0x6d, 0xf5, 0x7b, 0x5a, 0x5d, 0xf9, 0xee, 0x9e, 0x52, 0xd3, 0xd8, 0x33, 0x67, 0x61, 0x8a, 0xbe,
+	0xa4, 0x5e, 0xd4, 0x2d, 0xde, 0x41, 0xb9, 0xf1, 0x0d, 0x0c, 0xa0, 0x09, 0xbd, 0xbc, 0xc3, 0x8d,
+	0x6f, 0xa0, 0x83, 0x55, 0x9c, 0x0a, 0x52, 0xe9, 0xb7, 0x12, 0x20, 0xf9, 0x84, 0x3e, 0x53, 0x75,
+	0x31, 0x2d, 0x6b, 0x5f, 0x9e, 0xde, 0x8f, 0x50, 0x4d, 0x09, 0x84, 0x21, 0x8d, 0x9d, 0x55, 0xc6,
+	0x4e, 0x2d, 0x35, 0xc1, 0x53, 0x8b, 0x02, 0x3a, 0x4b, 0xc0, 0x27, 0xf8, 0x0a, 0xd2, 0x62, 0x80,
+	0xf4, 0xd3, 0x0a, 0x7e, 0xa3, 0x88, 0xeb, 0xf8, 0xd3, 0xce, 0x90, 0xe5, 0x90, 0xe2, 0xba, 0xf0,
+	0xf7, 0x04, 0xe4, 0x83, 0xcf, 0x9b, 0x54, 0x4c, 0xb0, 0x77, 0x34, 0xd4, 0xfb, 0xdd, 0x00, 0xa0,
+	0xec, 0xa2, 0xbe, 0xc1, 0x73, 0xd3, 0x59, 0x4a, 0xe1, 0x05, 0x8e, 0x98, 0xa0, 0xa5, 0x09, 0x0a,
+	0xfa, 0x49, 0x6f, 0xde, 0x5b, 0xef, 0x7e, 0xfc, 0x68, 0x25, 0xf1, 0xc9, 0xa3, 0x95, 0xc4, 0x3f,
+	0x1e, 0xad, 0x24, 0x3e, 0xf8, 0x6a, 0x65, 0xea, 0x93, 0xaf, 0x56, 0xa6, 0x3e, 0xfb, 0x6a, 0x65,
+	0xea, 0x9d, 0x4a, 0x6c, 0x41, 0x7d, 0xec, 0x11, 0x8b, 0xf8, 0x34, 0x4c, 0x35, 0x1d, 0xbc, 0xc1,
+	0x3d, 0xd9, 0x2d, 0x47, 0xa7, 0xdf, 0x42, 0x6e, 0x1c, 0x6d, 0x6e, 0x9c, 0x9c, 0xfd, 0xf0, 0x9a,
+	0xad, 0xb7, 0x33, 0xcd, 0x34, 0xef, 0xd5, 0x7f, 0x0f, 0x00, 0xf3, 0x04, 0x65, 0x10, 0x9e, 0x2d,
+	0x00, 0x00,
+}
+
+func (m *HostChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IbcMemoExits {
+		i--
+		if m.IbcMemoExits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.CircuitBreaker != nil {
+		{
+			size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.EligibilityParams != nil {
+		{
+			size, err := m.EligibilityParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.DelegationParams != nil {
+		{
+			size, err := m.DelegationParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.WindDownState != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.WindDownState))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.RewardsParams != nil {
+		{
+			size, err := m.RewardsParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.NextProposalId != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.NextProposalId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.AdditionalDelegationAccounts) > 0 {
+		for iNdEx := len(m.AdditionalDelegationAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalDelegationAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.ZeroWeightOnSlash {
+		i--
+		if m.ZeroWeightOnSlash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.RebalanceFactor != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.RebalanceFactor))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.UnbondingFactor != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingFactor))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.NextValsetHash) > 0 {
+		i -= len(m.NextValsetHash)
+		copy(dAtA[i:], m.NextValsetHash)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.NextValsetHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size := m.LastCValue.Size()
+		i -= size
+		if _, err := m.LastCValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MinimumDeposit.Size()
+		i -= size
+		if _, err := m.MinimumDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.RewardsAccount != nil {
+		{
+			size, err := m.RewardsAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DelegationAccount != nil {
+		{
+			size, err := m.DelegationAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.HostDenom) > 0 {
+		i -= len(m.HostDenom)
+		copy(dAtA[i:], m.HostDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.HostDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainLSParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainLSParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainLSParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RedemptionFee.Size()
+		i -= size
+		if _, err := m.RedemptionFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.UnstakeFee.Size()
+		i -= size
+		if _, err := m.UnstakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RestakeFee.Size()
+		i -= size
+		if _, err := m.RestakeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.DepositFee.Size()
+		i -= size
+		if _, err := m.DepositFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainRewardsParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainRewardsParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainRewardsParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMsgsPerTx != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MaxMsgsPerTx))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinClaimThreshold.Size()
+		i -= size
+		if _, err := m.MinClaimThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainDelegationParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainDelegationParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainDelegationParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorCap.Size()
+		i -= size
+		if _, err := m.ValidatorCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastIcqHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.LastIcqHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.MaxSlashFraction.Size()
+		i -= size
+		if _, err := m.MaxSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxIcqStaleness != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MaxIcqStaleness))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TripOnIcaClose {
+		i--
+		if m.TripOnIcaClose {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MaxCValueDeviation.Size()
+		i -= size
+		if _, err := m.MaxCValueDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TrippedHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.TrippedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainEligibilityParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainEligibilityParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainEligibilityParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MaxVotingPowerShare.Size()
+		i -= size
+		if _, err := m.MaxVotingPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MaxJailedCount != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.MaxJailedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxCommission.Size()
+		i -= size
+		if _, err := m.MaxCommission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ICAAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICAAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICAAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ChannelState != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ChannelState))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Validator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Validator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedCount != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.JailedCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.UnbondingEpoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.UnbondingEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegationAccountOwner) > 0 {
+		i -= len(m.DelegationAccountOwner)
+		copy(dAtA[i:], m.DelegationAccountOwner)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegationAccountOwner)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Unbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Unbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Unbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegationAccountOwner) > 0 {
+		i -= len(m.DelegationAccountOwner)
+		copy(dAtA[i:], m.DelegationAccountOwner)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegationAccountOwner)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BurnAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnbondAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.StkAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegationAccountOwner) > 0 {
+		i -= len(m.DelegationAccountOwner)
+		copy(dAtA[i:], m.DelegationAccountOwner)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegationAccountOwner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.MatureTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KVUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KVUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KVUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LSMDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LSMDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LSMDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DstValidatorAddress) > 0 {
+		i -= len(m.DstValidatorAddress)
+		copy(dAtA[i:], m.DstValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.DstValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValidatorAddress) > 0 {
+		i -= len(m.SrcValidatorAddress)
+		copy(dAtA[i:], m.SrcValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RestakedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.FeeMinted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.RestakeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.GrossRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Slash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Slash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Slash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnbondingLoss.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ICATx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ICATx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ICATx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.RetryCount != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.RetryCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SentHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.SentHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SequenceId) > 0 {
+		i -= len(m.SequenceId)
+		copy(dAtA[i:], m.SequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.SequenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalShares.Size()
+		i -= size
+		if _, err := m.TotalShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HostChainProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostChainProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostChainProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IbcSequenceId) > 0 {
+		i -= len(m.IbcSequenceId)
+		copy(dAtA[i:], m.IbcSequenceId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.IbcSequenceId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Tally) > 0 {
+		for iNdEx := len(m.Tally) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tally[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TallyHeight != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.TallyHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.State != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	n26, err26 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingEndTime):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x1a
+	if m.ProposalId != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LedgerEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LedgerEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LedgerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err27 != nil {
+		return 0, err27
+	}
+	i -= n27
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x52
+	if m.Height != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.StkAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Type != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MintedAmount.Size()
+		i -= size
+		if _, err := m.MintedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalStaked.Size()
+		i -= size
+		if _, err := m.TotalStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n31, err31 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintLiquidstakeibc(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindDownReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindDownReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindDownReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintLiquidstakeibc(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidstakeibc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidstakeibc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.DelegationAccount != nil {
+		l = m.DelegationAccount.Size()
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.RewardsAccount != nil {
+		l = m.RewardsAccount.Size()
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	l = m.MinimumDeposit.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.LastCValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.NextValsetHash)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.UnbondingFactor != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingFactor))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.RebalanceFactor != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.RebalanceFactor))
+	}
+	if m.ZeroWeightOnSlash {
+		n += 3
+	}
+	if len(m.AdditionalDelegationAccounts) > 0 {
+		for _, e := range m.AdditionalDelegationAccounts {
+			l = e.Size()
+			n += 2 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if m.NextProposalId != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.NextProposalId))
+	}
+	if m.RewardsParams != nil {
+		l = m.RewardsParams.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.WindDownState != 0 {
+		n += 2 + sovLiquidstakeibc(uint64(m.WindDownState))
+	}
+	if m.DelegationParams != nil {
+		l = m.DelegationParams.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EligibilityParams != nil {
+		l = m.EligibilityParams.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.CircuitBreaker != nil {
+		l = m.CircuitBreaker.Size()
+		n += 2 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.IbcMemoExits {
+		n += 3
+	}
+	return n
+}
+
+func (m *HostChainLSParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RestakeFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnstakeFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RedemptionFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *HostChainRewardsParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.MinClaimThreshold.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.MaxMsgsPerTx != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.MaxMsgsPerTx))
+	}
+	return n
+}
+
+func (m *HostChainDelegationParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.ValidatorCap.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *HostChainCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.TrippedHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.TrippedHeight))
+	}
+	l = m.MaxCValueDeviation.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.TripOnIcaClose {
+		n += 2
+	}
+	if m.MaxIcqStaleness != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.MaxIcqStaleness))
+	}
+	l = m.MaxSlashFraction.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.LastIcqHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.LastIcqHeight))
+	}
+	return n
+}
+
+func (m *HostChainEligibilityParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxCommission.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.MaxJailedCount != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.MaxJailedCount))
+	}
+	l = m.MaxVotingPowerShare.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ICAAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ChannelState != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ChannelState))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.UnbondingEpoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.UnbondingEpoch))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedCount != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.JailedCount))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Epoch.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.DelegationAccountOwner)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *Unbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.BurnAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnbondAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	l = len(m.DelegationAccountOwner)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *UserUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.StkAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnbondAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *ValidatorUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.MatureTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.DelegationAccountOwner)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *KVUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *LSMDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.SrcValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.DstValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	return n
+}
+
+func (m *RewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Epoch))
+	}
+	l = m.GrossRewards.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RestakeFee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.FeeMinted.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.RestakedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *Slash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.UnbondingLoss.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *ICATx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	if m.SentHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.SentHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Status))
+	}
+	if m.RetryCount != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.RetryCount))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.TotalShares.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *LiquidityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *HostChainProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingEndTime)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.State))
+	}
+	if m.TallyHeight != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.TallyHeight))
+	}
+	if len(m.Tally) > 0 {
+		for _, e := range m.Tally {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	l = m.TotalPower.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = len(m.IbcSequenceId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovLiquidstakeibc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LedgerEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Type))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.StkAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *ExchangeRateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstakeibc(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.TotalStaked.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.MintedAmount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func (m *WindDownReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	}
+	l = m.CValue.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstakeibc(uint64(l))
+	return n
+}
+
+func sovLiquidstakeibc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidstakeibc(x uint64) (n int) {
+	return sovLiquidstakeibc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HostChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &HostChainLSParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationAccount == nil {
+				m.DelegationAccount = &ICAAccount{}
+			}
+			if err := m.DelegationAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardsAccount == nil {
+				m.RewardsAccount = &ICAAccount{}
+			}
+			if err := m.RewardsAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextValsetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextValsetHash = append(m.NextValsetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextValsetHash == nil {
+				m.NextValsetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
+			}
+			m.UnbondingFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFactor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceFactor", wireType)
+			}
+			m.RebalanceFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalanceFactor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroWeightOnSlash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ZeroWeightOnSlash = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalDelegationAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalDelegationAccounts = append(m.AdditionalDelegationAccounts, &ICAAccount{})
+			if err := m.AdditionalDelegationAccounts[len(m.AdditionalDelegationAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextProposalId", wireType)
+			}
+			m.NextProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardsParams == nil {
+				m.RewardsParams = &HostChainRewardsParams{}
+			}
+			if err := m.RewardsParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindDownState", wireType)
+			}
+			m.WindDownState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindDownState |= HostChain_WindDownState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegationParams == nil {
+				m.DelegationParams = &HostChainDelegationParams{}
+			}
+			if err := m.DelegationParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibilityParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EligibilityParams == nil {
+				m.EligibilityParams = &HostChainEligibilityParams{}
+			}
+			if err := m.EligibilityParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CircuitBreaker == nil {
+				m.CircuitBreaker = &HostChainCircuitBreaker{}
+			}
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcMemoExits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcMemoExits = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainLSParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainLSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainRewardsParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainRewardsParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainRewardsParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinClaimThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinClaimThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerTx", wireType)
+			}
+			m.MaxMsgsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainDelegationParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainDelegationParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainDelegationParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= HostChainCircuitBreaker_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedHeight", wireType)
+			}
+			m.TrippedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCValueDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCValueDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TripOnIcaClose", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TripOnIcaClose = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIcqStaleness", wireType)
+			}
+			m.MaxIcqStaleness = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIcqStaleness |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIcqHeight", wireType)
+			}
+			m.LastIcqHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastIcqHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostChainEligibilityParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostChainEligibilityParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostChainEligibilityParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJailedCount", wireType)
+			}
+			m.MaxJailedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxJailedCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVotingPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ICAAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			m.ChannelState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelState |= ICAAccount_ChannelState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, &AccountDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpoch", wireType)
+			}
+			m.UnbondingEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedCount", wireType)
+			}
+			m.JailedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Deposit_DepositState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccountOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationAccountOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Unbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Unbonding_UnbondingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccountOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationAccountOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUnbonding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccountOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationAccountOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KVUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KVUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KVUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LSMDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LSMDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LSMDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= LSMDeposit_LSMDepositState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *KVUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovLiquidstakeibc(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovLiquidstakeibc(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidstakeibc(x uint64) (n int) {
-	return sovLiquidstakeibc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *HostChain) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1924,17 +9259,146 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -1944,27 +9408,28 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1992,13 +9457,13 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2008,31 +9473,64 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= Redelegation_RedelegationState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &HostChainLSParams{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2060,13 +9558,13 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2076,29 +9574,16 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrossRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2108,27 +9593,28 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			if err := m.GrossRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2155,16 +9641,13 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegationAccount == nil {
-				m.DelegationAccount = &ICAAccount{}
-			}
-			if err := m.DelegationAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMinted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2191,16 +9674,13 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RewardsAccount == nil {
-				m.RewardsAccount = &ICAAccount{}
-			}
-			if err := m.RewardsAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2227,14 +9707,63 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RestakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Slash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2262,13 +9791,11 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2296,15 +9823,32 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2314,31 +9858,30 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastCValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextValsetHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2348,31 +9891,30 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextValsetHash = append(m.NextValsetHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextValsetHash == nil {
-				m.NextValsetHash = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
-			m.UnbondingFactor = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2382,16 +9924,31 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingFactor |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingLoss", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2401,12 +9958,25 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnbondingLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -2428,7 +9998,7 @@ func (m *HostChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
+func (m *ICATx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2451,15 +10021,15 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostChainLSParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ICATx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostChainLSParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ICATx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2487,13 +10057,11 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SequenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2521,13 +10089,11 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2555,13 +10121,11 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2589,65 +10153,13 @@ func (m *HostChainLSParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ICAAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstakeibc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ICAAccount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ICAAccount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2657,29 +10169,31 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentHeight", wireType)
 			}
-			var msglen int
+			m.SentHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -2689,28 +10203,52 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SentHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ICATx_ICATxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2738,27 +10276,8 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.LastError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
-			}
-			m.ChannelState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChannelState |= ICAAccount_ChannelState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -2780,7 +10299,7 @@ func (m *ICAAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *LiquidityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2803,15 +10322,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2839,11 +10358,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2871,45 +10390,63 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstakeibc
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2937,13 +10474,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2971,13 +10506,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3005,29 +10538,10 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingEpoch", wireType)
-			}
-			m.UnbondingEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstakeibc
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingEpoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
@@ -3049,7 +10563,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *HostChainProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3072,10 +10586,10 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostChainProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostChainProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3111,8 +10625,27 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3139,15 +10672,53 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= HostChainProposal_ProposalState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyHeight", wireType)
+			}
+			m.TallyHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3157,31 +10728,31 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tally = append(m.Tally, v1beta1.WeightedVoteOption{})
+			if err := m.Tally[len(m.Tally)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 			}
-			m.State = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3191,12 +10762,27 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= Deposit_DepositState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
 			}
@@ -3249,7 +10835,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Unbonding) Unmarshal(dAtA []byte) error {
+func (m *ProposalVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,10 +10858,10 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Unbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Unbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3312,9 +10898,9 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			m.EpochNumber = 0
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3324,16 +10910,16 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3343,28 +10929,27 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3391,15 +10976,85 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, v1beta1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LedgerEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstakeibc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LedgerEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LedgerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3409,28 +11064,27 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3458,13 +11112,13 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.State = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3474,66 +11128,16 @@ func (m *Unbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= Unbonding_UnbondingState(b&0x7F) << shift
+				m.Type |= LedgerEntry_EntryType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstakeibc(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstakeibc
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstakeibc
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbonding: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3543,29 +11147,30 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
 			}
-			m.EpochNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3575,16 +11180,30 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNumber |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3594,29 +11213,30 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StkAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3626,28 +11246,48 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StkAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3674,7 +11314,7 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnbondAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3699,7 +11339,7 @@ func (m *UserUnbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
+func (m *ExchangeRateRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3722,10 +11362,10 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUnbonding: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExchangeRateRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExchangeRateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3781,7 +11421,7 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatureTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3808,13 +11448,13 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.MatureTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3842,13 +11482,15 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstakeibc
@@ -3858,28 +11500,29 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstakeibc
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSequenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3907,7 +11550,9 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcSequenceId = string(dAtA[iNdEx:postIndex])
+			if err := m.MintedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3930,7 +11575,7 @@ func (m *ValidatorUnbonding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KVUpdate) Unmarshal(dAtA []byte) error {
+func (m *WindDownReserve) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3953,15 +11598,15 @@ func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KVUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindDownReserve: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KVUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindDownReserve: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3989,11 +11634,11 @@ func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4021,7 +11666,74 @@ func (m *KVUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstakeibc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstakeibc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/liquidstakeibc/types"
+)
+
+func TestComputeApr(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name    string
+		from    *types.ExchangeRateRecord
+		to      *types.ExchangeRateRecord
+		apr     sdk.Dec
+		invalid bool
+	}{
+		{
+			name: "Year",
+			from: &types.ExchangeRateRecord{Time: start, CValue: sdk.OneDec()},
+			to:   &types.ExchangeRateRecord{Time: start.Add(365 * 24 * time.Hour), CValue: sdk.MustNewDecFromStr("0.8")},
+			apr:  sdk.MustNewDecFromStr("0.25"),
+		},
+		{
+			name: "Annualised",
+			from: &types.ExchangeRateRecord{Time: start, CValue: sdk.OneDec()},
+			to:   &types.ExchangeRateRecord{Time: start.Add(73 * 24 * time.Hour), CValue: sdk.MustNewDecFromStr("0.8")},
+			apr:  sdk.MustNewDecFromStr("1.25"),
+		},
+		{
+			name: "Negative",
+			from: &types.ExchangeRateRecord{Time: start, CValue: sdk.MustNewDecFromStr("0.8")},
+			to:   &types.ExchangeRateRecord{Time: start.Add(365 * 24 * time.Hour), CValue: sdk.OneDec()},
+			apr:  sdk.MustNewDecFromStr("-0.2"),
+		},
+		{
+			name:    "Unordered",
+			from:    &types.ExchangeRateRecord{Time: start, CValue: sdk.OneDec()},
+			to:      &types.ExchangeRateRecord{Time: start, CValue: sdk.OneDec()},
+			invalid: true,
+		},
+		{
+			name:    "ZeroCValue",
+			from:    &types.ExchangeRateRecord{Time: start, CValue: sdk.OneDec()},
+			to:      &types.ExchangeRateRecord{Time: start.Add(time.Hour), CValue: sdk.ZeroDec()},
+			invalid: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			apr, err := types.ComputeApr(tc.from, tc.to)
+			if tc.invalid {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.apr, apr)
+		})
+	}
+}
@@ -8,26 +8,37 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 )
 
 const (
-	MsgTypeRegisterHostChain string = "msg_register_host_chain"
-	MsgTypeUpdateHostChain   string = "msg_update_host_chain"
-	MsgTypeLiquidStake       string = "msg_liquid_stake"
-	MsgTypeLiquidUnstake     string = "msg_liquid_unstake"
-	MsgTypeRedeem            string = "msg_redeem"
-	MsgTypeUpdateParams      string = "msg_update_params"
+	MsgTypeRegisterHostChain   string = "msg_register_host_chain"
+	MsgTypeUpdateHostChain     string = "msg_update_host_chain"
+	MsgTypeDeregisterHostChain string = "msg_deregister_host_chain"
+	MsgTypeResetCircuitBreaker string = "msg_reset_circuit_breaker"
+	MsgTypeLiquidStake         string = "msg_liquid_stake"
+	MsgTypeLiquidStakeLSM      string = "msg_liquid_stake_lsm"
+	MsgTypeLiquidUnstake       string = "msg_liquid_unstake"
+	MsgTypeRedeem              string = "msg_redeem"
+	MsgTypeDepositLiquidity    string = "msg_deposit_liquidity"
+	MsgTypeWithdrawLiquidity   string = "msg_withdraw_liquidity"
+	MsgTypeVoteWeighted        string = "msg_vote_weighted"
+	MsgTypeUpdateParams        string = "msg_update_params"
 )
 
 var (
 	_ sdk.Msg = &MsgRegisterHostChain{}
 	_ sdk.Msg = &MsgUpdateHostChain{}
 	_ sdk.Msg = &MsgLiquidStake{}
+	_ sdk.Msg = &MsgLiquidStakeLSM{}
 	_ sdk.Msg = &MsgLiquidUnstake{}
 	_ sdk.Msg = &MsgRedeem{}
+	_ sdk.Msg = &MsgDepositLiquidity{}
+	_ sdk.Msg = &MsgWithdrawLiquidity{}
+	_ sdk.Msg = &MsgVoteWeighted{}
 )
 
 func NewMsgRegisterHostChain(
@@ -175,6 +186,84 @@ func (m *MsgUpdateHostChain) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgDeregisterHostChain(chainID, authority string) *MsgDeregisterHostChain {
+	return &MsgDeregisterHostChain{
+		ChainId:   chainID,
+		Authority: authority,
+	}
+}
+
+func (m *MsgDeregisterHostChain) Route() string {
+	return RouterKey
+}
+
+func (m *MsgDeregisterHostChain) Type() string {
+	return MsgTypeDeregisterHostChain
+}
+
+func (m *MsgDeregisterHostChain) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgDeregisterHostChain) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgDeregisterHostChain) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id cannot be empty")
+	}
+
+	return nil
+}
+
+func NewMsgResetCircuitBreaker(chainID, authority string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		ChainId:   chainID,
+		Authority: authority,
+	}
+}
+
+func (m *MsgResetCircuitBreaker) Route() string {
+	return RouterKey
+}
+
+func (m *MsgResetCircuitBreaker) Type() string {
+	return MsgTypeResetCircuitBreaker
+}
+
+func (m *MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if strings.TrimSpace(m.ChainId) == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chain id cannot be empty")
+	}
+
+	return nil
+}
+
 //nolint:interfacer
 func NewMsgLiquidStake(amount sdk.Coin, address sdk.AccAddress) *MsgLiquidStake {
 	return &MsgLiquidStake{
@@ -223,6 +312,60 @@ func (m *MsgLiquidStake) ValidateBasic() error {
 	return ibctransfertypes.ValidateIBCDenom(m.Amount.Denom)
 }
 
+//nolint:interfacer
+func NewMsgLiquidStakeLSM(delegations sdk.Coins, address sdk.AccAddress) *MsgLiquidStakeLSM {
+	return &MsgLiquidStakeLSM{
+		DelegatorAddress: address.String(),
+		Delegations:      delegations,
+	}
+}
+
+func (m *MsgLiquidStakeLSM) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgLiquidStakeLSM) Type() string {
+	return MsgTypeLiquidStakeLSM
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgLiquidStakeLSM) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgLiquidStakeLSM) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgLiquidStakeLSM) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.DelegatorAddress)
+	}
+
+	if m.Delegations.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "no delegations to liquid stake")
+	}
+
+	if !m.Delegations.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Delegations.String())
+	}
+
+	for _, delegation := range m.Delegations {
+		if err := ibctransfertypes.ValidateIBCDenom(delegation.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 //nolint:interfacer
 func NewMsgLiquidUnstake(amount sdk.Coin, address sdk.AccAddress) *MsgLiquidUnstake {
 	return &MsgLiquidUnstake{
@@ -319,6 +462,152 @@ func (m *MsgRedeem) ValidateBasic() error {
 	return nil
 }
 
+func NewMsgDepositLiquidity(amount sdk.Coin, address sdk.AccAddress) *MsgDepositLiquidity {
+	return &MsgDepositLiquidity{
+		ProviderAddress: address.String(),
+		Amount:          amount,
+	}
+}
+
+func (m *MsgDepositLiquidity) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgDepositLiquidity) Type() string {
+	return MsgTypeDepositLiquidity
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgDepositLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgDepositLiquidity) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.ProviderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgDepositLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.ProviderAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.ProviderAddress)
+	}
+
+	if !m.Amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	if !m.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return nil
+}
+
+func NewMsgWithdrawLiquidity(chainID string, shares math.Int, address sdk.AccAddress) *MsgWithdrawLiquidity {
+	return &MsgWithdrawLiquidity{
+		ProviderAddress: address.String(),
+		ChainId:         chainID,
+		Shares:          shares,
+	}
+}
+
+func (m *MsgWithdrawLiquidity) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgWithdrawLiquidity) Type() string {
+	return MsgTypeWithdrawLiquidity
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgWithdrawLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgWithdrawLiquidity) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.ProviderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgWithdrawLiquidity) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.ProviderAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.ProviderAddress)
+	}
+
+	if m.ChainId == "" {
+		return fmt.Errorf("chain id cannot be empty")
+	}
+
+	if m.Shares.IsNil() || !m.Shares.IsPositive() {
+		return fmt.Errorf("shares must be positive")
+	}
+
+	return nil
+}
+
+//nolint:interfacer
+func NewMsgVoteWeighted(
+	chainID string,
+	proposalID uint64,
+	options govv1beta1.WeightedVoteOptions,
+	address sdk.AccAddress,
+) *MsgVoteWeighted {
+	return &MsgVoteWeighted{
+		Voter:      address.String(),
+		ChainId:    chainID,
+		ProposalId: proposalID,
+		Options:    options,
+	}
+}
+
+func (m *MsgVoteWeighted) Route() string {
+	return RouterKey
+}
+
+// Type should return the action
+func (m *MsgVoteWeighted) Type() string {
+	return MsgTypeVoteWeighted
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgVoteWeighted) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m *MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(m.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// ValidateBasic performs stateless checks
+func (m *MsgVoteWeighted) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Voter); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, m.Voter)
+	}
+
+	if m.ChainId == "" {
+		return fmt.Errorf("chain id cannot be empty")
+	}
+
+	return ValidateWeightedVoteOptions(m.Options)
+}
+
 //nolint:interfacer
 func NewMsgUpdateParams(authority sdk.AccAddress, amount Params) *MsgUpdateParams {
 	return &MsgUpdateParams{
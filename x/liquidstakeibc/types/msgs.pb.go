@@ -10,6 +10,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -193,6 +194,156 @@ func (m *MsgUpdateHostChainResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateHostChainResponse proto.InternalMessageInfo
 
+type MsgDeregisterHostChain struct {
+	// authority is the address of the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ChainId   string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgDeregisterHostChain) Reset()         { *m = MsgDeregisterHostChain{} }
+func (m *MsgDeregisterHostChain) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterHostChain) ProtoMessage()    {}
+func (*MsgDeregisterHostChain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{4}
+}
+func (m *MsgDeregisterHostChain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterHostChain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterHostChain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterHostChain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterHostChain.Merge(m, src)
+}
+func (m *MsgDeregisterHostChain) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterHostChain) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterHostChain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterHostChain proto.InternalMessageInfo
+
+type MsgDeregisterHostChainResponse struct {
+}
+
+func (m *MsgDeregisterHostChainResponse) Reset()         { *m = MsgDeregisterHostChainResponse{} }
+func (m *MsgDeregisterHostChainResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterHostChainResponse) ProtoMessage()    {}
+func (*MsgDeregisterHostChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{5}
+}
+func (m *MsgDeregisterHostChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterHostChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterHostChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeregisterHostChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterHostChainResponse.Merge(m, src)
+}
+func (m *MsgDeregisterHostChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterHostChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterHostChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterHostChainResponse proto.InternalMessageInfo
+
+type MsgResetCircuitBreaker struct {
+	// authority is the address of the governance account or the module admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ChainId   string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgResetCircuitBreaker) Reset()         { *m = MsgResetCircuitBreaker{} }
+func (m *MsgResetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreaker) ProtoMessage()    {}
+func (*MsgResetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{6}
+}
+func (m *MsgResetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgResetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreaker proto.InternalMessageInfo
+
+type MsgResetCircuitBreakerResponse struct {
+}
+
+func (m *MsgResetCircuitBreakerResponse) Reset()         { *m = MsgResetCircuitBreakerResponse{} }
+func (m *MsgResetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{7}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreakerResponse proto.InternalMessageInfo
+
 type MsgLiquidStake struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
@@ -202,7 +353,7 @@ func (m *MsgLiquidStake) Reset()         { *m = MsgLiquidStake{} }
 func (m *MsgLiquidStake) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidStake) ProtoMessage()    {}
 func (*MsgLiquidStake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{4}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{8}
 }
 func (m *MsgLiquidStake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,7 +403,7 @@ func (m *MsgLiquidStakeResponse) Reset()         { *m = MsgLiquidStakeResponse{}
 func (m *MsgLiquidStakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidStakeResponse) ProtoMessage()    {}
 func (*MsgLiquidStakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{5}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{9}
 }
 func (m *MsgLiquidStakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,6 +432,94 @@ func (m *MsgLiquidStakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLiquidStakeResponse proto.InternalMessageInfo
 
+type MsgLiquidStakeLSM struct {
+	DelegatorAddress string                                   `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	Delegations      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=delegations,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegations"`
+}
+
+func (m *MsgLiquidStakeLSM) Reset()         { *m = MsgLiquidStakeLSM{} }
+func (m *MsgLiquidStakeLSM) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidStakeLSM) ProtoMessage()    {}
+func (*MsgLiquidStakeLSM) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{10}
+}
+func (m *MsgLiquidStakeLSM) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidStakeLSM) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidStakeLSM.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidStakeLSM) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidStakeLSM.Merge(m, src)
+}
+func (m *MsgLiquidStakeLSM) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidStakeLSM) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidStakeLSM.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiquidStakeLSM proto.InternalMessageInfo
+
+func (m *MsgLiquidStakeLSM) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgLiquidStakeLSM) GetDelegations() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+type MsgLiquidStakeLSMResponse struct {
+}
+
+func (m *MsgLiquidStakeLSMResponse) Reset()         { *m = MsgLiquidStakeLSMResponse{} }
+func (m *MsgLiquidStakeLSMResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidStakeLSMResponse) ProtoMessage()    {}
+func (*MsgLiquidStakeLSMResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{11}
+}
+func (m *MsgLiquidStakeLSMResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidStakeLSMResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidStakeLSMResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidStakeLSMResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidStakeLSMResponse.Merge(m, src)
+}
+func (m *MsgLiquidStakeLSMResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidStakeLSMResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidStakeLSMResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLiquidStakeLSMResponse proto.InternalMessageInfo
+
 type MsgLiquidUnstake struct {
 	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
@@ -290,7 +529,7 @@ func (m *MsgLiquidUnstake) Reset()         { *m = MsgLiquidUnstake{} }
 func (m *MsgLiquidUnstake) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidUnstake) ProtoMessage()    {}
 func (*MsgLiquidUnstake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{6}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{12}
 }
 func (m *MsgLiquidUnstake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +579,7 @@ func (m *MsgLiquidUnstakeResponse) Reset()         { *m = MsgLiquidUnstakeRespon
 func (m *MsgLiquidUnstakeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLiquidUnstakeResponse) ProtoMessage()    {}
 func (*MsgLiquidUnstakeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{7}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{13}
 }
 func (m *MsgLiquidUnstakeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +617,7 @@ func (m *MsgRedeem) Reset()         { *m = MsgRedeem{} }
 func (m *MsgRedeem) String() string { return proto.CompactTextString(m) }
 func (*MsgRedeem) ProtoMessage()    {}
 func (*MsgRedeem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{8}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{14}
 }
 func (m *MsgRedeem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,7 +667,7 @@ func (m *MsgRedeemResponse) Reset()         { *m = MsgRedeemResponse{} }
 func (m *MsgRedeemResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRedeemResponse) ProtoMessage()    {}
 func (*MsgRedeemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{9}
+	return fileDescriptor_dce3cdc829e5c7d3, []int{15}
 }
 func (m *MsgRedeemResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -457,23 +696,23 @@ func (m *MsgRedeemResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRedeemResponse proto.InternalMessageInfo
 
-type MsgUpdateParams struct {
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+type MsgDepositLiquidity struct {
+	ProviderAddress string     `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	Amount          types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{10}
+func (m *MsgDepositLiquidity) Reset()         { *m = MsgDepositLiquidity{} }
+func (m *MsgDepositLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositLiquidity) ProtoMessage()    {}
+func (*MsgDepositLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{16}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgDepositLiquidity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDepositLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDepositLiquidity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -483,33 +722,47 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgDepositLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositLiquidity.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgDepositLiquidity) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgDepositLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositLiquidity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgDepositLiquidity proto.InternalMessageInfo
 
-type MsgUpdateParamsResponse struct {
+func (m *MsgDepositLiquidity) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dce3cdc829e5c7d3, []int{11}
+func (m *MsgDepositLiquidity) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+
+type MsgDepositLiquidityResponse struct {
+}
+
+func (m *MsgDepositLiquidityResponse) Reset()         { *m = MsgDepositLiquidityResponse{} }
+func (m *MsgDepositLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositLiquidityResponse) ProtoMessage()    {}
+func (*MsgDepositLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{17}
+}
+func (m *MsgDepositLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDepositLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDepositLiquidityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -519,127 +772,438 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgDepositLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositLiquidityResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgDepositLiquidityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgDepositLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositLiquidityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDepositLiquidityResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgRegisterHostChain")
-	proto.RegisterType((*MsgRegisterHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgRegisterHostChainResponse")
-	proto.RegisterType((*MsgUpdateHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateHostChain")
-	proto.RegisterType((*MsgUpdateHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateHostChainResponse")
-	proto.RegisterType((*MsgLiquidStake)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStake")
-	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeResponse")
-	proto.RegisterType((*MsgLiquidUnstake)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidUnstake")
-	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidUnstakeResponse")
-	proto.RegisterType((*MsgRedeem)(nil), "pstake.liquidstakeibc.v1beta1.MsgRedeem")
-	proto.RegisterType((*MsgRedeemResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgRedeemResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateParamsResponse")
+type MsgWithdrawLiquidity struct {
+	ProviderAddress string                                 `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	ChainId         string                                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Shares          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shares"`
 }
 
-func init() {
-	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/msgs.proto", fileDescriptor_dce3cdc829e5c7d3)
+func (m *MsgWithdrawLiquidity) Reset()         { *m = MsgWithdrawLiquidity{} }
+func (m *MsgWithdrawLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawLiquidity) ProtoMessage()    {}
+func (*MsgWithdrawLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{18}
 }
-
-var fileDescriptor_dce3cdc829e5c7d3 = []byte{
-	// 959 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0x75, 0xeb, 0xd4, 0xcf, 0xcd, 0xaf, 0x25, 0x4a, 0x36, 0xab, 0xd4, 0x89, 0x8c,
-	0x4a, 0x4d, 0x54, 0xef, 0x12, 0x17, 0x5a, 0x11, 0xb8, 0xe4, 0x07, 0x15, 0x16, 0x44, 0xa0, 0xad,
-	0xca, 0x01, 0x84, 0xac, 0xf1, 0xee, 0x74, 0x3d, 0x6a, 0x76, 0x66, 0xd9, 0x19, 0x5b, 0xf4, 0x84,
-	0x54, 0x09, 0x89, 0x23, 0x52, 0xff, 0x81, 0x4a, 0x48, 0x80, 0xb8, 0xc0, 0x21, 0x7f, 0x44, 0x8f,
-	0x55, 0xb9, 0x20, 0x0e, 0x15, 0x4a, 0x90, 0xe8, 0x9f, 0xc0, 0x11, 0xcd, 0xec, 0x78, 0xed, 0x38,
-	0x55, 0x6c, 0x97, 0x1c, 0x7a, 0xb2, 0xf7, 0xcd, 0x7b, 0xdf, 0xf9, 0x7c, 0x77, 0xe6, 0xcd, 0x0e,
-	0x54, 0x63, 0x2e, 0xd0, 0x3d, 0xec, 0xee, 0x93, 0xaf, 0x3a, 0x24, 0x50, 0xff, 0x49, 0xcb, 0x77,
-	0xbb, 0x1b, 0x2d, 0x2c, 0xd0, 0x86, 0x1b, 0xf1, 0x90, 0x3b, 0x71, 0xc2, 0x04, 0x33, 0x2f, 0xa7,
-	0x99, 0xce, 0xf1, 0x4c, 0x47, 0x67, 0xda, 0x2b, 0x21, 0x63, 0xe1, 0x3e, 0x76, 0x51, 0x4c, 0x5c,
-	0x44, 0x29, 0x13, 0x48, 0x10, 0x46, 0x75, 0xb1, 0xbd, 0xec, 0x33, 0x1e, 0x31, 0xde, 0x54, 0x4f,
-	0x6e, 0xfa, 0xa0, 0x87, 0x16, 0x42, 0x16, 0xb2, 0x34, 0x2e, 0xff, 0xe9, 0xe8, 0x52, 0x9a, 0x23,
-	0x01, 0xdc, 0xae, 0xe2, 0xd0, 0x03, 0x65, 0x3d, 0xd0, 0x42, 0x1c, 0x67, 0x98, 0x3e, 0x23, 0x54,
-	0x8f, 0xd7, 0x4f, 0x37, 0x34, 0x44, 0x9f, 0xd6, 0xac, 0x9f, 0x5e, 0x13, 0xa3, 0x04, 0x45, 0x1a,
-	0xb7, 0xf2, 0xfc, 0x02, 0x2c, 0xec, 0xf1, 0xd0, 0xc3, 0x21, 0xe1, 0x02, 0x27, 0x1f, 0x32, 0x2e,
-	0x76, 0xda, 0x88, 0x50, 0xf3, 0x06, 0x14, 0x51, 0x47, 0xb4, 0x59, 0x42, 0xc4, 0x7d, 0xcb, 0x58,
-	0x33, 0xaa, 0xc5, 0x6d, 0xeb, 0xe9, 0x41, 0x6d, 0x41, 0x9b, 0xdd, 0x0a, 0x82, 0x04, 0x73, 0x7e,
-	0x5b, 0x24, 0x84, 0x86, 0x5e, 0x3f, 0xd5, 0x7c, 0x1d, 0xa6, 0x7d, 0x46, 0x29, 0xf6, 0xe5, 0xfb,
-	0x6a, 0x92, 0xc0, 0x3a, 0x27, 0x6b, 0xbd, 0x4b, 0xfd, 0x60, 0x23, 0x30, 0xbf, 0x84, 0x52, 0x80,
-	0x63, 0xc6, 0x89, 0x68, 0xde, 0xc5, 0xd8, 0xca, 0x2b, 0xf9, 0xf7, 0x1f, 0x3f, 0x5b, 0xcd, 0xfd,
-	0xf9, 0x6c, 0xf5, 0x8d, 0x90, 0x88, 0x76, 0xa7, 0xe5, 0xf8, 0x2c, 0xd2, 0xaf, 0x56, 0xff, 0xd4,
-	0x78, 0x70, 0xcf, 0x15, 0xf7, 0x63, 0xcc, 0x9d, 0x5d, 0xec, 0x3f, 0x3d, 0xa8, 0x81, 0x86, 0xd9,
-	0xc5, 0xbe, 0x07, 0x5a, 0xf0, 0x16, 0xc6, 0x52, 0x3e, 0xc1, 0xca, 0xb7, 0x92, 0x3f, 0x7f, 0x16,
-	0xf2, 0x5a, 0x50, 0xcb, 0x77, 0x68, 0x5f, 0xfe, 0xc2, 0x59, 0xc8, 0x6b, 0x41, 0x29, 0xef, 0xc3,
-	0x4c, 0x82, 0x03, 0x1c, 0xc5, 0xea, 0x0d, 0xca, 0x19, 0x0a, 0x67, 0x30, 0xc3, 0x74, 0x5f, 0x53,
-	0x4e, 0x72, 0x19, 0xc0, 0x6f, 0x23, 0x4a, 0xf1, 0xbe, 0x5c, 0xa3, 0x29, 0xb5, 0x46, 0x45, 0x1d,
-	0x69, 0x04, 0xe6, 0x12, 0x4c, 0xc5, 0x2c, 0x11, 0x72, 0xec, 0xa2, 0x1a, 0x2b, 0xc8, 0xc7, 0x46,
-	0x20, 0xeb, 0xda, 0x8c, 0x8b, 0x66, 0x80, 0x29, 0x8b, 0xac, 0x62, 0x5a, 0x27, 0x23, 0xbb, 0x32,
-	0x60, 0x62, 0x98, 0x8d, 0x08, 0x25, 0x51, 0x27, 0x6a, 0xea, 0xf5, 0xb0, 0x60, 0x62, 0xf8, 0x06,
-	0x15, 0x03, 0xf0, 0x0d, 0x2a, 0xbc, 0x19, 0x2d, 0xba, 0x9b, 0x6a, 0x9a, 0x6f, 0xc2, 0x5c, 0x87,
-	0xb6, 0x18, 0x0d, 0x08, 0x0d, 0x9b, 0x77, 0x91, 0x2f, 0x58, 0x62, 0x95, 0xd6, 0x8c, 0x6a, 0xde,
-	0x9b, 0xcd, 0xe2, 0xb7, 0x54, 0x78, 0xf3, 0xe2, 0x77, 0x8f, 0x56, 0x73, 0xcf, 0x1f, 0xad, 0xe6,
-	0x2a, 0x65, 0x58, 0x79, 0xd1, 0x4e, 0xf7, 0x30, 0x8f, 0x19, 0xe5, 0xb8, 0x72, 0x60, 0x80, 0xb9,
-	0xc7, 0xc3, 0x3b, 0x71, 0x80, 0x04, 0xfe, 0xff, 0x8d, 0xb0, 0x0c, 0x17, 0x7d, 0x29, 0xd0, 0xef,
-	0x81, 0x29, 0xf5, 0xdc, 0x08, 0xcc, 0x2d, 0x98, 0xea, 0xa8, 0x59, 0xb8, 0x95, 0x5f, 0xcb, 0x57,
-	0x4b, 0xf5, 0xab, 0xce, 0xa9, 0xa7, 0x91, 0xf3, 0xd1, 0x67, 0x29, 0x95, 0xd7, 0xab, 0x1b, 0xb0,
-	0xb5, 0x02, 0xf6, 0x49, 0xea, 0xcc, 0xd4, 0xcf, 0x06, 0xcc, 0xec, 0xf1, 0xf0, 0x63, 0xa5, 0x7b,
-	0x5b, 0xea, 0x9a, 0x1f, 0xc0, 0x7c, 0x80, 0xf7, 0x71, 0x88, 0x04, 0x4b, 0x9a, 0x28, 0xc5, 0x1f,
-	0x69, 0x6c, 0x2e, 0x2b, 0xd1, 0x71, 0xf3, 0x26, 0x14, 0x50, 0xc4, 0x3a, 0x54, 0x28, 0x77, 0xa5,
-	0xfa, 0xb2, 0xa3, 0x0b, 0xe5, 0x51, 0x96, 0x91, 0xef, 0x30, 0x42, 0xb7, 0xcf, 0xcb, 0xc5, 0xf7,
-	0x74, 0xfa, 0xe6, 0xe2, 0x83, 0x7f, 0x7e, 0x5b, 0x3f, 0x89, 0x50, 0xb1, 0x60, 0xf1, 0x38, 0x69,
-	0x66, 0xe2, 0x17, 0x03, 0xe6, 0xb2, 0xa1, 0x3b, 0x69, 0xa7, 0xbc, 0xb2, 0x36, 0x6c, 0xb0, 0x86,
-	0x59, 0x33, 0x23, 0x3f, 0x18, 0x50, 0x54, 0x7b, 0x30, 0xc0, 0x38, 0x7a, 0x65, 0x1d, 0xbc, 0x06,
-	0xf3, 0x19, 0x64, 0x86, 0xfe, 0xa3, 0x01, 0xb3, 0xd9, 0x3e, 0xfb, 0x54, 0x7d, 0x42, 0x5e, 0xba,
-	0x35, 0x76, 0xa0, 0x90, 0x7e, 0x84, 0x34, 0xf1, 0x95, 0x11, 0xdb, 0x3f, 0x9d, 0xae, 0x47, 0x9f,
-	0x96, 0x6e, 0x2e, 0xf6, 0x3a, 0x40, 0xba, 0xe8, 0x8b, 0x57, 0x96, 0x61, 0x69, 0x88, 0xb3, 0xe7,
-	0xa1, 0xfe, 0x6f, 0x01, 0xf2, 0x7b, 0x3c, 0x34, 0xbf, 0x35, 0x60, 0xfe, 0xe4, 0x17, 0xef, 0xfa,
-	0x08, 0x8a, 0x17, 0x1d, 0x1e, 0xf6, 0x7b, 0x2f, 0x51, 0xd4, 0xe3, 0x31, 0xbf, 0x81, 0xd9, 0xe1,
-	0xd3, 0x66, 0x63, 0xb4, 0xde, 0x50, 0x89, 0xfd, 0xee, 0xc4, 0x25, 0x19, 0xc0, 0x4f, 0x06, 0x94,
-	0x06, 0x8f, 0x86, 0xda, 0x68, 0xa9, 0x81, 0x74, 0xfb, 0x9d, 0x89, 0xd2, 0xb3, 0xad, 0x54, 0x7f,
-	0xf0, 0xfb, 0xdf, 0x0f, 0xcf, 0x5d, 0xab, 0xac, 0xbb, 0xa7, 0x5f, 0x54, 0x06, 0xc9, 0x7e, 0x35,
-	0x60, 0xfa, 0x78, 0xff, 0xbb, 0xe3, 0x4e, 0xae, 0x0b, 0xec, 0x9b, 0x13, 0x16, 0x64, 0xbc, 0x6f,
-	0x2b, 0x5e, 0xa7, 0x72, 0x6d, 0x2c, 0xde, 0x1e, 0xdf, 0x43, 0x03, 0x0a, 0xba, 0xd1, 0xab, 0xe3,
-	0x6c, 0x12, 0x99, 0x69, 0xbf, 0x35, 0x6e, 0x66, 0x06, 0x57, 0x53, 0x70, 0x57, 0x2b, 0x57, 0x46,
-	0xc0, 0x69, 0x94, 0x2e, 0x5c, 0x3a, 0xd6, 0xc2, 0xce, 0xb8, 0x9b, 0x27, 0xcd, 0xb7, 0x6f, 0x4c,
-	0x96, 0xdf, 0xc3, 0xdc, 0xfe, 0xe2, 0xf1, 0x61, 0xd9, 0x78, 0x72, 0x58, 0x36, 0xfe, 0x3a, 0x2c,
-	0x1b, 0xdf, 0x1f, 0x95, 0x73, 0x4f, 0x8e, 0xca, 0xb9, 0x3f, 0x8e, 0xca, 0xb9, 0xcf, 0xb7, 0x06,
-	0x6e, 0x04, 0x31, 0x4e, 0xb8, 0xec, 0x15, 0xea, 0xe3, 0x4f, 0x28, 0xd6, 0x8e, 0x6a, 0x14, 0x09,
-	0xd2, 0xc5, 0x6e, 0xb7, 0xee, 0x7e, 0x3d, 0xec, 0x4e, 0x5d, 0x18, 0x5a, 0x05, 0x75, 0x97, 0xbd,
-	0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xba, 0x0f, 0x22, 0x8b, 0xfe, 0x0b, 0x00, 0x00,
+func (m *MsgWithdrawLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawLiquidity.Merge(m, src)
+}
+func (m *MsgWithdrawLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawLiquidity.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgWithdrawLiquidity proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RegisterHostChain(ctx context.Context, in *MsgRegisterHostChain, opts ...grpc.CallOption) (*MsgRegisterHostChainResponse, error)
-	UpdateHostChain(ctx context.Context, in *MsgUpdateHostChain, opts ...grpc.CallOption) (*MsgUpdateHostChainResponse, error)
-	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
-	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
-	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgWithdrawLiquidity) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgWithdrawLiquidity) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgWithdrawLiquidityResponse struct {
+}
+
+func (m *MsgWithdrawLiquidityResponse) Reset()         { *m = MsgWithdrawLiquidityResponse{} }
+func (m *MsgWithdrawLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawLiquidityResponse) ProtoMessage()    {}
+func (*MsgWithdrawLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{19}
+}
+func (m *MsgWithdrawLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawLiquidityResponse.Merge(m, src)
+}
+func (m *MsgWithdrawLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawLiquidityResponse proto.InternalMessageInfo
+
+type MsgVoteWeighted struct {
+	Voter      string                       `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	ChainId    string                       `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProposalId uint64                       `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Options    []v1beta1.WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()         { *m = MsgVoteWeighted{} }
+func (m *MsgVoteWeighted) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteWeighted) ProtoMessage()    {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{20}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
+func (m *MsgVoteWeighted) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *MsgVoteWeighted) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *MsgVoteWeighted) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVoteWeighted) GetOptions() []v1beta1.WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type MsgVoteWeightedResponse struct {
+}
+
+func (m *MsgVoteWeightedResponse) Reset()         { *m = MsgVoteWeightedResponse{} }
+func (m *MsgVoteWeightedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteWeightedResponse) ProtoMessage()    {}
+func (*MsgVoteWeightedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{21}
+}
+func (m *MsgVoteWeightedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeightedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeightedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeightedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeightedResponse.Merge(m, src)
+}
+func (m *MsgVoteWeightedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeightedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeightedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeightedResponse proto.InternalMessageInfo
+
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{22}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dce3cdc829e5c7d3, []int{23}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgRegisterHostChain")
+	proto.RegisterType((*MsgRegisterHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgRegisterHostChainResponse")
+	proto.RegisterType((*MsgUpdateHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateHostChain")
+	proto.RegisterType((*MsgUpdateHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateHostChainResponse")
+	proto.RegisterType((*MsgDeregisterHostChain)(nil), "pstake.liquidstakeibc.v1beta1.MsgDeregisterHostChain")
+	proto.RegisterType((*MsgDeregisterHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgDeregisterHostChainResponse")
+	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "pstake.liquidstakeibc.v1beta1.MsgResetCircuitBreaker")
+	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgResetCircuitBreakerResponse")
+	proto.RegisterType((*MsgLiquidStake)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStake")
+	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeResponse")
+	proto.RegisterType((*MsgLiquidStakeLSM)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeLSM")
+	proto.RegisterType((*MsgLiquidStakeLSMResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidStakeLSMResponse")
+	proto.RegisterType((*MsgLiquidUnstake)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidUnstake")
+	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgLiquidUnstakeResponse")
+	proto.RegisterType((*MsgRedeem)(nil), "pstake.liquidstakeibc.v1beta1.MsgRedeem")
+	proto.RegisterType((*MsgRedeemResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgRedeemResponse")
+	proto.RegisterType((*MsgDepositLiquidity)(nil), "pstake.liquidstakeibc.v1beta1.MsgDepositLiquidity")
+	proto.RegisterType((*MsgDepositLiquidityResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgDepositLiquidityResponse")
+	proto.RegisterType((*MsgWithdrawLiquidity)(nil), "pstake.liquidstakeibc.v1beta1.MsgWithdrawLiquidity")
+	proto.RegisterType((*MsgWithdrawLiquidityResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgWithdrawLiquidityResponse")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "pstake.liquidstakeibc.v1beta1.MsgVoteWeighted")
+	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgVoteWeightedResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pstake.liquidstakeibc.v1beta1.MsgUpdateParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/msgs.proto", fileDescriptor_dce3cdc829e5c7d3)
+}
+
+var fileDescriptor_dce3cdc829e5c7d3 = []byte{
+	// 1375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcd, 0x6f, 0x13, 0xc7,
+	0x1b, 0xc7, 0xb3, 0x24, 0x38, 0xe4, 0x31, 0xe4, 0x65, 0xe1, 0x17, 0x9c, 0x25, 0x38, 0xd1, 0xfe,
+	0x04, 0xa4, 0x14, 0x7b, 0x13, 0xd3, 0x00, 0x0d, 0xe5, 0x40, 0x92, 0xa2, 0x5a, 0xc5, 0xa2, 0x72,
+	0x0a, 0x48, 0xad, 0x2a, 0x6b, 0xb3, 0x3b, 0xac, 0x57, 0xc4, 0x33, 0xdb, 0x9d, 0xb1, 0x5b, 0x4e,
+	0x95, 0x90, 0x2a, 0x55, 0xea, 0xa5, 0x12, 0xff, 0x00, 0x52, 0xa5, 0xb6, 0xa2, 0x87, 0x56, 0x2a,
+	0xb7, 0x1e, 0x7a, 0xe5, 0x48, 0xe9, 0xa5, 0xea, 0x81, 0xb6, 0xa4, 0x52, 0xf9, 0x13, 0x7a, 0xac,
+	0x66, 0x76, 0x76, 0xe2, 0x37, 0xfc, 0x92, 0x58, 0x15, 0x27, 0xbc, 0x33, 0xcf, 0xf7, 0x99, 0xcf,
+	0x77, 0x5e, 0x1f, 0x02, 0x0b, 0x01, 0x65, 0xf6, 0x6d, 0x64, 0x6d, 0xf9, 0x1f, 0x56, 0x7d, 0x57,
+	0xfc, 0xf6, 0x37, 0x1d, 0xab, 0xb6, 0xb4, 0x89, 0x98, 0xbd, 0x64, 0x55, 0xa8, 0x47, 0xb3, 0x41,
+	0x48, 0x18, 0xd1, 0x8f, 0x47, 0x91, 0xd9, 0xc6, 0xc8, 0xac, 0x8c, 0x34, 0x66, 0x3d, 0x42, 0xbc,
+	0x2d, 0x64, 0xd9, 0x81, 0x6f, 0xd9, 0x18, 0x13, 0x66, 0x33, 0x9f, 0x60, 0x29, 0x36, 0x66, 0x1c,
+	0x42, 0x2b, 0x84, 0x96, 0xc4, 0x97, 0x15, 0x7d, 0xc8, 0xae, 0x23, 0x1e, 0xf1, 0x48, 0xd4, 0xce,
+	0x7f, 0xc9, 0xd6, 0xa3, 0x51, 0x0c, 0x07, 0xb0, 0x6a, 0x82, 0x43, 0x76, 0xa4, 0x65, 0xc7, 0xa6,
+	0x4d, 0x91, 0xc2, 0x74, 0x88, 0x8f, 0x65, 0xff, 0xac, 0xec, 0xf7, 0x48, 0x4d, 0x75, 0x7b, 0xa4,
+	0x26, 0x7b, 0x73, 0x9d, 0xed, 0x36, 0x79, 0x8b, 0x34, 0xa7, 0x3b, 0x6b, 0x02, 0x3b, 0xb4, 0x2b,
+	0xd2, 0x8c, 0xf9, 0x7c, 0x3f, 0x1c, 0x29, 0x50, 0xaf, 0x88, 0x3c, 0x9f, 0x32, 0x14, 0xbe, 0x45,
+	0x28, 0x5b, 0x2b, 0xdb, 0x3e, 0xd6, 0xcf, 0xc1, 0x98, 0x5d, 0x65, 0x65, 0x12, 0xfa, 0xec, 0x4e,
+	0x4a, 0x9b, 0xd7, 0x16, 0xc6, 0x56, 0x53, 0x4f, 0x1e, 0x66, 0x8e, 0xc8, 0xa9, 0xb8, 0xec, 0xba,
+	0x21, 0xa2, 0x74, 0x83, 0x85, 0x3e, 0xf6, 0x8a, 0x3b, 0xa1, 0xfa, 0xff, 0xe1, 0x90, 0x43, 0x30,
+	0x46, 0x0e, 0x9f, 0xcd, 0x92, 0xef, 0xa6, 0xf6, 0x71, 0x6d, 0xf1, 0xe0, 0x4e, 0x63, 0xde, 0xd5,
+	0x3f, 0x80, 0xa4, 0x8b, 0x02, 0x42, 0x7d, 0x56, 0xba, 0x85, 0x50, 0x6a, 0x58, 0xa4, 0x7f, 0xe3,
+	0xd1, 0xd3, 0xb9, 0xa1, 0xdf, 0x9e, 0xce, 0x9d, 0xf4, 0x7c, 0x56, 0xae, 0x6e, 0x66, 0x1d, 0x52,
+	0x91, 0x13, 0x2f, 0xff, 0xc9, 0x50, 0xf7, 0xb6, 0xc5, 0xee, 0x04, 0x88, 0x66, 0xd7, 0x91, 0xf3,
+	0xe4, 0x61, 0x06, 0x24, 0xcc, 0x3a, 0x72, 0x8a, 0x20, 0x13, 0x5e, 0x41, 0x88, 0xa7, 0x0f, 0x91,
+	0xf0, 0x2d, 0xd2, 0x8f, 0x0c, 0x22, 0xbd, 0x4c, 0x28, 0xd3, 0x57, 0xf1, 0x4e, 0xfa, 0xfd, 0x83,
+	0x48, 0x5f, 0xc5, 0x2a, 0xbd, 0x03, 0xe3, 0x21, 0x72, 0x51, 0x25, 0x10, 0x33, 0xc8, 0x47, 0x48,
+	0x0c, 0x60, 0x84, 0x43, 0x3b, 0x39, 0xf9, 0x20, 0xc7, 0x01, 0x9c, 0xb2, 0x8d, 0x31, 0xda, 0xe2,
+	0x6b, 0x34, 0x2a, 0xd6, 0x68, 0x4c, 0xb6, 0xe4, 0x5d, 0xfd, 0x28, 0x8c, 0x06, 0x24, 0x64, 0xbc,
+	0xef, 0x80, 0xe8, 0x4b, 0xf0, 0xcf, 0xbc, 0xcb, 0x75, 0x65, 0x42, 0x59, 0xc9, 0x45, 0x98, 0x54,
+	0x52, 0x63, 0x91, 0x8e, 0xb7, 0xac, 0xf3, 0x06, 0x1d, 0xc1, 0x44, 0xc5, 0xc7, 0x7e, 0xa5, 0x5a,
+	0x29, 0xc9, 0xf5, 0x48, 0x41, 0xdf, 0xf0, 0x79, 0xcc, 0xea, 0xe0, 0xf3, 0x98, 0x15, 0xc7, 0x65,
+	0xd2, 0xf5, 0x28, 0xa7, 0xfe, 0x0a, 0x4c, 0x56, 0xf1, 0x26, 0xc1, 0xae, 0x8f, 0xbd, 0xd2, 0x2d,
+	0xdb, 0x61, 0x24, 0x4c, 0x25, 0xe7, 0xb5, 0x85, 0xe1, 0xe2, 0x84, 0x6a, 0xbf, 0x22, 0x9a, 0x57,
+	0x0e, 0x7c, 0x76, 0x7f, 0x6e, 0xe8, 0xf9, 0xfd, 0xb9, 0x21, 0x33, 0x0d, 0xb3, 0xed, 0x76, 0x7a,
+	0x11, 0xd1, 0x80, 0x60, 0x8a, 0xcc, 0x87, 0x1a, 0xe8, 0x05, 0xea, 0x5d, 0x0f, 0x5c, 0x9b, 0xa1,
+	0xbd, 0x1f, 0x84, 0x19, 0x38, 0xe0, 0xf0, 0x04, 0x3b, 0x67, 0x60, 0x54, 0x7c, 0xe7, 0x5d, 0xfd,
+	0x32, 0x8c, 0x56, 0xc5, 0x28, 0x34, 0x35, 0x3c, 0x3f, 0xbc, 0x90, 0xcc, 0x9d, 0xca, 0x76, 0xbc,
+	0xab, 0xb2, 0x6f, 0xdf, 0x88, 0xa8, 0x8a, 0xb1, 0xae, 0xce, 0xd6, 0x2c, 0x18, 0xad, 0xd4, 0xca,
+	0x54, 0x15, 0xa6, 0x0b, 0xd4, 0x5b, 0x47, 0xe1, 0xc0, 0x0e, 0xf8, 0x8b, 0x7d, 0xd5, 0x41, 0xcd,
+	0x43, 0xba, 0xfd, 0xb0, 0x4d, 0x60, 0x45, 0x44, 0x11, 0x5b, 0xf3, 0x43, 0xa7, 0xea, 0xb3, 0xd5,
+	0x10, 0xd9, 0xb7, 0x51, 0xf8, 0x5f, 0x80, 0xb5, 0x19, 0x56, 0x81, 0x7d, 0xa3, 0xc1, 0x78, 0x81,
+	0x7a, 0x57, 0xc5, 0x4a, 0x6c, 0xf0, 0x95, 0xd0, 0xdf, 0x84, 0x29, 0x17, 0x6d, 0x21, 0xcf, 0x66,
+	0x24, 0x2c, 0xd9, 0xd1, 0xf8, 0x5d, 0xc9, 0x26, 0x95, 0x44, 0xb6, 0xeb, 0xe7, 0x21, 0x61, 0x57,
+	0x48, 0x15, 0x33, 0x81, 0x97, 0xcc, 0xcd, 0x64, 0xa5, 0x90, 0x3f, 0x0d, 0x6a, 0xad, 0xd7, 0x88,
+	0x8f, 0x57, 0x47, 0xf8, 0x71, 0x29, 0xca, 0xf0, 0x95, 0xe9, 0xbb, 0x7f, 0x7f, 0x7f, 0xba, 0x15,
+	0xc1, 0x4c, 0xc1, 0x74, 0x23, 0xa9, 0x32, 0xf1, 0xa7, 0x06, 0x53, 0x8d, 0x5d, 0x57, 0x37, 0x0a,
+	0x83, 0xf2, 0x51, 0x81, 0xa4, 0x6c, 0xe3, 0x0f, 0x66, 0x6a, 0xdf, 0xfc, 0x70, 0x67, 0x33, 0x8b,
+	0xdc, 0xcc, 0x83, 0xdf, 0xe7, 0x16, 0x7a, 0x38, 0xfb, 0x5c, 0x40, 0x8b, 0xf5, 0xf9, 0x5f, 0xe8,
+	0xfe, 0x18, 0xcc, 0xb4, 0x58, 0x54, 0x13, 0xf0, 0x40, 0x83, 0x49, 0xd5, 0x7b, 0x3d, 0xba, 0x5c,
+	0x5f, 0xda, 0x75, 0x34, 0x20, 0xd5, 0xcc, 0xaa, 0x8c, 0x7c, 0xa9, 0xc1, 0x98, 0xd8, 0xb1, 0x2e,
+	0x42, 0x95, 0x97, 0xd6, 0xc1, 0x61, 0x98, 0x52, 0x90, 0xf5, 0x6b, 0x70, 0x58, 0xdc, 0x02, 0xe2,
+	0xd2, 0x8e, 0xec, 0xf1, 0x83, 0xba, 0x06, 0x93, 0x41, 0x48, 0x6a, 0xbe, 0x8b, 0x7a, 0xf7, 0x30,
+	0x11, 0x2b, 0xf6, 0x6c, 0xe1, 0x7f, 0xdc, 0x42, 0x0b, 0x80, 0x79, 0x1c, 0x8e, 0xb5, 0x61, 0x55,
+	0x5e, 0xb6, 0x35, 0x51, 0x27, 0xdd, 0xf4, 0x59, 0xd9, 0x0d, 0xed, 0x8f, 0x06, 0x6c, 0xa6, 0xc3,
+	0x5b, 0xf1, 0x2e, 0x24, 0x68, 0xd9, 0x0e, 0xc5, 0x53, 0xb1, 0xf7, 0x87, 0x54, 0xe6, 0x7a, 0xd1,
+	0x24, 0x44, 0x4f, 0x64, 0x8b, 0x49, 0x35, 0x0b, 0x3f, 0x6b, 0x30, 0x51, 0xa0, 0xde, 0x0d, 0xc2,
+	0xd0, 0x4d, 0xe4, 0x7b, 0x65, 0x86, 0x5c, 0x3d, 0x0b, 0xfb, 0x6b, 0x84, 0xa1, 0xb0, 0xab, 0xeb,
+	0x28, 0xac, 0x93, 0xd7, 0x39, 0x48, 0x06, 0x21, 0x09, 0x08, 0xb5, 0x45, 0x55, 0xc2, 0x0d, 0x8f,
+	0x14, 0x21, 0x6e, 0xca, 0xbb, 0xfa, 0x15, 0x18, 0x25, 0x41, 0x74, 0xeb, 0x8c, 0x88, 0x5b, 0xe7,
+	0x64, 0xbc, 0xea, 0xbc, 0x62, 0x8e, 0x17, 0x3d, 0x46, 0xe3, 0x98, 0xd7, 0x44, 0xb8, 0xdc, 0x02,
+	0xb1, 0x78, 0x05, 0xb8, 0xfd, 0x88, 0xc7, 0x9c, 0x81, 0xa3, 0x4d, 0x96, 0x94, 0xdd, 0xaf, 0x22,
+	0xbb, 0xd1, 0xdb, 0xfa, 0x8e, 0x28, 0x9b, 0x77, 0xfd, 0x3a, 0xad, 0x41, 0x22, 0x2a, 0xbc, 0xe5,
+	0x7e, 0x3d, 0xd1, 0xe5, 0xc9, 0x8f, 0x86, 0x8b, 0xf7, 0x6e, 0x24, 0x5d, 0x99, 0x8e, 0xdf, 0x31,
+	0xce, 0xbf, 0x93, 0x5c, 0x7a, 0xa8, 0xe7, 0x8c, 0x3d, 0xe4, 0xfe, 0x19, 0x87, 0xe1, 0x02, 0xf5,
+	0xf4, 0x4f, 0x35, 0x98, 0x6a, 0xad, 0xf2, 0xcf, 0x76, 0xa1, 0x68, 0x57, 0x30, 0x19, 0x17, 0x77,
+	0x21, 0x8a, 0x79, 0xf4, 0x4f, 0x60, 0xa2, 0xb9, 0xc2, 0x5a, 0xea, 0x9e, 0xaf, 0x49, 0x62, 0xbc,
+	0xde, 0xb7, 0x44, 0x01, 0x7c, 0xae, 0xc1, 0xe1, 0x76, 0xf5, 0xd0, 0x72, 0xf7, 0x94, 0x6d, 0x64,
+	0xc6, 0xa5, 0x5d, 0xc9, 0x1a, 0x68, 0xda, 0x15, 0x41, 0xcb, 0xbd, 0xcc, 0x71, 0x8b, 0xcc, 0xb8,
+	0xb4, 0x2b, 0x99, 0xa2, 0xf9, 0x5a, 0x83, 0x64, 0x7d, 0xe1, 0x93, 0xe9, 0x9e, 0xae, 0x2e, 0xdc,
+	0x58, 0xee, 0x2b, 0x5c, 0x1d, 0xb3, 0xdc, 0xdd, 0x5f, 0xfe, 0xba, 0xb7, 0xef, 0x8c, 0x79, 0xda,
+	0xea, 0xfc, 0x1f, 0xd7, 0x7a, 0xb2, 0x1f, 0x34, 0x18, 0x6f, 0xaa, 0x6e, 0x16, 0xfb, 0x1a, 0xfd,
+	0xea, 0x46, 0xc1, 0xb8, 0xd0, 0xaf, 0x42, 0x21, 0x2f, 0x0b, 0x64, 0xcb, 0xcc, 0xf4, 0x8e, 0xcc,
+	0x11, 0xbf, 0xd3, 0xe0, 0x50, 0x63, 0x49, 0x62, 0xf5, 0x8a, 0x20, 0x05, 0xc6, 0xf9, 0x3e, 0x05,
+	0x0a, 0xf9, 0x35, 0x81, 0x9c, 0x35, 0xcf, 0xf4, 0x84, 0x1c, 0xf3, 0xdd, 0xd3, 0x20, 0x21, 0x6b,
+	0x8f, 0x85, 0x5e, 0xf6, 0x16, 0x8f, 0x34, 0x16, 0x7b, 0x8d, 0x54, 0x70, 0x19, 0x01, 0x77, 0xca,
+	0x3c, 0xd1, 0x05, 0x4e, 0xa2, 0xfc, 0xa8, 0xc1, 0x64, 0x4b, 0x59, 0x91, 0xeb, 0xe5, 0x24, 0x36,
+	0x6a, 0x8c, 0x95, 0xfe, 0x35, 0x8a, 0xf9, 0xbc, 0x60, 0x5e, 0x32, 0xad, 0x2e, 0xcc, 0x2d, 0xa0,
+	0x3f, 0x69, 0x30, 0xd5, 0x5a, 0x48, 0xf4, 0x70, 0x15, 0xb7, 0x88, 0x8c, 0x8b, 0xbb, 0x10, 0x29,
+	0x03, 0x17, 0x84, 0x81, 0x9c, 0xb9, 0xd8, 0xc5, 0x40, 0x2b, 0xeb, 0xb7, 0x1a, 0x1c, 0x6c, 0x2c,
+	0x02, 0xba, 0x73, 0xd4, 0xc7, 0x1b, 0xe7, 0xfa, 0x8b, 0x57, 0xc8, 0x67, 0x05, 0x72, 0xc6, 0x7c,
+	0xb5, 0x0b, 0x72, 0x03, 0x5c, 0x0d, 0x0e, 0x36, 0x3c, 0xe1, 0xd9, 0x5e, 0x1f, 0x8f, 0x28, 0xde,
+	0x38, 0xd7, 0x5f, 0x7c, 0x0c, 0xbb, 0xfa, 0xfe, 0xa3, 0x67, 0x69, 0xed, 0xf1, 0xb3, 0xb4, 0xf6,
+	0xc7, 0xb3, 0xb4, 0xf6, 0xc5, 0x76, 0x7a, 0xe8, 0xf1, 0x76, 0x7a, 0xe8, 0xd7, 0xed, 0xf4, 0xd0,
+	0x7b, 0x97, 0xeb, 0x8a, 0xb7, 0x00, 0x85, 0xd4, 0xa7, 0x0c, 0x61, 0x07, 0x5d, 0xc3, 0x48, 0xfa,
+	0xca, 0x60, 0x9b, 0xf9, 0x35, 0x64, 0xd5, 0x72, 0xd6, 0xc7, 0xcd, 0x1e, 0x45, 0x6d, 0xb7, 0x99,
+	0x10, 0x7f, 0xbf, 0x3b, 0xfb, 0xef, 0x00, 0xb3, 0xc9, 0x05, 0x03, 0x10, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RegisterHostChain(ctx context.Context, in *MsgRegisterHostChain, opts ...grpc.CallOption) (*MsgRegisterHostChainResponse, error)
+	UpdateHostChain(ctx context.Context, in *MsgUpdateHostChain, opts ...grpc.CallOption) (*MsgUpdateHostChainResponse, error)
+	DeregisterHostChain(ctx context.Context, in *MsgDeregisterHostChain, opts ...grpc.CallOption) (*MsgDeregisterHostChainResponse, error)
+	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error)
+	LiquidStakeLSM(ctx context.Context, in *MsgLiquidStakeLSM, opts ...grpc.CallOption) (*MsgLiquidStakeLSMResponse, error)
+	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
+	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	DepositLiquidity(ctx context.Context, in *MsgDepositLiquidity, opts ...grpc.CallOption) (*MsgDepositLiquidityResponse, error)
+	WithdrawLiquidity(ctx context.Context, in *MsgWithdrawLiquidity, opts ...grpc.CallOption) (*MsgWithdrawLiquidityResponse, error)
+	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
 func (c *msgClient) RegisterHostChain(ctx context.Context, in *MsgRegisterHostChain, opts ...grpc.CallOption) (*MsgRegisterHostChainResponse, error) {
@@ -660,6 +1224,24 @@ func (c *msgClient) UpdateHostChain(ctx context.Context, in *MsgUpdateHostChain,
 	return out, nil
 }
 
+func (c *msgClient) DeregisterHostChain(ctx context.Context, in *MsgDeregisterHostChain, opts ...grpc.CallOption) (*MsgDeregisterHostChainResponse, error) {
+	out := new(MsgDeregisterHostChainResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/DeregisterHostChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error) {
+	out := new(MsgResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/ResetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ...grpc.CallOption) (*MsgLiquidStakeResponse, error) {
 	out := new(MsgLiquidStakeResponse)
 	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStake", in, out, opts...)
@@ -669,6 +1251,15 @@ func (c *msgClient) LiquidStake(ctx context.Context, in *MsgLiquidStake, opts ..
 	return out, nil
 }
 
+func (c *msgClient) LiquidStakeLSM(ctx context.Context, in *MsgLiquidStakeLSM, opts ...grpc.CallOption) (*MsgLiquidStakeLSMResponse, error) {
+	out := new(MsgLiquidStakeLSMResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStakeLSM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error) {
 	out := new(MsgLiquidUnstakeResponse)
 	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/LiquidUnstake", in, out, opts...)
@@ -687,6 +1278,33 @@ func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) DepositLiquidity(ctx context.Context, in *MsgDepositLiquidity, opts ...grpc.CallOption) (*MsgDepositLiquidityResponse, error) {
+	out := new(MsgDepositLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/DepositLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawLiquidity(ctx context.Context, in *MsgWithdrawLiquidity, opts ...grpc.CallOption) (*MsgWithdrawLiquidityResponse, error) {
+	out := new(MsgWithdrawLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/WithdrawLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error) {
+	out := new(MsgVoteWeightedResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/VoteWeighted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -700,9 +1318,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	RegisterHostChain(context.Context, *MsgRegisterHostChain) (*MsgRegisterHostChainResponse, error)
 	UpdateHostChain(context.Context, *MsgUpdateHostChain) (*MsgUpdateHostChainResponse, error)
+	DeregisterHostChain(context.Context, *MsgDeregisterHostChain) (*MsgDeregisterHostChainResponse, error)
+	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
 	LiquidStake(context.Context, *MsgLiquidStake) (*MsgLiquidStakeResponse, error)
+	LiquidStakeLSM(context.Context, *MsgLiquidStakeLSM) (*MsgLiquidStakeLSMResponse, error)
 	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
 	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
+	DepositLiquidity(context.Context, *MsgDepositLiquidity) (*MsgDepositLiquidityResponse, error)
+	WithdrawLiquidity(context.Context, *MsgWithdrawLiquidity) (*MsgWithdrawLiquidityResponse, error)
+	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
@@ -716,15 +1340,33 @@ func (*UnimplementedMsgServer) RegisterHostChain(ctx context.Context, req *MsgRe
 func (*UnimplementedMsgServer) UpdateHostChain(ctx context.Context, req *MsgUpdateHostChain) (*MsgUpdateHostChainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateHostChain not implemented")
 }
+func (*UnimplementedMsgServer) DeregisterHostChain(ctx context.Context, req *MsgDeregisterHostChain) (*MsgDeregisterHostChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterHostChain not implemented")
+}
+func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
 func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidStake) (*MsgLiquidStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidStake not implemented")
 }
+func (*UnimplementedMsgServer) LiquidStakeLSM(ctx context.Context, req *MsgLiquidStakeLSM) (*MsgLiquidStakeLSMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidStakeLSM not implemented")
+}
 func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
 }
 func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgRedeemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
 }
+func (*UnimplementedMsgServer) DepositLiquidity(ctx context.Context, req *MsgDepositLiquidity) (*MsgDepositLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawLiquidity(ctx context.Context, req *MsgWithdrawLiquidity) (*MsgWithdrawLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -769,44 +1411,98 @@ func _Msg_UpdateHostChain_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidStake)
+func _Msg_DeregisterHostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterHostChain)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).LiquidStake(ctx, in)
+		return srv.(MsgServer).DeregisterHostChain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStake",
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/DeregisterHostChain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
+		return srv.(MsgServer).DeregisterHostChain(ctx, req.(*MsgDeregisterHostChain))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLiquidUnstake)
+func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreaker)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).LiquidUnstake(ctx, in)
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidUnstake",
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/ResetCircuitBreaker",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).LiquidUnstake(ctx, req.(*MsgLiquidUnstake))
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, req.(*MsgResetCircuitBreaker))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRedeem)
+func _Msg_LiquidStake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidStake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidStake(ctx, req.(*MsgLiquidStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiquidStakeLSM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidStakeLSM)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidStakeLSM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidStakeLSM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidStakeLSM(ctx, req.(*MsgLiquidStakeLSM))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidUnstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/LiquidUnstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidUnstake(ctx, req.(*MsgLiquidUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -823,6 +1519,60 @@ func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DepositLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/DepositLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositLiquidity(ctx, req.(*MsgDepositLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/WithdrawLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawLiquidity(ctx, req.(*MsgWithdrawLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteWeighted)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteWeighted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Msg/VoteWeighted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteWeighted(ctx, req.(*MsgVoteWeighted))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -853,10 +1603,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateHostChain",
 			Handler:    _Msg_UpdateHostChain_Handler,
 		},
+		{
+			MethodName: "DeregisterHostChain",
+			Handler:    _Msg_DeregisterHostChain_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Msg_ResetCircuitBreaker_Handler,
+		},
 		{
 			MethodName: "LiquidStake",
 			Handler:    _Msg_LiquidStake_Handler,
 		},
+		{
+			MethodName: "LiquidStakeLSM",
+			Handler:    _Msg_LiquidStakeLSM_Handler,
+		},
 		{
 			MethodName: "LiquidUnstake",
 			Handler:    _Msg_LiquidUnstake_Handler,
@@ -865,6 +1627,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Redeem",
 			Handler:    _Msg_Redeem_Handler,
 		},
+		{
+			MethodName: "DepositLiquidity",
+			Handler:    _Msg_DepositLiquidity_Handler,
+		},
+		{
+			MethodName: "WithdrawLiquidity",
+			Handler:    _Msg_WithdrawLiquidity_Handler,
+		},
+		{
+			MethodName: "VoteWeighted",
+			Handler:    _Msg_VoteWeighted_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1084,7 +1858,7 @@ func (m *MsgUpdateHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeregisterHostChain) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1094,37 +1868,34 @@ func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeregisterHostChain) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeregisterHostChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeregisterHostChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1134,12 +1905,12 @@ func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeregisterHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeregisterHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1147,7 +1918,7 @@ func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidUnstake) Marshal() (dAtA []byte, err error) {
+func (m *MsgResetCircuitBreaker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1157,12 +1928,72 @@ func (m *MsgLiquidUnstake) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgResetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1187,7 +2018,7 @@ func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgLiquidStakeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1197,12 +2028,12 @@ func (m *MsgLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgLiquidStakeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgLiquidStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1210,7 +2041,7 @@ func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
+func (m *MsgLiquidStakeLSM) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1220,26 +2051,30 @@ func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRedeem) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgLiquidStakeLSM) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgLiquidStakeLSM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.DelegatorAddress) > 0 {
 		i -= len(m.DelegatorAddress)
 		copy(dAtA[i:], m.DelegatorAddress)
@@ -1250,7 +2085,7 @@ func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgLiquidStakeLSMResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1260,12 +2095,12 @@ func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgLiquidStakeLSMResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgLiquidStakeLSMResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1273,7 +2108,7 @@ func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgLiquidUnstake) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1283,18 +2118,18 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgLiquidUnstake) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgLiquidUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1303,17 +2138,17 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgLiquidUnstakeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1323,12 +2158,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgLiquidUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1336,203 +2171,1519 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterHostChain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.DepositFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.RestakeFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.UnstakeFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.RedemptionFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.HostDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	l = m.MinimumDeposit.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	if m.UnbondingFactor != 0 {
-		n += 1 + sovMsgs(uint64(m.UnbondingFactor))
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterHostChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateHostChain) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDepositLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovMsgs(uint64(l))
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateHostChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDepositLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgLiquidStake) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+func (m *MsgDepositLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLiquidStakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDepositLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidUnstake) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLiquidUnstakeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRedeem) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteWeightedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeightedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeightedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterHostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.DepositFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.RestakeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.UnstakeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.RedemptionFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.HostDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.MinimumDeposit.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.UnbondingFactor != 0 {
+		n += 1 + sovMsgs(uint64(m.UnbondingFactor))
+	}
+	return n
+}
+
+func (m *MsgRegisterHostChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateHostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateHostChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterHostChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterHostChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidStakeLSM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLiquidStakeLSMResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgDepositLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovMsgs(uint64(m.ProposalId))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVoteWeightedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterHostChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
+			}
+			m.UnbondingFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingFactor |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterHostChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterHostChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, &KVUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateHostChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateHostChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterHostChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterHostChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
-}
 
-func (m *MsgRedeemResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgDeregisterHostChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterHostChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1555,10 +3706,10 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterHostChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1595,7 +3746,139 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1623,13 +3906,13 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1639,63 +3922,128 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DepositFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RestakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeLSM) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeLSM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeLSM: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1723,15 +4071,13 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnstakeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1741,93 +4087,129 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Delegations = append(m.Delegations, types.Coin{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidStakeLSMResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidStakeLSMResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidStakeLSMResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1855,13 +4237,13 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostDenom = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -1871,45 +4253,25 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinimumDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingFactor", wireType)
-			}
-			m.UnbondingFactor = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingFactor |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -1931,7 +4293,7 @@ func (m *MsgRegisterHostChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterHostChainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,10 +4316,10 @@ func (m *MsgRegisterHostChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterHostChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1981,7 +4343,7 @@ func (m *MsgRegisterHostChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,15 +4366,15 @@ func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2040,43 +4402,11 @@ func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2103,8 +4433,7 @@ func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, &KVUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2129,7 +4458,7 @@ func (m *MsgUpdateHostChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateHostChainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2152,10 +4481,10 @@ func (m *MsgUpdateHostChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateHostChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2179,7 +4508,7 @@ func (m *MsgUpdateHostChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositLiquidity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2202,15 +4531,15 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositLiquidity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2238,7 +4567,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2294,7 +4623,7 @@ func (m *MsgLiquidStake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2317,10 +4646,10 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2344,7 +4673,7 @@ func (m *MsgLiquidStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawLiquidity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2367,15 +4696,15 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawLiquidity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2403,13 +4732,13 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -2419,22 +4748,55 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2459,7 +4821,7 @@ func (m *MsgLiquidUnstake) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2482,10 +4844,10 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2509,7 +4871,7 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2532,15 +4894,15 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2568,11 +4930,62 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2599,7 +5012,8 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Options = append(m.Options, v1beta1.WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2624,7 +5038,7 @@ func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2647,10 +5061,10 @@ func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13,6 +13,8 @@ const (
 	DefaultFeeAddress       string = "persistence1xruvjju28j0a5ud5325rfdak8f5a04h0s30mld" // TODO: Use correct address on launch
 	DefaultUpperCValueLimit string = "1.1"
 	DefaultLowerCValueLimit string = "0.85"
+
+	DefaultExchangeRateHistorySize uint32 = 720
 )
 
 // NewParams creates a new Params object
@@ -36,12 +38,15 @@ func NewParams(
 
 // DefaultParams returns the default set of parameters of the module
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultAdminAddress,
 		DefaultFeeAddress,
 		DefaultUpperCValueLimit,
 		DefaultLowerCValueLimit,
 	)
+	params.ExchangeRateHistorySize = DefaultExchangeRateHistorySize
+
+	return params
 }
 
 // Validate all liquidstakeibc module parameters
@@ -58,6 +63,9 @@ func (p *Params) Validate() error {
 	if err := isLTOne(p.LowerCValueLimit); err != nil {
 		return err
 	}
+	if p.LedgerRetentionBlocks < 0 {
+		return fmt.Errorf("ledger retention blocks can't be negative")
+	}
 
 	return nil
 }
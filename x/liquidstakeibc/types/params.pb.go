@@ -27,10 +27,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	AdminAddress     string                                 `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
-	FeeAddress       string                                 `protobuf:"bytes,2,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
-	UpperCValueLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=upper_c_value_limit,json=upperCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_c_value_limit"`
-	LowerCValueLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=lower_c_value_limit,json=lowerCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_c_value_limit"`
+	AdminAddress            string                                 `protobuf:"bytes,1,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	FeeAddress              string                                 `protobuf:"bytes,2,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty"`
+	UpperCValueLimit        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=upper_c_value_limit,json=upperCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_c_value_limit"`
+	LowerCValueLimit        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=lower_c_value_limit,json=lowerCValueLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_c_value_limit"`
+	LedgerEnabled           bool                                   `protobuf:"varint,5,opt,name=ledger_enabled,json=ledgerEnabled,proto3" json:"ledger_enabled,omitempty"`
+	LedgerRetentionBlocks   int64                                  `protobuf:"varint,6,opt,name=ledger_retention_blocks,json=ledgerRetentionBlocks,proto3" json:"ledger_retention_blocks,omitempty"`
+	ExchangeRateHistorySize uint32                                 `protobuf:"varint,7,opt,name=exchange_rate_history_size,json=exchangeRateHistorySize,proto3" json:"exchange_rate_history_size,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,6 +82,27 @@ func (m *Params) GetFeeAddress() string {
 	return ""
 }
 
+func (m *Params) GetLedgerEnabled() bool {
+	if m != nil {
+		return m.LedgerEnabled
+	}
+	return false
+}
+
+func (m *Params) GetLedgerRetentionBlocks() int64 {
+	if m != nil {
+		return m.LedgerRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetExchangeRateHistorySize() uint32 {
+	if m != nil {
+		return m.ExchangeRateHistorySize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "pstake.liquidstakeibc.v1beta1.Params")
 }
@@ -88,29 +112,36 @@ func init() {
 }
 
 var fileDescriptor_ed8bf02c8aabc0b0 = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2a, 0x28, 0x2e, 0x49,
-	0xcc, 0x4e, 0xd5, 0xcf, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0x01, 0xb3, 0x33, 0x93, 0x92, 0xf5, 0xcb,
-	0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x64, 0x21, 0x6a, 0xf5, 0x50, 0xd5, 0xea, 0x41, 0xd5, 0x4a, 0x89, 0xa4,
-	0xe7, 0xa7, 0xe7, 0x83, 0x55, 0xea, 0x83, 0x58, 0x10, 0x4d, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9,
-	0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22, 0xa5, 0xf4, 0x88, 0x89, 0x8b, 0x2d, 0x00, 0x6c,
-	0x81, 0x90, 0x2d, 0x17, 0x6f, 0x62, 0x4a, 0x6e, 0x66, 0x5e, 0x7c, 0x62, 0x4a, 0x4a, 0x51, 0x6a,
-	0x71, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0x3d,
-	0x8e, 0x10, 0x99, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x1e, 0xb0, 0x72, 0xa8, 0x98, 0x90,
-	0x25, 0x17, 0x77, 0x5a, 0x6a, 0x2a, 0x5c, 0x33, 0x13, 0x01, 0xcd, 0x5c, 0x69, 0xa9, 0xa9, 0x30,
-	0xad, 0xd9, 0x5c, 0xc2, 0xa5, 0x05, 0x05, 0xa9, 0x45, 0xf1, 0xc9, 0xf1, 0x65, 0x89, 0x39, 0xa5,
-	0xa9, 0xf1, 0x39, 0x99, 0xb9, 0x99, 0x25, 0x12, 0xcc, 0x60, 0x23, 0x6c, 0x4e, 0xdc, 0x93, 0x67,
-	0xb8, 0x75, 0x4f, 0x5e, 0x2d, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0xea,
-	0x05, 0x28, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0xe7, 0x92, 0x9a,
-	0x7c, 0x69, 0x8b, 0x2e, 0x17, 0xd4, 0x42, 0x97, 0xd4, 0xe4, 0x20, 0x01, 0xb0, 0xc1, 0xce, 0x61,
-	0x20, 0x63, 0x7d, 0x40, 0xa6, 0x82, 0x2c, 0xcb, 0xc9, 0x2f, 0xc7, 0xb0, 0x8c, 0x85, 0x1a, 0x96,
-	0x81, 0x0d, 0x46, 0xb2, 0xcc, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0xa7, 0xe8, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x72, 0x44, 0xb2, 0xa7, 0x20, 0xb5, 0xa8, 0x38, 0xb3,
-	0xb8, 0x24, 0x35, 0x2f, 0x39, 0xd5, 0x3f, 0x2f, 0x55, 0x1f, 0x12, 0xd1, 0xba, 0x79, 0x89, 0x25,
-	0x99, 0x65, 0xa9, 0xfa, 0x65, 0x46, 0xfa, 0x15, 0xe8, 0x09, 0x04, 0xec, 0x8c, 0x24, 0x36, 0x70,
-	0x44, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x9f, 0x8e, 0x0f, 0x46, 0x02, 0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x4f, 0x6b, 0x13, 0x41,
+	0x18, 0xc6, 0x77, 0x4c, 0x8d, 0x3a, 0x1a, 0x91, 0xb5, 0xd2, 0x35, 0xe0, 0x26, 0x08, 0x4a, 0x10,
+	0xb2, 0x43, 0x15, 0x04, 0xff, 0x1d, 0x1a, 0x2b, 0x78, 0x10, 0x94, 0x2d, 0x78, 0xd0, 0xc3, 0x30,
+	0x3b, 0xfb, 0x76, 0x33, 0x64, 0x77, 0x66, 0x9d, 0x99, 0xc4, 0xb6, 0x9f, 0xc2, 0xa3, 0x47, 0x3f,
+	0x44, 0x3f, 0x44, 0x8f, 0xa5, 0x27, 0xf1, 0x50, 0x24, 0xf9, 0x1e, 0x22, 0x99, 0xd9, 0x48, 0xd5,
+	0x83, 0x17, 0x4f, 0x3b, 0xfb, 0x3e, 0xbf, 0xe7, 0x79, 0x98, 0xe1, 0xc5, 0xf7, 0x6a, 0x63, 0xd9,
+	0x04, 0x48, 0x29, 0x3e, 0x4c, 0x45, 0xee, 0xce, 0x22, 0xe3, 0x64, 0xb6, 0x99, 0x81, 0x65, 0x9b,
+	0xa4, 0x66, 0x9a, 0x55, 0x26, 0xa9, 0xb5, 0xb2, 0x2a, 0xbc, 0xe5, 0xd9, 0xe4, 0x77, 0x36, 0x69,
+	0xd8, 0xee, 0x7a, 0xa1, 0x0a, 0xe5, 0x48, 0xb2, 0x3c, 0x79, 0x53, 0xf7, 0x26, 0x57, 0xa6, 0x52,
+	0x86, 0x7a, 0xc1, 0xff, 0x78, 0xe9, 0xf6, 0x8f, 0x16, 0x6e, 0xbf, 0x71, 0x05, 0xe1, 0x33, 0xdc,
+	0x61, 0x79, 0x25, 0x24, 0x65, 0x79, 0xae, 0xc1, 0x98, 0x08, 0xf5, 0xd1, 0xe0, 0xd2, 0x28, 0x3a,
+	0x39, 0x1c, 0xae, 0x37, 0x9e, 0x2d, 0xaf, 0xec, 0x58, 0x2d, 0x64, 0x91, 0x5e, 0x71, 0x78, 0x33,
+	0x0b, 0x1f, 0xe1, 0xcb, 0xbb, 0x00, 0xbf, 0xcc, 0xe7, 0xfe, 0x61, 0xc6, 0xbb, 0x00, 0x2b, 0xeb,
+	0x04, 0x5f, 0x9f, 0xd6, 0x35, 0x68, 0xca, 0xe9, 0x8c, 0x95, 0x53, 0xa0, 0xa5, 0xa8, 0x84, 0x8d,
+	0x5a, 0x2e, 0xe2, 0xe9, 0xd1, 0x69, 0x2f, 0xf8, 0x76, 0xda, 0xbb, 0x5b, 0x08, 0x3b, 0x9e, 0x66,
+	0x09, 0x57, 0x55, 0x73, 0x85, 0xe6, 0x33, 0x34, 0xf9, 0x84, 0xd8, 0xfd, 0x1a, 0x4c, 0xb2, 0x0d,
+	0xfc, 0xe4, 0x70, 0x88, 0x9b, 0xc2, 0x6d, 0xe0, 0xe9, 0x35, 0x17, 0xfc, 0xfc, 0xed, 0x32, 0xf6,
+	0xd5, 0x32, 0x75, 0x59, 0x56, 0xaa, 0x8f, 0x7f, 0x95, 0xad, 0xfd, 0x8f, 0x32, 0x17, 0x7c, 0xb6,
+	0xec, 0x0e, 0xbe, 0x5a, 0x42, 0x5e, 0x80, 0xa6, 0x20, 0x59, 0x56, 0x42, 0x1e, 0x9d, 0xef, 0xa3,
+	0xc1, 0xc5, 0xb4, 0xe3, 0xa7, 0x2f, 0xfc, 0x30, 0x7c, 0x88, 0x37, 0x1a, 0x4c, 0x83, 0x05, 0x69,
+	0x85, 0x92, 0x34, 0x2b, 0x15, 0x9f, 0x98, 0xa8, 0xdd, 0x47, 0x83, 0x56, 0x7a, 0xc3, 0xcb, 0xe9,
+	0x4a, 0x1d, 0x39, 0x31, 0x7c, 0x82, 0xbb, 0xb0, 0xc7, 0xc7, 0x4c, 0x16, 0x40, 0x35, 0xb3, 0x40,
+	0xc7, 0xc2, 0x58, 0xa5, 0xf7, 0xa9, 0x11, 0x07, 0x10, 0x5d, 0xe8, 0xa3, 0x41, 0x27, 0xdd, 0x58,
+	0x11, 0x29, 0xb3, 0xf0, 0xd2, 0xeb, 0x3b, 0xe2, 0x00, 0x1e, 0xaf, 0x7d, 0xfe, 0xd2, 0x0b, 0x46,
+	0xef, 0x8f, 0xe6, 0x31, 0x3a, 0x9e, 0xc7, 0xe8, 0xfb, 0x3c, 0x46, 0x9f, 0x16, 0x71, 0x70, 0xbc,
+	0x88, 0x83, 0xaf, 0x8b, 0x38, 0x78, 0xb7, 0x75, 0xe6, 0x0d, 0x6a, 0xd0, 0x46, 0x18, 0x0b, 0x92,
+	0xc3, 0x6b, 0x09, 0xc4, 0x2f, 0xe1, 0x50, 0x32, 0x2b, 0x66, 0x40, 0x66, 0xf7, 0xc9, 0xde, 0x9f,
+	0xcb, 0xeb, 0x9e, 0x28, 0x6b, 0xbb, 0x25, 0x7b, 0xf0, 0x73, 0x00, 0x9b, 0x98, 0xfe, 0x27, 0xe2,
+	0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -133,6 +164,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExchangeRateHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExchangeRateHistorySize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LedgerRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LedgerRetentionBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LedgerEnabled {
+		i--
+		if m.LedgerEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.LowerCValueLimit.Size()
 		i -= size
@@ -199,6 +250,15 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.LowerCValueLimit.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.LedgerEnabled {
+		n += 2
+	}
+	if m.LedgerRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.LedgerRetentionBlocks))
+	}
+	if m.ExchangeRateHistorySize != 0 {
+		n += 1 + sovParams(uint64(m.ExchangeRateHistorySize))
+	}
 	return n
 }
 
@@ -369,6 +429,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LedgerEnabled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerRetentionBlocks", wireType)
+			}
+			m.LedgerRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LedgerRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateHistorySize", wireType)
+			}
+			m.ExchangeRateHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExchangeRateHistorySize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
+	v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -368,22 +369,22 @@ func (m *QueryDepositsResponse) GetDeposits() []*Deposit {
 	return nil
 }
 
-type QueryUnbondingsRequest struct {
+type QueryLSMDepositsRequest struct {
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryUnbondingsRequest) Reset()         { *m = QueryUnbondingsRequest{} }
-func (m *QueryUnbondingsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryUnbondingsRequest) ProtoMessage()    {}
-func (*QueryUnbondingsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLSMDepositsRequest) Reset()         { *m = QueryLSMDepositsRequest{} }
+func (m *QueryLSMDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLSMDepositsRequest) ProtoMessage()    {}
+func (*QueryLSMDepositsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{8}
 }
-func (m *QueryUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLSMDepositsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLSMDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUnbondingsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLSMDepositsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -393,41 +394,41 @@ func (m *QueryUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryUnbondingsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUnbondingsRequest.Merge(m, src)
+func (m *QueryLSMDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLSMDepositsRequest.Merge(m, src)
 }
-func (m *QueryUnbondingsRequest) XXX_Size() int {
+func (m *QueryLSMDepositsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUnbondingsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUnbondingsRequest.DiscardUnknown(m)
+func (m *QueryLSMDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLSMDepositsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUnbondingsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLSMDepositsRequest proto.InternalMessageInfo
 
-func (m *QueryUnbondingsRequest) GetChainId() string {
+func (m *QueryLSMDepositsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type QueryUnbondingsResponse struct {
-	Unbondings []*Unbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings,omitempty"`
+type QueryLSMDepositsResponse struct {
+	Deposits []*LSMDeposit `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits,omitempty"`
 }
 
-func (m *QueryUnbondingsResponse) Reset()         { *m = QueryUnbondingsResponse{} }
-func (m *QueryUnbondingsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryUnbondingsResponse) ProtoMessage()    {}
-func (*QueryUnbondingsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryLSMDepositsResponse) Reset()         { *m = QueryLSMDepositsResponse{} }
+func (m *QueryLSMDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLSMDepositsResponse) ProtoMessage()    {}
+func (*QueryLSMDepositsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{9}
 }
-func (m *QueryUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLSMDepositsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLSMDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUnbondingsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLSMDepositsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -437,42 +438,41 @@ func (m *QueryUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryUnbondingsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUnbondingsResponse.Merge(m, src)
+func (m *QueryLSMDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLSMDepositsResponse.Merge(m, src)
 }
-func (m *QueryUnbondingsResponse) XXX_Size() int {
+func (m *QueryLSMDepositsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUnbondingsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUnbondingsResponse.DiscardUnknown(m)
+func (m *QueryLSMDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLSMDepositsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUnbondingsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLSMDepositsResponse proto.InternalMessageInfo
 
-func (m *QueryUnbondingsResponse) GetUnbondings() []*Unbonding {
+func (m *QueryLSMDepositsResponse) GetDeposits() []*LSMDeposit {
 	if m != nil {
-		return m.Unbondings
+		return m.Deposits
 	}
 	return nil
 }
 
-type QueryUnbondingRequest struct {
+type QueryRewardsHistoryRequest struct {
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Epoch   int64  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (m *QueryUnbondingRequest) Reset()         { *m = QueryUnbondingRequest{} }
-func (m *QueryUnbondingRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryUnbondingRequest) ProtoMessage()    {}
-func (*QueryUnbondingRequest) Descriptor() ([]byte, []int) {
+func (m *QueryRewardsHistoryRequest) Reset()         { *m = QueryRewardsHistoryRequest{} }
+func (m *QueryRewardsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsHistoryRequest) ProtoMessage()    {}
+func (*QueryRewardsHistoryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{10}
 }
-func (m *QueryUnbondingRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRewardsHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUnbondingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRewardsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUnbondingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRewardsHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -482,48 +482,41 @@ func (m *QueryUnbondingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryUnbondingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUnbondingRequest.Merge(m, src)
+func (m *QueryRewardsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsHistoryRequest.Merge(m, src)
 }
-func (m *QueryUnbondingRequest) XXX_Size() int {
+func (m *QueryRewardsHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUnbondingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUnbondingRequest.DiscardUnknown(m)
+func (m *QueryRewardsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUnbondingRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRewardsHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryUnbondingRequest) GetChainId() string {
+func (m *QueryRewardsHistoryRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-func (m *QueryUnbondingRequest) GetEpoch() int64 {
-	if m != nil {
-		return m.Epoch
-	}
-	return 0
-}
-
-type QueryUnbondingResponse struct {
-	Unbonding *Unbonding `protobuf:"bytes,1,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
+type QueryRewardsHistoryResponse struct {
+	RewardsRecords []*RewardsRecord `protobuf:"bytes,1,rep,name=rewards_records,json=rewardsRecords,proto3" json:"rewards_records,omitempty"`
 }
 
-func (m *QueryUnbondingResponse) Reset()         { *m = QueryUnbondingResponse{} }
-func (m *QueryUnbondingResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryUnbondingResponse) ProtoMessage()    {}
-func (*QueryUnbondingResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRewardsHistoryResponse) Reset()         { *m = QueryRewardsHistoryResponse{} }
+func (m *QueryRewardsHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsHistoryResponse) ProtoMessage()    {}
+func (*QueryRewardsHistoryResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{11}
 }
-func (m *QueryUnbondingResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRewardsHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUnbondingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRewardsHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUnbondingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRewardsHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -533,41 +526,42 @@ func (m *QueryUnbondingResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryUnbondingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUnbondingResponse.Merge(m, src)
+func (m *QueryRewardsHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsHistoryResponse.Merge(m, src)
 }
-func (m *QueryUnbondingResponse) XXX_Size() int {
+func (m *QueryRewardsHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUnbondingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUnbondingResponse.DiscardUnknown(m)
+func (m *QueryRewardsHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUnbondingResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRewardsHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryUnbondingResponse) GetUnbonding() *Unbonding {
+func (m *QueryRewardsHistoryResponse) GetRewardsRecords() []*RewardsRecord {
 	if m != nil {
-		return m.Unbonding
+		return m.RewardsRecords
 	}
 	return nil
 }
 
-type QueryUserUnbondingsRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+type QuerySlashesRequest struct {
+	ChainId          string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *QueryUserUnbondingsRequest) Reset()         { *m = QueryUserUnbondingsRequest{} }
-func (m *QueryUserUnbondingsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryUserUnbondingsRequest) ProtoMessage()    {}
-func (*QueryUserUnbondingsRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySlashesRequest) Reset()         { *m = QuerySlashesRequest{} }
+func (m *QuerySlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesRequest) ProtoMessage()    {}
+func (*QuerySlashesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{12}
 }
-func (m *QueryUserUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySlashesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserUnbondingsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySlashesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -577,41 +571,48 @@ func (m *QueryUserUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryUserUnbondingsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserUnbondingsRequest.Merge(m, src)
+func (m *QuerySlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesRequest.Merge(m, src)
 }
-func (m *QueryUserUnbondingsRequest) XXX_Size() int {
+func (m *QuerySlashesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserUnbondingsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserUnbondingsRequest.DiscardUnknown(m)
+func (m *QuerySlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserUnbondingsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySlashesRequest proto.InternalMessageInfo
 
-func (m *QueryUserUnbondingsRequest) GetAddress() string {
+func (m *QuerySlashesRequest) GetChainId() string {
 	if m != nil {
-		return m.Address
+		return m.ChainId
 	}
 	return ""
 }
 
-type QueryUserUnbondingsResponse struct {
-	UserUnbondings []*UserUnbonding `protobuf:"bytes,1,rep,name=user_unbondings,json=userUnbondings,proto3" json:"user_unbondings,omitempty"`
+func (m *QuerySlashesRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
 
-func (m *QueryUserUnbondingsResponse) Reset()         { *m = QueryUserUnbondingsResponse{} }
-func (m *QueryUserUnbondingsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryUserUnbondingsResponse) ProtoMessage()    {}
-func (*QueryUserUnbondingsResponse) Descriptor() ([]byte, []int) {
+type QuerySlashesResponse struct {
+	Slashes []*Slash `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes,omitempty"`
+}
+
+func (m *QuerySlashesResponse) Reset()         { *m = QuerySlashesResponse{} }
+func (m *QuerySlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashesResponse) ProtoMessage()    {}
+func (*QuerySlashesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{13}
 }
-func (m *QueryUserUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySlashesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryUserUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryUserUnbondingsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySlashesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -621,41 +622,41 @@ func (m *QueryUserUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryUserUnbondingsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryUserUnbondingsResponse.Merge(m, src)
+func (m *QuerySlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashesResponse.Merge(m, src)
 }
-func (m *QueryUserUnbondingsResponse) XXX_Size() int {
+func (m *QuerySlashesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryUserUnbondingsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUserUnbondingsResponse.DiscardUnknown(m)
+func (m *QuerySlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUserUnbondingsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySlashesResponse proto.InternalMessageInfo
 
-func (m *QueryUserUnbondingsResponse) GetUserUnbondings() []*UserUnbonding {
+func (m *QuerySlashesResponse) GetSlashes() []*Slash {
 	if m != nil {
-		return m.UserUnbondings
+		return m.Slashes
 	}
 	return nil
 }
 
-type QueryValidatorUnbondingRequest struct {
+type QueryIcaTxsRequest struct {
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryValidatorUnbondingRequest) Reset()         { *m = QueryValidatorUnbondingRequest{} }
-func (m *QueryValidatorUnbondingRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidatorUnbondingRequest) ProtoMessage()    {}
-func (*QueryValidatorUnbondingRequest) Descriptor() ([]byte, []int) {
+func (m *QueryIcaTxsRequest) Reset()         { *m = QueryIcaTxsRequest{} }
+func (m *QueryIcaTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaTxsRequest) ProtoMessage()    {}
+func (*QueryIcaTxsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{14}
 }
-func (m *QueryValidatorUnbondingRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryIcaTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidatorUnbondingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIcaTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidatorUnbondingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIcaTxsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -665,41 +666,41 @@ func (m *QueryValidatorUnbondingRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryValidatorUnbondingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidatorUnbondingRequest.Merge(m, src)
+func (m *QueryIcaTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaTxsRequest.Merge(m, src)
 }
-func (m *QueryValidatorUnbondingRequest) XXX_Size() int {
+func (m *QueryIcaTxsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidatorUnbondingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidatorUnbondingRequest.DiscardUnknown(m)
+func (m *QueryIcaTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidatorUnbondingRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryIcaTxsRequest proto.InternalMessageInfo
 
-func (m *QueryValidatorUnbondingRequest) GetChainId() string {
+func (m *QueryIcaTxsRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type QueryValidatorUnbondingResponse struct {
-	ValidatorUnbondings []*ValidatorUnbonding `protobuf:"bytes,1,rep,name=validator_unbondings,json=validatorUnbondings,proto3" json:"validator_unbondings,omitempty"`
+type QueryIcaTxsResponse struct {
+	IcaTxs []*ICATx `protobuf:"bytes,1,rep,name=ica_txs,json=icaTxs,proto3" json:"ica_txs,omitempty"`
 }
 
-func (m *QueryValidatorUnbondingResponse) Reset()         { *m = QueryValidatorUnbondingResponse{} }
-func (m *QueryValidatorUnbondingResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidatorUnbondingResponse) ProtoMessage()    {}
-func (*QueryValidatorUnbondingResponse) Descriptor() ([]byte, []int) {
+func (m *QueryIcaTxsResponse) Reset()         { *m = QueryIcaTxsResponse{} }
+func (m *QueryIcaTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaTxsResponse) ProtoMessage()    {}
+func (*QueryIcaTxsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{15}
 }
-func (m *QueryValidatorUnbondingResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryIcaTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidatorUnbondingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIcaTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidatorUnbondingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIcaTxsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -709,41 +710,41 @@ func (m *QueryValidatorUnbondingResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryValidatorUnbondingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidatorUnbondingResponse.Merge(m, src)
+func (m *QueryIcaTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaTxsResponse.Merge(m, src)
 }
-func (m *QueryValidatorUnbondingResponse) XXX_Size() int {
+func (m *QueryIcaTxsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidatorUnbondingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidatorUnbondingResponse.DiscardUnknown(m)
+func (m *QueryIcaTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidatorUnbondingResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryIcaTxsResponse proto.InternalMessageInfo
 
-func (m *QueryValidatorUnbondingResponse) GetValidatorUnbondings() []*ValidatorUnbonding {
+func (m *QueryIcaTxsResponse) GetIcaTxs() []*ICATx {
 	if m != nil {
-		return m.ValidatorUnbondings
+		return m.IcaTxs
 	}
 	return nil
 }
 
-type QueryDepositAccountBalanceRequest struct {
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+type QueryIcaTxRequest struct {
+	SequenceId string `protobuf:"bytes,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
 }
 
-func (m *QueryDepositAccountBalanceRequest) Reset()         { *m = QueryDepositAccountBalanceRequest{} }
-func (m *QueryDepositAccountBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDepositAccountBalanceRequest) ProtoMessage()    {}
-func (*QueryDepositAccountBalanceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryIcaTxRequest) Reset()         { *m = QueryIcaTxRequest{} }
+func (m *QueryIcaTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaTxRequest) ProtoMessage()    {}
+func (*QueryIcaTxRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{16}
 }
-func (m *QueryDepositAccountBalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryIcaTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDepositAccountBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIcaTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDepositAccountBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIcaTxRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -753,41 +754,41 @@ func (m *QueryDepositAccountBalanceRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryDepositAccountBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDepositAccountBalanceRequest.Merge(m, src)
+func (m *QueryIcaTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaTxRequest.Merge(m, src)
 }
-func (m *QueryDepositAccountBalanceRequest) XXX_Size() int {
+func (m *QueryIcaTxRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDepositAccountBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDepositAccountBalanceRequest.DiscardUnknown(m)
+func (m *QueryIcaTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaTxRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDepositAccountBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryIcaTxRequest proto.InternalMessageInfo
 
-func (m *QueryDepositAccountBalanceRequest) GetChainId() string {
+func (m *QueryIcaTxRequest) GetSequenceId() string {
 	if m != nil {
-		return m.ChainId
+		return m.SequenceId
 	}
 	return ""
 }
 
-type QueryDepositAccountBalanceResponse struct {
-	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+type QueryIcaTxResponse struct {
+	IcaTx *ICATx `protobuf:"bytes,1,opt,name=ica_tx,json=icaTx,proto3" json:"ica_tx,omitempty"`
 }
 
-func (m *QueryDepositAccountBalanceResponse) Reset()         { *m = QueryDepositAccountBalanceResponse{} }
-func (m *QueryDepositAccountBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDepositAccountBalanceResponse) ProtoMessage()    {}
-func (*QueryDepositAccountBalanceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryIcaTxResponse) Reset()         { *m = QueryIcaTxResponse{} }
+func (m *QueryIcaTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIcaTxResponse) ProtoMessage()    {}
+func (*QueryIcaTxResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{17}
 }
-func (m *QueryDepositAccountBalanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryIcaTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDepositAccountBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryIcaTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDepositAccountBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryIcaTxResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -797,41 +798,41 @@ func (m *QueryDepositAccountBalanceResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryDepositAccountBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDepositAccountBalanceResponse.Merge(m, src)
+func (m *QueryIcaTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIcaTxResponse.Merge(m, src)
 }
-func (m *QueryDepositAccountBalanceResponse) XXX_Size() int {
+func (m *QueryIcaTxResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDepositAccountBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDepositAccountBalanceResponse.DiscardUnknown(m)
+func (m *QueryIcaTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIcaTxResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDepositAccountBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryIcaTxResponse proto.InternalMessageInfo
 
-func (m *QueryDepositAccountBalanceResponse) GetBalance() types.Coin {
+func (m *QueryIcaTxResponse) GetIcaTx() *ICATx {
 	if m != nil {
-		return m.Balance
+		return m.IcaTx
 	}
-	return types.Coin{}
+	return nil
 }
 
-type QueryExchangeRateRequest struct {
+type QueryLiquidityPoolRequest struct {
 	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryExchangeRateRequest) Reset()         { *m = QueryExchangeRateRequest{} }
-func (m *QueryExchangeRateRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryExchangeRateRequest) ProtoMessage()    {}
-func (*QueryExchangeRateRequest) Descriptor() ([]byte, []int) {
+func (m *QueryLiquidityPoolRequest) Reset()         { *m = QueryLiquidityPoolRequest{} }
+func (m *QueryLiquidityPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityPoolRequest) ProtoMessage()    {}
+func (*QueryLiquidityPoolRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{18}
 }
-func (m *QueryExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryLiquidityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryExchangeRateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityPoolRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -841,41 +842,47 @@ func (m *QueryExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryExchangeRateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryExchangeRateRequest.Merge(m, src)
+func (m *QueryLiquidityPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPoolRequest.Merge(m, src)
 }
-func (m *QueryExchangeRateRequest) XXX_Size() int {
+func (m *QueryLiquidityPoolRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryExchangeRateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryExchangeRateRequest.DiscardUnknown(m)
+func (m *QueryLiquidityPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPoolRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidityPoolRequest proto.InternalMessageInfo
 
-func (m *QueryExchangeRateRequest) GetChainId() string {
+func (m *QueryLiquidityPoolRequest) GetChainId() string {
 	if m != nil {
 		return m.ChainId
 	}
 	return ""
 }
 
-type QueryExchangeRateResponse struct {
-	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+type QueryLiquidityPoolResponse struct {
+	Pool *LiquidityPool `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	// host tokens available to serve redemptions
+	Available types.Coin `protobuf:"bytes,2,opt,name=available,proto3" json:"available"`
+	// host tokens owed to the pool by its queued unbondings
+	Unbonding types.Coin `protobuf:"bytes,3,opt,name=unbonding,proto3" json:"unbonding"`
+	// total value of the pool in host tokens
+	TotalValue types.Coin `protobuf:"bytes,4,opt,name=total_value,json=totalValue,proto3" json:"total_value"`
 }
 
-func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
-func (m *QueryExchangeRateResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryExchangeRateResponse) ProtoMessage()    {}
-func (*QueryExchangeRateResponse) Descriptor() ([]byte, []int) {
+func (m *QueryLiquidityPoolResponse) Reset()         { *m = QueryLiquidityPoolResponse{} }
+func (m *QueryLiquidityPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityPoolResponse) ProtoMessage()    {}
+func (*QueryLiquidityPoolResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b143d1c5e28840b2, []int{19}
 }
-func (m *QueryExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLiquidityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLiquidityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryExchangeRateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLiquidityPoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -885,1457 +892,8115 @@ func (m *QueryExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryExchangeRateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryExchangeRateResponse.Merge(m, src)
+func (m *QueryLiquidityPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPoolResponse.Merge(m, src)
 }
-func (m *QueryExchangeRateResponse) XXX_Size() int {
+func (m *QueryLiquidityPoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryExchangeRateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryExchangeRateResponse.DiscardUnknown(m)
+func (m *QueryLiquidityPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryExchangeRateResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLiquidityPoolResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryHostChainRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainRequest")
-	proto.RegisterType((*QueryHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainResponse")
-	proto.RegisterType((*QueryHostChainsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainsRequest")
-	proto.RegisterType((*QueryHostChainsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainsResponse")
-	proto.RegisterType((*QueryDepositsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsRequest")
-	proto.RegisterType((*QueryDepositsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsResponse")
-	proto.RegisterType((*QueryUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsRequest")
-	proto.RegisterType((*QueryUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsResponse")
-	proto.RegisterType((*QueryUnbondingRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingRequest")
-	proto.RegisterType((*QueryUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingResponse")
-	proto.RegisterType((*QueryUserUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUserUnbondingsRequest")
-	proto.RegisterType((*QueryUserUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUserUnbondingsResponse")
-	proto.RegisterType((*QueryValidatorUnbondingRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorUnbondingRequest")
-	proto.RegisterType((*QueryValidatorUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorUnbondingResponse")
-	proto.RegisterType((*QueryDepositAccountBalanceRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositAccountBalanceRequest")
-	proto.RegisterType((*QueryDepositAccountBalanceResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositAccountBalanceResponse")
-	proto.RegisterType((*QueryExchangeRateRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateRequest")
-	proto.RegisterType((*QueryExchangeRateResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateResponse")
+func (m *QueryLiquidityPoolResponse) GetPool() *LiquidityPool {
+	if m != nil {
+		return m.Pool
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/query.proto", fileDescriptor_b143d1c5e28840b2)
+func (m *QueryLiquidityPoolResponse) GetAvailable() types.Coin {
+	if m != nil {
+		return m.Available
+	}
+	return types.Coin{}
 }
 
-var fileDescriptor_b143d1c5e28840b2 = []byte{
-	// 1041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xdf, 0x6f, 0xdb, 0x54,
-	0x14, 0xc7, 0xeb, 0xfd, 0x6a, 0x73, 0x86, 0x36, 0xe9, 0x36, 0x83, 0xd4, 0x40, 0x3a, 0x2c, 0x6d,
-	0x94, 0x6a, 0xb5, 0xd5, 0xf4, 0xc7, 0xd6, 0x8e, 0x95, 0x36, 0x19, 0x53, 0xfb, 0x80, 0x00, 0x4b,
-	0xe3, 0x61, 0x7b, 0x08, 0x37, 0xf6, 0x55, 0x62, 0xad, 0xf5, 0x75, 0x73, 0x9d, 0x68, 0x53, 0xd5,
-	0x17, 0x5e, 0x78, 0x45, 0xe2, 0x19, 0xfe, 0x05, 0xc4, 0x0b, 0x12, 0x0f, 0xbc, 0xf0, 0x34, 0xde,
-	0x26, 0xf1, 0x82, 0x10, 0x9a, 0x50, 0x8b, 0xc4, 0xbf, 0x81, 0x72, 0x7d, 0xec, 0x38, 0x71, 0x56,
-	0x5f, 0x8f, 0xa7, 0xc6, 0xd7, 0xe7, 0x7b, 0xce, 0xe7, 0x9c, 0xd8, 0xe7, 0xdb, 0xc0, 0x07, 0x81,
-	0x08, 0xe9, 0x13, 0x66, 0xed, 0x7b, 0x87, 0x3d, 0xcf, 0x95, 0x9f, 0xbd, 0x96, 0x63, 0xf5, 0x97,
-	0x5b, 0x2c, 0xa4, 0xcb, 0xd6, 0x61, 0x8f, 0x75, 0x9f, 0x99, 0x41, 0x97, 0x87, 0x9c, 0xbc, 0x1b,
-	0x85, 0x9a, 0xa3, 0xa1, 0x26, 0x86, 0xea, 0xe5, 0x36, 0x6f, 0x73, 0x19, 0x69, 0x0d, 0x3e, 0x45,
-	0x22, 0xfd, 0x9d, 0x36, 0xe7, 0xed, 0x7d, 0x66, 0xd1, 0xc0, 0xb3, 0xa8, 0xef, 0xf3, 0x90, 0x86,
-	0x1e, 0xf7, 0x05, 0xde, 0x5d, 0x74, 0xb8, 0x38, 0xe0, 0xc2, 0x6a, 0x51, 0xc1, 0xa2, 0x5a, 0x49,
-	0xe5, 0x80, 0xb6, 0x3d, 0x5f, 0x06, 0x63, 0x6c, 0x35, 0x1d, 0x1b, 0x47, 0x39, 0xdc, 0x8b, 0xef,
-	0x2f, 0x9e, 0xdd, 0x49, 0x40, 0xbb, 0xf4, 0x20, 0xae, 0x5b, 0x3b, 0x3b, 0x76, 0xac, 0x43, 0xa9,
-	0x31, 0xca, 0x40, 0x3e, 0x1f, 0x10, 0x7e, 0x26, 0x13, 0xd9, 0xec, 0xb0, 0xc7, 0x44, 0x68, 0x3c,
-	0x82, 0xd9, 0x91, 0x53, 0x11, 0x70, 0x5f, 0x30, 0xd2, 0x80, 0x4b, 0x51, 0xc1, 0x8a, 0x76, 0x5d,
-	0x5b, 0xb8, 0x5c, 0xbb, 0x61, 0x9e, 0x39, 0x3c, 0x33, 0x92, 0xd7, 0x2f, 0x3c, 0x7f, 0x39, 0x3f,
-	0x65, 0xa3, 0xd4, 0xa8, 0xc1, 0x35, 0x99, 0x7b, 0x97, 0x8b, 0xb0, 0xd1, 0xa1, 0x9e, 0x8f, 0x45,
-	0xc9, 0x1c, 0xcc, 0x38, 0x83, 0xeb, 0xa6, 0xe7, 0xca, 0xfc, 0x25, 0x7b, 0x5a, 0x5e, 0xef, 0xb9,
-	0x46, 0x1b, 0xde, 0x1c, 0xd7, 0x20, 0xd2, 0x27, 0x00, 0x1d, 0x2e, 0xc2, 0xa6, 0x8c, 0x44, 0xac,
-	0x85, 0x1c, 0xac, 0x24, 0x0b, 0x92, 0x95, 0x3a, 0xf1, 0x81, 0x51, 0x19, 0x2f, 0x94, 0x8c, 0xc4,
-	0x85, 0xb7, 0x32, 0x77, 0x90, 0x61, 0x0f, 0x2e, 0x0f, 0x19, 0x06, 0xb3, 0x39, 0x5f, 0x04, 0xc2,
-	0x86, 0xa4, 0xbc, 0x30, 0x96, 0xa1, 0x2c, 0xab, 0xdc, 0x67, 0x01, 0x17, 0x5e, 0x28, 0x14, 0x66,
-	0xf3, 0x18, 0xe7, 0x39, 0x94, 0x20, 0x56, 0x1d, 0x66, 0x5c, 0x3c, 0x43, 0xa6, 0x9b, 0x39, 0x4c,
-	0x98, 0xc2, 0x4e, 0x74, 0xc6, 0x0a, 0xce, 0xe3, 0xa1, 0xdf, 0xe2, 0xbe, 0xeb, 0xf9, 0x6d, 0x15,
-	0x22, 0x07, 0x47, 0x95, 0x16, 0x21, 0xd3, 0x2e, 0x40, 0x2f, 0x39, 0x55, 0x9c, 0x54, 0x92, 0xc6,
-	0x4e, 0x69, 0x8d, 0x5d, 0x6c, 0x7b, 0x78, 0x37, 0x17, 0x8c, 0x94, 0xe1, 0x22, 0x0b, 0xb8, 0xd3,
-	0xa9, 0x9c, 0xbb, 0xae, 0x2d, 0x9c, 0xb7, 0xa3, 0x0b, 0xe3, 0xcb, 0xf1, 0x1e, 0x13, 0xda, 0x07,
-	0x50, 0x4a, 0x2a, 0x2a, 0x3e, 0x5b, 0xc3, 0x24, 0x43, 0xa9, 0xb1, 0x0e, 0x7a, 0x54, 0x41, 0xb0,
-	0x6e, 0x76, 0x92, 0x15, 0x98, 0xa6, 0xae, 0xdb, 0x65, 0x42, 0xc4, 0xbc, 0x78, 0x69, 0x84, 0xf0,
-	0xf6, 0x44, 0x1d, 0xe2, 0x3d, 0x84, 0xab, 0x3d, 0xc1, 0xba, 0xcd, 0xcc, 0x44, 0x6f, 0xe5, 0x41,
-	0xa6, 0xf3, 0xd9, 0x57, 0x7a, 0x23, 0xe9, 0x8d, 0xbb, 0x50, 0x95, 0x55, 0xbf, 0xa0, 0xfb, 0x9e,
-	0x4b, 0x43, 0xde, 0x2d, 0x30, 0x62, 0xe3, 0x6b, 0x0d, 0xe6, 0x5f, 0xa9, 0x46, 0x6e, 0x17, 0xca,
-	0xfd, 0xf8, 0x6e, 0x16, 0x7e, 0x39, 0x07, 0x7e, 0x42, 0xe2, 0xd9, 0x7e, 0xe6, 0x4c, 0x18, 0x5b,
-	0xf0, 0x5e, 0xfa, 0xbd, 0xd8, 0x71, 0x1c, 0xde, 0xf3, 0xc3, 0x3a, 0xdd, 0xa7, 0xbe, 0xc3, 0x14,
-	0x3a, 0x69, 0x82, 0x71, 0x96, 0x1e, 0x7b, 0xd9, 0x80, 0xe9, 0x56, 0x74, 0x84, 0x0f, 0xc8, 0x9c,
-	0x19, 0x6d, 0x74, 0x73, 0xb0, 0xd1, 0x13, 0xe8, 0x06, 0x4f, 0xb6, 0x4d, 0x1c, 0x6f, 0xac, 0x41,
-	0x45, 0x16, 0xf8, 0xf8, 0xa9, 0xd3, 0xa1, 0x7e, 0x9b, 0xd9, 0x34, 0x54, 0xe3, 0x9a, 0x9b, 0x20,
-	0x4b, 0xde, 0xf9, 0x0b, 0x5d, 0x1a, 0x46, 0x2c, 0xa5, 0xba, 0x39, 0x28, 0xf8, 0xe7, 0xcb, 0xf9,
-	0x9b, 0x6d, 0x2f, 0xec, 0xf4, 0x5a, 0xa6, 0xc3, 0x0f, 0x2c, 0xf4, 0x9b, 0xe8, 0xcf, 0x92, 0x70,
-	0x9f, 0x58, 0xe1, 0xb3, 0x80, 0x09, 0xf3, 0x3e, 0x73, 0x6c, 0xa9, 0xad, 0x7d, 0x77, 0x15, 0x2e,
-	0xca, 0x0a, 0xe4, 0x7b, 0x0d, 0x2e, 0x45, 0x3b, 0x9c, 0xe4, 0x7d, 0x2b, 0x59, 0x13, 0xd1, 0x6b,
-	0x45, 0x24, 0x11, 0xbf, 0xb1, 0xf4, 0xd5, 0xef, 0xff, 0x7c, 0x7b, 0xee, 0x7d, 0x72, 0xc3, 0x52,
-	0xf1, 0x3d, 0xf2, 0x93, 0x06, 0xa5, 0x64, 0x91, 0x92, 0x55, 0x95, 0x82, 0xe3, 0xb6, 0xa3, 0xaf,
-	0x15, 0x54, 0x21, 0xe9, 0x87, 0x92, 0x74, 0x9d, 0xac, 0xe6, 0x90, 0x0e, 0x9d, 0xc1, 0x3a, 0x8a,
-	0xbf, 0xd2, 0x63, 0xf2, 0x83, 0x06, 0x30, 0x74, 0x12, 0x52, 0x8c, 0x21, 0x99, 0xf0, 0x7a, 0x51,
-	0x19, 0xb2, 0xd7, 0x24, 0xfb, 0x2d, 0xb2, 0xa8, 0xcc, 0x2e, 0xc8, 0x8f, 0x1a, 0xcc, 0xc4, 0x16,
-	0x43, 0x56, 0x54, 0x0a, 0x8f, 0x79, 0x98, 0xbe, 0x5a, 0x4c, 0x84, 0xac, 0x9b, 0x92, 0x75, 0x95,
-	0xd4, 0x72, 0x58, 0x63, 0xcb, 0x4a, 0x4f, 0xf9, 0x67, 0x0d, 0x60, 0xb8, 0x11, 0xd4, 0xa6, 0x9c,
-	0xd9, 0xcf, 0x6a, 0x53, 0xce, 0xae, 0x67, 0xe5, 0x27, 0x64, 0xb8, 0x01, 0xd3, 0xec, 0xbf, 0x68,
-	0x50, 0x4a, 0x92, 0xaa, 0x3d, 0xda, 0xe3, 0x7b, 0x5a, 0x5f, 0x2b, 0xa8, 0x42, 0xf0, 0x86, 0x04,
-	0xbf, 0x47, 0xee, 0xaa, 0x82, 0xa7, 0xb8, 0xad, 0x23, 0x69, 0xaa, 0xc7, 0xe4, 0x37, 0x0d, 0xae,
-	0x8c, 0xfa, 0x16, 0xd9, 0x50, 0xc2, 0x99, 0xe4, 0x91, 0xfa, 0xe6, 0xeb, 0x48, 0xb1, 0x9d, 0x6d,
-	0xd9, 0xce, 0x26, 0xb9, 0x93, 0xd7, 0xce, 0xa8, 0x97, 0x5a, 0x47, 0x68, 0xc3, 0xc7, 0xe4, 0x2f,
-	0x0d, 0x66, 0xb3, 0xb6, 0x23, 0xc8, 0x3d, 0x15, 0xaa, 0x57, 0xda, 0xa8, 0xbe, 0xf5, 0xba, 0x72,
-	0x6c, 0xec, 0x81, 0x6c, 0x6c, 0x9b, 0x6c, 0xe5, 0x34, 0x36, 0xc9, 0x6c, 0xd3, 0x8f, 0xda, 0xbf,
-	0x1a, 0x5c, 0x9b, 0xe8, 0x72, 0x64, 0xbb, 0xc0, 0x2b, 0x3b, 0xd1, 0x60, 0xf5, 0x9d, 0xff, 0x91,
-	0x01, 0xdb, 0xdc, 0x93, 0x6d, 0x36, 0xc8, 0x8e, 0xda, 0x06, 0x68, 0xd2, 0x28, 0x4d, 0x13, 0x7d,
-	0x36, 0xdd, 0xe9, 0xaf, 0x1a, 0xbc, 0x91, 0xf6, 0x4d, 0x72, 0x5b, 0x05, 0x6f, 0x82, 0x41, 0xeb,
-	0x77, 0x8a, 0x0b, 0xb1, 0x9d, 0x8f, 0x64, 0x3b, 0x1b, 0xe4, 0x76, 0x4e, 0x3b, 0x0c, 0xc5, 0xcd,
-	0x81, 0x29, 0xa7, 0x9a, 0xa8, 0x3f, 0x7e, 0x7e, 0x52, 0xd5, 0x5e, 0x9c, 0x54, 0xb5, 0xbf, 0x4f,
-	0xaa, 0xda, 0x37, 0xa7, 0xd5, 0xa9, 0x17, 0xa7, 0xd5, 0xa9, 0x3f, 0x4e, 0xab, 0x53, 0x8f, 0x76,
-	0x52, 0x3e, 0x1f, 0xb0, 0xae, 0xf0, 0x44, 0xc8, 0x7c, 0x87, 0x7d, 0xea, 0x33, 0xac, 0xb5, 0x34,
-	0xf8, 0xed, 0xd9, 0x67, 0x56, 0xbf, 0x66, 0x3d, 0x1d, 0xaf, 0x2b, 0xff, 0x0d, 0x68, 0x5d, 0x92,
-	0x3f, 0x0b, 0x57, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x14, 0x4d, 0x97, 0xce, 0x42, 0x0f, 0x00,
-	0x00,
+func (m *QueryLiquidityPoolResponse) GetUnbonding() types.Coin {
+	if m != nil {
+		return m.Unbonding
+	}
+	return types.Coin{}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryLiquidityPoolResponse) GetTotalValue() types.Coin {
+	if m != nil {
+		return m.TotalValue
+	}
+	return types.Coin{}
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a HostChain by id.
-	HostChain(ctx context.Context, in *QueryHostChainRequest, opts ...grpc.CallOption) (*QueryHostChainResponse, error)
-	// Queries for all the HostChains.
-	HostChains(ctx context.Context, in *QueryHostChainsRequest, opts ...grpc.CallOption) (*QueryHostChainsResponse, error)
-	// Queries for all the deposits for a host chain.
-	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
-	// Queries all unbondings for a host chain.
-	Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error)
-	// Queries an unbonding for a host chain.
-	Unbonding(ctx context.Context, in *QueryUnbondingRequest, opts ...grpc.CallOption) (*QueryUnbondingResponse, error)
-	// Queries all unbondings for a delegator address.
-	UserUnbondings(ctx context.Context, in *QueryUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryUserUnbondingsResponse, error)
-	// Queries all validator unbondings for a host chain.
-	ValidatorUnbondings(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error)
-	// Queries for a host chain deposit account balance.
-	DepositAccountBalance(ctx context.Context, in *QueryDepositAccountBalanceRequest, opts ...grpc.CallOption) (*QueryDepositAccountBalanceResponse, error)
-	// Queries for a host chain exchange rate between the host token and the stk token.
-	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+type QueryLiquidityProviderRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryLiquidityProviderRequest) Reset()         { *m = QueryLiquidityProviderRequest{} }
+func (m *QueryLiquidityProviderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProviderRequest) ProtoMessage()    {}
+func (*QueryLiquidityProviderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{20}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryLiquidityProviderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityProviderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProviderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLiquidityProviderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProviderRequest.Merge(m, src)
+}
+func (m *QueryLiquidityProviderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityProviderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProviderRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) HostChain(ctx context.Context, in *QueryHostChainRequest, opts ...grpc.CallOption) (*QueryHostChainResponse, error) {
-	out := new(QueryHostChainResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidityProviderRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityProviderRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) HostChains(ctx context.Context, in *QueryHostChainsRequest, opts ...grpc.CallOption) (*QueryHostChainsResponse, error) {
-	out := new(QueryHostChainsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChains", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityProviderRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
-	out := new(QueryDepositsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Deposits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryLiquidityProviderResponse struct {
+	Provider *LiquidityProvider `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// host token value of the provider shares
+	Value types.Coin `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
 }
 
-func (c *queryClient) Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error) {
-	out := new(QueryUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Unbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityProviderResponse) Reset()         { *m = QueryLiquidityProviderResponse{} }
+func (m *QueryLiquidityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityProviderResponse) ProtoMessage()    {}
+func (*QueryLiquidityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{21}
+}
+func (m *QueryLiquidityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLiquidityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityProviderResponse.Merge(m, src)
+}
+func (m *QueryLiquidityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityProviderResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Unbonding(ctx context.Context, in *QueryUnbondingRequest, opts ...grpc.CallOption) (*QueryUnbondingResponse, error) {
-	out := new(QueryUnbondingResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Unbonding", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidityProviderResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityProviderResponse) GetProvider() *LiquidityProvider {
+	if m != nil {
+		return m.Provider
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) UserUnbondings(ctx context.Context, in *QueryUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryUserUnbondingsResponse, error) {
-	out := new(QueryUserUnbondingsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/UserUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityProviderResponse) GetValue() types.Coin {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) ValidatorUnbondings(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error) {
-	out := new(QueryValidatorUnbondingResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ValidatorUnbondings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryHostChainProposalsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (c *queryClient) DepositAccountBalance(ctx context.Context, in *QueryDepositAccountBalanceRequest, opts ...grpc.CallOption) (*QueryDepositAccountBalanceResponse, error) {
-	out := new(QueryDepositAccountBalanceResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DepositAccountBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHostChainProposalsRequest) Reset()         { *m = QueryHostChainProposalsRequest{} }
+func (m *QueryHostChainProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostChainProposalsRequest) ProtoMessage()    {}
+func (*QueryHostChainProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{22}
+}
+func (m *QueryHostChainProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostChainProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostChainProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHostChainProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostChainProposalsRequest.Merge(m, src)
+}
+func (m *QueryHostChainProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostChainProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostChainProposalsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
-	out := new(QueryExchangeRateResponse)
-	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHostChainProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryHostChainProposalsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a HostChain by id.
-	HostChain(context.Context, *QueryHostChainRequest) (*QueryHostChainResponse, error)
-	// Queries for all the HostChains.
-	HostChains(context.Context, *QueryHostChainsRequest) (*QueryHostChainsResponse, error)
-	// Queries for all the deposits for a host chain.
-	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
-	// Queries all unbondings for a host chain.
-	Unbondings(context.Context, *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error)
-	// Queries an unbonding for a host chain.
-	Unbonding(context.Context, *QueryUnbondingRequest) (*QueryUnbondingResponse, error)
-	// Queries all unbondings for a delegator address.
-	UserUnbondings(context.Context, *QueryUserUnbondingsRequest) (*QueryUserUnbondingsResponse, error)
-	// Queries all validator unbondings for a host chain.
-	ValidatorUnbondings(context.Context, *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error)
-	// Queries for a host chain deposit account balance.
-	DepositAccountBalance(context.Context, *QueryDepositAccountBalanceRequest) (*QueryDepositAccountBalanceResponse, error)
-	// Queries for a host chain exchange rate between the host token and the stk token.
-	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+type QueryHostChainProposalsResponse struct {
+	Proposals []*HostChainProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryHostChainProposalsResponse) Reset()         { *m = QueryHostChainProposalsResponse{} }
+func (m *QueryHostChainProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostChainProposalsResponse) ProtoMessage()    {}
+func (*QueryHostChainProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{23}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryHostChainProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HostChain(ctx context.Context, req *QueryHostChainRequest) (*QueryHostChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostChain not implemented")
+func (m *QueryHostChainProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostChainProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) HostChains(ctx context.Context, req *QueryHostChainsRequest) (*QueryHostChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HostChains not implemented")
+func (m *QueryHostChainProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostChainProposalsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+func (m *QueryHostChainProposalsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Unbondings(ctx context.Context, req *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unbondings not implemented")
+func (m *QueryHostChainProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostChainProposalsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Unbonding(ctx context.Context, req *QueryUnbondingRequest) (*QueryUnbondingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unbonding not implemented")
+
+var xxx_messageInfo_QueryHostChainProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryHostChainProposalsResponse) GetProposals() []*HostChainProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) UserUnbondings(ctx context.Context, req *QueryUserUnbondingsRequest) (*QueryUserUnbondingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondings not implemented")
+
+type QueryProposalTallyRequest struct {
+	ChainId    string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
-func (*UnimplementedQueryServer) ValidatorUnbondings(ctx context.Context, req *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondings not implemented")
+
+func (m *QueryProposalTallyRequest) Reset()         { *m = QueryProposalTallyRequest{} }
+func (m *QueryProposalTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTallyRequest) ProtoMessage()    {}
+func (*QueryProposalTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{24}
 }
-func (*UnimplementedQueryServer) DepositAccountBalance(ctx context.Context, req *QueryDepositAccountBalanceRequest) (*QueryDepositAccountBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositAccountBalance not implemented")
+func (m *QueryProposalTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+func (m *QueryProposalTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryProposalTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTallyRequest.Merge(m, src)
+}
+func (m *QueryProposalTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTallyRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryProposalTallyRequest proto.InternalMessageInfo
+
+func (m *QueryProposalTallyRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_HostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHostChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryProposalTallyRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostChain(ctx, req.(*QueryHostChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_HostChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHostChainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HostChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HostChains(ctx, req.(*QueryHostChainsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryProposalTallyResponse struct {
+	// proposal with its submission status
+	Proposal *HostChainProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	// weighted options of the tally, the submitted one if the vote was already cast
+	Tally []v1beta1.WeightedVoteOption `protobuf:"bytes,2,rep,name=tally,proto3" json:"tally"`
+	// total stk voting power of the tally
+	TotalPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_power,json=totalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_power"`
+	// number of votes cast by stk holders
+	Votes uint64 `protobuf:"varint,4,opt,name=votes,proto3" json:"votes,omitempty"`
 }
 
-func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Deposits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryProposalTallyResponse) Reset()         { *m = QueryProposalTallyResponse{} }
+func (m *QueryProposalTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalTallyResponse) ProtoMessage()    {}
+func (*QueryProposalTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{25}
 }
-
-func _Query_Unbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnbondingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Unbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Unbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Unbondings(ctx, req.(*QueryUnbondingsRequest))
+func (m *QueryProposalTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProposalTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalTallyResponse.Merge(m, src)
+}
+func (m *QueryProposalTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalTallyResponse.DiscardUnknown(m)
 }
 
-func _Query_Unbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnbondingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Unbonding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Unbonding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Unbonding(ctx, req.(*QueryUnbondingRequest))
+var xxx_messageInfo_QueryProposalTallyResponse proto.InternalMessageInfo
+
+func (m *QueryProposalTallyResponse) GetProposal() *HostChainProposal {
+	if m != nil {
+		return m.Proposal
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_UserUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserUnbondingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/UserUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserUnbondings(ctx, req.(*QueryUserUnbondingsRequest))
+func (m *QueryProposalTallyResponse) GetTally() []v1beta1.WeightedVoteOption {
+	if m != nil {
+		return m.Tally
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ValidatorUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorUnbondingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidatorUnbondings(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ValidatorUnbondings",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidatorUnbondings(ctx, req.(*QueryValidatorUnbondingRequest))
+func (m *QueryProposalTallyResponse) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_DepositAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositAccountBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DepositAccountBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DepositAccountBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DepositAccountBalance(ctx, req.(*QueryDepositAccountBalanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryUnbondingsRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+func (m *QueryUnbondingsRequest) Reset()         { *m = QueryUnbondingsRequest{} }
+func (m *QueryUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingsRequest) ProtoMessage()    {}
+func (*QueryUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{26}
+}
+func (m *QueryUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.liquidstakeibc.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "HostChain",
-			Handler:    _Query_HostChain_Handler,
-		},
-		{
-			MethodName: "HostChains",
-			Handler:    _Query_HostChains_Handler,
-		},
-		{
-			MethodName: "Deposits",
-			Handler:    _Query_Deposits_Handler,
-		},
-		{
-			MethodName: "Unbondings",
-			Handler:    _Query_Unbondings_Handler,
-		},
-		{
-			MethodName: "Unbonding",
-			Handler:    _Query_Unbonding_Handler,
-		},
-		{
-			MethodName: "UserUnbondings",
-			Handler:    _Query_UserUnbondings_Handler,
-		},
-		{
-			MethodName: "ValidatorUnbondings",
-			Handler:    _Query_ValidatorUnbondings_Handler,
-		},
-		{
-			MethodName: "DepositAccountBalance",
-			Handler:    _Query_DepositAccountBalance_Handler,
-		},
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pstake/liquidstakeibc/v1beta1/query.proto",
+func (m *QueryUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingsRequest.Merge(m, src)
+}
+func (m *QueryUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnbondingsRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingsRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryUnbondingsResponse struct {
+	Unbondings []*Unbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryUnbondingsResponse) Reset()         { *m = QueryUnbondingsResponse{} }
+func (m *QueryUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingsResponse) ProtoMessage()    {}
+func (*QueryUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{27}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryHostChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingsResponse.Merge(m, src)
 }
-
-func (m *QueryHostChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryHostChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingsResponse) GetUnbondings() []*Unbonding {
+	if m != nil {
+		return m.Unbondings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryUnbondingRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Epoch   int64  `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (m *QueryHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.HostChain.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryUnbondingRequest) Reset()         { *m = QueryUnbondingRequest{} }
+func (m *QueryUnbondingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingRequest) ProtoMessage()    {}
+func (*QueryUnbondingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{28}
+}
+func (m *QueryUnbondingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryHostChainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryUnbondingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingRequest.Merge(m, src)
 }
-
-func (m *QueryHostChainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryUnbondingRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *QueryHostChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnbondingRequest) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryHostChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryUnbondingResponse struct {
+	Unbonding *Unbonding `protobuf:"bytes,1,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
 }
 
-func (m *QueryHostChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostChains) > 0 {
-		for iNdEx := len(m.HostChains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HostChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryUnbondingResponse) Reset()         { *m = QueryUnbondingResponse{} }
+func (m *QueryUnbondingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingResponse) ProtoMessage()    {}
+func (*QueryUnbondingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{29}
+}
+func (m *QueryUnbondingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryUnbondingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingResponse.Merge(m, src)
 }
-
-func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUnbondingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryUnbondingResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingResponse) GetUnbonding() *Unbonding {
+	if m != nil {
+		return m.Unbonding
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryUserUnbondingsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUserUnbondingsRequest) Reset()         { *m = QueryUserUnbondingsRequest{} }
+func (m *QueryUserUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserUnbondingsRequest) ProtoMessage()    {}
+func (*QueryUserUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{30}
 }
-
-func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryUserUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryUserUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserUnbondingsRequest.Merge(m, src)
+}
+func (m *QueryUserUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserUnbondingsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUserUnbondingsRequest proto.InternalMessageInfo
+
+func (m *QueryUserUnbondingsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryUserUnbondingsResponse struct {
+	UserUnbondings []*UserUnbonding `protobuf:"bytes,1,rep,name=user_unbondings,json=userUnbondings,proto3" json:"user_unbondings,omitempty"`
 }
 
-func (m *QueryUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryUserUnbondingsResponse) Reset()         { *m = QueryUserUnbondingsResponse{} }
+func (m *QueryUserUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserUnbondingsResponse) ProtoMessage()    {}
+func (*QueryUserUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{31}
+}
+func (m *QueryUserUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryUserUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserUnbondingsResponse.Merge(m, src)
+}
+func (m *QueryUserUnbondingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserUnbondingsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUserUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QueryUserUnbondingsResponse) GetUserUnbondings() []*UserUnbonding {
+	if m != nil {
+		return m.UserUnbondings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryValidatorUnbondingRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Unbondings) > 0 {
-		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidatorUnbondingRequest) Reset()         { *m = QueryValidatorUnbondingRequest{} }
+func (m *QueryValidatorUnbondingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUnbondingRequest) ProtoMessage()    {}
+func (*QueryValidatorUnbondingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{32}
+}
+func (m *QueryValidatorUnbondingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUnbondingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUnbondingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorUnbondingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUnbondingRequest.Merge(m, src)
+}
+func (m *QueryValidatorUnbondingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUnbondingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUnbondingRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorUnbondingRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorUnbondingRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryValidatorUnbondingResponse struct {
+	ValidatorUnbondings []*ValidatorUnbonding `protobuf:"bytes,1,rep,name=validator_unbondings,json=validatorUnbondings,proto3" json:"validator_unbondings,omitempty"`
 }
 
-func (m *QueryUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryValidatorUnbondingResponse) Reset()         { *m = QueryValidatorUnbondingResponse{} }
+func (m *QueryValidatorUnbondingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUnbondingResponse) ProtoMessage()    {}
+func (*QueryValidatorUnbondingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{33}
+}
+func (m *QueryValidatorUnbondingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUnbondingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUnbondingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorUnbondingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUnbondingResponse.Merge(m, src)
+}
+func (m *QueryValidatorUnbondingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUnbondingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUnbondingResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorUnbondingResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorUnbondingResponse) GetValidatorUnbondings() []*ValidatorUnbonding {
+	if m != nil {
+		return m.ValidatorUnbondings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDepositAccountBalanceRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Unbonding != nil {
-		{
-			size, err := m.Unbonding.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryDepositAccountBalanceRequest) Reset()         { *m = QueryDepositAccountBalanceRequest{} }
+func (m *QueryDepositAccountBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositAccountBalanceRequest) ProtoMessage()    {}
+func (*QueryDepositAccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{34}
+}
+func (m *QueryDepositAccountBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositAccountBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositAccountBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryUserUnbondingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositAccountBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositAccountBalanceRequest.Merge(m, src)
 }
-
-func (m *QueryUserUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositAccountBalanceRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryUserUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryDepositAccountBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositAccountBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryUserUnbondingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositAccountBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryDepositAccountBalanceRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryUserUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryDepositAccountBalanceResponse struct {
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
 }
 
-func (m *QueryUserUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UserUnbondings) > 0 {
-		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDepositAccountBalanceResponse) Reset()         { *m = QueryDepositAccountBalanceResponse{} }
+func (m *QueryDepositAccountBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDepositAccountBalanceResponse) ProtoMessage()    {}
+func (*QueryDepositAccountBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{35}
+}
+func (m *QueryDepositAccountBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDepositAccountBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDepositAccountBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryValidatorUnbondingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDepositAccountBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDepositAccountBalanceResponse.Merge(m, src)
 }
-
-func (m *QueryValidatorUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDepositAccountBalanceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryValidatorUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryDepositAccountBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDepositAccountBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorUnbondingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDepositAccountBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryDepositAccountBalanceResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryValidatorUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryExchangeRateRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryValidatorUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorUnbondings) > 0 {
-		for iNdEx := len(m.ValidatorUnbondings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryExchangeRateRequest) Reset()         { *m = QueryExchangeRateRequest{} }
+func (m *QueryExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateRequest) ProtoMessage()    {}
+func (*QueryExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{36}
+}
+func (m *QueryExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDepositAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateRequest.Merge(m, src)
 }
-
-func (m *QueryDepositAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExchangeRateRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryDepositAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDepositAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
+
+func (m *QueryExchangeRateRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDepositAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryExchangeRateResponse struct {
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
 }
 
-func (m *QueryDepositAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
+func (m *QueryExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateResponse) ProtoMessage()    {}
+func (*QueryExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{37}
 }
-
-func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryExchangeRateResponse proto.InternalMessageInfo
+
+type QueryExchangeRateHistoryRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryExchangeRateHistoryRequest) Reset()         { *m = QueryExchangeRateHistoryRequest{} }
+func (m *QueryExchangeRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateHistoryRequest) ProtoMessage()    {}
+func (*QueryExchangeRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{38}
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateHistoryRequest.Merge(m, src)
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExchangeRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryExchangeRateHistoryRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryExchangeRateHistoryResponse struct {
+	// exchange rate records, oldest first
+	Records []*ExchangeRateRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
 }
 
-func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryExchangeRateHistoryResponse) Reset()         { *m = QueryExchangeRateHistoryResponse{} }
+func (m *QueryExchangeRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateHistoryResponse) ProtoMessage()    {}
+func (*QueryExchangeRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{39}
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateHistoryResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateHistoryResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryExchangeRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeRateHistoryResponse) GetRecords() []*ExchangeRateRecord {
+	if m != nil {
+		return m.Records
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+type QueryAprRequest struct {
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// number of c value epochs to compute the APR over, the default window if zero
+	Window uint32 `protobuf:"varint,2,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAprRequest) Reset()         { *m = QueryAprRequest{} }
+func (m *QueryAprRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAprRequest) ProtoMessage()    {}
+func (*QueryAprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{40}
+}
+func (m *QueryAprRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAprRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryAprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAprRequest.Merge(m, src)
+}
+func (m *QueryAprRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAprRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHostChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAprRequest proto.InternalMessageInfo
+
+func (m *QueryAprRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryHostChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAprRequest) GetWindow() uint32 {
+	if m != nil {
+		return m.Window
 	}
-	var l int
-	_ = l
-	l = m.HostChain.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryHostChainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryAprResponse struct {
+	// annualised growth of the host token value of the stk token
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr"`
+	// first record of the window
+	From *ExchangeRateRecord `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// last record of the window
+	To *ExchangeRateRecord `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (m *QueryHostChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.HostChains) > 0 {
-		for _, e := range m.HostChains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAprResponse) Reset()         { *m = QueryAprResponse{} }
+func (m *QueryAprResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAprResponse) ProtoMessage()    {}
+func (*QueryAprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{41}
+}
+func (m *QueryAprResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAprResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAprResponse.Merge(m, src)
+}
+func (m *QueryAprResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAprResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAprResponse proto.InternalMessageInfo
+
+func (m *QueryAprResponse) GetFrom() *ExchangeRateRecord {
+	if m != nil {
+		return m.From
 	}
-	return n
+	return nil
 }
 
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryAprResponse) GetTo() *ExchangeRateRecord {
+	if m != nil {
+		return m.To
 	}
-	return n
+	return nil
 }
 
-func (m *QueryUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryDelegatorPortfolioRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegatorPortfolioRequest) Reset()         { *m = QueryDelegatorPortfolioRequest{} }
+func (m *QueryDelegatorPortfolioRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPortfolioRequest) ProtoMessage()    {}
+func (*QueryDelegatorPortfolioRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{42}
+}
+func (m *QueryDelegatorPortfolioRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorPortfolioRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPortfolioRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Unbondings) > 0 {
-		for _, e := range m.Unbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDelegatorPortfolioRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPortfolioRequest.Merge(m, src)
+}
+func (m *QueryDelegatorPortfolioRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorPortfolioRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPortfolioRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorPortfolioRequest proto.InternalMessageInfo
+
+func (m *QueryDelegatorPortfolioRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryDelegatorPortfolioResponse struct {
+	// positions of the address on each host chain
+	Positions []PortfolioPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	// recorded liquid staking operations of the address
+	Entries []*LedgerEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *QueryDelegatorPortfolioResponse) Reset()         { *m = QueryDelegatorPortfolioResponse{} }
+func (m *QueryDelegatorPortfolioResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorPortfolioResponse) ProtoMessage()    {}
+func (*QueryDelegatorPortfolioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{43}
+}
+func (m *QueryDelegatorPortfolioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorPortfolioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorPortfolioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryDelegatorPortfolioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorPortfolioResponse.Merge(m, src)
+}
+func (m *QueryDelegatorPortfolioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorPortfolioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorPortfolioResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryDelegatorPortfolioResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorPortfolioResponse) GetPositions() []PortfolioPosition {
+	if m != nil {
+		return m.Positions
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryDelegatorPortfolioResponse) GetEntries() []*LedgerEntry {
+	if m != nil {
+		return m.Entries
 	}
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
+	return nil
+}
+
+type PortfolioPosition struct {
+	// host chain id
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// stk tokens held by the address
+	StkBalance types.Coin `protobuf:"bytes,2,opt,name=stk_balance,json=stkBalance,proto3" json:"stk_balance"`
+	// current host chain c value
+	CValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=c_value,json=cValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"c_value"`
+	// host token value of the stk balance at the current c value
+	Value types.Coin `protobuf:"bytes,4,opt,name=value,proto3" json:"value"`
+	// host tokens being unbonded for the address
+	Unbonding types.Coin `protobuf:"bytes,5,opt,name=unbonding,proto3" json:"unbonding"`
+	// host tokens liquid staked, according to the ledger
+	Staked types.Coin `protobuf:"bytes,6,opt,name=staked,proto3" json:"staked"`
+	// host tokens redeemed and claimed, according to the ledger
+	Withdrawn types.Coin `protobuf:"bytes,7,opt,name=withdrawn,proto3" json:"withdrawn"`
+	// value, unbonding and withdrawn tokens minus the staked ones, only accurate
+	// if the ledger was enabled during the whole position lifetime
+	Profit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=profit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"profit"`
+}
+
+func (m *PortfolioPosition) Reset()         { *m = PortfolioPosition{} }
+func (m *PortfolioPosition) String() string { return proto.CompactTextString(m) }
+func (*PortfolioPosition) ProtoMessage()    {}
+func (*PortfolioPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{44}
+}
+func (m *PortfolioPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PortfolioPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PortfolioPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *PortfolioPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortfolioPosition.Merge(m, src)
+}
+func (m *PortfolioPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *PortfolioPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortfolioPosition.DiscardUnknown(m)
 }
 
-func (m *QueryUnbondingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PortfolioPosition proto.InternalMessageInfo
+
+func (m *PortfolioPosition) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	var l int
-	_ = l
-	if m.Unbonding != nil {
-		l = m.Unbonding.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *PortfolioPosition) GetStkBalance() types.Coin {
+	if m != nil {
+		return m.StkBalance
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *QueryUserUnbondingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PortfolioPosition) GetValue() types.Coin {
+	if m != nil {
+		return m.Value
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return types.Coin{}
+}
+
+func (m *PortfolioPosition) GetUnbonding() types.Coin {
+	if m != nil {
+		return m.Unbonding
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *QueryUserUnbondingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PortfolioPosition) GetStaked() types.Coin {
+	if m != nil {
+		return m.Staked
 	}
-	var l int
-	_ = l
-	if len(m.UserUnbondings) > 0 {
-		for _, e := range m.UserUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return types.Coin{}
+}
+
+func (m *PortfolioPosition) GetWithdrawn() types.Coin {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return types.Coin{}
+}
+
+type QueryInvariantsRequest struct {
+}
+
+func (m *QueryInvariantsRequest) Reset()         { *m = QueryInvariantsRequest{} }
+func (m *QueryInvariantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsRequest) ProtoMessage()    {}
+func (*QueryInvariantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{45}
+}
+func (m *QueryInvariantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryInvariantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsRequest.Merge(m, src)
+}
+func (m *QueryInvariantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorUnbondingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryInvariantsRequest proto.InternalMessageInfo
+
+type QueryInvariantsResponse struct {
+	Invariants []InvariantResult `protobuf:"bytes,1,rep,name=invariants,proto3" json:"invariants"`
+}
+
+func (m *QueryInvariantsResponse) Reset()         { *m = QueryInvariantsResponse{} }
+func (m *QueryInvariantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantsResponse) ProtoMessage()    {}
+func (*QueryInvariantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{46}
+}
+func (m *QueryInvariantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryInvariantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantsResponse.Merge(m, src)
+}
+func (m *QueryInvariantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantsResponse) GetInvariants() []InvariantResult {
+	if m != nil {
+		return m.Invariants
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorUnbondingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type InvariantResult struct {
+	// invariant route, as registered in the crisis module
+	Route string `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// true if the invariant is broken
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// invariant check message
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b143d1c5e28840b2, []int{47}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.ValidatorUnbondings) > 0 {
-		for _, e := range m.ValidatorUnbondings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryHostChainRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainRequest")
+	proto.RegisterType((*QueryHostChainResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainResponse")
+	proto.RegisterType((*QueryHostChainsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainsRequest")
+	proto.RegisterType((*QueryHostChainsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainsResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryLSMDepositsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryLSMDepositsRequest")
+	proto.RegisterType((*QueryLSMDepositsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryLSMDepositsResponse")
+	proto.RegisterType((*QueryRewardsHistoryRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryRewardsHistoryRequest")
+	proto.RegisterType((*QueryRewardsHistoryResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryRewardsHistoryResponse")
+	proto.RegisterType((*QuerySlashesRequest)(nil), "pstake.liquidstakeibc.v1beta1.QuerySlashesRequest")
+	proto.RegisterType((*QuerySlashesResponse)(nil), "pstake.liquidstakeibc.v1beta1.QuerySlashesResponse")
+	proto.RegisterType((*QueryIcaTxsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryIcaTxsRequest")
+	proto.RegisterType((*QueryIcaTxsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryIcaTxsResponse")
+	proto.RegisterType((*QueryIcaTxRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryIcaTxRequest")
+	proto.RegisterType((*QueryIcaTxResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryIcaTxResponse")
+	proto.RegisterType((*QueryLiquidityPoolRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryLiquidityPoolRequest")
+	proto.RegisterType((*QueryLiquidityPoolResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryLiquidityPoolResponse")
+	proto.RegisterType((*QueryLiquidityProviderRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryLiquidityProviderRequest")
+	proto.RegisterType((*QueryLiquidityProviderResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryLiquidityProviderResponse")
+	proto.RegisterType((*QueryHostChainProposalsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainProposalsRequest")
+	proto.RegisterType((*QueryHostChainProposalsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryHostChainProposalsResponse")
+	proto.RegisterType((*QueryProposalTallyRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryProposalTallyRequest")
+	proto.RegisterType((*QueryProposalTallyResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryProposalTallyResponse")
+	proto.RegisterType((*QueryUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsRequest")
+	proto.RegisterType((*QueryUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingsResponse")
+	proto.RegisterType((*QueryUnbondingRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingRequest")
+	proto.RegisterType((*QueryUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUnbondingResponse")
+	proto.RegisterType((*QueryUserUnbondingsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryUserUnbondingsRequest")
+	proto.RegisterType((*QueryUserUnbondingsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryUserUnbondingsResponse")
+	proto.RegisterType((*QueryValidatorUnbondingRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorUnbondingRequest")
+	proto.RegisterType((*QueryValidatorUnbondingResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryValidatorUnbondingResponse")
+	proto.RegisterType((*QueryDepositAccountBalanceRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositAccountBalanceRequest")
+	proto.RegisterType((*QueryDepositAccountBalanceResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDepositAccountBalanceResponse")
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRateHistoryRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateHistoryRequest")
+	proto.RegisterType((*QueryExchangeRateHistoryResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryExchangeRateHistoryResponse")
+	proto.RegisterType((*QueryAprRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryAprRequest")
+	proto.RegisterType((*QueryAprResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryAprResponse")
+	proto.RegisterType((*QueryDelegatorPortfolioRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryDelegatorPortfolioRequest")
+	proto.RegisterType((*QueryDelegatorPortfolioResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryDelegatorPortfolioResponse")
+	proto.RegisterType((*PortfolioPosition)(nil), "pstake.liquidstakeibc.v1beta1.PortfolioPosition")
+	proto.RegisterType((*QueryInvariantsRequest)(nil), "pstake.liquidstakeibc.v1beta1.QueryInvariantsRequest")
+	proto.RegisterType((*QueryInvariantsResponse)(nil), "pstake.liquidstakeibc.v1beta1.QueryInvariantsResponse")
+	proto.RegisterType((*InvariantResult)(nil), "pstake.liquidstakeibc.v1beta1.InvariantResult")
+}
+
+func init() {
+	proto.RegisterFile("pstake/liquidstakeibc/v1beta1/query.proto", fileDescriptor_b143d1c5e28840b2)
+}
+
+var fileDescriptor_b143d1c5e28840b2 = []byte{
+	// 2271 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0xf9, 0x36, 0xf5, 0xb1, 0x92, 0x5e, 0xfd, 0x6c, 0xc7, 0x63, 0x39, 0x5e, 0xf3, 0x97, 0x48, 0x29,
+	0xdb, 0xa4, 0xb1, 0x13, 0xef, 0x5a, 0x9f, 0xb6, 0xfc, 0x21, 0x6b, 0x25, 0xd9, 0xb1, 0xd0, 0x24,
+	0x56, 0x18, 0xd9, 0x41, 0x13, 0x14, 0x5b, 0x6a, 0x39, 0xde, 0x25, 0xbc, 0xe2, 0xd0, 0x1c, 0xee,
+	0xca, 0x86, 0xe1, 0x4b, 0x2f, 0xbd, 0x16, 0x28, 0x7a, 0xe8, 0xa1, 0xb9, 0xf5, 0xd0, 0x02, 0x6d,
+	0xd1, 0x4b, 0x81, 0x1e, 0xd2, 0x43, 0x0f, 0x45, 0xda, 0x93, 0x81, 0xf6, 0xd0, 0x16, 0x85, 0x1b,
+	0xd8, 0x05, 0x7a, 0xec, 0xbf, 0x50, 0x70, 0xf8, 0x0e, 0x3f, 0x76, 0x57, 0xe6, 0x70, 0x7b, 0xf2,
+	0x72, 0x66, 0x9e, 0x77, 0x9e, 0xe7, 0xe5, 0x7c, 0xbc, 0x7c, 0x2c, 0x38, 0xeb, 0xf1, 0xc0, 0xba,
+	0x4f, 0xab, 0x6d, 0xe7, 0x41, 0xc7, 0xb1, 0xc5, 0x6f, 0x67, 0xaf, 0x51, 0xed, 0xce, 0xef, 0xd1,
+	0xc0, 0x9a, 0xaf, 0x3e, 0xe8, 0x50, 0xff, 0x51, 0xc5, 0xf3, 0x59, 0xc0, 0xc8, 0xeb, 0xd1, 0xd0,
+	0x4a, 0x76, 0x68, 0x05, 0x87, 0xea, 0x33, 0x4d, 0xd6, 0x64, 0x62, 0x64, 0x35, 0xfc, 0x15, 0x81,
+	0xf4, 0xd7, 0x9a, 0x8c, 0x35, 0xdb, 0xb4, 0x6a, 0x79, 0x4e, 0xd5, 0x72, 0x5d, 0x16, 0x58, 0x81,
+	0xc3, 0x5c, 0x8e, 0xbd, 0xe7, 0x1a, 0x8c, 0xef, 0x33, 0x5e, 0xdd, 0xb3, 0x38, 0x8d, 0xe6, 0x8a,
+	0x67, 0xf6, 0xac, 0xa6, 0xe3, 0x8a, 0xc1, 0x38, 0x76, 0x36, 0x3d, 0x56, 0x8e, 0x6a, 0x30, 0x47,
+	0xf6, 0xbf, 0x86, 0xfd, 0x4d, 0xd6, 0x8d, 0xbb, 0x9b, 0xac, 0x2b, 0x67, 0x7a, 0xb9, 0x4e, 0xcf,
+	0xf2, 0xad, 0x7d, 0xc9, 0x6a, 0xe1, 0xe5, 0x63, 0x7b, 0xf4, 0x0b, 0x8c, 0x31, 0x03, 0xe4, 0xa3,
+	0x90, 0xff, 0x8e, 0x08, 0x64, 0xd2, 0x07, 0x1d, 0xca, 0x03, 0xe3, 0x53, 0x38, 0x99, 0x69, 0xe5,
+	0x1e, 0x73, 0x39, 0x25, 0x9b, 0x50, 0x8a, 0x26, 0x2c, 0x6b, 0x6f, 0x68, 0x6f, 0x4f, 0x2f, 0xbc,
+	0x59, 0x79, 0x69, 0x6a, 0x2b, 0x11, 0x7c, 0x63, 0xec, 0xcb, 0x67, 0x73, 0x47, 0x4c, 0x84, 0x1a,
+	0x0b, 0x70, 0x4a, 0xc4, 0xbe, 0xc5, 0x78, 0xb0, 0xd9, 0xb2, 0x1c, 0x17, 0x27, 0x25, 0x67, 0x60,
+	0xb2, 0x11, 0x3e, 0xd7, 0x1d, 0x5b, 0xc4, 0x9f, 0x32, 0x27, 0xc4, 0xf3, 0xb6, 0x6d, 0x34, 0xe1,
+	0xd5, 0x5e, 0x0c, 0x52, 0xfa, 0x00, 0xa0, 0xc5, 0x78, 0x50, 0x17, 0x23, 0x91, 0xd6, 0xdb, 0x39,
+	0xb4, 0xe2, 0x28, 0xc8, 0x6c, 0xaa, 0x25, 0x1b, 0x8c, 0x72, 0xef, 0x44, 0x71, 0x4a, 0x6c, 0x38,
+	0xdd, 0xd7, 0x83, 0x1c, 0xb6, 0x61, 0x3a, 0xe1, 0x10, 0xe6, 0x66, 0xb4, 0x08, 0x09, 0x13, 0xe2,
+	0xe9, 0xb9, 0x31, 0x0f, 0x33, 0x62, 0x96, 0x2d, 0xea, 0x31, 0xee, 0x04, 0x5c, 0x21, 0x37, 0x9f,
+	0xc1, 0xa9, 0x1e, 0x08, 0xd2, 0xda, 0x80, 0x49, 0x1b, 0xdb, 0x90, 0xd3, 0x5b, 0x39, 0x9c, 0x30,
+	0x84, 0x19, 0xe3, 0x8c, 0x25, 0x54, 0xfd, 0xfe, 0xc7, 0x1f, 0x14, 0xa0, 0x64, 0x41, 0xb9, 0x1f,
+	0x85, 0xac, 0x6e, 0xf4, 0xb1, 0x3a, 0x9b, 0xc3, 0x2a, 0x89, 0x92, 0x22, 0x76, 0x11, 0x74, 0x31,
+	0x85, 0x49, 0x0f, 0x2c, 0xdf, 0xe6, 0xb7, 0x1c, 0x1e, 0x30, 0xff, 0x91, 0x02, 0xb7, 0x00, 0xfe,
+	0x7f, 0x20, 0x10, 0xe9, 0xdd, 0x81, 0xe3, 0x7e, 0xd4, 0x53, 0xf7, 0x69, 0x83, 0xf9, 0xb6, 0x64,
+	0xf9, 0x6e, 0x0e, 0x4b, 0x8c, 0x67, 0x0a, 0x90, 0x79, 0xcc, 0x4f, 0x3f, 0x72, 0xe3, 0x3b, 0xb8,
+	0xa1, 0x3e, 0x6e, 0x5b, 0xbc, 0x45, 0x15, 0x72, 0x48, 0xde, 0x81, 0x13, 0x5d, 0xab, 0xed, 0xd8,
+	0x56, 0xc0, 0xfc, 0xba, 0x65, 0xdb, 0x3e, 0xe5, 0xbc, 0x3c, 0x22, 0xc6, 0xbc, 0x12, 0x77, 0xd4,
+	0xa2, 0x76, 0xe3, 0x2e, 0xcc, 0x64, 0xc3, 0xa3, 0x9a, 0x35, 0x98, 0xe0, 0x51, 0x13, 0xaa, 0xf8,
+	0x46, 0x8e, 0x0a, 0x11, 0xc0, 0x94, 0x20, 0xa3, 0x8a, 0xa7, 0xc3, 0x76, 0xc3, 0xda, 0x7d, 0xa8,
+	0xf2, 0xe6, 0x77, 0xe1, 0x64, 0x06, 0x80, 0x3c, 0xae, 0xc1, 0x84, 0xd3, 0xb0, 0xea, 0xc1, 0x43,
+	0x55, 0x1e, 0xdb, 0x9b, 0xb5, 0xdd, 0x87, 0x66, 0xc9, 0x11, 0x61, 0x8c, 0x25, 0x38, 0x91, 0x44,
+	0x95, 0x2c, 0xe6, 0x60, 0x9a, 0x87, 0x3f, 0xdd, 0x06, 0x4d, 0x88, 0x80, 0x6c, 0xda, 0xb6, 0x8d,
+	0x8f, 0xd2, 0xe4, 0x63, 0x2a, 0x57, 0xa0, 0x14, 0x51, 0xc1, 0xc3, 0x42, 0x8d, 0xc9, 0xb8, 0x60,
+	0x62, 0xac, 0xc0, 0x99, 0x68, 0x61, 0x8b, 0xb1, 0x4e, 0xf0, 0x68, 0x87, 0xb1, 0xb6, 0x42, 0x5a,
+	0x3e, 0x1f, 0x01, 0x7d, 0x10, 0x10, 0x39, 0xad, 0xc3, 0x98, 0xc7, 0x58, 0x1b, 0x19, 0xe5, 0xad,
+	0xb4, 0x6c, 0x0c, 0x81, 0x24, 0xd7, 0x60, 0xca, 0xea, 0x5a, 0x4e, 0xdb, 0xda, 0x6b, 0x53, 0xb1,
+	0x4a, 0xa6, 0x17, 0xce, 0x54, 0xa2, 0x8b, 0xa5, 0x12, 0x5e, 0x3c, 0x31, 0x78, 0x93, 0x25, 0xc7,
+	0x5e, 0x8c, 0x08, 0xe1, 0x1d, 0x77, 0x8f, 0xb9, 0xb6, 0xe3, 0x36, 0xcb, 0xa3, 0x8a, 0xf0, 0x18,
+	0x41, 0xd6, 0x61, 0x3a, 0x60, 0x81, 0xd5, 0xae, 0x77, 0xad, 0x76, 0x87, 0x96, 0xc7, 0xd4, 0x02,
+	0x80, 0xc0, 0xdc, 0x0d, 0x21, 0xc6, 0x2e, 0xbc, 0xde, 0x93, 0x1f, 0x9f, 0x75, 0x1d, 0x9b, 0xfa,
+	0x0a, 0x3b, 0xa5, 0x0c, 0x13, 0xd9, 0xfd, 0x21, 0x1f, 0x8d, 0x9f, 0x6a, 0x30, 0x7b, 0x58, 0x58,
+	0x4c, 0xfd, 0xfb, 0x30, 0xe9, 0x61, 0x1b, 0xa6, 0xff, 0x82, 0x72, 0xfa, 0x65, 0xac, 0x38, 0x02,
+	0x59, 0x86, 0xf1, 0x28, 0x05, 0x8a, 0xaf, 0x20, 0x1a, 0x6d, 0x5c, 0x41, 0x9a, 0xf1, 0x9d, 0xb0,
+	0xe3, 0x33, 0x8f, 0x71, 0xab, 0xad, 0xb2, 0xe5, 0x1e, 0xc0, 0xdc, 0xa1, 0x60, 0x14, 0xf9, 0x21,
+	0x4c, 0x79, 0xb2, 0x11, 0x37, 0xe0, 0x05, 0xd5, 0xeb, 0x49, 0x46, 0x33, 0x93, 0x10, 0xc6, 0x27,
+	0xb8, 0x0d, 0x64, 0xdf, 0xae, 0xd5, 0x6e, 0x2b, 0x9c, 0xbd, 0xe1, 0x96, 0x95, 0x41, 0xc2, 0xde,
+	0x30, 0x49, 0x63, 0x26, 0xc8, 0xa6, 0x6d, 0xdb, 0xf8, 0x91, 0xdc, 0x27, 0x3d, 0x91, 0x33, 0x2f,
+	0x4b, 0x74, 0x28, 0xbe, 0xac, 0x7e, 0x19, 0x71, 0x04, 0xb2, 0x01, 0xe3, 0x41, 0x18, 0xbe, 0x3c,
+	0x82, 0x97, 0x23, 0xbe, 0xac, 0xb0, 0xf8, 0x92, 0xf8, 0x4f, 0xa8, 0xd3, 0x6c, 0x05, 0xd4, 0xbe,
+	0xcb, 0x02, 0x7a, 0xdb, 0x0b, 0xab, 0x3a, 0xf9, 0xe6, 0x04, 0x94, 0xdc, 0x96, 0x2b, 0xdf, 0x63,
+	0x07, 0xd4, 0x17, 0x5b, 0x67, 0x6a, 0xa3, 0x12, 0x8e, 0xf8, 0xfb, 0xb3, 0xb9, 0xb7, 0x9a, 0x4e,
+	0xd0, 0xea, 0xec, 0x55, 0x1a, 0x6c, 0xbf, 0x8a, 0x45, 0x5e, 0xf4, 0xcf, 0x79, 0x6e, 0xdf, 0xaf,
+	0x06, 0x8f, 0x3c, 0xca, 0x2b, 0xdb, 0x6e, 0x80, 0x1b, 0x61, 0x27, 0x8c, 0x40, 0x66, 0x60, 0xbc,
+	0xcb, 0x02, 0xca, 0xc5, 0x26, 0x1a, 0x33, 0xa3, 0x07, 0x63, 0x11, 0xcb, 0x92, 0x3b, 0x72, 0xcb,
+	0xa9, 0x2c, 0x8c, 0x06, 0x9c, 0xee, 0x03, 0x61, 0x22, 0x6f, 0x01, 0xc4, 0xbb, 0x57, 0xb5, 0x60,
+	0x89, 0xc3, 0x98, 0x29, 0xac, 0x71, 0x0b, 0xab, 0x8f, 0xa4, 0x37, 0x7f, 0x19, 0xcc, 0xc0, 0x38,
+	0xf5, 0x58, 0xa3, 0x25, 0x16, 0xc0, 0xa8, 0x19, 0x3d, 0x18, 0xdf, 0xed, 0xd5, 0x18, 0xb3, 0xbd,
+	0x99, 0x3e, 0x9d, 0xd4, 0x4a, 0xbc, 0x24, 0x48, 0x02, 0x35, 0x56, 0x70, 0x71, 0xdd, 0xe1, 0xd4,
+	0xef, 0xcf, 0x64, 0xea, 0x18, 0xd1, 0xb2, 0xc7, 0x88, 0x2c, 0x19, 0x7a, 0x71, 0x49, 0xc9, 0xd0,
+	0xe1, 0xd4, 0xaf, 0xf7, 0x65, 0x34, 0xef, 0x20, 0xcf, 0xc4, 0x33, 0x8f, 0x75, 0x32, 0xe1, 0xe3,
+	0x43, 0xe1, 0xae, 0xbc, 0xec, 0x0b, 0xa4, 0xd8, 0xf8, 0xbe, 0x06, 0x73, 0x87, 0xa2, 0x91, 0xb7,
+	0x0d, 0x33, 0x49, 0x85, 0xd1, 0x47, 0x7e, 0x3e, 0x87, 0xfc, 0x80, 0xc0, 0x27, 0xbb, 0x7d, 0x6d,
+	0xdc, 0x58, 0x83, 0xaf, 0xa5, 0xcb, 0xd3, 0x5a, 0xa3, 0xc1, 0x3a, 0x6e, 0xb0, 0x61, 0xb5, 0x2d,
+	0xb7, 0x41, 0x15, 0x94, 0xd4, 0xc1, 0x78, 0x19, 0x1e, 0xb5, 0xac, 0xc2, 0xc4, 0x5e, 0xd4, 0x54,
+	0xd6, 0xd4, 0x8e, 0x5e, 0x39, 0xde, 0x58, 0xc6, 0x62, 0xf5, 0xc6, 0xc3, 0x46, 0xcb, 0x72, 0x9b,
+	0xd4, 0xb4, 0x02, 0x35, 0x5e, 0x67, 0x06, 0xc0, 0xe2, 0xd2, 0x7b, 0xcc, 0xb7, 0x82, 0x88, 0x4b,
+	0xb1, 0xf3, 0x60, 0x8b, 0x36, 0x4c, 0x81, 0x35, 0xae, 0xc2, 0x5c, 0xdf, 0x04, 0xea, 0x65, 0x2e,
+	0x83, 0x37, 0x0e, 0x47, 0x23, 0xcb, 0x6f, 0xc1, 0x44, 0xb6, 0xc6, 0xcd, 0x7b, 0xe7, 0x59, 0xad,
+	0x21, 0xd2, 0x94, 0x11, 0x8c, 0x2d, 0x38, 0x2e, 0x26, 0xac, 0x79, 0x2a, 0x77, 0xf6, 0xab, 0x50,
+	0x3a, 0x70, 0x5c, 0x9b, 0x1d, 0x88, 0x33, 0xe0, 0xa8, 0x89, 0x4f, 0xc6, 0x33, 0x0d, 0x5e, 0x49,
+	0xc2, 0xc4, 0xe5, 0xd1, 0xa8, 0xe5, 0xf9, 0x43, 0x26, 0x33, 0x84, 0x92, 0x1b, 0x30, 0x76, 0xcf,
+	0x67, 0xfb, 0x78, 0x2d, 0x0f, 0x21, 0x53, 0xc0, 0x49, 0x0d, 0x46, 0x02, 0x56, 0x1e, 0x1d, 0x36,
+	0xc8, 0x48, 0xc0, 0x8c, 0xcb, 0xb8, 0xab, 0xb7, 0x68, 0x9b, 0x36, 0xc3, 0xad, 0xb2, 0xc3, 0xfc,
+	0xe0, 0x1e, 0x6b, 0x3b, 0x2c, 0xff, 0x1c, 0xfa, 0x42, 0x6e, 0xea, 0x41, 0x60, 0xcc, 0xd5, 0x2e,
+	0x4c, 0x89, 0x7d, 0xe2, 0x30, 0x57, 0xf5, 0xaa, 0x8f, 0x83, 0xec, 0x20, 0x50, 0x16, 0x78, 0x71,
+	0x20, 0xb2, 0x05, 0x13, 0xd4, 0x0d, 0x7c, 0x87, 0x72, 0xbc, 0x2c, 0xcf, 0xe5, 0x15, 0x49, 0xd4,
+	0x6e, 0x52, 0xff, 0x86, 0x1b, 0xf8, 0x8f, 0x4c, 0x09, 0x35, 0xfe, 0x33, 0x0a, 0x27, 0xfa, 0x26,
+	0x7b, 0xd9, 0x2a, 0x59, 0x87, 0x69, 0x1e, 0xdc, 0xaf, 0xcb, 0x9d, 0xad, 0x58, 0x54, 0x01, 0x0f,
+	0xee, 0xe3, 0xf9, 0x40, 0xde, 0x83, 0x89, 0x06, 0x56, 0xa5, 0xa3, 0x43, 0x2d, 0x9f, 0x52, 0x43,
+	0x14, 0xa8, 0x49, 0x65, 0x37, 0x56, 0xa4, 0xb2, 0xcb, 0x16, 0xd6, 0xe3, 0x85, 0x0b, 0xeb, 0x8b,
+	0x50, 0x12, 0xa9, 0xb5, 0xcb, 0x25, 0x35, 0x2c, 0x0e, 0x0f, 0xe7, 0x3d, 0x70, 0x82, 0x96, 0xed,
+	0x5b, 0x07, 0x6e, 0x79, 0x42, 0x71, 0xde, 0x18, 0x41, 0x6e, 0x42, 0xc9, 0xf3, 0xd9, 0x3d, 0x27,
+	0x28, 0x4f, 0x0e, 0x55, 0xd1, 0x20, 0x3a, 0xb6, 0x53, 0xb6, 0xdd, 0xae, 0xe5, 0x3b, 0x96, 0x1b,
+	0xbb, 0x07, 0x06, 0x83, 0xd3, 0x7d, 0x3d, 0xf1, 0x12, 0x06, 0x27, 0x6e, 0xc5, 0x35, 0x5c, 0xc9,
+	0xfb, 0x4a, 0x93, 0x00, 0x93, 0xf2, 0x4e, 0x3b, 0x90, 0x2b, 0x21, 0x89, 0x63, 0x7c, 0x1b, 0x8e,
+	0xf7, 0x0c, 0x0a, 0xeb, 0x10, 0x9f, 0x75, 0xe4, 0x31, 0x6d, 0x46, 0x0f, 0xe1, 0xd1, 0xb4, 0xe7,
+	0xb3, 0xfb, 0xd4, 0x15, 0xeb, 0x6d, 0xd2, 0xc4, 0xa7, 0x70, 0x5f, 0xee, 0x53, 0xce, 0xad, 0x26,
+	0x2e, 0x25, 0x53, 0x3e, 0x2e, 0xfc, 0xf8, 0xeb, 0x30, 0x2e, 0xc4, 0x90, 0xcf, 0x35, 0x28, 0x45,
+	0xa6, 0x17, 0xc9, 0x3b, 0x1f, 0xfa, 0x5d, 0x37, 0x7d, 0xa1, 0x08, 0x24, 0x4a, 0x96, 0x71, 0xfe,
+	0x7b, 0x7f, 0xfe, 0xd7, 0x0f, 0x47, 0xbe, 0x49, 0xde, 0xac, 0xaa, 0x18, 0x85, 0xe4, 0x37, 0x1a,
+	0x4c, 0xc5, 0x35, 0x31, 0x59, 0x52, 0x99, 0xb0, 0xd7, 0xa7, 0xd3, 0x97, 0x0b, 0xa2, 0x90, 0xe9,
+	0x55, 0xc1, 0x74, 0x85, 0x2c, 0xe5, 0x30, 0x4d, 0xac, 0xb4, 0xea, 0x63, 0x79, 0x30, 0x3c, 0x21,
+	0xbf, 0xd2, 0x00, 0xe2, 0x98, 0x9c, 0x14, 0xe3, 0x10, 0x67, 0x78, 0xa5, 0x28, 0x0c, 0xb9, 0x2f,
+	0x08, 0xee, 0xef, 0x92, 0x73, 0xca, 0xdc, 0x39, 0xf9, 0xb5, 0x06, 0x93, 0xd2, 0xfd, 0x22, 0x8b,
+	0x2a, 0x13, 0xf7, 0x38, 0x6c, 0xfa, 0x52, 0x31, 0x10, 0x72, 0xbd, 0x2c, 0xb8, 0x2e, 0x91, 0x85,
+	0x1c, 0xae, 0xd2, 0x4a, 0x4b, 0x67, 0xf9, 0xb7, 0x1a, 0x40, 0x52, 0xbb, 0xa9, 0x65, 0xb9, 0xaf,
+	0x92, 0xd6, 0x57, 0x8a, 0xc2, 0x0a, 0xae, 0x90, 0xa4, 0x56, 0x4d, 0x73, 0xff, 0x42, 0x83, 0xa9,
+	0x38, 0xa8, 0xda, 0xd2, 0xee, 0xad, 0xa8, 0xf5, 0xe5, 0x82, 0x28, 0x24, 0xbe, 0x29, 0x88, 0x5f,
+	0x23, 0x57, 0x54, 0x89, 0xa7, 0x78, 0x57, 0x1f, 0x8b, 0xcf, 0x9f, 0x27, 0xe4, 0x8f, 0x1a, 0x1c,
+	0xcb, 0x7e, 0x61, 0x90, 0x55, 0x25, 0x3a, 0x83, 0xbe, 0x66, 0xf4, 0xcb, 0xc3, 0x40, 0x51, 0xce,
+	0xba, 0x90, 0x73, 0x99, 0x5c, 0xca, 0x93, 0x93, 0xfd, 0xea, 0xa9, 0x3e, 0xc6, 0x42, 0xe5, 0x09,
+	0xf9, 0x87, 0x06, 0x27, 0xfb, 0x3f, 0x10, 0x38, 0xb9, 0xa6, 0xc2, 0xea, 0xd0, 0x0f, 0x1e, 0x7d,
+	0x6d, 0x58, 0x38, 0x0a, 0xbb, 0x29, 0x84, 0xad, 0x93, 0xb5, 0x1c, 0x61, 0x83, 0x3e, 0x8b, 0xd2,
+	0x4b, 0xed, 0x77, 0x1a, 0x4c, 0xa7, 0xbc, 0x6d, 0xa2, 0xb4, 0xe0, 0xfb, 0x2d, 0x74, 0xfd, 0x62,
+	0x61, 0x1c, 0x0a, 0x59, 0x13, 0x42, 0x2e, 0x91, 0x95, 0x1c, 0x21, 0x6d, 0xbe, 0x5f, 0x1f, 0xb4,
+	0xcf, 0xff, 0xa4, 0xc1, 0xb1, 0xac, 0x01, 0xae, 0xb6, 0xd6, 0x06, 0xba, 0xed, 0xfa, 0xe5, 0x61,
+	0xa0, 0xa8, 0xa4, 0x26, 0x94, 0x5c, 0x21, 0xab, 0x39, 0x4a, 0xa4, 0x29, 0xdf, 0x8a, 0xf0, 0x69,
+	0x31, 0xbf, 0xd0, 0x60, 0x02, 0x8d, 0x6f, 0xa2, 0x74, 0x85, 0x66, 0x4d, 0x78, 0x7d, 0xb1, 0x10,
+	0x06, 0x79, 0xaf, 0x0a, 0xde, 0x8b, 0x64, 0x3e, 0x87, 0x37, 0x3a, 0xe9, 0x69, 0xbe, 0x3f, 0xd7,
+	0xa0, 0x14, 0xf9, 0xe3, 0x6a, 0x45, 0x42, 0xc6, 0x7c, 0xd7, 0x17, 0x8a, 0x40, 0x0a, 0x92, 0x45,
+	0x8f, 0x3e, 0x4d, 0xf6, 0x67, 0x1a, 0x8c, 0x8b, 0x68, 0xe4, 0x82, 0xf2, 0xc4, 0x92, 0xea, 0x7c,
+	0x01, 0x04, 0x32, 0xbd, 0x22, 0x98, 0x2e, 0x93, 0x45, 0x25, 0xa6, 0xd5, 0xc7, 0xa9, 0xff, 0x01,
+	0x78, 0x42, 0xfe, 0xa0, 0xc1, 0xd1, 0x8c, 0x39, 0x4e, 0x2e, 0x29, 0x6d, 0xb0, 0x01, 0x66, 0xbe,
+	0xbe, 0x3a, 0x04, 0xb2, 0xe0, 0xf1, 0xd9, 0x96, 0xe8, 0x7a, 0x68, 0xe1, 0xa7, 0x93, 0xfe, 0x95,
+	0x06, 0x27, 0xfa, 0x6c, 0x66, 0x72, 0xb5, 0x18, 0xa5, 0xac, 0x81, 0xae, 0x5f, 0x1b, 0x12, 0x8d,
+	0xa2, 0x3e, 0x14, 0xa2, 0x6e, 0x91, 0x9b, 0xea, 0xa2, 0x30, 0x44, 0xe6, 0xae, 0x8b, 0x6f, 0x88,
+	0xa7, 0x1a, 0x90, 0x7e, 0xc7, 0x5a, 0xed, 0x82, 0x38, 0xd4, 0x26, 0xd7, 0xd7, 0x86, 0x85, 0x17,
+	0x5c, 0x7e, 0xb1, 0x15, 0x9e, 0x7e, 0x6b, 0x7f, 0xd1, 0xe0, 0x68, 0xc6, 0xb7, 0x56, 0x5b, 0x7e,
+	0x83, 0x4c, 0x74, 0x7d, 0x75, 0x08, 0x64, 0xc1, 0x37, 0x15, 0x3b, 0xf1, 0xc2, 0xc9, 0xce, 0xbc,
+	0x25, 0x2f, 0x31, 0xe9, 0x9f, 0x90, 0x7f, 0x6b, 0x70, 0x6a, 0xa0, 0xf9, 0x46, 0xd6, 0x0b, 0xd4,
+	0xa7, 0x03, 0x7d, 0x3f, 0xbd, 0xf6, 0x3f, 0x44, 0x40, 0xb9, 0xdb, 0x42, 0xee, 0x26, 0xa9, 0xa9,
+	0x95, 0xbb, 0x75, 0x2b, 0x0a, 0x23, 0x4d, 0x85, 0xf4, 0x0b, 0xfc, 0xbd, 0x06, 0xff, 0x97, 0xb6,
+	0x6d, 0x88, 0xd2, 0xfd, 0x3c, 0xc0, 0x37, 0xd4, 0x2f, 0x15, 0x07, 0xa2, 0x9c, 0xeb, 0x42, 0xce,
+	0x2a, 0xb9, 0x98, 0x23, 0x87, 0x22, 0xb8, 0xee, 0x5b, 0x41, 0x46, 0xc4, 0x3f, 0x35, 0x38, 0x39,
+	0xc0, 0xf4, 0x23, 0x6b, 0x45, 0x29, 0xf5, 0x5c, 0xf2, 0xd7, 0x87, 0xc6, 0xa3, 0xb2, 0xf7, 0x84,
+	0xb2, 0x1a, 0xb9, 0x5e, 0x44, 0xd9, 0xa0, 0xfb, 0xfe, 0x27, 0x1a, 0x8c, 0xd6, 0x3c, 0x9f, 0x54,
+	0x54, 0x18, 0x25, 0x76, 0xa4, 0x5e, 0x55, 0x1e, 0x8f, 0x8c, 0x97, 0x05, 0xe3, 0x2a, 0x39, 0x9f,
+	0xc3, 0xd8, 0xf2, 0xd2, 0x87, 0x1c, 0xf9, 0x9b, 0x06, 0xa4, 0xdf, 0xa1, 0x53, 0x3b, 0xda, 0x0e,
+	0xb5, 0x05, 0xf5, 0xb5, 0x61, 0xe1, 0x28, 0x66, 0x4b, 0x88, 0x59, 0x23, 0x57, 0x73, 0xf7, 0x09,
+	0x86, 0xa8, 0x7b, 0x32, 0x46, 0xea, 0xd8, 0xfe, 0xa5, 0x06, 0x90, 0x58, 0x36, 0x6a, 0x1f, 0x88,
+	0x7d, 0xe6, 0x8f, 0xbe, 0x52, 0x14, 0x86, 0x1a, 0xe6, 0x85, 0x86, 0x77, 0xc8, 0xd9, 0x1c, 0x0d,
+	0x89, 0xed, 0xb3, 0xf1, 0xd9, 0x97, 0xcf, 0x67, 0xb5, 0xa7, 0xcf, 0x67, 0xb5, 0xaf, 0x9e, 0xcf,
+	0x6a, 0x3f, 0x78, 0x31, 0x7b, 0xe4, 0xe9, 0x8b, 0xd9, 0x23, 0x7f, 0x7d, 0x31, 0x7b, 0xe4, 0xd3,
+	0x5a, 0xca, 0xcb, 0xf2, 0xa8, 0xcf, 0x1d, 0x1e, 0x84, 0x95, 0xc4, 0x6d, 0x97, 0x62, 0xf4, 0xf3,
+	0xae, 0x15, 0x38, 0x5d, 0x5a, 0xed, 0x2e, 0x54, 0x1f, 0xf6, 0xce, 0x24, 0xac, 0xae, 0xbd, 0x92,
+	0xf8, 0x1b, 0xaa, 0xc5, 0xff, 0x0e, 0x00, 0x3f, 0x9a, 0xfd, 0x89, 0x8d, 0x26, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a HostChain by id.
+	HostChain(ctx context.Context, in *QueryHostChainRequest, opts ...grpc.CallOption) (*QueryHostChainResponse, error)
+	// Queries for all the HostChains.
+	HostChains(ctx context.Context, in *QueryHostChainsRequest, opts ...grpc.CallOption) (*QueryHostChainsResponse, error)
+	// Queries for all the deposits for a host chain.
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// Queries all unbondings for a host chain.
+	Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error)
+	// Queries an unbonding for a host chain.
+	Unbonding(ctx context.Context, in *QueryUnbondingRequest, opts ...grpc.CallOption) (*QueryUnbondingResponse, error)
+	// Queries all unbondings for a delegator address.
+	UserUnbondings(ctx context.Context, in *QueryUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryUserUnbondingsResponse, error)
+	// Queries all validator unbondings for a host chain.
+	ValidatorUnbondings(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error)
+	// Queries for all the LSM deposits for a host chain.
+	LSMDeposits(ctx context.Context, in *QueryLSMDepositsRequest, opts ...grpc.CallOption) (*QueryLSMDepositsResponse, error)
+	// Queries for the rewards records of a host chain.
+	RewardsHistory(ctx context.Context, in *QueryRewardsHistoryRequest, opts ...grpc.CallOption) (*QueryRewardsHistoryResponse, error)
+	// Queries for the validator slashes of a host chain.
+	Slashes(ctx context.Context, in *QuerySlashesRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error)
+	// Queries for the ICA transactions of a host chain.
+	IcaTxs(ctx context.Context, in *QueryIcaTxsRequest, opts ...grpc.CallOption) (*QueryIcaTxsResponse, error)
+	// Queries for an ICA transaction given its sequence id.
+	IcaTx(ctx context.Context, in *QueryIcaTxRequest, opts ...grpc.CallOption) (*QueryIcaTxResponse, error)
+	// Queries for the instant redemption liquidity pool of a host chain.
+	LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
+	// Queries for the liquidity pool position of a provider.
+	LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error)
+	// Queries for the tracked governance proposals of a host chain.
+	HostChainProposals(ctx context.Context, in *QueryHostChainProposalsRequest, opts ...grpc.CallOption) (*QueryHostChainProposalsResponse, error)
+	// Queries for the stk holders tally of a host chain governance proposal.
+	ProposalTally(ctx context.Context, in *QueryProposalTallyRequest, opts ...grpc.CallOption) (*QueryProposalTallyResponse, error)
+	// Queries for a host chain deposit account balance.
+	DepositAccountBalance(ctx context.Context, in *QueryDepositAccountBalanceRequest, opts ...grpc.CallOption) (*QueryDepositAccountBalanceResponse, error)
+	// Queries for a host chain exchange rate between the host token and the stk token.
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// Queries for the c value history of a host chain.
+	ExchangeRateHistory(ctx context.Context, in *QueryExchangeRateHistoryRequest, opts ...grpc.CallOption) (*QueryExchangeRateHistoryResponse, error)
+	// Queries for the staking APR of a host chain, derived from its c value history.
+	Apr(ctx context.Context, in *QueryAprRequest, opts ...grpc.CallOption) (*QueryAprResponse, error)
+	// Queries for the liquid staking positions and ledger of an address.
+	DelegatorPortfolio(ctx context.Context, in *QueryDelegatorPortfolioRequest, opts ...grpc.CallOption) (*QueryDelegatorPortfolioResponse, error)
+	// Queries for the result of every module invariant.
+	Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostChain(ctx context.Context, in *QueryHostChainRequest, opts ...grpc.CallOption) (*QueryHostChainResponse, error) {
+	out := new(QueryHostChainResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostChains(ctx context.Context, in *QueryHostChainsRequest, opts ...grpc.CallOption) (*QueryHostChainsResponse, error) {
+	out := new(QueryHostChainsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Unbondings(ctx context.Context, in *QueryUnbondingsRequest, opts ...grpc.CallOption) (*QueryUnbondingsResponse, error) {
+	out := new(QueryUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Unbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Unbonding(ctx context.Context, in *QueryUnbondingRequest, opts ...grpc.CallOption) (*QueryUnbondingResponse, error) {
+	out := new(QueryUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Unbonding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserUnbondings(ctx context.Context, in *QueryUserUnbondingsRequest, opts ...grpc.CallOption) (*QueryUserUnbondingsResponse, error) {
+	out := new(QueryUserUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/UserUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUnbondings(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error) {
+	out := new(QueryValidatorUnbondingResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ValidatorUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LSMDeposits(ctx context.Context, in *QueryLSMDepositsRequest, opts ...grpc.CallOption) (*QueryLSMDepositsResponse, error) {
+	out := new(QueryLSMDepositsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/LSMDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardsHistory(ctx context.Context, in *QueryRewardsHistoryRequest, opts ...grpc.CallOption) (*QueryRewardsHistoryResponse, error) {
+	out := new(QueryRewardsHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/RewardsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Slashes(ctx context.Context, in *QuerySlashesRequest, opts ...grpc.CallOption) (*QuerySlashesResponse, error) {
+	out := new(QuerySlashesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Slashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaTxs(ctx context.Context, in *QueryIcaTxsRequest, opts ...grpc.CallOption) (*QueryIcaTxsResponse, error) {
+	out := new(QueryIcaTxsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/IcaTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IcaTx(ctx context.Context, in *QueryIcaTxRequest, opts ...grpc.CallOption) (*QueryIcaTxResponse, error) {
+	out := new(QueryIcaTxResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/IcaTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
+	out := new(QueryLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/LiquidityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityProvider(ctx context.Context, in *QueryLiquidityProviderRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderResponse, error) {
+	out := new(QueryLiquidityProviderResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/LiquidityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostChainProposals(ctx context.Context, in *QueryHostChainProposalsRequest, opts ...grpc.CallOption) (*QueryHostChainProposalsResponse, error) {
+	out := new(QueryHostChainProposalsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/HostChainProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalTally(ctx context.Context, in *QueryProposalTallyRequest, opts ...grpc.CallOption) (*QueryProposalTallyResponse, error) {
+	out := new(QueryProposalTallyResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ProposalTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositAccountBalance(ctx context.Context, in *QueryDepositAccountBalanceRequest, opts ...grpc.CallOption) (*QueryDepositAccountBalanceResponse, error) {
+	out := new(QueryDepositAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DepositAccountBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRateHistory(ctx context.Context, in *QueryExchangeRateHistoryRequest, opts ...grpc.CallOption) (*QueryExchangeRateHistoryResponse, error) {
+	out := new(QueryExchangeRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Apr(ctx context.Context, in *QueryAprRequest, opts ...grpc.CallOption) (*QueryAprResponse, error) {
+	out := new(QueryAprResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Apr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorPortfolio(ctx context.Context, in *QueryDelegatorPortfolioRequest, opts ...grpc.CallOption) (*QueryDelegatorPortfolioResponse, error) {
+	out := new(QueryDelegatorPortfolioResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/DelegatorPortfolio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Invariants(ctx context.Context, in *QueryInvariantsRequest, opts ...grpc.CallOption) (*QueryInvariantsResponse, error) {
+	out := new(QueryInvariantsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.liquidstakeibc.v1beta1.Query/Invariants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a HostChain by id.
+	HostChain(context.Context, *QueryHostChainRequest) (*QueryHostChainResponse, error)
+	// Queries for all the HostChains.
+	HostChains(context.Context, *QueryHostChainsRequest) (*QueryHostChainsResponse, error)
+	// Queries for all the deposits for a host chain.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// Queries all unbondings for a host chain.
+	Unbondings(context.Context, *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error)
+	// Queries an unbonding for a host chain.
+	Unbonding(context.Context, *QueryUnbondingRequest) (*QueryUnbondingResponse, error)
+	// Queries all unbondings for a delegator address.
+	UserUnbondings(context.Context, *QueryUserUnbondingsRequest) (*QueryUserUnbondingsResponse, error)
+	// Queries all validator unbondings for a host chain.
+	ValidatorUnbondings(context.Context, *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error)
+	// Queries for all the LSM deposits for a host chain.
+	LSMDeposits(context.Context, *QueryLSMDepositsRequest) (*QueryLSMDepositsResponse, error)
+	// Queries for the rewards records of a host chain.
+	RewardsHistory(context.Context, *QueryRewardsHistoryRequest) (*QueryRewardsHistoryResponse, error)
+	// Queries for the validator slashes of a host chain.
+	Slashes(context.Context, *QuerySlashesRequest) (*QuerySlashesResponse, error)
+	// Queries for the ICA transactions of a host chain.
+	IcaTxs(context.Context, *QueryIcaTxsRequest) (*QueryIcaTxsResponse, error)
+	// Queries for an ICA transaction given its sequence id.
+	IcaTx(context.Context, *QueryIcaTxRequest) (*QueryIcaTxResponse, error)
+	// Queries for the instant redemption liquidity pool of a host chain.
+	LiquidityPool(context.Context, *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error)
+	// Queries for the liquidity pool position of a provider.
+	LiquidityProvider(context.Context, *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error)
+	// Queries for the tracked governance proposals of a host chain.
+	HostChainProposals(context.Context, *QueryHostChainProposalsRequest) (*QueryHostChainProposalsResponse, error)
+	// Queries for the stk holders tally of a host chain governance proposal.
+	ProposalTally(context.Context, *QueryProposalTallyRequest) (*QueryProposalTallyResponse, error)
+	// Queries for a host chain deposit account balance.
+	DepositAccountBalance(context.Context, *QueryDepositAccountBalanceRequest) (*QueryDepositAccountBalanceResponse, error)
+	// Queries for a host chain exchange rate between the host token and the stk token.
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// Queries for the c value history of a host chain.
+	ExchangeRateHistory(context.Context, *QueryExchangeRateHistoryRequest) (*QueryExchangeRateHistoryResponse, error)
+	// Queries for the staking APR of a host chain, derived from its c value history.
+	Apr(context.Context, *QueryAprRequest) (*QueryAprResponse, error)
+	// Queries for the liquid staking positions and ledger of an address.
+	DelegatorPortfolio(context.Context, *QueryDelegatorPortfolioRequest) (*QueryDelegatorPortfolioResponse, error)
+	// Queries for the result of every module invariant.
+	Invariants(context.Context, *QueryInvariantsRequest) (*QueryInvariantsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) HostChain(ctx context.Context, req *QueryHostChainRequest) (*QueryHostChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostChain not implemented")
+}
+func (*UnimplementedQueryServer) HostChains(ctx context.Context, req *QueryHostChainsRequest) (*QueryHostChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostChains not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) Unbondings(ctx context.Context, req *QueryUnbondingsRequest) (*QueryUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unbondings not implemented")
+}
+func (*UnimplementedQueryServer) Unbonding(ctx context.Context, req *QueryUnbondingRequest) (*QueryUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unbonding not implemented")
+}
+func (*UnimplementedQueryServer) UserUnbondings(ctx context.Context, req *QueryUserUnbondingsRequest) (*QueryUserUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorUnbondings(ctx context.Context, req *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) LSMDeposits(ctx context.Context, req *QueryLSMDepositsRequest) (*QueryLSMDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LSMDeposits not implemented")
+}
+func (*UnimplementedQueryServer) RewardsHistory(ctx context.Context, req *QueryRewardsHistoryRequest) (*QueryRewardsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsHistory not implemented")
+}
+func (*UnimplementedQueryServer) Slashes(ctx context.Context, req *QuerySlashesRequest) (*QuerySlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Slashes not implemented")
+}
+func (*UnimplementedQueryServer) IcaTxs(ctx context.Context, req *QueryIcaTxsRequest) (*QueryIcaTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaTxs not implemented")
+}
+func (*UnimplementedQueryServer) IcaTx(ctx context.Context, req *QueryIcaTxRequest) (*QueryIcaTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IcaTx not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPool(ctx context.Context, req *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPool not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityProvider(ctx context.Context, req *QueryLiquidityProviderRequest) (*QueryLiquidityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProvider not implemented")
+}
+func (*UnimplementedQueryServer) HostChainProposals(ctx context.Context, req *QueryHostChainProposalsRequest) (*QueryHostChainProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostChainProposals not implemented")
+}
+func (*UnimplementedQueryServer) ProposalTally(ctx context.Context, req *QueryProposalTallyRequest) (*QueryProposalTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTally not implemented")
+}
+func (*UnimplementedQueryServer) DepositAccountBalance(ctx context.Context, req *QueryDepositAccountBalanceRequest) (*QueryDepositAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositAccountBalance not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRateHistory(ctx context.Context, req *QueryExchangeRateHistoryRequest) (*QueryExchangeRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateHistory not implemented")
+}
+func (*UnimplementedQueryServer) Apr(ctx context.Context, req *QueryAprRequest) (*QueryAprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apr not implemented")
+}
+func (*UnimplementedQueryServer) DelegatorPortfolio(ctx context.Context, req *QueryDelegatorPortfolioRequest) (*QueryDelegatorPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorPortfolio not implemented")
+}
+func (*UnimplementedQueryServer) Invariants(ctx context.Context, req *QueryInvariantsRequest) (*QueryInvariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invariants not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostChain(ctx, req.(*QueryHostChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostChains(ctx, req.(*QueryHostChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Deposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Unbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Unbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Unbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Unbondings(ctx, req.(*QueryUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Unbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Unbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Unbonding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Unbonding(ctx, req.(*QueryUnbondingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/UserUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserUnbondings(ctx, req.(*QueryUserUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUnbondingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ValidatorUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUnbondings(ctx, req.(*QueryValidatorUnbondingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LSMDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLSMDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LSMDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/LSMDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LSMDeposits(ctx, req.(*QueryLSMDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/RewardsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsHistory(ctx, req.(*QueryRewardsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Slashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Slashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Slashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Slashes(ctx, req.(*QuerySlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/IcaTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaTxs(ctx, req.(*QueryIcaTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IcaTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIcaTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IcaTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/IcaTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IcaTx(ctx, req.(*QueryIcaTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/LiquidityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPool(ctx, req.(*QueryLiquidityPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/LiquidityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityProvider(ctx, req.(*QueryLiquidityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostChainProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostChainProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostChainProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/HostChainProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostChainProposals(ctx, req.(*QueryHostChainProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ProposalTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTally(ctx, req.(*QueryProposalTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DepositAccountBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositAccountBalance(ctx, req.(*QueryDepositAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/ExchangeRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateHistory(ctx, req.(*QueryExchangeRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Apr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Apr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Apr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Apr(ctx, req.(*QueryAprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/DelegatorPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorPortfolio(ctx, req.(*QueryDelegatorPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Invariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Invariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.liquidstakeibc.v1beta1.Query/Invariants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Invariants(ctx, req.(*QueryInvariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.liquidstakeibc.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "HostChain",
+			Handler:    _Query_HostChain_Handler,
+		},
+		{
+			MethodName: "HostChains",
+			Handler:    _Query_HostChains_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _Query_Deposits_Handler,
+		},
+		{
+			MethodName: "Unbondings",
+			Handler:    _Query_Unbondings_Handler,
+		},
+		{
+			MethodName: "Unbonding",
+			Handler:    _Query_Unbonding_Handler,
+		},
+		{
+			MethodName: "UserUnbondings",
+			Handler:    _Query_UserUnbondings_Handler,
+		},
+		{
+			MethodName: "ValidatorUnbondings",
+			Handler:    _Query_ValidatorUnbondings_Handler,
+		},
+		{
+			MethodName: "LSMDeposits",
+			Handler:    _Query_LSMDeposits_Handler,
+		},
+		{
+			MethodName: "RewardsHistory",
+			Handler:    _Query_RewardsHistory_Handler,
+		},
+		{
+			MethodName: "Slashes",
+			Handler:    _Query_Slashes_Handler,
+		},
+		{
+			MethodName: "IcaTxs",
+			Handler:    _Query_IcaTxs_Handler,
+		},
+		{
+			MethodName: "IcaTx",
+			Handler:    _Query_IcaTx_Handler,
+		},
+		{
+			MethodName: "LiquidityPool",
+			Handler:    _Query_LiquidityPool_Handler,
+		},
+		{
+			MethodName: "LiquidityProvider",
+			Handler:    _Query_LiquidityProvider_Handler,
+		},
+		{
+			MethodName: "HostChainProposals",
+			Handler:    _Query_HostChainProposals_Handler,
+		},
+		{
+			MethodName: "ProposalTally",
+			Handler:    _Query_ProposalTally_Handler,
+		},
+		{
+			MethodName: "DepositAccountBalance",
+			Handler:    _Query_DepositAccountBalance_Handler,
+		},
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "ExchangeRateHistory",
+			Handler:    _Query_ExchangeRateHistory_Handler,
+		},
+		{
+			MethodName: "Apr",
+			Handler:    _Query_Apr_Handler,
+		},
+		{
+			MethodName: "DelegatorPortfolio",
+			Handler:    _Query_DelegatorPortfolio_Handler,
+		},
+		{
+			MethodName: "Invariants",
+			Handler:    _Query_Invariants_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pstake/liquidstakeibc/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostChain.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostChains) > 0 {
+		for iNdEx := len(m.HostChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLSMDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLSMDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLSMDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLSMDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLSMDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLSMDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsRecords) > 0 {
+		for iNdEx := len(m.RewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IcaTxs) > 0 {
+		for iNdEx := len(m.IcaTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IcaTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SequenceId) > 0 {
+		i -= len(m.SequenceId)
+		copy(dAtA[i:], m.SequenceId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SequenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIcaTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIcaTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIcaTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IcaTx != nil {
+		{
+			size, err := m.IcaTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalValue.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Unbonding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Available.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Pool != nil {
+		{
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Provider != nil {
+		{
+			size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostChainProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostChainProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostChainProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Votes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Tally) > 0 {
+		for iNdEx := len(m.Tally) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tally[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Proposal != nil {
+		{
+			size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unbonding != nil {
+		{
+			size, err := m.Unbonding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserUnbondings) > 0 {
+		for iNdEx := len(m.UserUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUnbondingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUnbondingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUnbondingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorUnbondingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUnbondingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUnbondingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorUnbondings) > 0 {
+		for iNdEx := len(m.ValidatorUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAccountBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAccountBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAccountBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositAccountBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositAccountBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositAccountBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAprRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAprRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAprRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAprResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAprResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAprResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPortfolioRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPortfolioRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPortfolioRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegatorPortfolioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegatorPortfolioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorPortfolioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PortfolioPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PortfolioPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PortfolioPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Profit.Size()
+		i -= size
+		if _, err := m.Profit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Withdrawn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Staked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Unbonding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CValue.Size()
+		i -= size
+		if _, err := m.CValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.StkBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for iNdEx := len(m.Invariants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Invariants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostChain.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHostChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HostChains) > 0 {
+		for _, e := range m.HostChains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLSMDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLSMDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardsRecords) > 0 {
+		for _, e := range m.RewardsRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIcaTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIcaTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IcaTxs) > 0 {
+		for _, e := range m.IcaTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIcaTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SequenceId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIcaTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IcaTx != nil {
+		l = m.IcaTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Available.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unbonding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Provider != nil {
+		l = m.Provider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostChainProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostChainProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProposalTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposal != nil {
+		l = m.Proposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Tally) > 0 {
+		for _, e := range m.Tally {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Votes != 0 {
+		n += 1 + sovQuery(uint64(m.Votes))
+	}
+	return n
+}
+
+func (m *QueryUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUnbondingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unbonding != nil {
+		l = m.Unbonding.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserUnbondings) > 0 {
+		for _, e := range m.UserUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorUnbondingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorUnbondingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorUnbondings) > 0 {
+		for _, e := range m.ValidatorUnbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDepositAccountBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositAccountBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAprRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryAprResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPortfolioRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorPortfolioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PortfolioPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.StkBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unbonding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Staked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Withdrawn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Profit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInvariantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInvariantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Invariants) > 0 {
+		for _, e := range m.Invariants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostChains = append(m.HostChains, &HostChain{})
+			if err := m.HostChains[len(m.HostChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLSMDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLSMDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLSMDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLSMDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLSMDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLSMDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, &LSMDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsRecords = append(m.RewardsRecords, &RewardsRecord{})
+			if err := m.RewardsRecords[len(m.RewardsRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashes = append(m.Slashes, &Slash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIcaTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIcaTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIcaTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIcaTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIcaTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIcaTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcaTxs = append(m.IcaTxs, &ICATx{})
+			if err := m.IcaTxs[len(m.IcaTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIcaTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIcaTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIcaTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIcaTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIcaTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIcaTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcaTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IcaTx == nil {
+				m.IcaTx = &ICATx{}
+			}
+			if err := m.IcaTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &LiquidityPool{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Available.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Provider == nil {
+				m.Provider = &LiquidityProvider{}
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostChainProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostChainProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostChainProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &HostChainProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proposal == nil {
+				m.Proposal = &HostChainProposal{}
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tally = append(m.Tally, v1beta1.WeightedVoteOption{})
+			if err := m.Tally[len(m.Tally)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			m.Votes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Votes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, &Unbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Unbonding == nil {
+				m.Unbonding = &Unbonding{}
+			}
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserUnbondings = append(m.UserUnbondings, &UserUnbonding{})
+			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorUnbondingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorUnbondingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUnbondings = append(m.ValidatorUnbondings, &ValidatorUnbonding{})
+			if err := m.ValidatorUnbondings[len(m.ValidatorUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryDepositAccountBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryDepositAccountBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2358,12 +9023,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositAccountBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2385,7 +9082,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDepositAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2408,15 +9105,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDepositAccountBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDepositAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2443,7 +9140,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2468,7 +9165,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2491,10 +9188,10 @@ func (m *QueryHostChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2550,7 +9247,7 @@ func (m *QueryHostChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2573,17 +9270,17 @@ func (m *QueryHostChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2593,22 +9290,23 @@ func (m *QueryHostChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HostChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2633,7 +9331,7 @@ func (m *QueryHostChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2656,12 +9354,44 @@ func (m *QueryHostChainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2683,7 +9413,7 @@ func (m *QueryHostChainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExchangeRateHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,15 +9436,15 @@ func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExchangeRateHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExchangeRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostChains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2741,8 +9471,8 @@ func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostChains = append(m.HostChains, &HostChain{})
-			if err := m.HostChains[len(m.HostChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &ExchangeRateRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2767,7 +9497,7 @@ func (m *QueryHostChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAprRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2790,10 +9520,10 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAprRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAprRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2828,6 +9558,25 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2849,7 +9598,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAprResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2872,15 +9621,85 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAprResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAprResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &ExchangeRateRecord{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2907,8 +9726,10 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, &Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.To == nil {
+				m.To = &ExchangeRateRecord{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2933,7 +9754,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPortfolioRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2956,15 +9777,15 @@ func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPortfolioRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPortfolioRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2992,7 +9813,7 @@ func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3015,7 +9836,7 @@ func (m *QueryUnbondingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegatorPortfolioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3038,15 +9859,15 @@ func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegatorPortfolioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegatorPortfolioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3073,8 +9894,42 @@ func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unbondings = append(m.Unbondings, &Unbonding{})
-			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, PortfolioPosition{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &LedgerEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3099,7 +9954,7 @@ func (m *QueryUnbondingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
+func (m *PortfolioPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3122,10 +9977,10 @@ func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PortfolioPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PortfolioPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3161,10 +10016,10 @@ func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
 			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StkBalance", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3174,66 +10029,30 @@ func (m *QueryUnbondingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUnbondingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StkBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnbondingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3243,83 +10062,31 @@ func (m *QueryUnbondingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Unbonding == nil {
-				m.Unbonding = &Unbonding{}
-			}
-			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserUnbondingsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3329,77 +10096,61 @@ func (m *QueryUserUnbondingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserUnbondingsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Unbonding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3426,66 +10177,15 @@ func (m *QueryUserUnbondingsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserUnbondings = append(m.UserUnbondings, &UserUnbonding{})
-			if err := m.UserUnbondings[len(m.UserUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorUnbondingRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3495,79 +10195,30 @@ func (m *QueryValidatorUnbondingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorUnbondingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorUnbondingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUnbondings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3577,23 +10228,23 @@ func (m *QueryValidatorUnbondingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorUnbondings = append(m.ValidatorUnbondings, &ValidatorUnbonding{})
-			if err := m.ValidatorUnbondings[len(m.ValidatorUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3618,7 +10269,7 @@ func (m *QueryValidatorUnbondingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositAccountBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInvariantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3641,44 +10292,12 @@ func (m *QueryDepositAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositAccountBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInvariantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositAccountBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInvariantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3700,7 +10319,7 @@ func (m *QueryDepositAccountBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositAccountBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInvariantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3723,15 +10342,15 @@ func (m *QueryDepositAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositAccountBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInvariantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositAccountBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInvariantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invariants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3758,7 +10377,8 @@ func (m *QueryDepositAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Invariants = append(m.Invariants, InvariantResult{})
+			if err := m.Invariants[len(m.Invariants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3783,7 +10403,7 @@ func (m *QueryDepositAccountBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3806,15 +10426,15 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3842,61 +10462,31 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Route = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Broken = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3924,9 +10514,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
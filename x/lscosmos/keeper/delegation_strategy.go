@@ -9,6 +9,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
 
+	"github.com/persistenceOne/pstake-native/v2/delegation"
 	"github.com/persistenceOne/pstake-native/v2/x/lscosmos/types"
 )
 
@@ -99,7 +100,12 @@ func FetchValidatorsToDelegate(valList types.AllowListedValidators, delegationSt
 	}
 	sort.Sort(sort.Reverse(curDiffDistribution))
 
-	return DivideAmountIntoValidatorSet(curDiffDistribution, amount)
+	strategy, err := delegation.NewStrategy(delegation.WeightProportional, sdk.ZeroDec())
+	if err != nil {
+		return nil, err
+	}
+
+	return DivideAmountIntoValidatorSet(strategy, curDiffDistribution, delegationState, amount)
 }
 
 // FetchValidatorsToUndelegate gives a list of all validators having weighted amount for few and 1uatom for rest in order to auto claim all rewards accumulated in current epoch
@@ -109,7 +115,13 @@ func FetchValidatorsToUndelegate(valList types.AllowListedValidators, delegation
 		return nil, err
 	}
 	sort.Sort(sort.Reverse(currDiffDistribution))
-	return DivideUndelegateAmountIntoValidatorSet(currDiffDistribution, amount)
+
+	strategy, err := delegation.NewStrategy(delegation.WeightProportional, sdk.ZeroDec())
+	if err != nil {
+		return nil, err
+	}
+
+	return DivideUndelegateAmountIntoValidatorSet(strategy, currDiffDistribution, delegationState, amount)
 }
 
 // GetIdealCurrentDelegations returns ideal amount of delegations to validators on host chain
@@ -171,30 +183,78 @@ func divideAmountWeightedSet(valAmounts types.ValAddressAmounts, coin sdk.Coin,
 }
 
 // distributeCoinsAmongstValSet takes the validator distribution and coins to distribute and returns the
-// validator address amount to distribute and the remaining amount to make
-func distributeCoinsAmongstValSet(ws types.WeightedAddressAmounts, coin sdk.Coin) (types.ValAddressAmounts, sdk.Coin) {
+// validator address amount to distribute and the remaining amount to make. The amounts are allocated by the
+// delegation strategy, each one capped at the validator difference so the remaining amount is left to the caller.
+func distributeCoinsAmongstValSet(
+	strategy delegation.Strategy,
+	ws types.WeightedAddressAmounts,
+	delegationState types.DelegationState,
+	coin sdk.Coin,
+	undelegating bool,
+) (types.ValAddressAmounts, sdk.Coin, error) {
+	delegationMap := types.GetHostAccountDelegationMap(delegationState.HostAccountDelegations)
+
+	validators := make([]delegation.Validator, 0, len(ws))
+	listed := make(map[string]bool, len(ws))
+	for _, w := range ws {
+		delegated := sdk.ZeroInt()
+		if curCoins, ok := delegationMap[w.Address]; ok && curCoins.Denom == coin.Denom {
+			delegated = curCoins.Amount
+		}
+		validators = append(validators, delegation.Validator{Address: w.Address, Weight: w.Weight, Delegated: delegated})
+		listed[w.Address] = true
+	}
+
+	// delegations outside the validator set still count towards the total delegations
+	for _, del := range delegationState.HostAccountDelegations {
+		if !listed[del.ValidatorAddress] && del.Amount.Denom == coin.Denom {
+			validators = append(validators, delegation.Validator{
+				Address:   del.ValidatorAddress,
+				Weight:    sdk.ZeroDec(),
+				Delegated: del.Amount.Amount,
+			})
+		}
+	}
+
+	allocations, err := strategy.Allocate(validators, coin.Amount, undelegating)
+	if err != nil {
+		return nil, coin, errorsmod.Wrap(types.ErrInvalidMsgs, err.Error())
+	}
+
+	allocationMap := make(map[string]math.Int, len(allocations))
+	for _, allocation := range allocations {
+		allocationMap[allocation.Address] = allocation.Amount
+	}
+
 	var valAddrAmts types.ValAddressAmounts
 
 	for _, w := range ws {
-		if coin.Amount.LTE(w.Amount) {
-			valAddrAmts = append(valAddrAmts, types.ValAddressAmount{ValidatorAddr: w.Address, Amount: coin})
-			return valAddrAmts, sdk.NewInt64Coin(coin.Denom, 0)
+		amount, ok := allocationMap[w.Address]
+		if !ok || !w.Amount.IsPositive() {
+			amount = sdk.ZeroInt()
 		}
 
-		tempAmount := w.Amount
-		if w.Amount.IsNegative() {
-			tempAmount = sdk.ZeroInt()
+		if coin.Amount.LTE(amount) {
+			valAddrAmts = append(valAddrAmts, types.ValAddressAmount{ValidatorAddr: w.Address, Amount: coin})
+			return valAddrAmts, sdk.NewInt64Coin(coin.Denom, 0), nil
 		}
 
-		valAddrAmts = append(valAddrAmts, types.ValAddressAmount{ValidatorAddr: w.Address, Amount: sdk.NewCoin(w.Denom, tempAmount)})
-		coin = coin.SubAmount(tempAmount)
+		amount = math.MinInt(amount, math.MaxInt(w.Amount, sdk.ZeroInt()))
+
+		valAddrAmts = append(valAddrAmts, types.ValAddressAmount{ValidatorAddr: w.Address, Amount: sdk.NewCoin(w.Denom, amount)})
+		coin = coin.SubAmount(amount)
 	}
 
-	return valAddrAmts, coin
+	return valAddrAmts, coin, nil
 }
 
-// DivideAmountIntoValidatorSet : divides amount into validator set
-func DivideAmountIntoValidatorSet(sortedValDiff types.WeightedAddressAmounts, coin sdk.Coin) (types.ValAddressAmounts, error) {
+// DivideAmountIntoValidatorSet : divides amount into validator set using the delegation strategy
+func DivideAmountIntoValidatorSet(
+	strategy delegation.Strategy,
+	sortedValDiff types.WeightedAddressAmounts,
+	delegationState types.DelegationState,
+	coin sdk.Coin,
+) (types.ValAddressAmounts, error) {
 	if coin.IsZero() {
 		return nil, nil
 	}
@@ -203,7 +263,10 @@ func DivideAmountIntoValidatorSet(sortedValDiff types.WeightedAddressAmounts, co
 	_, nonZeroWeighted := types.GetZeroNonZeroWightedAddrAmts(sortedValDiff)
 	sort.Sort(sort.Reverse(nonZeroWeighted))
 
-	valAmounts, remainderCoin := distributeCoinsAmongstValSet(nonZeroWeighted, coin)
+	valAmounts, remainderCoin, err := distributeCoinsAmongstValSet(strategy, nonZeroWeighted, delegationState, coin, false)
+	if err != nil {
+		return nil, err
+	}
 
 	// If the remaining amount is not possitive, return early
 	if !remainderCoin.IsPositive() {
@@ -220,10 +283,16 @@ func DivideAmountIntoValidatorSet(sortedValDiff types.WeightedAddressAmounts, co
 	return valAmounts, nil
 }
 
-// DivideUndelegateAmountIntoValidatorSet : divides undelegation amount into validator set
+// DivideUndelegateAmountIntoValidatorSet : divides undelegation amount into validator set using the delegation
+// strategy
 //
 //nolint:gocritic,len_not_fixed
-func DivideUndelegateAmountIntoValidatorSet(sortedValDiff types.WeightedAddressAmounts, coin sdk.Coin) (types.ValAddressAmounts, error) {
+func DivideUndelegateAmountIntoValidatorSet(
+	strategy delegation.Strategy,
+	sortedValDiff types.WeightedAddressAmounts,
+	delegationState types.DelegationState,
+	coin sdk.Coin,
+) (types.ValAddressAmounts, error) {
 	if coin.IsZero() {
 		return nil, nil
 	}
@@ -234,7 +303,10 @@ func DivideUndelegateAmountIntoValidatorSet(sortedValDiff types.WeightedAddressA
 	sort.Sort(sort.Reverse(nonZeroWeighted))
 	valWeighted := append(zeroWeighted, nonZeroWeighted...)
 
-	valAmounts, remainderCoin := distributeCoinsAmongstValSet(valWeighted, coin)
+	valAmounts, remainderCoin, err := distributeCoinsAmongstValSet(strategy, valWeighted, delegationState, coin, true)
+	if err != nil {
+		return nil, err
+	}
 
 	// If the remaining amount is not positive, return early
 	if !remainderCoin.IsPositive() {
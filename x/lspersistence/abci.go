@@ -10,8 +10,11 @@ import (
 	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
 )
 
-// BeginBlocker updates liquid validator set changes for the current block
+// BeginBlocker scores the whitelisted validators, updates liquid validator set changes and processes the unstake
+// epochs for the current block
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	k.ScoreValidators(ctx)
 	k.UpdateLiquidValidatorSet(ctx)
+	k.ProcessUnstakeEpochs(ctx)
 }
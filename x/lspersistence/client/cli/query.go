@@ -27,6 +27,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryLiquidValidators(),
 		GetCmdQueryStates(),
+		GetCmdQueryVotingPower(),
+		GetCmdQueryPendingUnstakes(),
+		GetCmdQueryClaimableUnstakes(),
+		GetCmdQueryRebalancing(),
+		GetCmdQueryValidatorScores(),
 	)
 
 	return liquidValidatorQueryCmd
@@ -153,3 +158,200 @@ $ %s query %s states
 
 	return cmd
 }
+
+// GetCmdQueryVotingPower implements the query voting power command.
+func GetCmdQueryVotingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power [voter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the voting power of a voter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the staking, liquid staking and validator voting power of a voter.
+
+Example:
+$ %s query %s voting-power persistence1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VotingPower(
+				cmd.Context(),
+				&types.QueryVotingPowerRequest{Voter: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.VotingPower)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingUnstakes implements the query pending unstakes command.
+func GetCmdQueryPendingUnstakes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-unstakes [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the queued liquid unstakes of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the liquid unstakes of a delegator queued in the unstake epochs and not claimed yet.
+
+Example:
+$ %s query %s pending-unstakes persistence1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingUnstakes(
+				cmd.Context(),
+				&types.QueryPendingUnstakesRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryClaimableUnstakes implements the query claimable unstakes command.
+func GetCmdQueryClaimableUnstakes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-unstakes [delegator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the matured liquid unstakes of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the liquid unstakes of a delegator whose batch unbonding has completed and the amount they can claim.
+
+Example:
+$ %s query %s claimable-unstakes persistence1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimableUnstakes(
+				cmd.Context(),
+				&types.QueryClaimableUnstakesRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryRebalancing implements the query rebalancing command.
+func GetCmdQueryRebalancing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalancing",
+		Args:  cobra.NoArgs,
+		Short: "Query the rebalancing state of the liquid validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the target and the actual liquid tokens of the liquid validators and the in-flight rebalancing redelegations.
+
+Example:
+$ %s query %s rebalancing
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Rebalancing(cmd.Context(), &types.QueryRebalancingRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryValidatorScores implements the query validator scores command.
+func GetCmdQueryValidatorScores() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-scores [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the score history of a whitelisted validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries the performance scores sampled for a whitelisted validator, the latest score first.
+
+Example:
+$ %s query %s validator-scores persistencevaloper1...
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorScores(
+				cmd.Context(),
+				&types.QueryValidatorScoresRequest{ValidatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -33,6 +33,8 @@ func GetTxCmd() *cobra.Command {
 	liquidstakingTxCmd.AddCommand(
 		NewLiquidStakeCmd(),
 		NewLiquidUnstakeCmd(),
+		NewRedeemCmd(),
+		NewClaimCmd(),
 		NewUpdateParamsCmd(),
 	)
 
@@ -117,6 +119,77 @@ $ %s tx %s liquid-unstake 500stake --from mykey
 	return cmd
 }
 
+// NewRedeemCmd implements the instant redeem coin command handler.
+func NewRedeemCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Instantly redeem liquid bond coin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Instantly redeem liquid bond coin out of the liquidity buffer, paying the redemption fee.
+
+Example:
+$ %s tx %s redeem 500stk/uxprt --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidStaker := clientCtx.GetFromAddress()
+
+			redeemCoin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRedeem(liquidStaker, redeemCoin)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewClaimCmd implements the claim matured unstakes command handler.
+func NewClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Args:  cobra.NoArgs,
+		Short: "Claim the matured liquid unstakes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the native tokens of the liquid unstakes queued in the unstake epochs whose batch unbonding has completed.
+
+Example:
+$ %s tx %s claim --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaim(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewUpdateParamsCmd implements the liquid unstake coin command handler.
 func NewUpdateParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -17,12 +17,35 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	if genState.Params.WhitelistedValidators == nil || len(genState.Params.WhitelistedValidators) == 0 {
 		genState.Params.WhitelistedValidators = []types.WhitelistedValidator{}
 	}
+	if len(genState.Params.WhitelistedPools) == 0 {
+		genState.Params.WhitelistedPools = []types.WhitelistedPool{}
+	}
 	k.SetParams(ctx, genState.Params)
 
 	for _, lv := range genState.LiquidValidators {
 		k.SetLiquidValidator(ctx, lv)
 	}
 
+	k.SetUnstakeEpoch(ctx, genState.UnstakeEpoch)
+	for _, batch := range genState.UnstakeBatches {
+		k.SetUnstakeBatch(ctx, batch)
+	}
+	for _, pu := range genState.PendingUnstakes {
+		k.SetPendingUnstake(ctx, pu)
+	}
+	for _, red := range genState.InFlightRedelegations {
+		k.SetInFlightRedelegation(ctx, red)
+	}
+	if genState.LastRebalancingHeight != 0 {
+		k.SetLastRebalancingHeight(ctx, genState.LastRebalancingHeight)
+	}
+	for _, score := range genState.ValidatorScores {
+		k.SetValidatorScore(ctx, score)
+	}
+	if genState.LastScoringHeight != 0 {
+		k.SetLastScoringHeight(ctx, genState.LastScoringHeight)
+	}
+
 	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
@@ -36,7 +59,36 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if params.WhitelistedValidators == nil || len(params.WhitelistedValidators) == 0 {
 		params.WhitelistedValidators = []types.WhitelistedValidator{}
 	}
+	if len(params.WhitelistedPools) == 0 {
+		params.WhitelistedPools = []types.WhitelistedPool{}
+	}
 
 	liquidValidators := k.GetAllLiquidValidators(ctx)
-	return types.NewGenesisState(params, liquidValidators)
+	unstakeBatches := k.GetAllUnstakeBatches(ctx)
+	if unstakeBatches == nil {
+		unstakeBatches = []types.UnstakeBatch{}
+	}
+	pendingUnstakes := k.GetAllPendingUnstakes(ctx)
+	if pendingUnstakes == nil {
+		pendingUnstakes = []types.PendingUnstake{}
+	}
+	inFlightRedelegations := k.GetAllInFlightRedelegations(ctx)
+	if inFlightRedelegations == nil {
+		inFlightRedelegations = []types.InFlightRedelegation{}
+	}
+	validatorScores := k.GetAllValidatorScores(ctx)
+	if validatorScores == nil {
+		validatorScores = []types.ValidatorScore{}
+	}
+	return types.NewGenesisState(
+		params,
+		liquidValidators,
+		k.GetUnstakeEpoch(ctx),
+		unstakeBatches,
+		pendingUnstakes,
+		inFlightRedelegations,
+		k.GetLastRebalancingHeight(ctx),
+		validatorScores,
+		k.GetLastScoringHeight(ctx),
+	)
 }
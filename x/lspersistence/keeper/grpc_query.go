@@ -44,3 +44,95 @@ func (k Querier) States(c context.Context, req *types.QueryStatesRequest) (*type
 
 	return &types.QueryStatesResponse{NetAmountState: k.GetNetAmountState(ctx)}, nil
 }
+
+// VotingPower queries the voting power of a voter.
+func (k Querier) VotingPower(c context.Context, req *types.QueryVotingPowerRequest) (*types.QueryVotingPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid voter address: %s", err)
+	}
+
+	return &types.QueryVotingPowerResponse{VotingPower: k.GetVotingPower(ctx, voter)}, nil
+}
+
+// PendingUnstakes queries the queued liquid unstakes of a delegator.
+func (k Querier) PendingUnstakes(c context.Context, req *types.QueryPendingUnstakesRequest) (*types.QueryPendingUnstakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+	}
+
+	pus := k.GetPendingUnstakesByDelegator(ctx, delAddr)
+	if pus == nil {
+		pus = []types.PendingUnstake{}
+	}
+	return &types.QueryPendingUnstakesResponse{PendingUnstakes: pus}, nil
+}
+
+// ClaimableUnstakes queries the matured liquid unstakes of a delegator and their claimable amount.
+func (k Querier) ClaimableUnstakes(c context.Context, req *types.QueryClaimableUnstakesRequest) (*types.QueryClaimableUnstakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address: %s", err)
+	}
+
+	pus, amount := k.GetClaimableUnstakes(ctx, delAddr)
+	if pus == nil {
+		pus = []types.PendingUnstake{}
+	}
+	return &types.QueryClaimableUnstakesResponse{
+		ClaimableUnstakes: pus,
+		Amount:            sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), amount),
+	}, nil
+}
+
+// Rebalancing queries the target and the actual liquid tokens of the liquid validators and the in-flight redelegations.
+func (k Querier) Rebalancing(c context.Context, req *types.QueryRebalancingRequest) (*types.QueryRebalancingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	reds := k.GetAllInFlightRedelegations(ctx)
+	if reds == nil {
+		reds = []types.InFlightRedelegation{}
+	}
+	return &types.QueryRebalancingResponse{
+		Targets:               k.GetRebalancingTargets(ctx),
+		InFlightRedelegations: reds,
+	}, nil
+}
+
+// ValidatorScores queries the score history of a whitelisted validator.
+func (k Querier) ValidatorScores(c context.Context, req *types.QueryValidatorScoresRequest) (*types.QueryValidatorScoresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address: %s", err)
+	}
+
+	scores := k.GetValidatorScores(ctx, valAddr)
+	if scores == nil {
+		scores = []types.ValidatorScore{}
+	}
+	return &types.QueryValidatorScoresResponse{Scores: scores}, nil
+}
@@ -37,6 +37,8 @@ func (k Keeper) GetNetAmountState(ctx sdk.Context) (nas types.NetAmountState) {
 		TotalRemainingRewards: totalRemainingRewards,
 		TotalUnbondingBalance: totalUnbondingBalance,
 		ProxyAccBalance:       k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc).Amount,
+		// the queued liquid unstakes are still delegated but their btoken are already burned
+		TotalPendingUnstakeAmount: k.GetTotalPendingUnstakeAmount(ctx),
 	}
 
 	nas.NetAmount = nas.CalcNetAmount()
@@ -62,7 +64,7 @@ func (k Keeper) LiquidStake(
 		)
 	}
 
-	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	whitelistedValsMap := k.GetEffectiveWhitelistedValsMap(ctx, params)
 	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
 	if activeVals.Len() == 0 || !activeVals.TotalWeight(whitelistedValsMap).IsPositive() {
 		return sdk.ZeroDec(), sdk.ZeroInt(), types.ErrActiveLiquidValidatorsNotExists
@@ -252,6 +254,80 @@ func (k Keeper) LiquidUnstake(
 	return ubdTime, totalReturnAmount, ubds, sdk.ZeroInt(), totalFeeAmount, nil
 }
 
+// GetLiquidityBuffer returns the amount of native tokens kept undelegated in LiquidStakingProxyAcc for instant redemptions.
+func (k Keeper) GetLiquidityBuffer(ctx sdk.Context, nas types.NetAmountState) math.Int {
+	return k.GetParams(ctx).LiquidityBufferRate.Mul(nas.NetAmount).TruncateInt()
+}
+
+// Redeem burns redeemBtoken and instantly pays out its worth of native tokens according to NetAmount out of the proxy
+// account balance, withdrawing the accumulated rewards when the balance is not enough. RedemptionFeeRate is charged
+// to the fee address.
+func (k Keeper) Redeem(
+	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, redeemBtoken sdk.Coin,
+) (redeemedAmount math.Int, fee math.Int, err error) {
+	// check bond denomination
+	params := k.GetParams(ctx)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	if redeemBtoken.Denom != liquidBondDenom {
+		return sdk.ZeroInt(), sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInvalidLiquidBondDenom, "invalid coin denomination: got %s, expected %s", redeemBtoken.Denom, liquidBondDenom,
+		)
+	}
+
+	// Get NetAmount states
+	nas := k.GetNetAmountState(ctx)
+
+	if redeemBtoken.Amount.GT(nas.BtokenTotalSupply) {
+		return sdk.ZeroInt(), sdk.ZeroInt(), types.ErrInvalidBTokenSupply
+	}
+
+	// RedeemAmount = NetAmount * BTokenAmount/TotalSupply * (1-RedemptionFeeRate)
+	redeemAmount := types.BTokenToNativeToken(redeemBtoken.Amount, nas.BtokenTotalSupply, nas.NetAmount)
+	if !redeemAmount.TruncateInt().IsPositive() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), types.ErrTooSmallRedeemAmount
+	}
+
+	// the redemption is paid out of the proxy account balance, topped up with the accumulated rewards
+	if nas.ProxyAccBalance.LT(redeemAmount.TruncateInt()) {
+		k.WithdrawLiquidRewards(ctx, proxyAcc)
+	}
+	proxyAccBalance := k.GetProxyAccBalance(ctx, proxyAcc)
+	if proxyAccBalance.Amount.LT(redeemAmount.TruncateInt()) {
+		return sdk.ZeroInt(), sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInsufficientRedeemLiquidity, "available %s, required %s%s", proxyAccBalance, redeemAmount.TruncateInt(), bondDenom,
+		)
+	}
+
+	// burn btoken
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, liquidStaker, types.ModuleName, sdk.NewCoins(redeemBtoken))
+	if err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(redeemBtoken))
+	if err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+
+	feeAdjustedRedeemAmount := types.DeductFeeRate(redeemAmount, params.RedemptionFeeRate)
+	fee = redeemAmount.Sub(feeAdjustedRedeemAmount).TruncateInt()
+	if fee.IsPositive() {
+		err = k.bankKeeper.SendCoins(ctx, proxyAcc, sdk.MustAccAddressFromBech32(params.FeeAddress),
+			sdk.NewCoins(sdk.NewCoin(bondDenom, fee)))
+		if err != nil {
+			return sdk.ZeroInt(), sdk.ZeroInt(), err
+		}
+	}
+
+	redeemedAmount = feeAdjustedRedeemAmount.TruncateInt()
+	err = k.bankKeeper.SendCoins(ctx, proxyAcc, liquidStaker, sdk.NewCoins(sdk.NewCoin(bondDenom, redeemedAmount)))
+	if err != nil {
+		return sdk.ZeroInt(), sdk.ZeroInt(), err
+	}
+
+	return redeemedAmount, fee, nil
+}
+
 // LiquidUnbond unbond delegation shares to active validators by proxy account.
 func (k Keeper) LiquidUnbond(
 	ctx sdk.Context, proxyAcc, liquidStaker sdk.AccAddress, valAddr sdk.ValAddress,
@@ -395,7 +471,7 @@ func (k Keeper) GetActiveLiquidValidators(ctx sdk.Context, whitelistedValsMap ty
 
 func (k Keeper) GetAllLiquidValidatorStates(ctx sdk.Context) (liquidValidatorStates []types.LiquidValidatorState) {
 	lvs := k.GetAllLiquidValidators(ctx)
-	whitelistedValsMap := k.GetParams(ctx).WhitelistedValsMap()
+	whitelistedValsMap := k.GetEffectiveWhitelistedValsMap(ctx, k.GetParams(ctx))
 	for _, lv := range lvs {
 		active := k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap)
 		lvState := types.LiquidValidatorState{
@@ -421,7 +497,7 @@ func (k Keeper) GetLiquidValidatorState(ctx sdk.Context, addr sdk.ValAddress) (l
 			LiquidTokens:    sdk.ZeroInt(),
 		}, false
 	}
-	whitelistedValsMap := k.GetParams(ctx).WhitelistedValsMap()
+	whitelistedValsMap := k.GetEffectiveWhitelistedValsMap(ctx, k.GetParams(ctx))
 	active := k.IsActiveLiquidValidator(ctx, lv, whitelistedValsMap)
 	return types.LiquidValidatorState{
 		OperatorAddress: lv.OperatorAddress,
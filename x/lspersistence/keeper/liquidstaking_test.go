@@ -333,3 +333,54 @@ func (s *KeeperTestSuite) TestLiquidUnstakeEdgeCases() {
 	s.Require().EqualValues(ubdTime, time.Time{})
 	s.Require().Len(ubds, 0)
 }
+
+func (s *KeeperTestSuite) TestRedeem() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.StakeFeeRate = sdk.ZeroDec()
+	params.LiquidityBufferRate = sdk.NewDecWithPrec(1, 1)
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+
+	// fail when invalid liquid bond denom
+	_, _, err := s.keeper.Redeem(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	s.Require().ErrorIs(err, types.ErrInvalidLiquidBondDenom)
+
+	// fail when there is no liquidity to redeem from
+	_, _, err = s.keeper.Redeem(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000)))
+	s.Require().ErrorIs(err, types.ErrInsufficientRedeemLiquidity)
+
+	// the rewards are re-staked except for the liquidity buffer
+	s.advanceHeight(100, false)
+	liquidityBuffer := s.keeper.GetLiquidityBuffer(s.ctx, s.keeper.GetNetAmountState(s.ctx))
+	s.Require().True(liquidityBuffer.IsPositive())
+	s.keeper.WithdrawRewardsAndReStake(s.ctx, params.WhitelistedValsMap())
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(liquidityBuffer, nas.ProxyAccBalance)
+
+	redeemBToken := sdk.NewCoin(params.LiquidBondDenom, types.NativeTokenToBToken(nas.ProxyAccBalance.QuoRaw(2), nas.BtokenTotalSupply, nas.NetAmount))
+	redeemAmount := types.BTokenToNativeToken(redeemBToken.Amount, nas.BtokenTotalSupply, nas.NetAmount)
+	expectedFee := redeemAmount.Sub(types.DeductFeeRate(redeemAmount, params.RedemptionFeeRate)).TruncateInt()
+
+	feeAddress := sdk.MustAccAddressFromBech32(params.FeeAddress)
+	feeBalanceBefore := s.app.BankKeeper.GetBalance(s.ctx, feeAddress, sdk.DefaultBondDenom).Amount
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[0], sdk.DefaultBondDenom).Amount
+
+	redeemedAmount, fee, err := s.keeper.Redeem(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], redeemBToken)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedFee, fee)
+	s.Require().Equal(types.DeductFeeRate(redeemAmount, params.RedemptionFeeRate).TruncateInt(), redeemedAmount)
+	s.Require().Equal(balanceBefore.Add(redeemedAmount), s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[0], sdk.DefaultBondDenom).Amount)
+	s.Require().Equal(feeBalanceBefore.Add(fee), s.app.BankKeeper.GetBalance(s.ctx, feeAddress, sdk.DefaultBondDenom).Amount)
+	s.Require().Equal(nas.BtokenTotalSupply.Sub(redeemBToken.Amount), s.app.BankKeeper.GetSupply(s.ctx, params.LiquidBondDenom).Amount)
+
+	// fail when the redemption exceeds the liquidity buffer
+	_, _, err = s.keeper.Redeem(s.ctx, types.LiquidStakingProxyAcc, s.delAddrs[0], redeemBToken.AddAmount(sdk.NewInt(1000000)))
+	s.Require().ErrorIs(err, types.ErrInsufficientRedeemLiquidity)
+}
@@ -56,6 +56,10 @@ func (k msgServer) LiquidStake(goCtx context.Context, msg *types.MsgLiquidStake)
 func (k msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnstake) (*types.MsgLiquidUnstakeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if k.GetParams(ctx).UnstakeEpochDuration > 0 {
+		return k.queueLiquidUnstake(ctx, msg)
+	}
+
 	completionTime, unbondingAmount, _, unbondedAmount, fee, err := k.Keeper.LiquidUnstake(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.Amount)
 	if err != nil {
 		return nil, err
@@ -82,6 +86,81 @@ func (k msgServer) LiquidUnstake(goCtx context.Context, msg *types.MsgLiquidUnst
 	}, nil
 }
 
+// queueLiquidUnstake queues the liquid unstake to the batch of the current unstake epoch.
+func (k msgServer) queueLiquidUnstake(ctx sdk.Context, msg *types.MsgLiquidUnstake) (*types.MsgLiquidUnstakeResponse, error) {
+	pu, fee, err := k.Keeper.QueueLiquidUnstake(ctx, msg.GetDelegator(), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime := k.GetUnstakeEpochCompletionTime(ctx)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgLiquidUnstake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, sdk.NewIntFromUint64(pu.EpochNumber).String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyPstakeUnstakeFee, sdk.Coin{Denom: bondDenom, Amount: fee}.String()),
+		),
+	})
+	return &types.MsgLiquidUnstakeResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+func (k msgServer) Claim(goCtx context.Context, msg *types.MsgClaim) (*types.MsgClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	claimed, err := k.Keeper.Claim(ctx, msg.GetDelegator())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgClaim,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, claimed.String()),
+		),
+	})
+	return &types.MsgClaimResponse{Amount: claimed}, nil
+}
+
+func (k msgServer) Redeem(goCtx context.Context, msg *types.MsgRedeem) (*types.MsgRedeemResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redeemedAmount, fee, err := k.Keeper.Redeem(ctx, types.LiquidStakingProxyAcc, msg.GetDelegator(), msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeMsgRedeem,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemedAmount, sdk.Coin{Denom: bondDenom, Amount: redeemedAmount}.String()),
+			sdk.NewAttribute(types.AttributeKeyPstakeRedeemFee, sdk.Coin{Denom: bondDenom, Amount: fee}.String()),
+		),
+	})
+	return &types.MsgRedeemResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
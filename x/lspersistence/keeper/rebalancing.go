@@ -48,19 +48,14 @@ func (k Keeper) TryRedelegation(ctx sdk.Context, re types.Redelegation) (complet
 	return completionTime, nil
 }
 
-// Rebalance argument liquidVals containing ValidatorStatusActive which is containing just added on whitelist(liquidToken 0) and ValidatorStatusInactive to delist
-func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, rebalancingTrigger sdk.Dec) (redelegations []types.Redelegation) {
-	logger := k.Logger(ctx)
-	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
-	if !totalLiquidTokens.IsPositive() {
-		return []types.Redelegation{}
-	}
-
+// GetRebalancingTargetMap returns the liquid tokens each liquid validator should have according to its weight, the crumb
+// going to the first liquid validator with a positive target.
+func (k Keeper) GetRebalancingTargetMap(ctx sdk.Context, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, totalLiquidTokens math.Int) (map[string]math.Int, bool) {
 	weightMap, totalWeight := k.GetWeightMap(ctx, liquidVals, whitelistedValsMap)
 
 	// no active liquid validators
 	if !totalWeight.IsPositive() {
-		return []types.Redelegation{}
+		return nil, false
 	}
 
 	// calculate rebalancing target map
@@ -72,7 +67,7 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 	}
 	crumb := totalLiquidTokens.Sub(totalTargetMap)
 	if !totalTargetMap.IsPositive() {
-		return []types.Redelegation{}
+		return nil, false
 	}
 	// crumb to first non zero liquid validator
 	for _, val := range liquidVals {
@@ -81,13 +76,35 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 			break
 		}
 	}
+	return targetMap, true
+}
+
+// Rebalance argument liquidVals containing ValidatorStatusActive which is containing just added on whitelist(liquidToken 0) and ValidatorStatusInactive to delist
+// The validators receiving in-flight redelegations are not picked as source, as transitive redelegations would fail.
+func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals types.LiquidValidators, whitelistedValsMap types.WhitelistedValsMap, rebalancingTrigger sdk.Dec) (redelegations []types.Redelegation) {
+	logger := k.Logger(ctx)
+	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
+	if !totalLiquidTokens.IsPositive() {
+		return []types.Redelegation{}
+	}
+
+	targetMap, ok := k.GetRebalancingTargetMap(ctx, liquidVals, whitelistedValsMap, totalLiquidTokens)
+	if !ok {
+		return []types.Redelegation{}
+	}
+
+	maxRedelegations := liquidVals.Len()
+	if maxRedelegationsPerBlock := int(k.GetParams(ctx).MaxRedelegationsPerBlock); maxRedelegationsPerBlock > 0 && maxRedelegationsPerBlock < maxRedelegations {
+		maxRedelegations = maxRedelegationsPerBlock
+	}
+	receivingMap := k.GetReceivingValidatorsMap(ctx)
 
 	failCount := 0
 	rebalancingThresholdAmt := rebalancingTrigger.Mul(sdk.NewDecFromInt(totalLiquidTokens)).TruncateInt()
 
-	for i := 0; i < liquidVals.Len(); i++ {
+	for i := 0; i < maxRedelegations; i++ {
 		// get min, max of liquid token gap
-		minVal, maxVal, amountNeeded, last := liquidVals.MinMaxGap(targetMap, liquidTokenMap)
+		minVal, maxVal, amountNeeded, last := liquidVals.MinMaxGapExcludingSrc(targetMap, liquidTokenMap, receivingMap)
 		if amountNeeded.IsZero() || (i == 0 && !amountNeeded.GT(rebalancingThresholdAmt)) {
 			break
 		}
@@ -104,10 +121,18 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 			Amount:       amountNeeded,
 			Last:         last,
 		}
-		_, err := k.TryRedelegation(ctx, redelegation)
+		completionTime, err := k.TryRedelegation(ctx, redelegation)
 		if err != nil {
 			redelegation.Error = err
 			failCount++
+		} else {
+			k.SetInFlightRedelegation(ctx, types.InFlightRedelegation{
+				SrcValidatorAddress: maxVal.OperatorAddress,
+				DstValidatorAddress: minVal.OperatorAddress,
+				Amount:              amountNeeded,
+				CompletionTime:      completionTime,
+			})
+			receivingMap[minVal.OperatorAddress] = struct{}{}
 		}
 		redelegations = append(redelegations, redelegation)
 	}
@@ -131,35 +156,148 @@ func (k Keeper) Rebalance(ctx sdk.Context, proxyAcc sdk.AccAddress, liquidVals t
 	return redelegations
 }
 
-// WithdrawRewardsAndReStake withdraw rewards and re-staking when over threshold
+// GetRebalancingTargets returns the target and the actual liquid tokens of the liquid validators.
+func (k Keeper) GetRebalancingTargets(ctx sdk.Context) []types.RebalancingTarget {
+	params := k.GetParams(ctx)
+	liquidVals := k.GetAllLiquidValidators(ctx)
+	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
+	targetMap, ok := k.GetRebalancingTargetMap(ctx, liquidVals, k.GetEffectiveWhitelistedValsMap(ctx, params), totalLiquidTokens)
+
+	targets := []types.RebalancingTarget{}
+	for _, val := range liquidVals {
+		target := sdk.ZeroInt()
+		if ok {
+			target = targetMap[val.OperatorAddress]
+		}
+		targets = append(targets, types.RebalancingTarget{
+			ValidatorAddress:   val.OperatorAddress,
+			TargetLiquidTokens: target,
+			LiquidTokens:       liquidTokenMap[val.OperatorAddress],
+		})
+	}
+	return targets
+}
+
+// IsRebalancingCooledDown returns whether RebalancingCooldownBlocks have passed since the last rebalancing.
+func (k Keeper) IsRebalancingCooledDown(ctx sdk.Context) bool {
+	lastHeight := k.GetLastRebalancingHeight(ctx)
+	if lastHeight == 0 {
+		return true
+	}
+	return uint64(ctx.BlockHeight()) >= lastHeight+k.GetParams(ctx).RebalancingCooldownBlocks
+}
+
+// GetLastRebalancingHeight returns the height of the last rebalancing which redelegated.
+func (k Keeper) GetLastRebalancingHeight(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastRebalancingHeightKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastRebalancingHeight sets the height of the last rebalancing which redelegated.
+func (k Keeper) SetLastRebalancingHeight(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastRebalancingHeightKey, sdk.Uint64ToBigEndian(height))
+}
+
+// SetInFlightRedelegation sets the in-flight redelegation, adding up the ones between the same validators completing at the same time.
+func (k Keeper) SetInFlightRedelegation(ctx sdk.Context, red types.InFlightRedelegation) {
+	store := ctx.KVStore(k.storeKey)
+	srcValAddr, err := sdk.ValAddressFromBech32(red.SrcValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	dstValAddr, err := sdk.ValAddressFromBech32(red.DstValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	key := types.GetInFlightRedelegationKey(red.CompletionTime, srcValAddr, dstValAddr)
+	if bz := store.Get(key); bz != nil {
+		var existing types.InFlightRedelegation
+		k.cdc.MustUnmarshal(bz, &existing)
+		red.Amount = red.Amount.Add(existing.Amount)
+	}
+	store.Set(key, k.cdc.MustMarshal(&red))
+}
+
+// GetAllInFlightRedelegations returns all the in-flight redelegations ordered by completion time.
+func (k Keeper) GetAllInFlightRedelegations(ctx sdk.Context) (reds []types.InFlightRedelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.InFlightRedelegationsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var red types.InFlightRedelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &red)
+		reds = append(reds, red)
+	}
+	return reds
+}
+
+// PruneCompletedRedelegations removes the in-flight redelegations completed by the current block time.
+func (k Keeper) PruneCompletedRedelegations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.InFlightRedelegationsKey, sdk.PrefixEndBytes(types.GetInFlightRedelegationsByTimeKey(ctx.BlockTime())))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetReceivingValidatorsMap returns the destination validators of the in-flight redelegations.
+func (k Keeper) GetReceivingValidatorsMap(ctx sdk.Context) map[string]struct{} {
+	receivingMap := map[string]struct{}{}
+	for _, red := range k.GetAllInFlightRedelegations(ctx) {
+		if red.CompletionTime.After(ctx.BlockTime()) {
+			receivingMap[red.DstValidatorAddress] = struct{}{}
+		}
+	}
+	return receivingMap
+}
+
+// WithdrawRewardsAndReStake withdraw rewards and re-staking when over threshold, keeping the liquidity buffer
+// undelegated in LiquidStakingProxyAcc for instant redemptions
 func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap types.WhitelistedValsMap) {
-	totalRemainingRewards, _, totalLiquidTokens := k.CheckDelegationStates(ctx, types.LiquidStakingProxyAcc)
+	params := k.GetParams(ctx)
+	nas := k.GetNetAmountState(ctx)
+	liquidityBuffer := k.GetLiquidityBuffer(ctx, nas)
 
 	// checking over types.RewardTrigger and execute GetRewards
 	proxyAccBalance := k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
-	rewardsThreshold := types.RewardTrigger.Mul(sdk.NewDecFromInt(totalLiquidTokens))
+	rewardsThreshold := types.RewardTrigger.Mul(sdk.NewDecFromInt(nas.TotalLiquidTokens))
 
-	// skip If it doesn't exceed the rewards threshold
-	if !sdk.NewDecFromInt(proxyAccBalance.Amount).Add(totalRemainingRewards).GT(rewardsThreshold) {
+	// skip If it doesn't exceed the rewards threshold, the balance kept as liquidity buffer is not re-staked
+	restakeAmount := sdk.MaxInt(proxyAccBalance.Amount.Sub(liquidityBuffer), sdk.ZeroInt())
+	if !sdk.NewDecFromInt(restakeAmount).Add(nas.TotalRemainingRewards).GT(rewardsThreshold) {
 		return
 	}
 
 	// Withdraw rewards of LiquidStakingProxyAcc and re-staking
 	k.WithdrawLiquidRewards(ctx, types.LiquidStakingProxyAcc)
 
-	// re-staking with proxyAccBalance, due to auto-withdraw on add staking by f1
+	// re-staking with proxyAccBalance over the liquidity buffer, due to auto-withdraw on add staking by f1
 	proxyAccBalance = k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
+	restakeAmount = proxyAccBalance.Amount.Sub(liquidityBuffer)
+	if !restakeAmount.IsPositive() {
+		return
+	}
 
-	//remove balance here for restaking fee. and reset proxyAccBalance
-	params := k.GetParams(ctx)
-	restakeFee := sdk.NewCoin(proxyAccBalance.Denom, params.RestakeFeeRate.MulInt(proxyAccBalance.Amount).TruncateInt())
+	//remove restaking fee from the re-staked amount.
+	restakeFee := sdk.NewCoin(proxyAccBalance.Denom, params.RestakeFeeRate.MulInt(restakeAmount).TruncateInt())
 	err := k.bankKeeper.SendCoins(ctx, types.LiquidStakingProxyAcc, sdk.MustAccAddressFromBech32(params.FeeAddress), sdk.NewCoins(restakeFee))
 	if err != nil {
 		k.Logger(ctx).Error("re-staking failed while fee collection", "error", err)
 		return
 	}
-	//reset proxyAccBalance
-	proxyAccBalance = k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc)
+	restakeCoin := sdk.NewCoin(proxyAccBalance.Denom, restakeAmount.Sub(restakeFee.Amount))
 
 	// skip when no active liquid validator
 	activeVals := k.GetActiveLiquidValidators(ctx, whitelistedValsMap)
@@ -169,7 +307,7 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 
 	// re-staking
 	cachedCtx, writeCache := ctx.CacheContext()
-	_, err = k.LiquidDelegate(cachedCtx, types.LiquidStakingProxyAcc, activeVals, proxyAccBalance.Amount, whitelistedValsMap)
+	_, err = k.LiquidDelegate(cachedCtx, types.LiquidStakingProxyAcc, activeVals, restakeCoin.Amount, whitelistedValsMap)
 	if err != nil {
 		logger := k.Logger(ctx)
 		logger.Error("re-staking failed", "error", err)
@@ -181,13 +319,13 @@ func (k Keeper) WithdrawRewardsAndReStake(ctx sdk.Context, whitelistedValsMap ty
 		sdk.NewEvent(
 			types.EventTypeReStake,
 			sdk.NewAttribute(types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, proxyAccBalance.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, restakeCoin.String()),
 			sdk.NewAttribute(types.AttributeKeyPstakeRestakeFee, restakeFee.String()),
 		),
 	})
 	logger.Info(types.EventTypeReStake,
 		types.AttributeKeyDelegator, types.LiquidStakingProxyAcc.String(),
-		sdk.AttributeKeyAmount, proxyAccBalance.String(),
+		sdk.AttributeKeyAmount, restakeCoin.String(),
 		types.AttributeKeyPstakeRestakeFee, restakeFee.String())
 }
 
@@ -196,7 +334,7 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 	params := k.GetParams(ctx)
 	liquidValidators := k.GetAllLiquidValidators(ctx)
 	liquidValsMap := liquidValidators.Map()
-	whitelistedValsMap := types.GetWhitelistedValsMap(params.WhitelistedValidators)
+	whitelistedValsMap := k.GetEffectiveWhitelistedValsMap(ctx, params)
 
 	// Set Liquid validators for added whitelist validators
 	for _, wv := range params.WhitelistedValidators {
@@ -220,7 +358,17 @@ func (k Keeper) UpdateLiquidValidatorSet(ctx sdk.Context) []types.Redelegation {
 
 	// rebalancing based updated liquid validators status with threshold, try by cachedCtx
 	// tombstone status also handled on Rebalance
-	reds := k.Rebalance(ctx, types.LiquidStakingProxyAcc, liquidValidators, whitelistedValsMap, types.RebalancingTrigger)
+	var reds []types.Redelegation
+	k.PruneCompletedRedelegations(ctx)
+	if k.IsRebalancingCooledDown(ctx) {
+		reds = k.Rebalance(ctx, types.LiquidStakingProxyAcc, liquidValidators, whitelistedValsMap, params.RebalancingTrigger)
+		for _, red := range reds {
+			if red.Error == nil {
+				k.SetLastRebalancingHeight(ctx, uint64(ctx.BlockHeight()))
+				break
+			}
+		}
+	}
 
 	// unbond all delShares to proxyAcc if delShares exist on inactive liquid validators
 	for _, lv := range liquidValidators {
@@ -410,9 +410,9 @@ func (s *KeeperTestSuite) TestRebalancingConsecutiveCase() {
 	s.keeper.SetParams(s.ctx, params)
 	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 100).WithBlockTime(s.ctx.BlockTime().Add(time.Hour * 24))
 	reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
-	s.Require().Len(reds, 11)
-	// fail rebalancing due to redelegation hopping
-	s.Require().Equal(s.redelegationsErrorCount(reds), 11)
+	// validators receiving in-flight redelegations are not used as source, so no hop is attempted
+	s.Require().Len(reds, 0)
+	s.Require().Len(s.keeper.GetAllInFlightRedelegations(s.ctx), 9)
 	s.printRedelegationsLiquidTokens()
 
 	// complete redelegation and retry
@@ -444,9 +444,9 @@ func (s *KeeperTestSuite) TestRebalancingConsecutiveCase() {
 	s.keeper.SetParams(s.ctx, params)
 	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 100).WithBlockTime(s.ctx.BlockTime().Add(time.Hour * 24))
 	reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
-	s.Require().Len(reds, 6)
-	// fail rebalancing partially due to redelegation hopping
-	s.Require().Equal(s.redelegationsErrorCount(reds), 3)
+	// the hops through validators receiving in-flight redelegations are planned around
+	s.Require().Len(reds, 3)
+	s.Require().Equal(s.redelegationsErrorCount(reds), 0)
 	s.printRedelegationsLiquidTokens()
 
 	// additional liquid staking when not rebalanced
@@ -458,10 +458,8 @@ func (s *KeeperTestSuite) TestRebalancingConsecutiveCase() {
 	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 100).WithBlockTime(s.ctx.BlockTime().Add(time.Hour * 24 * 20).Add(time.Hour))
 	staking.EndBlocker(s.ctx, s.app.StakingKeeper)
 	reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
-	s.Require().Len(reds, 9)
-
-	// failed redelegations with small amount (less than rebalancing trigger)
-	s.Require().Equal(s.redelegationsErrorCount(reds), 6)
+	s.Require().Len(reds, 3)
+	s.Require().Equal(s.redelegationsErrorCount(reds), 0)
 	s.printRedelegationsLiquidTokens()
 
 	// assert rebalanced
@@ -550,3 +548,69 @@ func (s *KeeperTestSuite) TestRemoveAllLiquidValidator() {
 	s.Require().EqualValues(nasAfter2.ProxyAccBalance, nasAfter.ProxyAccBalance.Add(nasBefore.TotalLiquidTokens))
 	s.Require().EqualValues(nasAfter2.NetAmount.Add(params.RestakeFeeRate.Mul(nasBefore.TotalRemainingRewards).TruncateDec()).TruncateInt(), nasBefore.NetAmount.TruncateInt())
 }
+
+func (s *KeeperTestSuite) TestRebalancingPolicy() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	params.StakeFeeRate = sdk.ZeroDec()
+	params.MaxRedelegationsPerBlock = 1
+	params.RebalancingCooldownBlocks = 10
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(300000)))
+
+	params.WhitelistedValidators = append(
+		params.WhitelistedValidators,
+		types.WhitelistedValidator{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	)
+	s.keeper.SetParams(s.ctx, params)
+
+	// a single redelegation per block is executed and tracked until its completion
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 1)
+	s.Require().Equal(s.redelegationsErrorCount(reds), 0)
+	s.Require().Equal(uint64(s.ctx.BlockHeight()), s.keeper.GetLastRebalancingHeight(s.ctx))
+
+	res, err := s.querier.Rebalancing(sdk.WrapSDKContext(s.ctx), &types.QueryRebalancingRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Targets, 3)
+	for _, target := range res.Targets {
+		s.Require().Equal(sdk.NewInt(100000), target.TargetLiquidTokens)
+	}
+	s.Require().Len(res.InFlightRedelegations, 1)
+	s.Require().Equal(valOpers[2].String(), res.InFlightRedelegations[0].DstValidatorAddress)
+	s.Require().Equal(sdk.NewInt(50000), res.InFlightRedelegations[0].Amount)
+	s.Require().Equal(
+		s.ctx.BlockTime().Add(s.app.StakingKeeper.UnbondingTime(s.ctx)),
+		res.InFlightRedelegations[0].CompletionTime,
+	)
+
+	// no rebalancing during the cooldown
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 9)
+	reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 0)
+
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	reds = s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 1)
+	s.Require().Equal(s.redelegationsErrorCount(reds), 0)
+	s.Require().Len(s.keeper.GetAllInFlightRedelegations(s.ctx), 2)
+
+	// the completed redelegations are pruned
+	s.completeRedelegationUnbonding()
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(s.keeper.GetAllInFlightRedelegations(s.ctx), 0)
+
+	res, err = s.querier.Rebalancing(sdk.WrapSDKContext(s.ctx), &types.QueryRebalancingRequest{})
+	s.Require().NoError(err)
+	for _, target := range res.Targets {
+		s.Require().Equal(target.TargetLiquidTokens, target.LiquidTokens)
+	}
+
+	_, err = s.querier.Rebalancing(sdk.WrapSDKContext(s.ctx), nil)
+	s.Require().Error(err)
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// IsScoringEpochEnd returns whether ScoringEpochBlocks have passed since the last validator scoring.
+func (k Keeper) IsScoringEpochEnd(ctx sdk.Context) bool {
+	epochBlocks := k.GetParams(ctx).ScoringEpochBlocks
+	if epochBlocks == 0 {
+		return false
+	}
+	lastHeight := k.GetLastScoringHeight(ctx)
+	return lastHeight == 0 || uint64(ctx.BlockHeight()) >= lastHeight+epochBlocks
+}
+
+// ScoreValidators samples the performance of the whitelisted validators at the end of each scoring epoch.
+func (k Keeper) ScoreValidators(ctx sdk.Context) {
+	if !k.IsScoringEpochEnd(ctx) {
+		return
+	}
+
+	logger := k.Logger(ctx)
+	for _, wv := range k.GetParams(ctx).WhitelistedValidators {
+		val, found := k.stakingKeeper.GetValidator(ctx, wv.GetOperator())
+		if !found {
+			continue
+		}
+		score := k.CalcValidatorScore(ctx, val)
+		k.SetValidatorScore(ctx, score)
+		k.pruneValidatorScores(ctx, val.GetOperator())
+
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeScoreValidator,
+				sdk.NewAttribute(types.AttributeKeyLiquidValidator, score.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyScore, score.Score.String()),
+			),
+		})
+		logger.Info(types.EventTypeScoreValidator,
+			types.AttributeKeyLiquidValidator, score.ValidatorAddress,
+			types.AttributeKeyScore, score.Score.String())
+	}
+	k.SetLastScoringHeight(ctx, uint64(ctx.BlockHeight()))
+}
+
+// CalcValidatorScore samples the uptime of the validator from its slashing signing info and its commission rate and
+// self-bond rate from the staking module.
+func (k Keeper) CalcValidatorScore(ctx sdk.Context, val stakingtypes.Validator) types.ValidatorScore {
+	uptime := sdk.ZeroDec()
+	consAddr, err := val.GetConsAddr()
+	if err == nil {
+		signingInfo, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+		window := k.slashingKeeper.SignedBlocksWindow(ctx)
+		if found && window > 0 && !val.IsJailed() {
+			uptime = sdk.OneDec().Sub(sdk.NewDec(signingInfo.MissedBlocksCounter).QuoInt64(window))
+			uptime = sdk.MinDec(sdk.MaxDec(uptime, sdk.ZeroDec()), sdk.OneDec())
+		}
+	}
+
+	selfBondRate := sdk.ZeroDec()
+	selfDel, found := k.stakingKeeper.GetDelegation(ctx, sdk.AccAddress(val.GetOperator()), val.GetOperator())
+	if found && val.Tokens.IsPositive() {
+		selfBondRate = sdk.MinDec(val.TokensFromShares(selfDel.GetShares()).QuoInt(val.Tokens), sdk.OneDec())
+	}
+
+	commissionRate := val.Commission.Rate
+	return types.ValidatorScore{
+		ValidatorAddress: val.OperatorAddress,
+		Height:           ctx.BlockHeight(),
+		Uptime:           uptime,
+		CommissionRate:   commissionRate,
+		SelfBondRate:     selfBondRate,
+		Score:            types.CalcScore(uptime, commissionRate, selfBondRate),
+	}
+}
+
+// GetEffectiveWhitelistedValsMap returns the whitelisted validators with the weights used for liquid staking and
+// rebalancing, derived from their latest score when ScoreWeightingEnabled.
+func (k Keeper) GetEffectiveWhitelistedValsMap(ctx sdk.Context, params types.Params) types.WhitelistedValsMap {
+	whitelistedValsMap := params.WhitelistedValsMap()
+	if !params.ScoreWeightingEnabled {
+		return whitelistedValsMap
+	}
+
+	scores := map[string]sdk.Dec{}
+	for _, wv := range params.WhitelistedValidators {
+		if score, found := k.GetLatestValidatorScore(ctx, wv.GetOperator()); found {
+			scores[wv.ValidatorAddress] = score.Score
+		}
+	}
+	return whitelistedValsMap.ScoreWeighted(scores, params.ScoreWeightFloor, params.ScoreWeightCap)
+}
+
+// GetLastScoringHeight returns the height of the last validator scoring.
+func (k Keeper) GetLastScoringHeight(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastScoringHeightKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastScoringHeight sets the height of the last validator scoring.
+func (k Keeper) SetLastScoringHeight(ctx sdk.Context, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastScoringHeightKey, sdk.Uint64ToBigEndian(height))
+}
+
+// SetValidatorScore sets the validator score.
+func (k Keeper) SetValidatorScore(ctx sdk.Context, score types.ValidatorScore) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr, err := sdk.ValAddressFromBech32(score.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetValidatorScoreKey(valAddr, score.Height), k.cdc.MustMarshal(&score))
+}
+
+// GetValidatorScores returns the score history of the validator, the latest score first.
+func (k Keeper) GetValidatorScores(ctx sdk.Context, valAddr sdk.ValAddress) (scores []types.ValidatorScore) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetValidatorScoresByValidatorPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var score types.ValidatorScore
+		k.cdc.MustUnmarshal(iterator.Value(), &score)
+		scores = append(scores, score)
+	}
+	return scores
+}
+
+// GetLatestValidatorScore returns the latest score of the validator.
+func (k Keeper) GetLatestValidatorScore(ctx sdk.Context, valAddr sdk.ValAddress) (score types.ValidatorScore, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetValidatorScoresByValidatorPrefix(valAddr))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return score, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &score)
+	return score, true
+}
+
+// GetAllValidatorScores returns the score history of all the validators.
+func (k Keeper) GetAllValidatorScores(ctx sdk.Context) (scores []types.ValidatorScore) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorScoresKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var score types.ValidatorScore
+		k.cdc.MustUnmarshal(iterator.Value(), &score)
+		scores = append(scores, score)
+	}
+	return scores
+}
+
+// pruneValidatorScores removes the scores of the validator older than the MaxValidatorScoreHistory latest ones.
+func (k Keeper) pruneValidatorScores(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.GetValidatorScoresByValidatorPrefix(valAddr))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for count := 0; iterator.Valid(); iterator.Next() {
+		if count >= types.MaxValidatorScoreHistory {
+			keys = append(keys, iterator.Key())
+		}
+		count++
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
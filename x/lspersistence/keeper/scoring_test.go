@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestScoreValidators() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	params.StakeFeeRate = sdk.ZeroDec()
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	val, found := s.app.StakingKeeper.GetValidator(s.ctx, valOpers[2])
+	s.Require().True(found)
+	val.Commission.Rate = sdk.OneDec()
+	s.app.StakingKeeper.SetValidator(s.ctx, val)
+
+	// the scoring is disabled by default
+	s.keeper.ScoreValidators(s.ctx)
+	s.Require().Len(s.keeper.GetAllValidatorScores(s.ctx), 0)
+
+	params.ScoringEpochBlocks = 10
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.ScoreValidators(s.ctx)
+	s.Require().Equal(uint64(s.ctx.BlockHeight()), s.keeper.GetLastScoringHeight(s.ctx))
+
+	score, found := s.keeper.GetLatestValidatorScore(s.ctx, valOpers[0])
+	s.Require().True(found)
+	s.Require().Equal(sdk.OneDec(), score.Uptime)
+	s.Require().Equal(sdk.OneDec(), score.SelfBondRate)
+	s.Require().Equal(sdk.OneDec(), score.Score)
+	score, _ = s.keeper.GetLatestValidatorScore(s.ctx, valOpers[2])
+	s.Require().Equal(sdk.OneDec(), score.CommissionRate)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.7"), score.Score)
+
+	// no sampling until the end of the epoch
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 9)
+	s.keeper.ScoreValidators(s.ctx)
+	s.Require().Len(s.keeper.GetValidatorScores(s.ctx, valOpers[0]), 1)
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.keeper.ScoreValidators(s.ctx)
+	s.Require().Len(s.keeper.GetValidatorScores(s.ctx, valOpers[0]), 2)
+
+	res, err := s.querier.ValidatorScores(sdk.WrapSDKContext(s.ctx), &types.QueryValidatorScoresRequest{ValidatorAddress: valOpers[2].String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Scores, 2)
+	s.Require().Equal(s.ctx.BlockHeight(), res.Scores[0].Height)
+
+	_, err = s.querier.ValidatorScores(sdk.WrapSDKContext(s.ctx), &types.QueryValidatorScoresRequest{ValidatorAddress: "invalid"})
+	s.Require().Error(err)
+
+	// the target weights are used as is until the score weighting is enabled
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(300000)))
+	for _, state := range s.keeper.GetAllLiquidValidatorStates(s.ctx) {
+		s.Require().Equal(sdk.OneInt(), state.Weight)
+		s.Require().Equal(sdk.NewInt(100000), state.LiquidTokens)
+	}
+
+	// 0.7 / 0.9 average score, floored to 0.8
+	params.ScoreWeightingEnabled = true
+	params.ScoreWeightFloor = sdk.NewDecWithPrec(8, 1)
+	s.keeper.SetParams(s.ctx, params)
+	weights := map[string]sdk.Int{}
+	for _, state := range s.keeper.GetAllLiquidValidatorStates(s.ctx) {
+		weights[state.OperatorAddress] = state.Weight
+	}
+	s.Require().Equal(sdk.NewInt(1111), weights[valOpers[0].String()])
+	s.Require().Equal(sdk.NewInt(1111), weights[valOpers[1].String()])
+	s.Require().Equal(sdk.NewInt(800), weights[valOpers[2].String()])
+
+	// the rebalancing follows the effective weights
+	reds := s.keeper.UpdateLiquidValidatorSet(s.ctx)
+	s.Require().Len(reds, 2)
+	s.Require().Equal(s.redelegationsErrorCount(reds), 0)
+	for _, target := range s.keeper.GetRebalancingTargets(s.ctx) {
+		s.Require().Equal(target.TargetLiquidTokens, target.LiquidTokens)
+	}
+	lv, _ := s.keeper.GetLiquidValidatorState(s.ctx, valOpers[2])
+	// 800 / 3022 of the liquid tokens
+	s.Require().Equal(sdk.NewInt(79417), lv.LiquidTokens)
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// GovStakingKeeper wraps the staking keeper used by the gov module tally. Besides the delegations of the voter, it
+// yields the share of the LiquidStakingProxyAcc delegations backing the bTokens of the voter, so gov credits the
+// liquid stakers with that voting power and deducts it from the validators they are delegated to.
+type GovStakingKeeper struct {
+	govtypes.StakingKeeper
+	keeper Keeper
+}
+
+var _ govtypes.StakingKeeper = GovStakingKeeper{}
+
+// NewGovStakingKeeper returns a staking keeper that accounts for the liquid staking voting power.
+func NewGovStakingKeeper(stakingKeeper govtypes.StakingKeeper, keeper Keeper) GovStakingKeeper {
+	return GovStakingKeeper{
+		StakingKeeper: stakingKeeper,
+		keeper:        keeper,
+	}
+}
+
+// IterateDelegations iterates over the staking delegations of the delegator followed by its liquid staking ones.
+func (gsk GovStakingKeeper) IterateDelegations(
+	ctx sdk.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
+) {
+	var index int64
+	stopped := false
+	gsk.StakingKeeper.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		stopped = fn(index, delegation)
+		index++
+		return stopped
+	})
+	if stopped {
+		return
+	}
+
+	for _, delegation := range gsk.keeper.GetLiquidStakingDelegations(ctx, delegator) {
+		if fn(index, delegation) {
+			return
+		}
+		index++
+	}
+}
+
+// GetVoterBTokenAmount returns the bTokens held by the voter, including its share of the bTokens held by the
+// whitelisted pools. The whitelisted pools themselves hold no bTokens, as those are attributed to their share holders.
+func (k Keeper) GetVoterBTokenAmount(ctx sdk.Context, voter sdk.AccAddress) math.Int {
+	params := k.GetParams(ctx)
+	for _, pool := range params.WhitelistedPools {
+		if pool.PoolAddress == voter.String() {
+			return sdk.ZeroInt()
+		}
+	}
+
+	bTokenAmount := k.bankKeeper.GetBalance(ctx, voter, params.LiquidBondDenom).Amount
+	for _, pool := range params.WhitelistedPools {
+		poolAddr := sdk.MustAccAddressFromBech32(pool.PoolAddress)
+		shareAmount := k.bankKeeper.GetBalance(ctx, voter, pool.ShareDenom).Amount
+		if !shareAmount.IsPositive() {
+			continue
+		}
+
+		shareSupply := k.bankKeeper.GetSupply(ctx, pool.ShareDenom).Amount
+		poolBTokenAmount := k.bankKeeper.GetBalance(ctx, poolAddr, params.LiquidBondDenom).Amount
+		if !shareSupply.IsPositive() || !poolBTokenAmount.IsPositive() {
+			continue
+		}
+
+		bTokenAmount = bTokenAmount.Add(poolBTokenAmount.Mul(shareAmount).Quo(shareSupply))
+	}
+
+	return bTokenAmount
+}
+
+// GetLiquidStakingDelegations returns the share of the LiquidStakingProxyAcc delegations corresponding to the
+// bTokens of the voter. The delegations are not stored, they are only used for the governance tally.
+func (k Keeper) GetLiquidStakingDelegations(ctx sdk.Context, voter sdk.AccAddress) (delegations []stakingtypes.Delegation) {
+	if voter.Equals(types.LiquidStakingProxyAcc) {
+		return nil
+	}
+
+	bTokenTotalSupply := k.bankKeeper.GetSupply(ctx, k.LiquidBondDenom(ctx)).Amount
+	if !bTokenTotalSupply.IsPositive() {
+		return nil
+	}
+
+	bTokenAmount := k.GetVoterBTokenAmount(ctx, voter)
+	if !bTokenAmount.IsPositive() {
+		return nil
+	}
+
+	k.stakingKeeper.IterateDelegations(
+		ctx, types.LiquidStakingProxyAcc,
+		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+			shares := del.GetShares().MulInt(bTokenAmount).QuoInt(bTokenTotalSupply)
+			if shares.IsPositive() {
+				delegations = append(delegations, stakingtypes.NewDelegation(voter, del.GetValidatorAddr(), shares, false))
+			}
+			return false
+		},
+	)
+
+	return delegations
+}
+
+// CalcStakingVotingPower returns the voting power of the voter delegations to bonded validators.
+func (k Keeper) CalcStakingVotingPower(ctx sdk.Context, voter sdk.AccAddress) math.Int {
+	votingPower := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegations(
+		ctx, voter,
+		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+			votingPower = votingPower.Add(k.calcDelegationVotingPower(ctx, del))
+			return false
+		},
+	)
+	return votingPower.TruncateInt()
+}
+
+// CalcLiquidStakingVotingPower returns the voting power of the voter bTokens, which is its bToken share of the
+// NetAmountState TotalLiquidTokens delegated to bonded validators.
+func (k Keeper) CalcLiquidStakingVotingPower(ctx sdk.Context, voter sdk.AccAddress) math.Int {
+	votingPower := sdk.ZeroDec()
+	for _, del := range k.GetLiquidStakingDelegations(ctx, voter) {
+		votingPower = votingPower.Add(k.calcDelegationVotingPower(ctx, del))
+	}
+	return votingPower.TruncateInt()
+}
+
+// GetVotingPower returns the staking, liquid staking and validator voting power of the voter.
+func (k Keeper) GetVotingPower(ctx sdk.Context, voter sdk.AccAddress) types.VotingPower {
+	validatorVotingPower := sdk.ZeroInt()
+	val, found := k.stakingKeeper.GetValidator(ctx, sdk.ValAddress(voter))
+	if found && val.IsBonded() {
+		validatorVotingPower = val.BondedTokens()
+	}
+
+	return types.VotingPower{
+		Voter:                    voter.String(),
+		StakingVotingPower:       k.CalcStakingVotingPower(ctx, voter),
+		LiquidStakingVotingPower: k.CalcLiquidStakingVotingPower(ctx, voter),
+		ValidatorVotingPower:     validatorVotingPower,
+	}
+}
+
+// calcDelegationVotingPower returns the voting power of a delegation the same way the gov tally does.
+func (k Keeper) calcDelegationVotingPower(ctx sdk.Context, del stakingtypes.DelegationI) sdk.Dec {
+	val, found := k.stakingKeeper.GetValidator(ctx, del.GetValidatorAddr())
+	if !found || !val.IsBonded() || !val.DelegatorShares.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return del.GetShares().MulInt(val.BondedTokens()).Quo(val.DelegatorShares)
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestGetVotingPower() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	params.StakeFeeRate = sdk.ZeroDec()
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	// no bTokens, no liquid staking voting power
+	votingPower := s.keeper.GetVotingPower(s.ctx, s.delAddrs[0])
+	s.Require().Equal(s.delAddrs[0].String(), votingPower.Voter)
+	s.Require().Equal(sdk.ZeroInt(), votingPower.StakingVotingPower)
+	s.Require().Equal(sdk.ZeroInt(), votingPower.LiquidStakingVotingPower)
+	s.Require().Equal(sdk.ZeroInt(), votingPower.ValidatorVotingPower)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(300000)))
+
+	votingPower = s.keeper.GetVotingPower(s.ctx, s.delAddrs[0])
+	s.Require().Equal(sdk.ZeroInt(), votingPower.StakingVotingPower)
+	s.Require().Equal(sdk.NewInt(300000), votingPower.LiquidStakingVotingPower)
+
+	// bTokens held by a whitelisted pool are attributed to the share holders
+	pool := sdk.AccAddress("test_liquidity_pool_")
+	s.Require().NoError(
+		s.app.BankKeeper.SendCoins(
+			s.ctx, s.delAddrs[0], pool, sdk.NewCoins(sdk.NewInt64Coin(params.LiquidBondDenom, 150000)),
+		),
+	)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, s.delAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("pool1", 30))))
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, s.delAddrs[2], sdk.NewCoins(sdk.NewInt64Coin("pool1", 70))))
+
+	votingPower = s.keeper.GetVotingPower(s.ctx, s.delAddrs[1])
+	s.Require().Equal(sdk.ZeroInt(), votingPower.LiquidStakingVotingPower)
+
+	params.WhitelistedPools = []types.WhitelistedPool{{PoolAddress: pool.String(), ShareDenom: "pool1"}}
+	s.keeper.SetParams(s.ctx, params)
+
+	s.Require().Equal(sdk.NewInt(150000), s.keeper.GetVotingPower(s.ctx, s.delAddrs[0]).LiquidStakingVotingPower)
+	s.Require().Equal(sdk.NewInt(45000), s.keeper.GetVotingPower(s.ctx, s.delAddrs[1]).LiquidStakingVotingPower)
+	s.Require().Equal(sdk.NewInt(105000), s.keeper.GetVotingPower(s.ctx, s.delAddrs[2]).LiquidStakingVotingPower)
+	s.Require().Equal(sdk.ZeroInt(), s.keeper.GetVotingPower(s.ctx, pool).LiquidStakingVotingPower)
+
+	// the validator operator voting power
+	votingPower = s.keeper.GetVotingPower(s.ctx, sdk.AccAddress(valOpers[0]))
+	s.Require().Equal(sdk.NewInt(1000000), votingPower.StakingVotingPower)
+	s.Require().Equal(sdk.NewInt(1100000), votingPower.ValidatorVotingPower)
+
+	res, err := s.querier.VotingPower(sdk.WrapSDKContext(s.ctx), &types.QueryVotingPowerRequest{Voter: s.delAddrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(45000), res.VotingPower.LiquidStakingVotingPower)
+
+	_, err = s.querier.VotingPower(sdk.WrapSDKContext(s.ctx), &types.QueryVotingPowerRequest{Voter: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestTallyLiquidStaking() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 2000000, 3000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(1)},
+		{ValidatorAddress: valOpers[2].String(), TargetWeight: sdk.NewInt(1)},
+	}
+	params.StakeFeeRate = sdk.ZeroDec()
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(300000)))
+
+	// the liquid staking delegations follow the proxy account delegations
+	gsk := keeper.NewGovStakingKeeper(s.app.StakingKeeper, s.keeper)
+	delShares := sdk.ZeroDec()
+	gsk.IterateDelegations(s.ctx, s.delAddrs[0], func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		delShares = delShares.Add(del.GetShares())
+		return false
+	})
+	s.Require().Equal(sdk.NewDec(300000), delShares)
+
+	proposal, err := s.app.GovKeeper.SubmitProposal(s.ctx, []sdk.Msg{}, "", "title", "summary", s.delAddrs[0])
+	s.Require().NoError(err)
+	s.app.GovKeeper.ActivateVotingPeriod(s.ctx, proposal)
+	s.Require().NoError(
+		s.app.GovKeeper.AddVote(s.ctx, proposal.Id, s.delAddrs[0], govv1.NewNonSplitVoteOption(govv1.OptionNo), ""),
+	)
+	s.Require().NoError(
+		s.app.GovKeeper.AddVote(s.ctx, proposal.Id, sdk.AccAddress(valOpers[0]), govv1.NewNonSplitVoteOption(govv1.OptionYes), ""),
+	)
+
+	// the bTokens voting power is deducted from the validator the proxy account delegated to
+	_, _, tally := s.app.GovKeeper.Tally(s.ctx, proposal)
+	s.Require().Equal(sdk.NewInt(300000).String(), tally.NoCount)
+	s.Require().Equal(sdk.NewInt(1000000).String(), tally.YesCount)
+}
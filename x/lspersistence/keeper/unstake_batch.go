@@ -0,0 +1,463 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+// GetUnstakeEpoch returns the current unstake epoch.
+func (k Keeper) GetUnstakeEpoch(ctx sdk.Context) (epoch types.UnstakeEpoch) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UnstakeEpochKey)
+	if bz == nil {
+		return epoch
+	}
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch
+}
+
+// SetUnstakeEpoch sets the current unstake epoch.
+func (k Keeper) SetUnstakeEpoch(ctx sdk.Context, epoch types.UnstakeEpoch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnstakeEpochKey, k.cdc.MustMarshal(&epoch))
+}
+
+// GetUnstakeBatch returns the unstake batch of the epoch.
+func (k Keeper) GetUnstakeBatch(ctx sdk.Context, epochNumber uint64) (batch types.UnstakeBatch, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnstakeBatchKey(epochNumber))
+	if bz == nil {
+		return batch, false
+	}
+	k.cdc.MustUnmarshal(bz, &batch)
+	return batch, true
+}
+
+// SetUnstakeBatch sets the unstake batch of its epoch.
+func (k Keeper) SetUnstakeBatch(ctx sdk.Context, batch types.UnstakeBatch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnstakeBatchKey(batch.EpochNumber), k.cdc.MustMarshal(&batch))
+}
+
+// DeleteUnstakeBatch removes the unstake batch of the epoch.
+func (k Keeper) DeleteUnstakeBatch(ctx sdk.Context, epochNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnstakeBatchKey(epochNumber))
+}
+
+// GetAllUnstakeBatches returns all the unstake batches ordered by epoch.
+func (k Keeper) GetAllUnstakeBatches(ctx sdk.Context) (batches []types.UnstakeBatch) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnstakeBatchesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var batch types.UnstakeBatch
+		k.cdc.MustUnmarshal(iterator.Value(), &batch)
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// GetPendingUnstake returns the pending unstake of the delegator queued in the epoch.
+func (k Keeper) GetPendingUnstake(ctx sdk.Context, delAddr sdk.AccAddress, epochNumber uint64) (pu types.PendingUnstake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingUnstakeKey(delAddr, epochNumber))
+	if bz == nil {
+		return pu, false
+	}
+	k.cdc.MustUnmarshal(bz, &pu)
+	return pu, true
+}
+
+// SetPendingUnstake sets the pending unstake of the delegator.
+func (k Keeper) SetPendingUnstake(ctx sdk.Context, pu types.PendingUnstake) {
+	store := ctx.KVStore(k.storeKey)
+	delAddr := sdk.MustAccAddressFromBech32(pu.DelegatorAddress)
+	store.Set(types.GetPendingUnstakeKey(delAddr, pu.EpochNumber), k.cdc.MustMarshal(&pu))
+	store.Set(types.GetPendingUnstakeByEpochIndexKey(pu.EpochNumber, delAddr), []byte{})
+}
+
+// DeletePendingUnstake removes the pending unstake of the delegator queued in the epoch.
+func (k Keeper) DeletePendingUnstake(ctx sdk.Context, delAddr sdk.AccAddress, epochNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingUnstakeKey(delAddr, epochNumber))
+	store.Delete(types.GetPendingUnstakeByEpochIndexKey(epochNumber, delAddr))
+}
+
+// GetPendingUnstakesByDelegator returns the pending unstakes of the delegator ordered by epoch.
+func (k Keeper) GetPendingUnstakesByDelegator(ctx sdk.Context, delAddr sdk.AccAddress) (pus []types.PendingUnstake) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingUnstakesByDelegatorPrefix(delAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pu types.PendingUnstake
+		k.cdc.MustUnmarshal(iterator.Value(), &pu)
+		pus = append(pus, pu)
+	}
+	return pus
+}
+
+// GetAllPendingUnstakes returns the pending unstakes of all the delegators.
+func (k Keeper) GetAllPendingUnstakes(ctx sdk.Context) (pus []types.PendingUnstake) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingUnstakesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pu types.PendingUnstake
+		k.cdc.MustUnmarshal(iterator.Value(), &pu)
+		pus = append(pus, pu)
+	}
+	return pus
+}
+
+// GetTotalPendingUnstakeAmount returns the native tokens owed by the unstake batches not unbonded yet, which are
+// still delegated by the proxy account while their bTokens are already burned.
+func (k Keeper) GetTotalPendingUnstakeAmount(ctx sdk.Context) math.Int {
+	total := sdk.ZeroInt()
+	for _, batch := range k.GetAllUnstakeBatches(ctx) {
+		if batch.State == types.UnstakeBatchStatePending {
+			total = total.Add(batch.UnstakeAmount).Add(batch.FeeAmount)
+		}
+	}
+	return total
+}
+
+// GetClaimableAmount returns the share of the unbonded tokens of the batch owed to the pending unstake.
+func GetClaimableAmount(batch types.UnstakeBatch, pu types.PendingUnstake) math.Int {
+	batchAmount := batch.UnstakeAmount.Add(batch.FeeAmount)
+	if !batchAmount.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return pu.UnstakeAmount.Mul(batch.UnbondedAmount).Quo(batchAmount)
+}
+
+// QueueLiquidUnstake burns unstakingBtoken at the current MintRate and queues its worth of native tokens to the
+// unstake batch of the current epoch, to be unbonded with the other unstakes of the epoch once it ends.
+func (k Keeper) QueueLiquidUnstake(
+	ctx sdk.Context, liquidStaker sdk.AccAddress, unstakingBtoken sdk.Coin,
+) (pu types.PendingUnstake, fee math.Int, err error) {
+	// check bond denomination
+	params := k.GetParams(ctx)
+	liquidBondDenom := k.LiquidBondDenom(ctx)
+	if unstakingBtoken.Denom != liquidBondDenom {
+		return pu, sdk.ZeroInt(), errorsmod.Wrapf(
+			types.ErrInvalidLiquidBondDenom, "invalid coin denomination: got %s, expected %s", unstakingBtoken.Denom, liquidBondDenom,
+		)
+	}
+
+	// Get NetAmount states
+	nas := k.GetNetAmountState(ctx)
+
+	if unstakingBtoken.Amount.GT(nas.BtokenTotalSupply) {
+		return pu, sdk.ZeroInt(), types.ErrInvalidBTokenSupply
+	}
+
+	// UnstakeAmount = NetAmount * BTokenAmount/TotalSupply * (1-UnstakeFeeRate)
+	unbondingAmount := types.BTokenToNativeToken(unstakingBtoken.Amount, nas.BtokenTotalSupply, nas.NetAmount)
+	unstakeAmount := types.DeductFeeRate(unbondingAmount, params.UnstakeFeeRate).TruncateInt()
+	if !unstakeAmount.IsPositive() {
+		return pu, sdk.ZeroInt(), types.ErrTooSmallLiquidUnstakingAmount
+	}
+	fee = unbondingAmount.TruncateInt().Sub(unstakeAmount)
+
+	// burn btoken
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, liquidStaker, types.ModuleName, sdk.NewCoins(unstakingBtoken))
+	if err != nil {
+		return pu, sdk.ZeroInt(), err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(unstakingBtoken))
+	if err != nil {
+		return pu, sdk.ZeroInt(), err
+	}
+
+	epoch := k.GetUnstakeEpoch(ctx)
+	batch, found := k.GetUnstakeBatch(ctx, epoch.Number)
+	if !found {
+		batch = types.UnstakeBatch{
+			EpochNumber:    epoch.Number,
+			UnstakeAmount:  sdk.ZeroInt(),
+			FeeAmount:      sdk.ZeroInt(),
+			UnbondedAmount: sdk.ZeroInt(),
+			State:          types.UnstakeBatchStatePending,
+		}
+	}
+	batch.UnstakeAmount = batch.UnstakeAmount.Add(unstakeAmount)
+	batch.FeeAmount = batch.FeeAmount.Add(fee)
+	k.SetUnstakeBatch(ctx, batch)
+
+	pu, found = k.GetPendingUnstake(ctx, liquidStaker, epoch.Number)
+	if !found {
+		pu = types.PendingUnstake{
+			DelegatorAddress: liquidStaker.String(),
+			EpochNumber:      epoch.Number,
+			BurnedAmount:     sdk.NewCoin(liquidBondDenom, sdk.ZeroInt()),
+			UnstakeAmount:    sdk.ZeroInt(),
+		}
+	}
+	pu.BurnedAmount = pu.BurnedAmount.Add(unstakingBtoken)
+	pu.UnstakeAmount = pu.UnstakeAmount.Add(unstakeAmount)
+	k.SetPendingUnstake(ctx, pu)
+
+	return pu, fee, nil
+}
+
+// GetUnstakeEpochCompletionTime returns the estimated time the unstakes queued in the current epoch become claimable.
+func (k Keeper) GetUnstakeEpochCompletionTime(ctx sdk.Context) time.Time {
+	epoch := k.GetUnstakeEpoch(ctx)
+	startTime := epoch.StartTime
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+	return startTime.Add(k.GetParams(ctx).UnstakeEpochDuration).Add(k.stakingKeeper.UnbondingTime(ctx))
+}
+
+// ProcessUnstakeEpochs unbonds the pending unstake batches once the current epoch ends and marks the unbonding
+// batches as claimable once their unbonding delegations mature.
+func (k Keeper) ProcessUnstakeEpochs(ctx sdk.Context) {
+	k.UpdateUnbondingUnstakeBatches(ctx)
+
+	epoch := k.GetUnstakeEpoch(ctx)
+	if epoch.StartTime.IsZero() {
+		epoch.StartTime = ctx.BlockTime()
+		k.SetUnstakeEpoch(ctx, epoch)
+		return
+	}
+	if ctx.BlockTime().Before(epoch.StartTime.Add(k.GetParams(ctx).UnstakeEpochDuration)) {
+		return
+	}
+
+	for _, batch := range k.GetAllUnstakeBatches(ctx) {
+		if batch.State != types.UnstakeBatchStatePending {
+			continue
+		}
+		// a failed batch unbonding is retried at the end of the next epoch
+		cachedCtx, writeCache := ctx.CacheContext()
+		unbondedBatch, err := k.UnbondUnstakeBatch(cachedCtx, batch)
+		if err != nil {
+			k.Logger(ctx).Error("failed to unbond unstake batch", "epoch", batch.EpochNumber, "error", err)
+			continue
+		}
+		writeCache()
+		k.SetUnstakeBatch(ctx, unbondedBatch)
+	}
+
+	epoch.Number++
+	epoch.StartTime = ctx.BlockTime()
+	k.SetUnstakeEpoch(ctx, epoch)
+}
+
+// UnbondUnstakeBatch unbonds the native tokens of the batch from the proxy account delegations to UnstakeBatchAcc
+// according to the current weight of the liquid validators.
+func (k Keeper) UnbondUnstakeBatch(ctx sdk.Context, batch types.UnstakeBatch) (types.UnstakeBatch, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	batchAmount := batch.UnstakeAmount.Add(batch.FeeAmount)
+
+	// the completed unbondings are only paid out to existing accounts
+	if k.accountKeeper.GetAccount(ctx, types.UnstakeBatchAcc) == nil {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, types.UnstakeBatchAcc))
+	}
+
+	liquidVals := k.GetAllLiquidValidators(ctx)
+	totalLiquidTokens, liquidTokenMap := liquidVals.TotalLiquidTokens(ctx, k.stakingKeeper, false)
+
+	// if no totalLiquidTokens, withdraw directly from balance of proxy acc
+	if !totalLiquidTokens.IsPositive() {
+		if k.GetProxyAccBalance(ctx, types.LiquidStakingProxyAcc).Amount.LT(batchAmount) {
+			return batch, types.ErrInsufficientProxyAccBalance
+		}
+		err := k.bankKeeper.SendCoins(ctx, types.LiquidStakingProxyAcc, types.UnstakeBatchAcc,
+			sdk.NewCoins(sdk.NewCoin(bondDenom, batchAmount)))
+		if err != nil {
+			return batch, err
+		}
+		batch.UnbondedAmount = batchAmount
+		batch.CompletionTime = ctx.BlockTime()
+		if err = k.SetUnstakeBatchClaimable(ctx, batch); err != nil {
+			return batch, err
+		}
+		batch.State = types.UnstakeBatchStateClaimable
+		return batch, nil
+	}
+
+	// crumb may occur due to a decimal error in dividing the batch into the weight of liquid validators, it will remain in the NetAmount
+	unbondingAmounts, _ := types.DivideByCurrentWeight(liquidVals, sdk.NewDecFromInt(batchAmount), totalLiquidTokens, liquidTokenMap)
+	unbondedAmount := sdk.ZeroInt()
+	var completionTime time.Time
+	for i, val := range liquidVals {
+		// skip zero weight liquid validator
+		if !unbondingAmounts[i].IsPositive() {
+			continue
+		}
+		// calculate delShares from tokens with validation
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, types.LiquidStakingProxyAcc, val.GetOperator(), unbondingAmounts[i].TruncateInt())
+		if err != nil {
+			return batch, err
+		}
+		if !shares.IsPositive() {
+			continue
+		}
+		// a single entry per validator and epoch, so the max entries limit is not checked
+		ubdTime, returnAmount, _, err := k.LiquidUnbond(ctx, types.LiquidStakingProxyAcc, types.UnstakeBatchAcc, val.GetOperator(), shares, false, k.stakingKeeper.UnbondingTime(ctx))
+		if err != nil {
+			return batch, err
+		}
+		completionTime = ubdTime
+		unbondedAmount = unbondedAmount.Add(returnAmount)
+	}
+	if !unbondedAmount.IsPositive() {
+		return batch, types.ErrTooSmallLiquidUnstakingAmount
+	}
+
+	batch.UnbondedAmount = unbondedAmount
+	batch.CompletionTime = completionTime
+	batch.State = types.UnstakeBatchStateUnbonding
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnstakeBatchUnbonded,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, sdk.NewIntFromUint64(batch.EpochNumber).String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondingAmount, sdk.NewCoin(bondDenom, unbondedAmount).String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	)
+	return batch, nil
+}
+
+// UpdateUnbondingUnstakeBatches tracks the slashing applied balance of the unbonding batches and marks them as
+// claimable once the unbonding delegation entries of UnstakeBatchAcc created for them are completed. Batches unbonded
+// in the same block share their entries, whose balance is split according to the amount each one unbonded.
+func (k Keeper) UpdateUnbondingUnstakeBatches(ctx sdk.Context) {
+	var batches []types.UnstakeBatch
+	recordedAmounts := map[int64]math.Int{}
+	for _, batch := range k.GetAllUnstakeBatches(ctx) {
+		if batch.State != types.UnstakeBatchStateUnbonding {
+			continue
+		}
+		batches = append(batches, batch)
+		completionTime := batch.CompletionTime.UnixNano()
+		if _, ok := recordedAmounts[completionTime]; !ok {
+			recordedAmounts[completionTime] = sdk.ZeroInt()
+		}
+		recordedAmounts[completionTime] = recordedAmounts[completionTime].Add(batch.UnbondedAmount)
+	}
+	if len(batches) == 0 {
+		return
+	}
+
+	balances := map[int64]math.Int{}
+	for _, ubd := range k.stakingKeeper.GetAllUnbondingDelegations(ctx, types.UnstakeBatchAcc) {
+		for _, entry := range ubd.Entries {
+			completionTime := entry.CompletionTime.UnixNano()
+			if _, ok := balances[completionTime]; !ok {
+				balances[completionTime] = sdk.ZeroInt()
+			}
+			balances[completionTime] = balances[completionTime].Add(entry.Balance)
+		}
+	}
+
+	for _, batch := range batches {
+		completionTime := batch.CompletionTime.UnixNano()
+		if balance, ok := balances[completionTime]; ok {
+			recordedAmount := recordedAmounts[completionTime]
+			if !balance.Equal(recordedAmount) && recordedAmount.IsPositive() {
+				batch.UnbondedAmount = balance.Mul(batch.UnbondedAmount).Quo(recordedAmount)
+				k.SetUnstakeBatch(ctx, batch)
+			}
+			continue
+		}
+		if ctx.BlockTime().Before(batch.CompletionTime) {
+			continue
+		}
+
+		if err := k.SetUnstakeBatchClaimable(ctx, batch); err != nil {
+			k.Logger(ctx).Error("failed to set unstake batch claimable", "epoch", batch.EpochNumber, "error", err)
+		}
+	}
+}
+
+// SetUnstakeBatchClaimable pays the fee share of the unbonded tokens of the batch to the fee address and lets the
+// delegators claim the rest.
+func (k Keeper) SetUnstakeBatchClaimable(ctx sdk.Context, batch types.UnstakeBatch) error {
+	params := k.GetParams(ctx)
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	batchAmount := batch.UnstakeAmount.Add(batch.FeeAmount)
+	if batchAmount.IsPositive() {
+		fee := batch.FeeAmount.Mul(batch.UnbondedAmount).Quo(batchAmount)
+		if fee.IsPositive() {
+			err := k.bankKeeper.SendCoins(ctx, types.UnstakeBatchAcc, sdk.MustAccAddressFromBech32(params.FeeAddress),
+				sdk.NewCoins(sdk.NewCoin(bondDenom, fee)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	batch.State = types.UnstakeBatchStateClaimable
+	k.SetUnstakeBatch(ctx, batch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnstakeBatchClaimable,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, sdk.NewIntFromUint64(batch.EpochNumber).String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondedAmount, sdk.NewCoin(bondDenom, batch.UnbondedAmount).String()),
+		),
+	)
+	return nil
+}
+
+// GetClaimableUnstakes returns the pending unstakes of the delegator whose batch is claimable and their total amount.
+func (k Keeper) GetClaimableUnstakes(ctx sdk.Context, delAddr sdk.AccAddress) (pus []types.PendingUnstake, amount math.Int) {
+	amount = sdk.ZeroInt()
+	for _, pu := range k.GetPendingUnstakesByDelegator(ctx, delAddr) {
+		batch, found := k.GetUnstakeBatch(ctx, pu.EpochNumber)
+		if !found || batch.State != types.UnstakeBatchStateClaimable {
+			continue
+		}
+		pus = append(pus, pu)
+		amount = amount.Add(GetClaimableAmount(batch, pu))
+	}
+	return pus, amount
+}
+
+// Claim sends the matured unstaked tokens of the delegator from UnstakeBatchAcc and removes its claimed pending
+// unstakes. The batches are removed once all of their pending unstakes are claimed.
+func (k Keeper) Claim(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Coin, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	pus, amount := k.GetClaimableUnstakes(ctx, delAddr)
+	if len(pus) == 0 {
+		return sdk.Coin{}, types.ErrNoClaimableUnstakes
+	}
+
+	claimed := sdk.NewCoin(bondDenom, amount)
+	if amount.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, types.UnstakeBatchAcc, delAddr, sdk.NewCoins(claimed)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	for _, pu := range pus {
+		k.DeletePendingUnstake(ctx, delAddr, pu.EpochNumber)
+		if !k.hasPendingUnstakesInEpoch(ctx, pu.EpochNumber) {
+			k.DeleteUnstakeBatch(ctx, pu.EpochNumber)
+		}
+	}
+	return claimed, nil
+}
+
+// hasPendingUnstakesInEpoch returns whether any delegator still has a pending unstake queued in the epoch.
+func (k Keeper) hasPendingUnstakesInEpoch(ctx sdk.Context, epochNumber uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPendingUnstakesByEpochIndexPrefix(epochNumber))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
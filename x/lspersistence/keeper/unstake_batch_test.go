@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/keeper"
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func (s *KeeperTestSuite) TestBatchedLiquidUnstake() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: valOpers[1].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.StakeFeeRate = sdk.ZeroDec()
+	params.UnstakeFeeRate = sdk.NewDecWithPrec(1, 2)
+	params.UnstakeEpochDuration = 24 * time.Hour
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	s.Require().NoError(s.liquidStaking(s.delAddrs[1], sdk.NewInt(100000000)))
+
+	// the first epoch starts
+	s.keeper.ProcessUnstakeEpochs(s.ctx)
+	epoch := s.keeper.GetUnstakeEpoch(s.ctx)
+	s.Require().Equal(uint64(0), epoch.Number)
+	s.Require().Equal(s.ctx.BlockTime(), epoch.StartTime)
+
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	res, err := msgServer.LiquidUnstake(
+		sdk.WrapSDKContext(s.ctx),
+		types.NewMsgLiquidUnstake(s.delAddrs[0], sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(50000000))),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal(
+		s.ctx.BlockTime().Add(params.UnstakeEpochDuration).Add(s.app.StakingKeeper.UnbondingTime(s.ctx)),
+		res.CompletionTime,
+	)
+	_, err = msgServer.LiquidUnstake(
+		sdk.WrapSDKContext(s.ctx),
+		types.NewMsgLiquidUnstake(s.delAddrs[1], sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(10000000))),
+	)
+	s.Require().NoError(err)
+
+	// the btokens are burned and the unstakes are queued without unbonding
+	batch, found := s.keeper.GetUnstakeBatch(s.ctx, 0)
+	s.Require().True(found)
+	s.Require().Equal(types.UnstakeBatchStatePending, batch.State)
+	s.Require().Equal(sdk.NewInt(59400000), batch.UnstakeAmount)
+	s.Require().Equal(sdk.NewInt(600000), batch.FeeAmount)
+
+	pus := s.keeper.GetPendingUnstakesByDelegator(s.ctx, s.delAddrs[0])
+	s.Require().Len(pus, 1)
+	s.Require().Equal(sdk.NewInt(49500000), pus[0].UnstakeAmount)
+	s.Require().Equal(sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(50000000)), pus[0].BurnedAmount)
+
+	nas := s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(sdk.NewInt(140000000), nas.BtokenTotalSupply)
+	s.Require().Equal(sdk.NewInt(60000000), nas.TotalPendingUnstakeAmount)
+	s.Require().Equal(sdk.NewDec(140000000), nas.NetAmount)
+	s.Require().Equal(sdk.OneDec(), nas.MintRate)
+	s.Require().Len(s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, s.delAddrs[0]), 0)
+
+	// nothing to claim before the batch is unbonded
+	_, err = s.keeper.Claim(s.ctx, s.delAddrs[0])
+	s.Require().ErrorIs(err, types.ErrNoClaimableUnstakes)
+
+	// the batch is unbonded from the proxy account once the epoch ends
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(s.ctx.BlockTime().Add(params.UnstakeEpochDuration))
+	s.keeper.ProcessUnstakeEpochs(s.ctx)
+	s.Require().Equal(uint64(1), s.keeper.GetUnstakeEpoch(s.ctx).Number)
+
+	batch, _ = s.keeper.GetUnstakeBatch(s.ctx, 0)
+	s.Require().Equal(types.UnstakeBatchStateUnbonding, batch.State)
+	s.Require().Equal(sdk.NewInt(60000000), batch.UnbondedAmount)
+	s.Require().Len(s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, types.UnstakeBatchAcc), 2)
+
+	nas = s.keeper.GetNetAmountState(s.ctx)
+	s.Require().Equal(sdk.ZeroInt(), nas.TotalPendingUnstakeAmount)
+	s.Require().Equal(sdk.NewDec(140000000), nas.NetAmount)
+
+	// the batch is claimable once the unbonding delegations mature
+	s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(batch.CompletionTime)
+	staking.EndBlocker(s.ctx, s.app.StakingKeeper)
+	feeAddress := sdk.MustAccAddressFromBech32(params.FeeAddress)
+	feeBalanceBefore := s.app.BankKeeper.GetBalance(s.ctx, feeAddress, sdk.DefaultBondDenom).Amount
+	s.keeper.ProcessUnstakeEpochs(s.ctx)
+
+	batch, _ = s.keeper.GetUnstakeBatch(s.ctx, 0)
+	s.Require().Len(s.app.StakingKeeper.GetAllUnbondingDelegations(s.ctx, types.UnstakeBatchAcc), 0)
+	s.Require().Equal(types.UnstakeBatchStateClaimable, batch.State)
+	s.Require().Equal(feeBalanceBefore.Add(sdk.NewInt(600000)), s.app.BankKeeper.GetBalance(s.ctx, feeAddress, sdk.DefaultBondDenom).Amount)
+
+	queryRes, err := s.querier.ClaimableUnstakes(
+		sdk.WrapSDKContext(s.ctx), &types.QueryClaimableUnstakesRequest{DelegatorAddress: s.delAddrs[0].String()},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(queryRes.ClaimableUnstakes, 1)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 49500000), queryRes.Amount)
+
+	balanceBefore := s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[0], sdk.DefaultBondDenom).Amount
+	claimRes, err := msgServer.Claim(sdk.WrapSDKContext(s.ctx), types.NewMsgClaim(s.delAddrs[0]))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 49500000), claimRes.Amount)
+	s.Require().Equal(balanceBefore.Add(sdk.NewInt(49500000)), s.app.BankKeeper.GetBalance(s.ctx, s.delAddrs[0], sdk.DefaultBondDenom).Amount)
+	s.Require().Len(s.keeper.GetPendingUnstakesByDelegator(s.ctx, s.delAddrs[0]), 0)
+
+	// the batch is removed once every unstake is claimed
+	_, found = s.keeper.GetUnstakeBatch(s.ctx, 0)
+	s.Require().True(found)
+	_, err = s.keeper.Claim(s.ctx, s.delAddrs[1])
+	s.Require().NoError(err)
+	_, found = s.keeper.GetUnstakeBatch(s.ctx, 0)
+	s.Require().False(found)
+	s.Require().True(s.app.BankKeeper.GetBalance(s.ctx, types.UnstakeBatchAcc, sdk.DefaultBondDenom).IsZero())
+
+	_, err = s.keeper.Claim(s.ctx, s.delAddrs[0])
+	s.Require().ErrorIs(err, types.ErrNoClaimableUnstakes)
+}
+
+func (s *KeeperTestSuite) TestPendingUnstakesQuery() {
+	_, valOpers, _ := s.CreateValidators([]int64{1000000, 1000000})
+	params := s.keeper.GetParams(s.ctx)
+	params.WhitelistedValidators = []types.WhitelistedValidator{
+		{ValidatorAddress: valOpers[0].String(), TargetWeight: sdk.NewInt(10)},
+	}
+	params.StakeFeeRate = sdk.ZeroDec()
+	s.keeper.SetParams(s.ctx, params)
+	s.keeper.UpdateLiquidValidatorSet(s.ctx)
+
+	s.Require().NoError(s.liquidStaking(s.delAddrs[0], sdk.NewInt(100000000)))
+	_, _, err := s.keeper.QueueLiquidUnstake(s.ctx, s.delAddrs[0], sdk.NewCoin(params.LiquidBondDenom, sdk.NewInt(1000000)))
+	s.Require().NoError(err)
+
+	res, err := s.querier.PendingUnstakes(
+		sdk.WrapSDKContext(s.ctx), &types.QueryPendingUnstakesRequest{DelegatorAddress: s.delAddrs[0].String()},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(res.PendingUnstakes, 1)
+	s.Require().Equal(sdk.NewInt(1000000), res.PendingUnstakes[0].UnstakeAmount)
+
+	res, err = s.querier.PendingUnstakes(
+		sdk.WrapSDKContext(s.ctx), &types.QueryPendingUnstakesRequest{DelegatorAddress: s.delAddrs[1].String()},
+	)
+	s.Require().NoError(err)
+	s.Require().Len(res.PendingUnstakes, 0)
+
+	_, err = s.querier.PendingUnstakes(sdk.WrapSDKContext(s.ctx), &types.QueryPendingUnstakesRequest{DelegatorAddress: "invalid"})
+	s.Require().Error(err)
+}
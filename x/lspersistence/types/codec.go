@@ -12,6 +12,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLiquidStake{}, "lspersistence/MsgLiquidStake", nil)
 	cdc.RegisterConcrete(&MsgLiquidUnstake{}, "lspersistence/MsgLiquidUnstake", nil)
+	cdc.RegisterConcrete(&MsgRedeem{}, "lspersistence/MsgRedeem", nil)
+	cdc.RegisterConcrete(&MsgClaim{}, "lspersistence/MsgClaim", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "lspersistence/MsgUpdateParams", nil)
 }
 
@@ -21,6 +23,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgLiquidStake{},
 		&MsgLiquidUnstake{},
+		&MsgRedeem{},
+		&MsgClaim{},
 		&MsgUpdateParams{},
 	)
 }
@@ -18,4 +18,7 @@ var (
 	ErrInsufficientProxyAccBalance     = errorsmod.Register(ModuleName, 11, "insufficient liquid tokens or balance of proxy account, need to wait for new liquid validator to be added or unbonding of proxy account to be completed")
 	ErrTooSmallLiquidStakingAmount     = errorsmod.Register(ModuleName, 12, "liquid staking amount is too small, the result becomes zero")
 	ErrTooSmallLiquidUnstakingAmount   = errorsmod.Register(ModuleName, 13, "liquid unstaking amount is too small, the result becomes zero")
+	ErrTooSmallRedeemAmount            = errorsmod.Register(ModuleName, 14, "redeem amount is too small, the result becomes zero")
+	ErrInsufficientRedeemLiquidity     = errorsmod.Register(ModuleName, 15, "insufficient balance of proxy account for instant redemption")
+	ErrNoClaimableUnstakes             = errorsmod.Register(ModuleName, 16, "no claimable liquid unstakes")
 )
@@ -4,12 +4,17 @@ package types
 const (
 	EventTypeMsgLiquidStake             = TypeMsgLiquidStake
 	EventTypeMsgLiquidUnstake           = TypeMsgLiquidUnstake
+	EventTypeMsgRedeem                  = TypeMsgRedeem
+	EventTypeMsgClaim                   = TypeMsgClaim
 	EventTypeMsgUpdateParams            = TypeMsgUpdateParams
 	EventTypeAddLiquidValidator         = "add_liquid_validator"
 	EventTypeRemoveLiquidValidator      = "remove_liquid_validator"
 	EventTypeBeginRebalancing           = "begin_rebalancing"
 	EventTypeReStake                    = "re_stake"
 	EventTypeUnbondInactiveLiquidTokens = "unbond_inactive_liquid_tokens"
+	EventTypeUnstakeBatchUnbonded       = "unstake_batch_unbonded"
+	EventTypeUnstakeBatchClaimable      = "unstake_batch_claimable"
+	EventTypeScoreValidator             = "score_validator"
 
 	AttributeKeyDelegator             = "delegator"
 	AttributeKeyNewShares             = "new_shares"
@@ -17,9 +22,12 @@ const (
 	AttributeKeyCompletionTime        = "completion_time"
 	AttributeKeyUnbondingAmount       = "unbonding_amount"
 	AttributeKeyUnbondedAmount        = "unbonded_amount"
+	AttributeKeyRedeemedAmount        = "redeemed_amount"
+	AttributeKeyEpochNumber           = "epoch_number"
 	AttributeKeyLiquidValidator       = "liquid_validator"
 	AttributeKeyRedelegationCount     = "redelegation_count"
 	AttributeKeyRedelegationFailCount = "redelegation_fail_count"
+	AttributeKeyScore                 = "score"
 	AttributeKeyPstakeDepositFee      = "pstake-deposit-fee"
 	AttributeKeyPstakeRestakeFee      = "pstake-restake-fee"
 	AttributeKeyPstakeRedeemFee       = "pstake-redeem-fee"
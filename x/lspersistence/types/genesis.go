@@ -2,14 +2,32 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewGenesisState returns new GenesisState instance.
-func NewGenesisState(params Params, liquidValidators []LiquidValidator) *GenesisState {
+func NewGenesisState(
+	params Params,
+	liquidValidators []LiquidValidator,
+	unstakeEpoch UnstakeEpoch,
+	unstakeBatches []UnstakeBatch,
+	pendingUnstakes []PendingUnstake,
+	inFlightRedelegations []InFlightRedelegation,
+	lastRebalancingHeight uint64,
+	validatorScores []ValidatorScore,
+	lastScoringHeight uint64,
+) *GenesisState {
 	return &GenesisState{
-		Params:           params,
-		LiquidValidators: liquidValidators,
+		Params:                params,
+		LiquidValidators:      liquidValidators,
+		UnstakeEpoch:          unstakeEpoch,
+		UnstakeBatches:        unstakeBatches,
+		PendingUnstakes:       pendingUnstakes,
+		InFlightRedelegations: inFlightRedelegations,
+		LastRebalancingHeight: lastRebalancingHeight,
+		ValidatorScores:       validatorScores,
+		LastScoringHeight:     lastScoringHeight,
 	}
 }
 
@@ -18,6 +36,13 @@ func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]LiquidValidator{},
+		UnstakeEpoch{},
+		[]UnstakeBatch{},
+		[]PendingUnstake{},
+		[]InFlightRedelegation{},
+		0,
+		[]ValidatorScore{},
+		0,
 	)
 }
 
@@ -33,5 +58,33 @@ func ValidateGenesis(data GenesisState) error {
 				"invalid liquid validator %s: %v", lv, err)
 		}
 	}
+	batches := map[uint64]struct{}{}
+	for _, batch := range data.UnstakeBatches {
+		if _, ok := batches[batch.EpochNumber]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated unstake batch for epoch %d", batch.EpochNumber)
+		}
+		if batch.EpochNumber > data.UnstakeEpoch.Number {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unstake batch for future epoch %d", batch.EpochNumber)
+		}
+		batches[batch.EpochNumber] = struct{}{}
+	}
+	for _, pu := range data.PendingUnstakes {
+		if _, err := sdk.AccAddressFromBech32(pu.DelegatorAddress); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid pending unstake delegator %s: %v", pu.DelegatorAddress, err)
+		}
+		if _, ok := batches[pu.EpochNumber]; !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no unstake batch for pending unstake epoch %d", pu.EpochNumber)
+		}
+	}
+	for _, red := range data.InFlightRedelegations {
+		if err := red.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid in-flight redelegation: %v", err)
+		}
+	}
+	for _, score := range data.ValidatorScores {
+		if err := score.Validate(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator score: %v", err)
+		}
+	}
 	return nil
 }
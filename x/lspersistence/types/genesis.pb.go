@@ -26,8 +26,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the liquidstaking module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters for the liquidstaking module
-	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	LiquidValidators []LiquidValidator `protobuf:"bytes,2,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators" yaml:"liquid_validators"`
+	Params                Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LiquidValidators      []LiquidValidator      `protobuf:"bytes,2,rep,name=liquid_validators,json=liquidValidators,proto3" json:"liquid_validators" yaml:"liquid_validators"`
+	UnstakeEpoch          UnstakeEpoch           `protobuf:"bytes,3,opt,name=unstake_epoch,json=unstakeEpoch,proto3" json:"unstake_epoch" yaml:"unstake_epoch"`
+	UnstakeBatches        []UnstakeBatch         `protobuf:"bytes,4,rep,name=unstake_batches,json=unstakeBatches,proto3" json:"unstake_batches" yaml:"unstake_batches"`
+	PendingUnstakes       []PendingUnstake       `protobuf:"bytes,5,rep,name=pending_unstakes,json=pendingUnstakes,proto3" json:"pending_unstakes" yaml:"pending_unstakes"`
+	InFlightRedelegations []InFlightRedelegation `protobuf:"bytes,6,rep,name=in_flight_redelegations,json=inFlightRedelegations,proto3" json:"in_flight_redelegations" yaml:"in_flight_redelegations"`
+	LastRebalancingHeight uint64                 `protobuf:"varint,7,opt,name=last_rebalancing_height,json=lastRebalancingHeight,proto3" json:"last_rebalancing_height,omitempty" yaml:"last_rebalancing_height"`
+	ValidatorScores       []ValidatorScore       `protobuf:"bytes,8,rep,name=validator_scores,json=validatorScores,proto3" json:"validator_scores" yaml:"validator_scores"`
+	LastScoringHeight     uint64                 `protobuf:"varint,9,opt,name=last_scoring_height,json=lastScoringHeight,proto3" json:"last_scoring_height,omitempty" yaml:"last_scoring_height"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -72,26 +79,43 @@ func init() {
 }
 
 var fileDescriptor_7f1ffec0efd8ea86 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x2a, 0x28, 0x2e, 0x49,
-	0xcc, 0x4e, 0xd5, 0xcf, 0x29, 0x2e, 0x48, 0x2d, 0x2a, 0xce, 0x2c, 0x2e, 0x49, 0xcd, 0x4b, 0x4e,
-	0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xa8, 0xd5, 0x43, 0x51, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x19, 0xe0,
-	0x35, 0x3f, 0x27, 0xb3, 0xb0, 0x34, 0x33, 0x05, 0xa4, 0x22, 0x33, 0x2f, 0x1d, 0xa2, 0x43, 0xe9,
-	0x16, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xde, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x27, 0x2e, 0xb6,
-	0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x15, 0x3d, 0x7c,
-	0xee, 0xd0, 0x0b, 0x00, 0xab, 0x75, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0x53, 0xa8,
-	0x86, 0x4b, 0x10, 0x62, 0x57, 0x7c, 0x59, 0x62, 0x4e, 0x66, 0x4a, 0x62, 0x49, 0x7e, 0x51, 0xb1,
-	0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x2e, 0x7e, 0xe3, 0x7c, 0xc0, 0xda, 0xc2, 0x60, 0xba,
-	0x9c, 0x14, 0x40, 0xe6, 0x7e, 0xba, 0x27, 0x2f, 0x51, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x61,
-	0xaa, 0x52, 0x90, 0x40, 0x0e, 0xaa, 0x96, 0x62, 0x2b, 0x8e, 0x8e, 0x05, 0xf2, 0x0c, 0x2f, 0x16,
-	0xc8, 0x33, 0x38, 0xc5, 0xad, 0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x0e, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x48,
-	0x6e, 0xf1, 0xcf, 0x4b, 0xd5, 0x87, 0xb8, 0x51, 0x37, 0x2f, 0xb1, 0x24, 0xb3, 0x2c, 0x55, 0xbf,
-	0xcc, 0x48, 0xbf, 0x02, 0x2d, 0x48, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x61, 0x68,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xec, 0x08, 0xec, 0xd7, 0x01, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x1c, 0xc6, 0x93, 0x5f, 0xfb, 0xeb, 0x46, 0x36, 0xe8, 0x16, 0x36, 0x1a, 0x55, 0x53, 0x52, 0x59,
+	0x08, 0x55, 0x13, 0x4b, 0x58, 0xb9, 0xed, 0x84, 0x22, 0xf1, 0x4f, 0x42, 0x80, 0x5c, 0xc1, 0x61,
+	0x07, 0x22, 0x37, 0x35, 0xa9, 0x45, 0xea, 0x84, 0xd8, 0xad, 0x36, 0x89, 0x17, 0xc0, 0x11, 0xf1,
+	0x0a, 0x76, 0xe4, 0xa5, 0xec, 0xb8, 0x23, 0xa7, 0x0a, 0xb5, 0x17, 0xce, 0xe3, 0x0d, 0x20, 0xdb,
+	0xe9, 0x96, 0x14, 0x56, 0x76, 0xab, 0xfc, 0x7d, 0x3e, 0xcf, 0xf3, 0x7d, 0xdc, 0xc8, 0xc6, 0x6e,
+	0xca, 0x38, 0xfa, 0x80, 0xbd, 0x98, 0xa5, 0x38, 0x63, 0x84, 0x71, 0x4c, 0x43, 0xec, 0x8d, 0xf7,
+	0x7b, 0x98, 0xa3, 0x7d, 0x2f, 0xc2, 0x14, 0x33, 0xc2, 0xdc, 0x34, 0x4b, 0x78, 0x62, 0xee, 0x28,
+	0xad, 0x5b, 0xd2, 0xba, 0xb9, 0xb6, 0xb9, 0x15, 0x25, 0x51, 0x22, 0x85, 0x9e, 0xf8, 0xa5, 0x98,
+	0xe6, 0x83, 0xa5, 0xfe, 0x31, 0xf9, 0x38, 0x22, 0x7d, 0xa1, 0x20, 0x34, 0x52, 0x04, 0xf8, 0xb5,
+	0x62, 0xac, 0x3f, 0x55, 0xb9, 0x5d, 0x8e, 0x38, 0x36, 0x7d, 0xa3, 0x96, 0xa2, 0x0c, 0x0d, 0x99,
+	0xa5, 0xb7, 0xf4, 0xf6, 0x5a, 0xe7, 0xae, 0xbb, 0x6c, 0x0f, 0xf7, 0xb5, 0xd4, 0xfa, 0xd5, 0xd3,
+	0x89, 0xa3, 0xc1, 0x9c, 0x34, 0x3f, 0x19, 0x9b, 0x2a, 0x2b, 0x18, 0xa3, 0x98, 0xf4, 0x11, 0x4f,
+	0x32, 0x66, 0xfd, 0xd7, 0xaa, 0xb4, 0xd7, 0x3a, 0x7b, 0xcb, 0xed, 0x5e, 0x48, 0xec, 0xed, 0x9c,
+	0xf2, 0x5b, 0xc2, 0xf7, 0x7c, 0xe2, 0x58, 0xc7, 0x68, 0x18, 0x1f, 0x80, 0x3f, 0x5c, 0x01, 0xdc,
+	0x88, 0xcb, 0x08, 0x33, 0x87, 0xc6, 0xcd, 0x11, 0x95, 0x21, 0x01, 0x4e, 0x93, 0x70, 0x60, 0x55,
+	0x64, 0x91, 0xdd, 0xe5, 0xc9, 0x6f, 0x14, 0xf2, 0x58, 0x10, 0xfe, 0x4e, 0x1e, 0xbb, 0xa5, 0x62,
+	0x4b, 0x76, 0x00, 0xae, 0x8f, 0x0a, 0x5a, 0x93, 0x19, 0xf5, 0xf9, 0xbc, 0x87, 0x78, 0x38, 0xc0,
+	0xcc, 0xaa, 0xb6, 0x2a, 0xd7, 0x0e, 0xf4, 0x05, 0xe3, 0xdb, 0x79, 0xe0, 0x9d, 0x72, 0x60, 0x6e,
+	0x08, 0xe0, 0xad, 0x51, 0x41, 0x8d, 0x99, 0x79, 0x64, 0x6c, 0xa4, 0x98, 0xf6, 0x09, 0x8d, 0x82,
+	0x7c, 0xc2, 0xac, 0xff, 0x65, 0xea, 0xfd, 0x7f, 0xfc, 0x5f, 0x8a, 0x9a, 0x87, 0x3b, 0x79, 0x6e,
+	0x43, 0xe5, 0x2e, 0x7a, 0x02, 0x58, 0x4f, 0x4b, 0x00, 0x33, 0xbf, 0xea, 0x46, 0x83, 0xd0, 0xe0,
+	0x7d, 0x4c, 0xa2, 0x01, 0x0f, 0x32, 0xdc, 0xc7, 0x31, 0x8e, 0x10, 0x27, 0x09, 0x65, 0x56, 0x4d,
+	0x6e, 0xd0, 0x59, 0xbe, 0xc1, 0x73, 0xfa, 0x44, 0xb2, 0xb0, 0x80, 0xfa, 0xf7, 0xf2, 0x3d, 0x6c,
+	0xb5, 0xc7, 0x15, 0x01, 0x00, 0x6e, 0x93, 0xbf, 0xd0, 0xcc, 0x3c, 0x34, 0x1a, 0x31, 0x62, 0x42,
+	0xdd, 0x43, 0x31, 0xa2, 0xa1, 0xe8, 0x30, 0xc0, 0x42, 0x67, 0xad, 0xb4, 0xf4, 0x76, 0xd5, 0x07,
+	0x97, 0xde, 0x57, 0x08, 0x01, 0xdc, 0x16, 0x13, 0x78, 0x39, 0x78, 0x26, 0xcf, 0xc5, 0x55, 0x5f,
+	0x7c, 0x6f, 0x01, 0x0b, 0x93, 0x0c, 0x33, 0x6b, 0xf5, 0x3a, 0x57, 0x7d, 0xf1, 0x49, 0x76, 0x05,
+	0xb4, 0x78, 0xd5, 0x8b, 0x9e, 0x00, 0xd6, 0xc7, 0x25, 0x80, 0x99, 0x2f, 0x8d, 0xdb, 0x72, 0x59,
+	0x21, 0x28, 0x34, 0xba, 0x21, 0x1b, 0xd9, 0xe7, 0x13, 0xa7, 0x59, 0x68, 0x54, 0x16, 0x01, 0xb8,
+	0x29, 0x4e, 0xbb, 0xea, 0x50, 0x35, 0x39, 0x58, 0xfd, 0x7c, 0xe2, 0x68, 0x3f, 0x4f, 0x1c, 0xcd,
+	0x7f, 0xf7, 0x6d, 0x6a, 0xeb, 0xa7, 0x53, 0x5b, 0x3f, 0x9b, 0xda, 0xfa, 0x8f, 0xa9, 0xad, 0x7f,
+	0x99, 0xd9, 0xda, 0xd9, 0xcc, 0xd6, 0xbe, 0xcf, 0x6c, 0xed, 0xf0, 0x51, 0x44, 0xf8, 0x60, 0xd4,
+	0x73, 0xc3, 0x64, 0xe8, 0x15, 0x8a, 0xbd, 0xa2, 0xd8, 0x53, 0x85, 0xf7, 0x28, 0xe2, 0x64, 0x8c,
+	0xbd, 0x71, 0xc7, 0x3b, 0x5a, 0x78, 0x6b, 0xf8, 0x71, 0x8a, 0x59, 0xaf, 0x26, 0x1f, 0x97, 0x87,
+	0xbf, 0x07, 0x00, 0x59, 0x2f, 0x69, 0x22, 0xf0, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +138,82 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastScoringHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastScoringHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ValidatorScores) > 0 {
+		for iNdEx := len(m.ValidatorScores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorScores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.LastRebalancingHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRebalancingHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.InFlightRedelegations) > 0 {
+		for iNdEx := len(m.InFlightRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingUnstakes) > 0 {
+		for iNdEx := len(m.PendingUnstakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUnstakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UnstakeBatches) > 0 {
+		for iNdEx := len(m.UnstakeBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnstakeBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.UnstakeEpoch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.LiquidValidators) > 0 {
 		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -166,6 +266,38 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.UnstakeEpoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UnstakeBatches) > 0 {
+		for _, e := range m.UnstakeBatches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingUnstakes) > 0 {
+		for _, e := range m.PendingUnstakes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InFlightRedelegations) > 0 {
+		for _, e := range m.InFlightRedelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastRebalancingHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRebalancingHeight))
+	}
+	if len(m.ValidatorScores) > 0 {
+		for _, e := range m.ValidatorScores {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastScoringHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastScoringHeight))
+	}
 	return n
 }
 
@@ -271,6 +403,213 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnstakeBatches = append(m.UnstakeBatches, UnstakeBatch{})
+			if err := m.UnstakeBatches[len(m.UnstakeBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnstakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingUnstakes = append(m.PendingUnstakes, PendingUnstake{})
+			if err := m.PendingUnstakes[len(m.PendingUnstakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightRedelegations = append(m.InFlightRedelegations, InFlightRedelegation{})
+			if err := m.InFlightRedelegations[len(m.InFlightRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRebalancingHeight", wireType)
+			}
+			m.LastRebalancingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRebalancingHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorScores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorScores = append(m.ValidatorScores, ValidatorScore{})
+			if err := m.ValidatorScores[len(m.ValidatorScores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScoringHeight", wireType)
+			}
+			m.LastScoringHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastScoringHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
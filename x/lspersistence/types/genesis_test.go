@@ -50,6 +50,62 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			"unstake fee rate must not be nil",
 		},
+		{
+			"duplicated unstake batch",
+			func(genState *types.GenesisState) {
+				genState.UnstakeEpoch = types.UnstakeEpoch{Number: 1}
+				genState.UnstakeBatches = []types.UnstakeBatch{{EpochNumber: 1}, {EpochNumber: 1}}
+			},
+			"duplicated unstake batch for epoch 1: invalid request",
+		},
+		{
+			"unstake batch for future epoch",
+			func(genState *types.GenesisState) {
+				genState.UnstakeBatches = []types.UnstakeBatch{{EpochNumber: 1}}
+			},
+			"unstake batch for future epoch 1: invalid request",
+		},
+		{
+			"pending unstake without unstake batch",
+			func(genState *types.GenesisState) {
+				genState.PendingUnstakes = []types.PendingUnstake{
+					{
+						DelegatorAddress: "persistence1kk3vjcjsvy3kd6389lavdkt5f2h5k3d2ry296c",
+						EpochNumber:      0,
+					},
+				}
+			},
+			"no unstake batch for pending unstake epoch 0: invalid request",
+		},
+		{
+			"in-flight redelegation without amount",
+			func(genState *types.GenesisState) {
+				genState.InFlightRedelegations = []types.InFlightRedelegation{
+					{
+						SrcValidatorAddress: "persistencevaloper15ky9du8a2wlstz6fpx3p4mqpjyrm5cgfkyfs5j",
+						DstValidatorAddress: "persistencevaloper15ky9du8a2wlstz6fpx3p4mqpjyrm5cgjnzsj3r",
+						Amount:              sdk.ZeroInt(),
+					},
+				}
+			},
+			"invalid in-flight redelegation: redelegation amount must be positive: 0: invalid request",
+		},
+		{
+			"validator score out of range",
+			func(genState *types.GenesisState) {
+				genState.ValidatorScores = []types.ValidatorScore{
+					{
+						ValidatorAddress: "persistencevaloper15ky9du8a2wlstz6fpx3p4mqpjyrm5cgfkyfs5j",
+						Height:           1,
+						Uptime:           sdk.OneDec(),
+						CommissionRate:   sdk.ZeroDec(),
+						SelfBondRate:     sdk.ZeroDec(),
+						Score:            sdk.NewDec(2),
+					},
+				}
+			},
+			"invalid validator score: score rates must be between zero and one: 2.000000000000000000: invalid request",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			genState := types.DefaultGenesisState()
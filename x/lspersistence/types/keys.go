@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -23,6 +25,17 @@ var (
 	// Keys for store prefixes
 	ParamsKey           = []byte{0x00}
 	LiquidValidatorsKey = []byte{0xc0} // prefix for each key to a liquid validator
+	UnstakeEpochKey     = []byte{0xd0} // key for the current unstake epoch
+	UnstakeBatchesKey   = []byte{0xd1} // prefix for each key to an unstake batch
+	PendingUnstakesKey  = []byte{0xd2} // prefix for each key to a pending unstake
+
+	PendingUnstakesByEpochIndexKey = []byte{0xd3} // prefix for each key to a pending unstake, indexed by epoch
+
+	LastRebalancingHeightKey = []byte{0xe0} // key for the height of the last rebalancing
+	InFlightRedelegationsKey = []byte{0xe1} // prefix for each key to an in-flight redelegation
+
+	LastScoringHeightKey = []byte{0xf0} // key for the height of the last validator scoring
+	ValidatorScoresKey   = []byte{0xf1} // prefix for each key to a validator score
 )
 
 // GetLiquidValidatorKey creates the key for the liquid validator with address
@@ -30,3 +43,54 @@ var (
 func GetLiquidValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(LiquidValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
+
+// GetUnstakeBatchKey creates the key for the unstake batch of the epoch
+// VALUE: lspersistence/UnstakeBatch
+func GetUnstakeBatchKey(epochNumber uint64) []byte {
+	return append(UnstakeBatchesKey, sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// GetPendingUnstakesByDelegatorPrefix creates the prefix for the pending unstakes of the delegator
+func GetPendingUnstakesByDelegatorPrefix(delAddr sdk.AccAddress) []byte {
+	return append(PendingUnstakesKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetPendingUnstakeKey creates the key for the pending unstake of the delegator queued in the epoch
+// VALUE: lspersistence/PendingUnstake
+func GetPendingUnstakeKey(delAddr sdk.AccAddress, epochNumber uint64) []byte {
+	return append(GetPendingUnstakesByDelegatorPrefix(delAddr), sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// GetPendingUnstakesByEpochIndexPrefix creates the prefix for the index of the pending unstakes queued in the epoch
+func GetPendingUnstakesByEpochIndexPrefix(epochNumber uint64) []byte {
+	return append(PendingUnstakesByEpochIndexKey, sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// GetPendingUnstakeByEpochIndexKey creates the index key for the pending unstake of the delegator queued in the epoch
+// VALUE: []byte{}
+func GetPendingUnstakeByEpochIndexKey(epochNumber uint64, delAddr sdk.AccAddress) []byte {
+	return append(GetPendingUnstakesByEpochIndexPrefix(epochNumber), address.MustLengthPrefix(delAddr)...)
+}
+
+// GetInFlightRedelegationKey creates the key for the in-flight redelegation, ordered by completion time
+// VALUE: lspersistence/InFlightRedelegation
+func GetInFlightRedelegationKey(completionTime time.Time, srcValAddr, dstValAddr sdk.ValAddress) []byte {
+	key := append(GetInFlightRedelegationsByTimeKey(completionTime), address.MustLengthPrefix(srcValAddr)...)
+	return append(key, address.MustLengthPrefix(dstValAddr)...)
+}
+
+// GetInFlightRedelegationsByTimeKey creates the prefix for the in-flight redelegations completing at the time
+func GetInFlightRedelegationsByTimeKey(completionTime time.Time) []byte {
+	return append(InFlightRedelegationsKey, sdk.FormatTimeBytes(completionTime)...)
+}
+
+// GetValidatorScoresByValidatorPrefix creates the prefix for the scores of the validator
+func GetValidatorScoresByValidatorPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorScoresKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorScoreKey creates the key for the score of the validator sampled at the height
+// VALUE: lspersistence/ValidatorScore
+func GetValidatorScoreKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetValidatorScoresByValidatorPrefix(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
@@ -24,6 +24,14 @@ func GetWhitelistedValsMap(whitelistedValidators []WhitelistedValidator) Whiteli
 	return whitelistedValsMap
 }
 
+func (wv WhitelistedValidator) GetOperator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(wv.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // Validate validates LiquidValidator.
 func (v LiquidValidator) Validate() error {
 	_, valErr := sdk.ValAddressFromBech32(v.OperatorAddress)
@@ -98,11 +106,20 @@ type ActiveLiquidValidators LiquidValidators
 
 // MinMaxGap Return the list of LiquidValidator with the maximum gap and minimum gap from the target weight of LiquidValidators, respectively.
 func (vs LiquidValidators) MinMaxGap(targetMap, liquidTokenMap map[string]math.Int) (minGapVal LiquidValidator, maxGapVal LiquidValidator, amountNeeded math.Int, lastRedelegation bool) {
+	return vs.MinMaxGapExcludingSrc(targetMap, liquidTokenMap, nil)
+}
+
+// MinMaxGapExcludingSrc is MinMaxGap where the validators of excludedSrcMap, which are receiving redelegations and
+// cannot redelegate until they complete, are not picked as the maximum gap validator.
+func (vs LiquidValidators) MinMaxGapExcludingSrc(targetMap, liquidTokenMap map[string]math.Int, excludedSrcMap map[string]struct{}) (minGapVal LiquidValidator, maxGapVal LiquidValidator, amountNeeded math.Int, lastRedelegation bool) {
 	maxGap := sdk.ZeroInt()
 	minGap := sdk.ZeroInt()
 
 	for _, val := range vs {
 		gap := liquidTokenMap[val.OperatorAddress].Sub(targetMap[val.OperatorAddress])
+		if _, excluded := excludedSrcMap[val.OperatorAddress]; excluded && gap.IsPositive() {
+			continue
+		}
 		if gap.GT(maxGap) {
 			maxGap = gap
 			maxGapVal = val
@@ -176,8 +193,13 @@ func DeductFeeRate(input sdk.Dec, feeRate sdk.Dec) (feeDeductedOutput sdk.Dec) {
 	return input.Sub(feeRate.Mul(input).TruncateDec()).TruncateDec()
 }
 
+// CalcNetAmount excludes the queued liquid unstakes, whose bTokens are already burned.
 func (nas NetAmountState) CalcNetAmount() sdk.Dec {
-	return sdk.NewDecFromInt(nas.ProxyAccBalance.Add(nas.TotalLiquidTokens).Add(nas.TotalUnbondingBalance)).Add(nas.TotalRemainingRewards)
+	netAmount := sdk.NewDecFromInt(nas.ProxyAccBalance.Add(nas.TotalLiquidTokens).Add(nas.TotalUnbondingBalance)).Add(nas.TotalRemainingRewards)
+	if !nas.TotalPendingUnstakeAmount.IsNil() {
+		netAmount = netAmount.Sub(sdk.NewDecFromInt(nas.TotalPendingUnstakeAmount))
+	}
+	return netAmount
 }
 
 func (nas NetAmountState) CalcMintRate() sdk.Dec {
@@ -6,20 +6,24 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -59,6 +63,38 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_729ca6a6bfc9e5d3, []int{0}
 }
 
+// State defines the state of the batch
+type UnstakeBatch_State int32
+
+const (
+	// the liquid unstakes are queued and not yet unbonded
+	UnstakeBatchStatePending UnstakeBatch_State = 0
+	// the batch is unbonding from the proxy account
+	UnstakeBatchStateUnbonding UnstakeBatch_State = 1
+	// the unbonded tokens can be claimed
+	UnstakeBatchStateClaimable UnstakeBatch_State = 2
+)
+
+var UnstakeBatch_State_name = map[int32]string{
+	0: "UNSTAKE_BATCH_STATE_PENDING",
+	1: "UNSTAKE_BATCH_STATE_UNBONDING",
+	2: "UNSTAKE_BATCH_STATE_CLAIMABLE",
+}
+
+var UnstakeBatch_State_value = map[string]int32{
+	"UNSTAKE_BATCH_STATE_PENDING":   0,
+	"UNSTAKE_BATCH_STATE_UNBONDING": 1,
+	"UNSTAKE_BATCH_STATE_CLAIMABLE": 2,
+}
+
+func (x UnstakeBatch_State) String() string {
+	return proto.EnumName(UnstakeBatch_State_name, int32(x))
+}
+
+func (UnstakeBatch_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{7, 0}
+}
+
 // Params defines the set of params for the liquidstaking module.
 type Params struct {
 	// LiquidBondDenom specifies the denomination of the token receiving after LiquidStaking, The value is calculated
@@ -77,6 +113,33 @@ type Params struct {
 	MinLiquidStakingAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=min_liquid_staking_amount,json=minLiquidStakingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_liquid_staking_amount" yaml:"min_liquid_staking_amount"`
 	AdminAddress           string                                 `protobuf:"bytes,8,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty" yaml:"admin_address"`
 	FeeAddress             string                                 `protobuf:"bytes,9,opt,name=fee_address,json=feeAddress,proto3" json:"fee_address,omitempty" yaml:"fee_address"`
+	// whitelisted_pools defines the pools and module accounts whose bToken balance is attributed to the holders of
+	// their share denom when calculating the governance voting power.
+	WhitelistedPools []WhitelistedPool `protobuf:"bytes,10,rep,name=whitelisted_pools,json=whitelistedPools,proto3" json:"whitelisted_pools" yaml:"whitelisted_pools"`
+	// liquidity_buffer_rate specifies the share of the net amount kept undelegated in LiquidStakingProxyAcc when
+	// re-staking rewards, providing the liquidity for instant redemptions
+	LiquidityBufferRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=liquidity_buffer_rate,json=liquidityBufferRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_buffer_rate" yaml:"liquidity_buffer_rate"`
+	// unstake_epoch_duration enables the epoch-batched liquid unstaking when positive; the liquid unstakes are queued
+	// and unbonded from LiquidStakingProxyAcc as a single batch once per epoch
+	UnstakeEpochDuration time.Duration `protobuf:"bytes,12,opt,name=unstake_epoch_duration,json=unstakeEpochDuration,proto3,stdduration" json:"unstake_epoch_duration" yaml:"unstake_epoch_duration"`
+	// rebalancing_trigger specifies the rate of the total liquid tokens the largest gap between the target and the
+	// actual liquid tokens of a liquid validator must exceed to trigger the rebalancing
+	RebalancingTrigger github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=rebalancing_trigger,json=rebalancingTrigger,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rebalancing_trigger" yaml:"rebalancing_trigger"`
+	// max_redelegations_per_block specifies the maximum number of redelegations attempted by a rebalancing, zero
+	// meaning one per liquid validator
+	MaxRedelegationsPerBlock uint32 `protobuf:"varint,14,opt,name=max_redelegations_per_block,json=maxRedelegationsPerBlock,proto3" json:"max_redelegations_per_block,omitempty" yaml:"max_redelegations_per_block"`
+	// rebalancing_cooldown_blocks specifies the number of blocks to wait after a rebalancing before the next one
+	RebalancingCooldownBlocks uint64 `protobuf:"varint,15,opt,name=rebalancing_cooldown_blocks,json=rebalancingCooldownBlocks,proto3" json:"rebalancing_cooldown_blocks,omitempty" yaml:"rebalancing_cooldown_blocks"`
+	// scoring_epoch_blocks specifies the number of blocks between two samplings of the whitelisted validators
+	// performance, zero disabling the scoring
+	ScoringEpochBlocks uint64 `protobuf:"varint,16,opt,name=scoring_epoch_blocks,json=scoringEpochBlocks,proto3" json:"scoring_epoch_blocks,omitempty" yaml:"scoring_epoch_blocks"`
+	// score_weighting_enabled derives the effective weights of the whitelisted validators from their target weight
+	// and their latest score instead of using the target weight as is
+	ScoreWeightingEnabled bool `protobuf:"varint,17,opt,name=score_weighting_enabled,json=scoreWeightingEnabled,proto3" json:"score_weighting_enabled,omitempty" yaml:"score_weighting_enabled"`
+	// score_weight_floor specifies the minimum rate of its target weight a scored validator keeps as effective weight
+	ScoreWeightFloor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=score_weight_floor,json=scoreWeightFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score_weight_floor" yaml:"score_weight_floor"`
+	// score_weight_cap specifies the maximum rate of its target weight a scored validator gets as effective weight
+	ScoreWeightCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=score_weight_cap,json=scoreWeightCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score_weight_cap" yaml:"score_weight_cap"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -155,6 +218,49 @@ func (m *WhitelistedValidator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WhitelistedValidator proto.InternalMessageInfo
 
+// WhitelistedPool consists of the address of a pool or module account holding bTokens and the denom of the shares
+// representing a claim over its reserves. The bTokens held by the pool are attributed to the share holders
+// proportionally to their share balance.
+type WhitelistedPool struct {
+	// pool_address defines the bech32-encoded address of the pool or module account
+	PoolAddress string `protobuf:"bytes,1,opt,name=pool_address,json=poolAddress,proto3" json:"pool_address,omitempty" yaml:"pool_address"`
+	// share_denom defines the denom of the pool shares
+	ShareDenom string `protobuf:"bytes,2,opt,name=share_denom,json=shareDenom,proto3" json:"share_denom,omitempty" yaml:"share_denom"`
+}
+
+func (m *WhitelistedPool) Reset()         { *m = WhitelistedPool{} }
+func (m *WhitelistedPool) String() string { return proto.CompactTextString(m) }
+func (*WhitelistedPool) ProtoMessage()    {}
+func (*WhitelistedPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{2}
+}
+func (m *WhitelistedPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhitelistedPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhitelistedPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WhitelistedPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhitelistedPool.Merge(m, src)
+}
+func (m *WhitelistedPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhitelistedPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhitelistedPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhitelistedPool proto.InternalMessageInfo
+
 // LiquidValidator defines a Validator that can be the target of LiquidStaking and LiquidUnstaking, Active, Weight, etc.
 // fields are derived as functions to deal with by maintaining consistency with the state of the staking module.
 type LiquidValidator struct {
@@ -166,7 +272,7 @@ func (m *LiquidValidator) Reset()         { *m = LiquidValidator{} }
 func (m *LiquidValidator) String() string { return proto.CompactTextString(m) }
 func (*LiquidValidator) ProtoMessage()    {}
 func (*LiquidValidator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_729ca6a6bfc9e5d3, []int{2}
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{3}
 }
 func (m *LiquidValidator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,7 +319,7 @@ func (m *LiquidValidatorState) Reset()         { *m = LiquidValidatorState{} }
 func (m *LiquidValidatorState) String() string { return proto.CompactTextString(m) }
 func (*LiquidValidatorState) ProtoMessage()    {}
 func (*LiquidValidatorState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_729ca6a6bfc9e5d3, []int{3}
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{4}
 }
 func (m *LiquidValidatorState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,13 +370,16 @@ type NetAmountState struct {
 	TotalUnbondingBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_unbonding_balance,json=totalUnbondingBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_unbonding_balance"`
 	// proxy_acc_balance define the balance of proxy account for the native token
 	ProxyAccBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=proxy_acc_balance,json=proxyAccBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"proxy_acc_balance"`
+	// total_pending_unstake_amount define the native token amount of the queued liquid unstakes not yet unbonded from
+	// the proxy account, including the unstake fees
+	TotalPendingUnstakeAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=total_pending_unstake_amount,json=totalPendingUnstakeAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_pending_unstake_amount"`
 }
 
 func (m *NetAmountState) Reset()         { *m = NetAmountState{} }
 func (m *NetAmountState) String() string { return proto.CompactTextString(m) }
 func (*NetAmountState) ProtoMessage()    {}
 func (*NetAmountState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_729ca6a6bfc9e5d3, []int{4}
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{5}
 }
 func (m *NetAmountState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,6 +408,279 @@ func (m *NetAmountState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NetAmountState proto.InternalMessageInfo
 
+// UnstakeEpoch is the epoch the queued liquid unstakes are batched by.
+type UnstakeEpoch struct {
+	// number defines the number of the current epoch
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// start_time defines the time the current epoch started at
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *UnstakeEpoch) Reset()         { *m = UnstakeEpoch{} }
+func (m *UnstakeEpoch) String() string { return proto.CompactTextString(m) }
+func (*UnstakeEpoch) ProtoMessage()    {}
+func (*UnstakeEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{6}
+}
+func (m *UnstakeEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnstakeEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnstakeEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnstakeEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnstakeEpoch.Merge(m, src)
+}
+func (m *UnstakeEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnstakeEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnstakeEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnstakeEpoch proto.InternalMessageInfo
+
+// UnstakeBatch aggregates the liquid unstakes queued during an epoch.
+type UnstakeBatch struct {
+	// epoch_number defines the epoch the liquid unstakes were queued in
+	EpochNumber uint64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// unstake_amount defines the native token amount owed to the liquid stakers
+	UnstakeAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=unstake_amount,json=unstakeAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unstake_amount"`
+	// fee_amount defines the native token amount of the unstake fees
+	FeeAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=fee_amount,json=feeAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee_amount"`
+	// unbonded_amount defines the native token amount returned by the batch undelegation (slashing applied amount)
+	UnbondedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=unbonded_amount,json=unbondedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unbonded_amount"`
+	// completion_time defines the time the batch undelegation completes at
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// state defines the state of the batch
+	State UnstakeBatch_State `protobuf:"varint,6,opt,name=state,proto3,enum=pstake.lspersistence.v1beta1.UnstakeBatch_State" json:"state,omitempty"`
+}
+
+func (m *UnstakeBatch) Reset()         { *m = UnstakeBatch{} }
+func (m *UnstakeBatch) String() string { return proto.CompactTextString(m) }
+func (*UnstakeBatch) ProtoMessage()    {}
+func (*UnstakeBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{7}
+}
+func (m *UnstakeBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnstakeBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnstakeBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnstakeBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnstakeBatch.Merge(m, src)
+}
+func (m *UnstakeBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnstakeBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnstakeBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnstakeBatch proto.InternalMessageInfo
+
+// PendingUnstake is a liquid unstake queued by a liquid staker during an epoch.
+type PendingUnstake struct {
+	// delegator_address defines the bech32-encoded address of the liquid staker
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// epoch_number defines the epoch the liquid unstake was queued in
+	EpochNumber uint64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// burned_amount defines the bTokens burned when queueing the liquid unstake
+	BurnedAmount types.Coin `protobuf:"bytes,3,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount"`
+	// unstake_amount defines the native token amount owed to the liquid staker
+	UnstakeAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=unstake_amount,json=unstakeAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unstake_amount"`
+}
+
+func (m *PendingUnstake) Reset()         { *m = PendingUnstake{} }
+func (m *PendingUnstake) String() string { return proto.CompactTextString(m) }
+func (*PendingUnstake) ProtoMessage()    {}
+func (*PendingUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{8}
+}
+func (m *PendingUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUnstake.Merge(m, src)
+}
+func (m *PendingUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUnstake proto.InternalMessageInfo
+
+// InFlightRedelegation is a rebalancing redelegation of LiquidStakingProxyAcc not completed yet. Its destination
+// validator cannot be the source of another redelegation until then, as transitive redelegations are not allowed.
+type InFlightRedelegation struct {
+	// src_validator_address defines the bech32-encoded address of the source validator
+	SrcValidatorAddress string `protobuf:"bytes,1,opt,name=src_validator_address,json=srcValidatorAddress,proto3" json:"src_validator_address,omitempty" yaml:"src_validator_address"`
+	// dst_validator_address defines the bech32-encoded address of the destination validator
+	DstValidatorAddress string `protobuf:"bytes,2,opt,name=dst_validator_address,json=dstValidatorAddress,proto3" json:"dst_validator_address,omitempty" yaml:"dst_validator_address"`
+	// amount defines the native token amount redelegated
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// completion_time defines the time the redelegation completes at
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *InFlightRedelegation) Reset()         { *m = InFlightRedelegation{} }
+func (m *InFlightRedelegation) String() string { return proto.CompactTextString(m) }
+func (*InFlightRedelegation) ProtoMessage()    {}
+func (*InFlightRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{9}
+}
+func (m *InFlightRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightRedelegation.Merge(m, src)
+}
+func (m *InFlightRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightRedelegation proto.InternalMessageInfo
+
+// RebalancingTarget is the target and the actual liquid tokens of a liquid validator returned by the rebalancing query.
+type RebalancingTarget struct {
+	// validator_address defines the bech32-encoded address of the liquid validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// target_liquid_tokens defines the liquid tokens of the validator according to its weight
+	TargetLiquidTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=target_liquid_tokens,json=targetLiquidTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"target_liquid_tokens"`
+	// liquid_tokens defines the current liquid tokens of the validator
+	LiquidTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=liquid_tokens,json=liquidTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"liquid_tokens"`
+}
+
+func (m *RebalancingTarget) Reset()         { *m = RebalancingTarget{} }
+func (m *RebalancingTarget) String() string { return proto.CompactTextString(m) }
+func (*RebalancingTarget) ProtoMessage()    {}
+func (*RebalancingTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{10}
+}
+func (m *RebalancingTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalancingTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalancingTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RebalancingTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalancingTarget.Merge(m, src)
+}
+func (m *RebalancingTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebalancingTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalancingTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalancingTarget proto.InternalMessageInfo
+
+// ValidatorScore is the performance of a whitelisted validator sampled at the end of a scoring epoch.
+type ValidatorScore struct {
+	// validator_address defines the bech32-encoded address of the whitelisted validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// height defines the block height the score was sampled at
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// uptime defines the rate of the blocks signed by the validator over the slashing signed blocks window
+	Uptime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+	// commission_rate defines the commission rate of the validator
+	CommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission_rate" yaml:"commission_rate"`
+	// self_bond_rate defines the rate of the validator tokens delegated by its operator
+	SelfBondRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=self_bond_rate,json=selfBondRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"self_bond_rate" yaml:"self_bond_rate"`
+	// score defines the performance score of the validator, between zero and one
+	Score github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+}
+
+func (m *ValidatorScore) Reset()         { *m = ValidatorScore{} }
+func (m *ValidatorScore) String() string { return proto.CompactTextString(m) }
+func (*ValidatorScore) ProtoMessage()    {}
+func (*ValidatorScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{11}
+}
+func (m *ValidatorScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorScore.Merge(m, src)
+}
+func (m *ValidatorScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorScore proto.InternalMessageInfo
+
 // VotingPower is type for current voting power of the voter including staking module's voting power and liquid staking
 // module's voting power, It depends on the amount of delegation of staking module, the bonded state of the delegated
 // validator, the value of btoken(liquid_bond_denom), and the pool coin and farming position containing btoken..
@@ -318,7 +700,7 @@ func (m *VotingPower) Reset()         { *m = VotingPower{} }
 func (m *VotingPower) String() string { return proto.CompactTextString(m) }
 func (*VotingPower) ProtoMessage()    {}
 func (*VotingPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_729ca6a6bfc9e5d3, []int{5}
+	return fileDescriptor_729ca6a6bfc9e5d3, []int{12}
 }
 func (m *VotingPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,11 +731,19 @@ var xxx_messageInfo_VotingPower proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("pstake.lspersistence.v1beta1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("pstake.lspersistence.v1beta1.UnstakeBatch_State", UnstakeBatch_State_name, UnstakeBatch_State_value)
 	proto.RegisterType((*Params)(nil), "pstake.lspersistence.v1beta1.Params")
 	proto.RegisterType((*WhitelistedValidator)(nil), "pstake.lspersistence.v1beta1.WhitelistedValidator")
+	proto.RegisterType((*WhitelistedPool)(nil), "pstake.lspersistence.v1beta1.WhitelistedPool")
 	proto.RegisterType((*LiquidValidator)(nil), "pstake.lspersistence.v1beta1.LiquidValidator")
 	proto.RegisterType((*LiquidValidatorState)(nil), "pstake.lspersistence.v1beta1.LiquidValidatorState")
 	proto.RegisterType((*NetAmountState)(nil), "pstake.lspersistence.v1beta1.NetAmountState")
+	proto.RegisterType((*UnstakeEpoch)(nil), "pstake.lspersistence.v1beta1.UnstakeEpoch")
+	proto.RegisterType((*UnstakeBatch)(nil), "pstake.lspersistence.v1beta1.UnstakeBatch")
+	proto.RegisterType((*PendingUnstake)(nil), "pstake.lspersistence.v1beta1.PendingUnstake")
+	proto.RegisterType((*InFlightRedelegation)(nil), "pstake.lspersistence.v1beta1.InFlightRedelegation")
+	proto.RegisterType((*RebalancingTarget)(nil), "pstake.lspersistence.v1beta1.RebalancingTarget")
+	proto.RegisterType((*ValidatorScore)(nil), "pstake.lspersistence.v1beta1.ValidatorScore")
 	proto.RegisterType((*VotingPower)(nil), "pstake.lspersistence.v1beta1.VotingPower")
 }
 
@@ -362,83 +752,144 @@ func init() {
 }
 
 var fileDescriptor_729ca6a6bfc9e5d3 = []byte{
-	// 1201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x4f, 0x4f, 0x23, 0xb7,
-	0x1b, 0xc7, 0x33, 0x84, 0xcd, 0x0f, 0xcc, 0xbf, 0x64, 0x08, 0x10, 0x02, 0xbf, 0x24, 0x8a, 0xd4,
-	0x6a, 0x55, 0x89, 0x64, 0x97, 0x4a, 0x3d, 0xa0, 0x3d, 0x6c, 0xd8, 0x80, 0x1a, 0x89, 0x02, 0x9a,
-	0x24, 0xa0, 0xd2, 0xc3, 0xd4, 0x99, 0x31, 0x61, 0xc4, 0x8c, 0x3d, 0x1d, 0x3b, 0x61, 0xd1, 0x5e,
-	0xda, 0xdb, 0x8a, 0xd3, 0x1e, 0x2b, 0x55, 0x48, 0x2b, 0xf5, 0x2d, 0xf4, 0x3d, 0x74, 0x2f, 0x95,
-	0x56, 0x3d, 0x54, 0x55, 0x0f, 0x51, 0x05, 0x97, 0x1e, 0x7a, 0xca, 0x2b, 0xa8, 0xc6, 0x76, 0xfe,
-	0x4d, 0xb2, 0x2b, 0x45, 0xe4, 0x04, 0xb6, 0x9f, 0xe7, 0xf3, 0x7d, 0x9e, 0xc7, 0xf6, 0xe3, 0x09,
-	0x78, 0xe2, 0x52, 0x06, 0x2f, 0x51, 0xde, 0xa6, 0x2e, 0xf2, 0xa8, 0x45, 0x19, 0xc2, 0x06, 0xca,
-	0x37, 0x9f, 0xd6, 0x10, 0x83, 0x4f, 0xf3, 0xb6, 0xf5, 0x5d, 0xc3, 0x32, 0x7d, 0x0b, 0x0b, 0xd7,
-	0x73, 0xae, 0x47, 0x18, 0x51, 0x37, 0x85, 0x47, 0x6e, 0xc0, 0x23, 0x27, 0x3d, 0x92, 0xf1, 0x3a,
-	0xa9, 0x13, 0x6e, 0x98, 0xf7, 0xff, 0x13, 0x3e, 0xc9, 0x75, 0x83, 0x50, 0x87, 0x50, 0x5d, 0x2c,
-	0x88, 0x81, 0x5c, 0x4a, 0x89, 0x51, 0xbe, 0x06, 0x69, 0x4f, 0xd7, 0x20, 0x16, 0x96, 0xeb, 0xe9,
-	0x3a, 0x21, 0x75, 0x1b, 0xe5, 0xf9, 0xa8, 0xd6, 0x38, 0xcf, 0x33, 0xcb, 0x41, 0x94, 0x41, 0xc7,
-	0x15, 0x06, 0xd9, 0x5f, 0x67, 0x40, 0xe4, 0x18, 0x7a, 0xd0, 0xa1, 0xea, 0x97, 0x20, 0x26, 0x22,
-	0xd6, 0x6b, 0x04, 0x9b, 0xba, 0x89, 0x30, 0x71, 0x12, 0x4a, 0x46, 0x79, 0x3c, 0xbb, 0xbb, 0xd9,
-	0x6e, 0xa5, 0x13, 0xd7, 0xd0, 0xb1, 0x77, 0xb2, 0x43, 0x26, 0x59, 0x6d, 0x49, 0xcc, 0xed, 0x12,
-	0x6c, 0x16, 0xfd, 0x19, 0xf5, 0x8d, 0x02, 0x56, 0xaf, 0x2e, 0x2c, 0x86, 0x6c, 0x3f, 0x41, 0x53,
-	0x6f, 0x42, 0xdb, 0x32, 0x21, 0x23, 0x1e, 0x4d, 0x4c, 0x65, 0xc2, 0x8f, 0xe7, 0xb6, 0xb7, 0x73,
-	0x1f, 0x2b, 0x43, 0xee, 0xb4, 0xe7, 0x7b, 0xd2, 0x71, 0xdd, 0xfd, 0xe4, 0x5d, 0x2b, 0x1d, 0x6a,
-	0xb7, 0xd2, 0xff, 0x17, 0x71, 0x8c, 0xe6, 0x67, 0xb5, 0x95, 0xab, 0x11, 0xce, 0x54, 0x7d, 0x05,
-	0x16, 0xb9, 0xa2, 0x7e, 0x8e, 0x90, 0xee, 0x41, 0x86, 0x12, 0x61, 0x9e, 0x59, 0xd5, 0xa7, 0xfe,
-	0xd5, 0x4a, 0x7f, 0x5a, 0xb7, 0xd8, 0x45, 0xa3, 0x96, 0x33, 0x88, 0x23, 0x2b, 0x2c, 0xff, 0x6c,
-	0x51, 0xf3, 0x32, 0xcf, 0xae, 0x5d, 0x44, 0x73, 0x45, 0x64, 0xb4, 0x5b, 0xe9, 0x15, 0xa1, 0x3f,
-	0x48, 0xcb, 0xfe, 0xfe, 0xcb, 0x16, 0x90, 0x3b, 0x53, 0x44, 0x86, 0x36, 0xcf, 0x97, 0xf7, 0x11,
-	0xd2, 0x20, 0x43, 0xea, 0x0f, 0x0a, 0x88, 0x36, 0x70, 0x40, 0x7f, 0x9a, 0xeb, 0x9f, 0x8e, 0xad,
-	0xbf, 0x26, 0xf4, 0x83, 0xbc, 0x60, 0x04, 0x8b, 0xd2, 0xa0, 0x13, 0xc3, 0x8d, 0x02, 0x96, 0x3d,
-	0x64, 0x22, 0xc7, 0x65, 0x16, 0xc1, 0xbd, 0x30, 0x1e, 0xf1, 0x30, 0xce, 0xc6, 0x0e, 0x23, 0x29,
-	0xc2, 0x18, 0x81, 0x0c, 0x46, 0x12, 0xeb, 0xd9, 0xf4, 0x17, 0xc4, 0x43, 0x81, 0x82, 0x44, 0x1e,
-	0x56, 0x90, 0x20, 0x6f, 0xa8, 0x20, 0xd2, 0xa0, 0x13, 0xc3, 0x4f, 0x0a, 0x58, 0x77, 0x2c, 0xac,
-	0xcb, 0x03, 0x2d, 0xaf, 0xa9, 0x0e, 0x1d, 0xd2, 0xc0, 0x2c, 0xf1, 0x3f, 0x1e, 0xcc, 0xb7, 0x63,
-	0x04, 0x53, 0xc2, 0xac, 0xdd, 0x4a, 0x67, 0x44, 0x30, 0x1f, 0x04, 0xf7, 0x47, 0x55, 0xc2, 0x4c,
-	0x5b, 0x75, 0x2c, 0x7c, 0xc0, 0x0d, 0xcb, 0xc2, 0xae, 0xc0, 0xcd, 0xd4, 0x2a, 0x58, 0x80, 0xa6,
-	0x4f, 0x81, 0xa6, 0xe9, 0x21, 0x4a, 0x13, 0x33, 0x3c, 0xa0, 0x27, 0xed, 0x56, 0x3a, 0x2e, 0x24,
-	0x06, 0x96, 0x7d, 0x6c, 0x5c, 0x62, 0x0b, 0x62, 0xaa, 0xcc, 0x3c, 0x0b, 0xd7, 0xb5, 0x79, 0x6e,
-	0x27, 0xe7, 0xd4, 0x23, 0x30, 0xe7, 0xd7, 0xa7, 0x03, 0x9d, 0xe5, 0xd0, 0x5c, 0xbb, 0x95, 0x56,
-	0x05, 0xb4, 0x6f, 0xf1, 0xc3, 0x48, 0x70, 0x8e, 0x90, 0x9c, 0xd9, 0x99, 0x79, 0xfd, 0x36, 0x1d,
-	0xfa, 0xf1, 0x6d, 0x3a, 0x94, 0xbd, 0x53, 0x40, 0x7c, 0xd4, 0xc5, 0x55, 0x4b, 0x20, 0xd6, 0xbd,
-	0xa0, 0x5d, 0xe5, 0xa1, 0xbe, 0x32, 0x64, 0x92, 0xd5, 0xa2, 0xdd, 0xb9, 0x4e, 0xf8, 0xd7, 0x60,
-	0x81, 0x41, 0xaf, 0x8e, 0x98, 0x7e, 0x85, 0xac, 0xfa, 0x05, 0x4b, 0x4c, 0x71, 0x4c, 0x65, 0xec,
-	0x6d, 0x92, 0x35, 0x1c, 0x80, 0x05, 0xb7, 0x66, 0x5e, 0xac, 0x9e, 0xf2, 0xc5, 0x9d, 0x69, 0x3f,
-	0xd1, 0xac, 0x01, 0x96, 0xc4, 0x6e, 0xf5, 0xd2, 0xdb, 0x07, 0x51, 0xe2, 0x22, 0x6f, 0x44, 0x76,
-	0x1b, 0xbd, 0xc3, 0x19, 0xb4, 0xc8, 0x6a, 0x4b, 0x9d, 0xa9, 0x81, 0x4a, 0xfe, 0xe3, 0x8b, 0xfc,
-	0x11, 0x06, 0xf1, 0x80, 0x4a, 0x99, 0xf9, 0x47, 0x76, 0x42, 0x52, 0x2a, 0x02, 0x91, 0x81, 0xfa,
-	0x7d, 0x35, 0x76, 0xfd, 0x16, 0x64, 0x13, 0x1e, 0x59, 0x38, 0x09, 0x57, 0xf7, 0x40, 0x84, 0x32,
-	0xc8, 0x1a, 0x94, 0xf7, 0xda, 0xc5, 0xed, 0xad, 0x8f, 0x77, 0xfd, 0x81, 0x64, 0x1b, 0x54, 0x93,
-	0xce, 0xea, 0x37, 0x00, 0x98, 0xc8, 0xd6, 0xe9, 0x05, 0xf4, 0x10, 0x95, 0x6d, 0xf3, 0xd9, 0x78,
-	0x5d, 0x22, 0xd0, 0x0a, 0x66, 0x4d, 0x64, 0x97, 0x39, 0x4e, 0x85, 0x60, 0x41, 0xde, 0x53, 0x46,
-	0x2e, 0x11, 0xa6, 0xb2, 0x1f, 0x3e, 0x1b, 0xaf, 0x22, 0xc1, 0x93, 0x23, 0x90, 0x15, 0x4e, 0xec,
-	0xdb, 0xd8, 0x7f, 0x23, 0x60, 0xf1, 0x10, 0x31, 0x71, 0xc5, 0xc5, 0x96, 0x7e, 0x0d, 0x66, 0x1d,
-	0x0b, 0x33, 0xd1, 0x01, 0x95, 0x09, 0xe4, 0x36, 0xe3, 0xe3, 0x78, 0x83, 0xb3, 0xc1, 0x72, 0x8d,
-	0x27, 0xa5, 0x33, 0xc2, 0xa0, 0xad, 0xd3, 0x86, 0xeb, 0xda, 0xd7, 0x72, 0xcb, 0x1f, 0x96, 0x60,
-	0x4c, 0x80, 0x2b, 0x3e, 0xb7, 0xcc, 0xb1, 0xfe, 0x2e, 0x61, 0xc4, 0x3a, 0xed, 0x33, 0x3c, 0x89,
-	0x5d, 0xc2, 0x9d, 0x52, 0xa9, 0xe7, 0x20, 0x2a, 0x72, 0x98, 0xf0, 0x41, 0x58, 0xe4, 0xd4, 0x62,
-	0xf7, 0x34, 0xd8, 0x60, 0x59, 0xe8, 0x4c, 0xfe, 0x4c, 0xc4, 0x38, 0xf8, 0xa0, 0xef, 0x60, 0xa8,
-	0x0c, 0xac, 0x09, 0x35, 0x0f, 0x39, 0xd0, 0xc2, 0xfe, 0x23, 0xe1, 0xa1, 0x2b, 0xe8, 0x99, 0x54,
-	0xbe, 0x85, 0x0f, 0x4b, 0x6e, 0x85, 0xc3, 0xb5, 0x0e, 0x5b, 0x13, 0xe8, 0x9e, 0x6a, 0x03, 0xfb,
-	0x1f, 0x71, 0xbe, 0x6a, 0x0d, 0xda, 0x10, 0x1b, 0x48, 0x3e, 0x7a, 0x0f, 0xcb, 0x53, 0xa8, 0x56,
-	0x3b, 0xec, 0x5d, 0x81, 0x56, 0x2f, 0x40, 0xcc, 0xf5, 0xc8, 0xcb, 0x6b, 0x1d, 0x1a, 0x46, 0x57,
-	0x6f, 0x66, 0x02, 0x7a, 0x4b, 0x1c, 0x5b, 0x30, 0x0c, 0xa9, 0xc4, 0xaf, 0x9b, 0xc2, 0xaf, 0xdb,
-	0xf7, 0x61, 0x30, 0x77, 0x42, 0x98, 0x85, 0xeb, 0xc7, 0xe4, 0x0a, 0x79, 0x6a, 0x1c, 0x3c, 0x6a,
-	0x12, 0x86, 0x3c, 0x71, 0xcf, 0x34, 0x31, 0x50, 0x31, 0x88, 0x77, 0x9e, 0xe8, 0x26, 0x37, 0xd6,
-	0x5d, 0xdf, 0x7a, 0x22, 0xf7, 0x44, 0x95, 0xe4, 0xfe, 0x28, 0x5e, 0x81, 0x8d, 0xc0, 0x97, 0xc1,
-	0x80, 0x6c, 0x78, 0x02, 0xb2, 0x09, 0xbb, 0xff, 0x8b, 0xa2, 0x5f, 0xdc, 0x04, 0xab, 0xbd, 0x87,
-	0x76, 0x40, 0x57, 0x5c, 0xa7, 0xdc, 0x78, 0xba, 0x5a, 0xbc, 0x4b, 0xeb, 0x53, 0xe9, 0x75, 0xbc,
-	0xcf, 0x7e, 0x53, 0xc0, 0x52, 0xa0, 0xaf, 0xab, 0xcf, 0xc1, 0xe6, 0x49, 0xe1, 0xa0, 0x54, 0x2c,
-	0x54, 0x8e, 0x34, 0xbd, 0x5c, 0x29, 0x54, 0xaa, 0x65, 0xbd, 0x7a, 0x58, 0x3e, 0xde, 0x7b, 0x51,
-	0xda, 0x2f, 0xed, 0x15, 0xa3, 0xa1, 0x64, 0xea, 0xe6, 0x36, 0x93, 0x0c, 0xb8, 0x55, 0x31, 0x75,
-	0x91, 0x61, 0x9d, 0x5b, 0xc8, 0x54, 0xbf, 0x00, 0x6b, 0x43, 0x84, 0xc2, 0x8b, 0x4a, 0xe9, 0x64,
-	0x2f, 0xaa, 0x24, 0xd7, 0x6f, 0x6e, 0x33, 0x2b, 0x01, 0xe7, 0x82, 0xc1, 0xac, 0x26, 0x52, 0x77,
-	0xc0, 0xfa, 0x90, 0x5f, 0xe9, 0x50, 0x7a, 0x4e, 0x25, 0x37, 0x6e, 0x6e, 0x33, 0x6b, 0x01, 0xcf,
-	0x12, 0x86, 0xdc, 0x37, 0x39, 0xfd, 0xfa, 0xe7, 0x54, 0x68, 0xf7, 0xec, 0xdd, 0x5d, 0x4a, 0x79,
-	0x7f, 0x97, 0x52, 0xfe, 0xbe, 0x4b, 0x29, 0x6f, 0xee, 0x53, 0xa1, 0xf7, 0xf7, 0xa9, 0xd0, 0x9f,
-	0xf7, 0xa9, 0xd0, 0xd9, 0xf3, 0xbe, 0x8a, 0xf5, 0xbd, 0x6e, 0x47, 0x18, 0xe5, 0xc5, 0xab, 0xb7,
-	0x85, 0xa1, 0x0f, 0xca, 0x37, 0xb7, 0xf3, 0x2f, 0x03, 0x3f, 0x18, 0x79, 0x3d, 0x6b, 0x11, 0xfe,
-	0x8b, 0xec, 0xf3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x22, 0xef, 0xde, 0x55, 0x0e, 0x00,
-	0x00,
+	// 2181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4f, 0x6f, 0x23, 0x49,
+	0x15, 0x4f, 0x3b, 0x8e, 0x27, 0xa9, 0x24, 0x8e, 0x5d, 0x71, 0x32, 0x1d, 0x27, 0x6b, 0x9b, 0x96,
+	0x58, 0x05, 0xa4, 0xb1, 0x67, 0x83, 0x04, 0xd2, 0x08, 0xa4, 0xb5, 0x13, 0x87, 0xb5, 0x98, 0xc9,
+	0x84, 0x8e, 0x9d, 0xd1, 0x0e, 0x48, 0x4d, 0xbb, 0xbb, 0xec, 0xb4, 0xa6, 0xff, 0x6d, 0x77, 0x39,
+	0x99, 0xb0, 0x20, 0xe0, 0xc4, 0x6a, 0xb8, 0xcc, 0x71, 0x25, 0x34, 0xd2, 0x22, 0xb8, 0x71, 0x45,
+	0xe2, 0x2b, 0xec, 0x01, 0xa4, 0xd5, 0x1e, 0x10, 0xe2, 0x60, 0xd0, 0xcc, 0x05, 0x09, 0xc1, 0xc1,
+	0x9f, 0x00, 0xd5, 0x9f, 0xb6, 0xdb, 0xdd, 0xce, 0x80, 0x67, 0xfa, 0x94, 0x74, 0xbd, 0xf7, 0x7e,
+	0xbf, 0x57, 0xf5, 0xde, 0xab, 0x7a, 0x55, 0x06, 0x77, 0x5d, 0x1f, 0xab, 0x4f, 0x50, 0xcd, 0xf4,
+	0x5d, 0xe4, 0xf9, 0x86, 0x8f, 0x91, 0xad, 0xa1, 0xda, 0xe5, 0x7b, 0x5d, 0x84, 0xd5, 0xf7, 0x6a,
+	0xa6, 0xf1, 0xd1, 0xc0, 0xd0, 0x89, 0x86, 0x61, 0xf7, 0xab, 0xae, 0xe7, 0x60, 0x07, 0xee, 0x31,
+	0x8b, 0xea, 0x94, 0x45, 0x95, 0x5b, 0x14, 0x0b, 0x7d, 0xa7, 0xef, 0x50, 0xc5, 0x1a, 0xf9, 0x8f,
+	0xd9, 0x14, 0x77, 0x34, 0xc7, 0xb7, 0x1c, 0x5f, 0x61, 0x02, 0xf6, 0xc1, 0x45, 0x25, 0xf6, 0x55,
+	0xeb, 0xaa, 0xfe, 0x84, 0x57, 0x73, 0x0c, 0x3b, 0x90, 0xf7, 0x1d, 0xa7, 0x6f, 0xa2, 0x1a, 0xfd,
+	0xea, 0x0e, 0x7a, 0x35, 0x7d, 0xe0, 0xa9, 0xd8, 0x70, 0x02, 0x79, 0x39, 0x2a, 0xc7, 0x86, 0x85,
+	0x7c, 0xac, 0x5a, 0x2e, 0x53, 0x90, 0xfe, 0x93, 0x07, 0x99, 0x53, 0xd5, 0x53, 0x2d, 0x1f, 0x7e,
+	0x00, 0xf2, 0x6c, 0x46, 0x4a, 0xd7, 0xb1, 0x75, 0x45, 0x47, 0xb6, 0x63, 0x89, 0x42, 0x45, 0xd8,
+	0x5f, 0x69, 0xec, 0x8d, 0x86, 0x65, 0xf1, 0x5a, 0xb5, 0xcc, 0x7b, 0x52, 0x4c, 0x45, 0x92, 0x37,
+	0xd8, 0x58, 0xc3, 0xb1, 0xf5, 0x23, 0x32, 0x02, 0x9f, 0x0b, 0x60, 0xfb, 0xea, 0xc2, 0xc0, 0xc8,
+	0x24, 0x0b, 0xa0, 0x2b, 0x97, 0xaa, 0x69, 0xe8, 0x2a, 0x76, 0x3c, 0x5f, 0x4c, 0x55, 0x16, 0xf7,
+	0x57, 0x0f, 0x0e, 0xaa, 0xaf, 0x5b, 0xa6, 0xea, 0xa3, 0x89, 0xed, 0x79, 0x60, 0xda, 0xf8, 0xea,
+	0xe7, 0xc3, 0xf2, 0xc2, 0x68, 0x58, 0x7e, 0x87, 0xf9, 0x31, 0x1b, 0x5f, 0x92, 0xb7, 0xae, 0x66,
+	0x18, 0xfb, 0xf0, 0x63, 0x90, 0xa5, 0x8c, 0x4a, 0x0f, 0x21, 0xc5, 0x53, 0x31, 0x12, 0x17, 0xe9,
+	0xcc, 0x3a, 0x04, 0xf5, 0x6f, 0xc3, 0xf2, 0xbb, 0x7d, 0x03, 0x5f, 0x0c, 0xba, 0x55, 0xcd, 0xb1,
+	0x78, 0x04, 0xf8, 0x9f, 0x3b, 0xbe, 0xfe, 0xa4, 0x86, 0xaf, 0x5d, 0xe4, 0x57, 0x8f, 0x90, 0x36,
+	0x1a, 0x96, 0xb7, 0x18, 0xff, 0x34, 0x9a, 0xf4, 0xe5, 0x1f, 0xee, 0x00, 0x1e, 0xb9, 0x23, 0xa4,
+	0xc9, 0x6b, 0x54, 0x7c, 0x8c, 0x90, 0xac, 0x62, 0x04, 0x7f, 0x21, 0x80, 0xdc, 0xc0, 0x8e, 0xf0,
+	0xa7, 0x29, 0xff, 0xa3, 0xb9, 0xf9, 0x6f, 0x33, 0xfe, 0x81, 0xfd, 0x7a, 0x0f, 0xb2, 0x03, 0x7b,
+	0xca, 0x87, 0x67, 0x02, 0xd8, 0xf4, 0x90, 0x8e, 0x2c, 0x97, 0xa4, 0xc7, 0xc4, 0x8d, 0x25, 0xea,
+	0xc6, 0xe3, 0xb9, 0xdd, 0x28, 0x32, 0x37, 0x66, 0x40, 0x46, 0x3d, 0xc9, 0x4f, 0x74, 0xc2, 0x0b,
+	0xe2, 0xa1, 0xc8, 0x82, 0x64, 0xde, 0x6e, 0x41, 0xa2, 0x78, 0xb1, 0x05, 0xe1, 0x0a, 0x81, 0x0f,
+	0xbf, 0x16, 0xc0, 0x8e, 0x65, 0xd8, 0x0a, 0x4f, 0x68, 0x5e, 0xc6, 0x8a, 0x6a, 0x39, 0x03, 0x1b,
+	0x8b, 0xb7, 0xa8, 0x33, 0x3f, 0x9a, 0xc3, 0x99, 0x96, 0x8d, 0x47, 0xc3, 0x72, 0x85, 0x39, 0x73,
+	0x23, 0x70, 0xd8, 0xab, 0x96, 0x8d, 0xe5, 0x6d, 0xcb, 0xb0, 0xef, 0x53, 0xc5, 0x33, 0xa6, 0x57,
+	0xa7, 0x6a, 0xb0, 0x03, 0xd6, 0x55, 0x9d, 0xa0, 0xa8, 0xba, 0xee, 0x21, 0xdf, 0x17, 0x97, 0xa9,
+	0x43, 0x77, 0x47, 0xc3, 0x72, 0x81, 0x51, 0x4c, 0x89, 0x09, 0x6c, 0x81, 0xc3, 0xd6, 0xd9, 0xd0,
+	0x19, 0xf6, 0x0c, 0xbb, 0x2f, 0xaf, 0x51, 0x3d, 0x3e, 0x06, 0x1f, 0x82, 0x55, 0xb2, 0x3e, 0x01,
+	0xe8, 0x0a, 0x05, 0xad, 0x8e, 0x86, 0x65, 0xc8, 0x40, 0x43, 0xc2, 0x9b, 0x21, 0x41, 0x0f, 0xa1,
+	0x00, 0xf0, 0x27, 0x20, 0x1f, 0xae, 0x44, 0xd7, 0x71, 0x4c, 0x5f, 0x04, 0xb4, 0xc8, 0xef, 0xfc,
+	0xdf, 0x45, 0x7e, 0xea, 0x38, 0x66, 0xa3, 0xc2, 0xeb, 0x5b, 0x8c, 0xd7, 0x37, 0x45, 0x95, 0xe4,
+	0xdc, 0xd5, 0xb4, 0x89, 0x4f, 0x36, 0x9a, 0x2d, 0xb6, 0xcc, 0x06, 0xbe, 0x56, 0xba, 0x83, 0x5e,
+	0x0f, 0x79, 0x2c, 0x99, 0x56, 0xe9, 0xcc, 0x7e, 0x38, 0x77, 0x32, 0xed, 0x85, 0x77, 0xb9, 0x08,
+	0x68, 0x34, 0xa3, 0x36, 0xc7, 0x5a, 0x0d, 0xaa, 0x44, 0xd3, 0xea, 0xc7, 0x60, 0x3b, 0x28, 0x4d,
+	0xe4, 0x3a, 0xda, 0x85, 0x12, 0xec, 0xc8, 0xe2, 0x5a, 0x45, 0xd8, 0x5f, 0x3d, 0xd8, 0xa9, 0xb2,
+	0x2d, 0xb9, 0x1a, 0x6c, 0xc9, 0xd5, 0x23, 0xae, 0xd0, 0xf8, 0xda, 0xf4, 0x0e, 0x37, 0x1b, 0x46,
+	0xfa, 0xf4, 0xef, 0x65, 0x41, 0x2e, 0x70, 0x61, 0x93, 0xc8, 0x02, 0x00, 0xf8, 0x2b, 0x5a, 0xe3,
+	0x5d, 0xd5, 0x54, 0x6d, 0x8d, 0xa4, 0x1c, 0xf6, 0x8c, 0x7e, 0x1f, 0x79, 0xe2, 0xfa, 0xdb, 0xd6,
+	0x78, 0x0c, 0x32, 0xba, 0x14, 0x30, 0xa4, 0xd3, 0x66, 0x2a, 0x10, 0x81, 0x5d, 0x4b, 0x7d, 0xaa,
+	0x90, 0xea, 0x37, 0x51, 0x9f, 0x7a, 0xe8, 0x2b, 0x2e, 0xf2, 0x94, 0xae, 0xe9, 0x68, 0x4f, 0xc4,
+	0x6c, 0x45, 0xd8, 0x5f, 0x6f, 0xbc, 0x3b, 0x1a, 0x96, 0x25, 0x5e, 0x33, 0x37, 0x2b, 0x4b, 0xb2,
+	0x68, 0xa9, 0x4f, 0xe5, 0xb0, 0xf0, 0x14, 0x79, 0x0d, 0x22, 0x82, 0x3d, 0xb0, 0x1b, 0x76, 0x50,
+	0x73, 0x1c, 0x53, 0x77, 0xae, 0x6c, 0x66, 0xe8, 0x8b, 0x1b, 0x15, 0x61, 0x3f, 0x1d, 0xa6, 0x79,
+	0x8d, 0xb2, 0x24, 0xef, 0x84, 0xa4, 0x87, 0x5c, 0x48, 0x69, 0x7c, 0xf8, 0x7d, 0x50, 0xf0, 0x35,
+	0x87, 0x14, 0x00, 0x8f, 0x08, 0x27, 0xc8, 0x51, 0x82, 0xf2, 0x68, 0x58, 0xde, 0x65, 0x04, 0xb3,
+	0xb4, 0x24, 0x19, 0xf2, 0x61, 0x1a, 0x31, 0x0e, 0xf9, 0x18, 0xdc, 0x26, 0xa3, 0x48, 0xb9, 0x42,
+	0x46, 0xff, 0x02, 0x53, 0x23, 0x5b, 0xed, 0x9a, 0x48, 0x17, 0xf3, 0x15, 0x61, 0x7f, 0xb9, 0x21,
+	0x8d, 0x86, 0xe5, 0xd2, 0x04, 0x75, 0x86, 0xa2, 0x24, 0x6f, 0x51, 0xc9, 0xa3, 0x40, 0xd0, 0x64,
+	0xe3, 0xf0, 0x97, 0x02, 0x80, 0x61, 0x1b, 0xa5, 0x67, 0x3a, 0x8e, 0x27, 0x42, 0x9a, 0x0a, 0x1f,
+	0xce, 0x9d, 0x0a, 0x3b, 0x71, 0x2f, 0x18, 0x62, 0x34, 0x13, 0x72, 0x21, 0x77, 0x8e, 0x89, 0x02,
+	0xdd, 0xec, 0xa7, 0xec, 0x34, 0xd5, 0x15, 0x37, 0xdf, 0x6e, 0xb3, 0x8f, 0xe2, 0xc5, 0x36, 0xfb,
+	0x90, 0x17, 0x87, 0xaa, 0x7b, 0x6f, 0xf9, 0x93, 0xcf, 0xca, 0x0b, 0x9f, 0x7e, 0x56, 0x5e, 0x90,
+	0x5e, 0x0a, 0xa0, 0x30, 0xab, 0xbf, 0x80, 0x2d, 0x90, 0x1f, 0xf7, 0x11, 0xe3, 0x0d, 0x32, 0xd6,
+	0xfe, 0xc4, 0x54, 0x24, 0x39, 0x37, 0x1e, 0x0b, 0x36, 0xc5, 0x6b, 0xb0, 0x8e, 0x55, 0xaf, 0x8f,
+	0x30, 0xf7, 0x50, 0x4c, 0x51, 0x98, 0xf6, 0xdc, 0xa7, 0x09, 0xdf, 0xea, 0xa7, 0xc0, 0xa2, 0x27,
+	0xc8, 0x1a, 0x93, 0xb2, 0xb9, 0xde, 0x4b, 0x93, 0x89, 0x4a, 0xbf, 0x13, 0xc0, 0x46, 0x64, 0x7f,
+	0x85, 0x32, 0x58, 0x23, 0xfb, 0x68, 0x64, 0x6a, 0xb5, 0xd1, 0xb0, 0xbc, 0xc9, 0x58, 0xc2, 0xd2,
+	0x9b, 0x37, 0xff, 0x55, 0xa2, 0x16, 0x4c, 0xf4, 0x5b, 0x60, 0xd5, 0xbf, 0x50, 0x3d, 0xc4, 0x9b,
+	0x45, 0x36, 0xcd, 0xed, 0xc9, 0x71, 0x12, 0x12, 0x4a, 0x32, 0xa0, 0x5f, 0xb4, 0x43, 0xe4, 0x6e,
+	0x6a, 0x60, 0x83, 0x9d, 0x7d, 0x93, 0x28, 0x1c, 0x83, 0x9c, 0xe3, 0x22, 0x6f, 0x46, 0x10, 0x76,
+	0x27, 0xd1, 0x8f, 0x6a, 0x48, 0xf2, 0x46, 0x30, 0xc4, 0x3d, 0x63, 0x01, 0xff, 0x27, 0x21, 0xf9,
+	0xcb, 0x22, 0x28, 0x44, 0x58, 0xce, 0x30, 0xd9, 0xa9, 0x13, 0xa2, 0x82, 0x08, 0x64, 0xa6, 0xc2,
+	0xfc, 0x60, 0xee, 0x30, 0xaf, 0xf3, 0x23, 0x6f, 0x66, 0x7c, 0x39, 0x38, 0x6c, 0x82, 0x8c, 0x8f,
+	0x55, 0x3c, 0xf0, 0x69, 0xe7, 0x9a, 0xfd, 0x5f, 0xc7, 0xeb, 0xd4, 0x64, 0x07, 0xbe, 0xcc, 0x8d,
+	0xe1, 0x0f, 0x00, 0xd0, 0x91, 0xa9, 0xd0, 0x58, 0xf8, 0xbc, 0x09, 0xfd, 0xf6, 0x7c, 0x65, 0x18,
+	0xa9, 0xb5, 0x15, 0x1d, 0x99, 0x67, 0x14, 0x0e, 0xaa, 0x60, 0x9d, 0x77, 0x3d, 0xd8, 0x79, 0x82,
+	0x6c, 0x5f, 0x5c, 0x9a, 0x1b, 0xbf, 0x65, 0xe3, 0x68, 0x82, 0x33, 0xc8, 0x36, 0x45, 0x0c, 0x05,
+	0xf6, 0x5f, 0xb7, 0x40, 0xf6, 0x04, 0x61, 0xd6, 0x30, 0xb1, 0x90, 0x7e, 0x08, 0x56, 0x2c, 0xc3,
+	0xc6, 0xac, 0x05, 0x10, 0x12, 0x98, 0xdb, 0x32, 0x81, 0xa3, 0xe7, 0xba, 0x09, 0x36, 0xbb, 0x74,
+	0x52, 0x0a, 0x76, 0xb0, 0x6a, 0x2a, 0xfe, 0xc0, 0x75, 0xcd, 0x6b, 0x31, 0x35, 0x37, 0x49, 0x7c,
+	0x82, 0x79, 0x06, 0xdc, 0x26, 0xb8, 0x67, 0x14, 0x96, 0x44, 0xc9, 0x46, 0x38, 0x68, 0x46, 0x17,
+	0x93, 0x88, 0x92, 0x1d, 0x2c, 0x15, 0xec, 0x81, 0x1c, 0x9b, 0x43, 0xc2, 0x89, 0x90, 0xa5, 0xa8,
+	0x47, 0xe3, 0x6c, 0x30, 0xc1, 0x26, 0xe3, 0x49, 0x3e, 0x27, 0xf2, 0x14, 0xf8, 0x7e, 0x28, 0x31,
+	0x20, 0x06, 0xb7, 0x19, 0x9b, 0x87, 0x2c, 0xd5, 0xb0, 0xc9, 0x19, 0xe9, 0xa1, 0x2b, 0xd5, 0xd3,
+	0x7d, 0x31, 0x33, 0x37, 0x63, 0x7c, 0x72, 0x5b, 0x14, 0x5c, 0x0e, 0xb0, 0x65, 0x06, 0x3d, 0x61,
+	0x1d, 0xd8, 0xe4, 0x4a, 0x4c, 0x58, 0x59, 0x03, 0x81, 0xc4, 0x5b, 0x73, 0xb3, 0xc6, 0xe7, 0xc9,
+	0x58, 0x3b, 0x01, 0x76, 0x83, 0x41, 0xc3, 0x0b, 0x90, 0x77, 0x3d, 0xe7, 0xe9, 0xb5, 0xa2, 0x6a,
+	0xda, 0x98, 0x6f, 0x39, 0x01, 0xbe, 0x0d, 0x0a, 0x5b, 0xd7, 0xb4, 0x80, 0xe9, 0xa7, 0x60, 0x8f,
+	0xcd, 0xcf, 0x45, 0x6c, 0x76, 0x41, 0x57, 0xca, 0x53, 0x73, 0x25, 0x01, 0xd2, 0x1d, 0xca, 0x70,
+	0xca, 0x08, 0x3a, 0x0c, 0x9f, 0xa5, 0x2a, 0xad, 0x76, 0x81, 0x56, 0xfb, 0x47, 0x60, 0xad, 0x13,
+	0xea, 0x79, 0xe1, 0x36, 0xc8, 0xd8, 0x03, 0xab, 0x8b, 0x3c, 0x5a, 0xe7, 0x69, 0x99, 0x7f, 0xc1,
+	0x43, 0x00, 0x7c, 0xac, 0x7a, 0x58, 0xc1, 0x86, 0x85, 0x68, 0x79, 0xae, 0x1e, 0x14, 0x63, 0x3d,
+	0x77, 0x3b, 0x78, 0x06, 0x69, 0x2c, 0x13, 0xd7, 0x9f, 0x93, 0x9e, 0x7a, 0x85, 0xda, 0x11, 0x09,
+	0x3f, 0x9e, 0xfe, 0xb4, 0x34, 0xe6, 0x6c, 0xa8, 0x58, 0xbb, 0x80, 0x5f, 0x01, 0x6b, 0xac, 0xa9,
+	0x9b, 0x62, 0x5e, 0xa5, 0x63, 0x27, 0x8c, 0x5e, 0x03, 0xd9, 0xc8, 0x0a, 0x25, 0xb1, 0x43, 0xac,
+	0x0f, 0xc2, 0xab, 0x42, 0x76, 0x07, 0x7a, 0x51, 0x7b, 0xd3, 0xdd, 0x21, 0x4e, 0xb0, 0x42, 0xae,
+	0x74, 0x0c, 0x1c, 0x81, 0x0d, 0x96, 0xcb, 0x48, 0x0f, 0x18, 0xd2, 0x09, 0x30, 0x64, 0x03, 0x50,
+	0x4e, 0xf3, 0x00, 0x6c, 0x68, 0x8e, 0xe5, 0x9a, 0x88, 0xbe, 0x1d, 0xd0, 0x60, 0x2d, 0xcd, 0x11,
+	0xac, 0xec, 0xc4, 0x98, 0x88, 0xe1, 0x31, 0x58, 0xf2, 0x71, 0xf0, 0x8a, 0x90, 0x3d, 0xb8, 0xfb,
+	0xfa, 0xc3, 0x31, 0x1c, 0xd5, 0x2a, 0x3d, 0x3a, 0x64, 0x66, 0x2e, 0x7d, 0x29, 0x80, 0x25, 0x3a,
+	0x00, 0xbf, 0x03, 0x76, 0x3b, 0x27, 0x67, 0xed, 0xfa, 0xf7, 0x9a, 0x4a, 0xa3, 0xde, 0x3e, 0xfc,
+	0x40, 0x39, 0x6b, 0xd7, 0xdb, 0x4d, 0xe5, 0xb4, 0x79, 0x72, 0xd4, 0x3a, 0xf9, 0x6e, 0x6e, 0xa1,
+	0xb8, 0xf7, 0xec, 0x45, 0x45, 0x0c, 0x23, 0x51, 0x3b, 0x9e, 0xc6, 0xb0, 0x0e, 0xde, 0x99, 0x65,
+	0xde, 0x39, 0x69, 0x3c, 0x64, 0x00, 0x42, 0xb1, 0xf4, 0xec, 0x45, 0xa5, 0x18, 0x03, 0x18, 0x17,
+	0xfb, 0x4d, 0x10, 0x87, 0xf7, 0xeb, 0xad, 0x07, 0xf5, 0xc6, 0xfd, 0x66, 0x2e, 0x75, 0x03, 0xc4,
+	0xa1, 0xa9, 0x1a, 0x16, 0xb9, 0x06, 0x14, 0xd3, 0x9f, 0xfc, 0xb6, 0xb4, 0xc0, 0xd3, 0xf9, 0xf7,
+	0x29, 0x90, 0x9d, 0x2e, 0x32, 0xd8, 0x04, 0x79, 0x7e, 0xa5, 0x8a, 0xf5, 0x40, 0xe2, 0x8d, 0x1d,
+	0x60, 0x6e, 0x6c, 0xc2, 0xc7, 0x63, 0x75, 0x91, 0x8a, 0xd7, 0xc5, 0x11, 0x58, 0xef, 0x0e, 0x3c,
+	0x1b, 0xe9, 0xe1, 0xac, 0x25, 0xb7, 0x61, 0x4e, 0x41, 0x1e, 0x38, 0xc7, 0xe1, 0x39, 0x74, 0x0c,
+	0xbb, 0x91, 0x26, 0xb1, 0x96, 0xd7, 0x98, 0x15, 0x4f, 0x9a, 0x78, 0x75, 0xa5, 0x13, 0xaf, 0x2e,
+	0xbe, 0x5a, 0xff, 0x4e, 0x81, 0x42, 0xcb, 0x3e, 0x36, 0x49, 0xef, 0x15, 0xbe, 0x78, 0xc2, 0x36,
+	0xd8, 0xf2, 0x3d, 0x4d, 0xb9, 0xe9, 0xae, 0x50, 0x99, 0x3c, 0x22, 0xcc, 0x54, 0x93, 0xe4, 0x4d,
+	0xdf, 0xd3, 0xce, 0xa3, 0x57, 0x86, 0x36, 0xd8, 0xd2, 0x7d, 0x3c, 0x03, 0x35, 0x15, 0x45, 0x9d,
+	0xa9, 0x26, 0xc9, 0x9b, 0xba, 0x8f, 0x67, 0xa0, 0x66, 0x12, 0xdc, 0x24, 0x32, 0xea, 0x8d, 0xa5,
+	0x9b, 0x7e, 0xf3, 0xd2, 0xe5, 0xeb, 0xfd, 0xc7, 0x14, 0xc8, 0xcb, 0xa1, 0x47, 0x04, 0x7a, 0xa9,
+	0x49, 0xf2, 0x52, 0x66, 0x83, 0x02, 0xbf, 0x47, 0x4d, 0xb7, 0x23, 0x49, 0xec, 0xcf, 0x90, 0x21,
+	0x4f, 0xf5, 0x23, 0xb1, 0x5e, 0x78, 0x31, 0xf1, 0x5e, 0x98, 0xad, 0xdc, 0x6f, 0xd2, 0x20, 0x3b,
+	0xe9, 0xf6, 0xc9, 0xbd, 0x37, 0xc9, 0x65, 0xdb, 0x06, 0x99, 0x8b, 0xc9, 0xed, 0x66, 0x51, 0xe6,
+	0x5f, 0x24, 0xb5, 0x06, 0x2e, 0x8d, 0x7d, 0x12, 0xdd, 0x29, 0xc7, 0x82, 0x3f, 0xa3, 0xa9, 0x65,
+	0x19, 0xbe, 0x4f, 0x52, 0x2b, 0xf4, 0x4e, 0x7e, 0x3e, 0xf7, 0x4b, 0xc1, 0x36, 0x9b, 0x64, 0x04,
+	0x2e, 0xf6, 0x50, 0x30, 0x91, 0xd3, 0x36, 0x9f, 0xfc, 0x4e, 0x80, 0xcc, 0x1e, 0xfb, 0x7d, 0x23,
+	0xf4, 0x40, 0xfe, 0xe6, 0xbf, 0x13, 0x4c, 0xa1, 0xc5, 0x7f, 0x27, 0x40, 0x66, 0x8f, 0xfc, 0x70,
+	0x42, 0xc9, 0x65, 0xb0, 0x44, 0xdf, 0x2d, 0x12, 0x69, 0x58, 0x19, 0x14, 0xcf, 0x91, 0x9f, 0x2f,
+	0x82, 0xd5, 0x73, 0x87, 0xbc, 0x0f, 0x9d, 0x3a, 0x57, 0xc8, 0x83, 0x05, 0xb0, 0x74, 0xe9, 0x60,
+	0xde, 0xc2, 0xac, 0xc8, 0xec, 0x83, 0x94, 0x48, 0xf0, 0x5a, 0x7d, 0x49, 0x95, 0x15, 0x97, 0x68,
+	0x27, 0x53, 0x22, 0x1c, 0x39, 0xec, 0xc5, 0xc7, 0x60, 0x37, 0xf2, 0x48, 0x3e, 0x45, 0x9b, 0x44,
+	0xc1, 0x88, 0x66, 0xf8, 0x71, 0x3d, 0x4c, 0xae, 0x83, 0xed, 0x49, 0x01, 0x4c, 0xf1, 0xb2, 0x8c,
+	0xab, 0xce, 0xc7, 0x2b, 0x17, 0xc6, 0x68, 0x21, 0x96, 0xc9, 0x75, 0xf5, 0xeb, 0x7f, 0x16, 0xc0,
+	0x46, 0xe4, 0x52, 0x0e, 0xdf, 0x07, 0x7b, 0xe7, 0xf5, 0xfb, 0xad, 0xa3, 0x7a, 0xfb, 0xa1, 0x4c,
+	0x4f, 0xf7, 0xce, 0x99, 0xd2, 0x39, 0x39, 0x3b, 0x6d, 0x1e, 0xb6, 0x8e, 0x5b, 0xcd, 0xa3, 0xdc,
+	0x02, 0x3b, 0xe0, 0x23, 0x66, 0x1d, 0xdb, 0x77, 0x91, 0x66, 0xf4, 0x0c, 0xa4, 0xc3, 0x6f, 0x82,
+	0xdb, 0x31, 0x84, 0xfa, 0x61, 0xbb, 0x75, 0xde, 0xcc, 0x09, 0xc5, 0x9d, 0x67, 0x2f, 0x2a, 0x5b,
+	0x11, 0xe3, 0xba, 0x86, 0x8d, 0x4b, 0x04, 0xef, 0x81, 0x9d, 0x98, 0x5d, 0xeb, 0x84, 0x5b, 0xa6,
+	0x8a, 0xbb, 0xcf, 0x5e, 0x54, 0x6e, 0x47, 0x2c, 0x5b, 0xb6, 0x4a, 0x6d, 0x59, 0x53, 0xd1, 0x78,
+	0xfc, 0xf9, 0xcb, 0x92, 0xf0, 0xc5, 0xcb, 0x92, 0xf0, 0x8f, 0x97, 0x25, 0xe1, 0xf9, 0xab, 0xd2,
+	0xc2, 0x17, 0xaf, 0x4a, 0x0b, 0x7f, 0x7d, 0x55, 0x5a, 0x78, 0xfc, 0x7e, 0x68, 0xc5, 0x42, 0xdd,
+	0xd7, 0x43, 0x1b, 0xd5, 0x58, 0x57, 0x76, 0xc7, 0x56, 0x09, 0x50, 0xed, 0xf2, 0xa0, 0xf6, 0x34,
+	0xf2, 0xdb, 0x2a, 0x5d, 0xcf, 0x6e, 0x86, 0x1e, 0x20, 0xdf, 0xf8, 0xef, 0x00, 0xc2, 0xce, 0xff,
+	0xb1, 0x80, 0x1d, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -461,6 +912,101 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ScoreWeightCap.Size()
+		i -= size
+		if _, err := m.ScoreWeightCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.ScoreWeightFloor.Size()
+		i -= size
+		if _, err := m.ScoreWeightFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if m.ScoreWeightingEnabled {
+		i--
+		if m.ScoreWeightingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ScoringEpochBlocks != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.ScoringEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.RebalancingCooldownBlocks != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.RebalancingCooldownBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxRedelegationsPerBlock != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.MaxRedelegationsPerBlock))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.RebalancingTrigger.Size()
+		i -= size
+		if _, err := m.RebalancingTrigger.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnstakeEpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnstakeEpochDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.LiquidityBufferRate.Size()
+		i -= size
+		if _, err := m.LiquidityBufferRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.WhitelistedPools) > 0 {
+		for iNdEx := len(m.WhitelistedPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.FeeAddress) > 0 {
 		i -= len(m.FeeAddress)
 		copy(dAtA[i:], m.FeeAddress)
@@ -589,6 +1135,43 @@ func (m *WhitelistedValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WhitelistedPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhitelistedPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhitelistedPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ShareDenom) > 0 {
+		i -= len(m.ShareDenom)
+		copy(dAtA[i:], m.ShareDenom)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ShareDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PoolAddress) > 0 {
+		i -= len(m.PoolAddress)
+		copy(dAtA[i:], m.PoolAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.PoolAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LiquidValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -704,6 +1287,16 @@ func (m *NetAmountState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalPendingUnstakeAmount.Size()
+		i -= size
+		if _, err := m.TotalPendingUnstakeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.ProxyAccBalance.Size()
 		i -= size
@@ -787,7 +1380,7 @@ func (m *NetAmountState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VotingPower) Marshal() (dAtA []byte, err error) {
+func (m *UnstakeEpoch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -797,20 +1390,69 @@ func (m *VotingPower) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VotingPower) MarshalTo(dAtA []byte) (int, error) {
+func (m *UnstakeEpoch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UnstakeEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.Number != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnstakeBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnstakeBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnstakeBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.State != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x30
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
 	{
-		size := m.ValidatorVotingPower.Size()
+		size := m.UnbondedAmount.Size()
 		i -= size
-		if _, err := m.ValidatorVotingPower.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.UnbondedAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
@@ -818,9 +1460,9 @@ func (m *VotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	{
-		size := m.LiquidStakingVotingPower.Size()
+		size := m.FeeAmount.Size()
 		i -= size
-		if _, err := m.LiquidStakingVotingPower.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.FeeAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
@@ -828,174 +1470,1778 @@ func (m *VotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x1a
 	{
-		size := m.StakingVotingPower.Size()
+		size := m.UnstakeAmount.Size()
 		i -= size
-		if _, err := m.StakingVotingPower.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.UnstakeAmount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.Voter)))
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.EpochNumber))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidstaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PendingUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LiquidBondDenom)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	{
+		size := m.UnstakeAmount.Size()
+		i -= size
+		if _, err := m.UnstakeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
-	if len(m.WhitelistedValidators) > 0 {
-		for _, e := range m.WhitelistedValidators {
-			l = e.Size()
-			n += 1 + l + sovLiquidstaking(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.BurnedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
-	l = m.StakeFeeRate.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.UnstakeFeeRate.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.RedemptionFeeRate.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.RestakeFeeRate.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.MinLiquidStakingAmount.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = len(m.AdminAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.FeeAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *WhitelistedValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+func (m *InFlightRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.TargetWeight.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *InFlightRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	return n
+	i -= n5
+	i = encodeVarintLiquidstaking(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DstValidatorAddress) > 0 {
+		i -= len(m.DstValidatorAddress)
+		copy(dAtA[i:], m.DstValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.DstValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SrcValidatorAddress) > 0 {
+		i -= len(m.SrcValidatorAddress)
+		copy(dAtA[i:], m.SrcValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidValidatorState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RebalancingTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalancingTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RebalancingTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
+	{
+		size := m.LiquidTokens.Size()
+		i -= size
+		if _, err := m.LiquidTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
-	l = m.Weight.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovLiquidstaking(uint64(m.Status))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetLiquidTokens.Size()
+		i -= size
+		if _, err := m.TargetLiquidTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
 	}
-	l = m.DelShares.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.LiquidTokens.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *NetAmountState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MintRate.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.BtokenTotalSupply.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.NetAmount.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.TotalDelShares.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.TotalLiquidTokens.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.TotalRemainingRewards.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.TotalUnbondingBalance.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.ProxyAccBalance.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VotingPower) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovLiquidstaking(uint64(l))
-	}
-	l = m.StakingVotingPower.Size()
-	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.LiquidStakingVotingPower.Size()
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SelfBondRate.Size()
+		i -= size
+		if _, err := m.SelfBondRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorVotingPower.Size()
+		i -= size
+		if _, err := m.ValidatorVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LiquidStakingVotingPower.Size()
+		i -= size
+		if _, err := m.LiquidStakingVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StakingVotingPower.Size()
+		i -= size
+		if _, err := m.StakingVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintLiquidstaking(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidstaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidstaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LiquidBondDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if len(m.WhitelistedValidators) > 0 {
+		for _, e := range m.WhitelistedValidators {
+			l = e.Size()
+			n += 1 + l + sovLiquidstaking(uint64(l))
+		}
+	}
+	l = m.StakeFeeRate.Size()
 	n += 1 + l + sovLiquidstaking(uint64(l))
-	l = m.ValidatorVotingPower.Size()
+	l = m.UnstakeFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.RedemptionFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.RestakeFeeRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.MinLiquidStakingAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.FeeAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if len(m.WhitelistedPools) > 0 {
+		for _, e := range m.WhitelistedPools {
+			l = e.Size()
+			n += 1 + l + sovLiquidstaking(uint64(l))
+		}
+	}
+	l = m.LiquidityBufferRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnstakeEpochDuration)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.RebalancingTrigger.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.MaxRedelegationsPerBlock != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.MaxRedelegationsPerBlock))
+	}
+	if m.RebalancingCooldownBlocks != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.RebalancingCooldownBlocks))
+	}
+	if m.ScoringEpochBlocks != 0 {
+		n += 2 + sovLiquidstaking(uint64(m.ScoringEpochBlocks))
+	}
+	if m.ScoreWeightingEnabled {
+		n += 3
+	}
+	l = m.ScoreWeightFloor.Size()
+	n += 2 + l + sovLiquidstaking(uint64(l))
+	l = m.ScoreWeightCap.Size()
+	n += 2 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *WhitelistedValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *WhitelistedPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.ShareDenom)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidValidatorState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Status))
+	}
+	l = m.DelShares.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.LiquidTokens.Size()
 	n += 1 + l + sovLiquidstaking(uint64(l))
 	return n
 }
 
-func sovLiquidstaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLiquidstaking(x uint64) (n int) {
-	return sovLiquidstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *NetAmountState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.BtokenTotalSupply.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.NetAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalDelShares.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalLiquidTokens.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalRemainingRewards.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalUnbondingBalance.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.ProxyAccBalance.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.TotalPendingUnstakeAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *UnstakeEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Number))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *UnstakeBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.EpochNumber))
+	}
+	l = m.UnstakeAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.FeeAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.UnbondedAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.State))
+	}
+	return n
+}
+
+func (m *PendingUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.EpochNumber))
+	}
+	l = m.BurnedAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.UnstakeAmount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *InFlightRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = len(m.DstValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *RebalancingTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.TargetLiquidTokens.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.LiquidTokens.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidstaking(uint64(m.Height))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.SelfBondRate.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func (m *VotingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovLiquidstaking(uint64(l))
+	}
+	l = m.StakingVotingPower.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.LiquidStakingVotingPower.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	l = m.ValidatorVotingPower.Size()
+	n += 1 + l + sovLiquidstaking(uint64(l))
+	return n
+}
+
+func sovLiquidstaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidstaking(x uint64) (n int) {
+	return sovLiquidstaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
+			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedemptionFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RestakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLiquidStakingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedPools = append(m.WhitelistedPools, WhitelistedPool{})
+			if err := m.WhitelistedPools[len(m.WhitelistedPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityBufferRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityBufferRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeEpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnstakeEpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalancingTrigger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RebalancingTrigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRedelegationsPerBlock", wireType)
+			}
+			m.MaxRedelegationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRedelegationsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalancingCooldownBlocks", wireType)
+			}
+			m.RebalancingCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebalancingCooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoringEpochBlocks", wireType)
+			}
+			m.ScoringEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScoringEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreWeightingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScoreWeightingEnabled = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreWeightFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScoreWeightFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScoreWeightCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScoreWeightCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistedPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistedPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistedPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1014,19 +3260,155 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidBondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1054,13 +3436,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidBondDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1070,29 +3454,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedValidators = append(m.WhitelistedValidators, WhitelistedValidator{})
-			if err := m.WhitelistedValidators[len(m.WhitelistedValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1120,13 +3504,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1154,13 +3538,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnstakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPendingUnstakeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1188,13 +3572,184 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedemptionFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalPendingUnstakeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnstakeEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnstakeEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnstakeEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestakeFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnstakeBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnstakeBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnstakeBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1222,13 +3777,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RestakeFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnstakeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidStakingAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1256,13 +3811,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinLiquidStakingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1290,13 +3845,48 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.UnbondedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeAddress", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var stringLen uint64
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1306,24 +3896,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.State |= UnstakeBatch_State(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
@@ -1345,7 +3922,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
+func (m *PendingUnstake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1368,15 +3945,15 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistedValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingUnstake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistedValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1404,13 +3981,32 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1420,79 +4016,28 @@ func (m *WhitelistedValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BurnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLiquidstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLiquidstaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnstakeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1520,7 +4065,9 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.UnstakeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1543,7 +4090,7 @@ func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
+func (m *InFlightRedelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1566,15 +4113,15 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidValidatorState: wiretype end group for non-group")
+			return fmt.Errorf("proto: InFlightRedelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidValidatorState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InFlightRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1602,11 +4149,11 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1634,32 +4181,11 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidstaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1687,15 +4213,15 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidstaking
@@ -1705,23 +4231,22 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidstaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1746,7 +4271,7 @@ func (m *LiquidValidatorState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetAmountState) Unmarshal(dAtA []byte) error {
+func (m *RebalancingTarget) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1769,15 +4294,15 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetAmountState: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebalancingTarget: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetAmountState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebalancingTarget: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1805,13 +4330,11 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtokenTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLiquidTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1839,13 +4362,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BtokenTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1873,13 +4396,63 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1907,13 +4480,30 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1941,13 +4531,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLiquidTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRemainingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1975,13 +4565,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalRemainingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfBondRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2009,13 +4599,13 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUnbondingBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SelfBondRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyAccBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2043,7 +4633,7 @@ func (m *NetAmountState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProxyAccBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9,6 +9,8 @@ import (
 var (
 	_ sdk.Msg = (*MsgLiquidStake)(nil)
 	_ sdk.Msg = (*MsgLiquidUnstake)(nil)
+	_ sdk.Msg = (*MsgRedeem)(nil)
+	_ sdk.Msg = (*MsgClaim)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 )
 
@@ -16,6 +18,8 @@ var (
 const (
 	TypeMsgLiquidStake   = "liquid_stake"
 	TypeMsgLiquidUnstake = "liquid_unstake"
+	TypeMsgRedeem        = "redeem"
+	TypeMsgClaim         = "claim"
 	TypeMsgUpdateParams  = "msg_update_params"
 )
 
@@ -115,6 +119,94 @@ func (msg MsgLiquidUnstake) GetDelegator() sdk.AccAddress {
 	return addr
 }
 
+// NewMsgRedeem creates a new MsgRedeem.
+func NewMsgRedeem(
+	liquidStaker sdk.AccAddress, //nolint: interfacer
+	amount sdk.Coin,
+) *MsgRedeem {
+	return &MsgRedeem{
+		DelegatorAddress: liquidStaker.String(),
+		Amount:           amount,
+	}
+}
+
+func (msg MsgRedeem) Route() string { return RouterKey }
+
+func (msg MsgRedeem) Type() string { return TypeMsgRedeem }
+
+func (msg MsgRedeem) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	if ok := msg.Amount.IsZero(); ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "redeem amount must not be zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg MsgRedeem) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRedeem) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgRedeem) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgClaim creates a new MsgClaim.
+func NewMsgClaim(
+	liquidStaker sdk.AccAddress, //nolint: interfacer
+) *MsgClaim {
+	return &MsgClaim{
+		DelegatorAddress: liquidStaker.String(),
+	}
+}
+
+func (msg MsgClaim) Route() string { return RouterKey }
+
+func (msg MsgClaim) Type() string { return TypeMsgClaim }
+
+func (msg MsgClaim) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %q: %v", msg.DelegatorAddress, err)
+	}
+	return nil
+}
+
+func (msg MsgClaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaim) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgClaim) GetDelegator() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 // NewMsgUpdateParams creates a new MsgUpdateParams.
 func NewMsgUpdateParams(
 	authority sdk.AccAddress, //nolint: interfacer
@@ -92,6 +92,81 @@ func TestMsgLiquidUnstake(t *testing.T) {
 	}
 }
 
+func TestMsgRedeem(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+	redeemCoin := sdk.NewCoin("btoken", sdk.NewInt(1))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgRedeem
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgRedeem(delegatorAddr, redeemCoin),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgRedeem(sdk.AccAddress{}, redeemCoin),
+		},
+		{
+			"redeem amount must not be zero: invalid request",
+			types.NewMsgRedeem(delegatorAddr, sdk.NewCoin("btoken", sdk.NewInt(0))),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgRedeem{}, tc.msg)
+		require.Equal(t, types.TypeMsgRedeem, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgClaim(t *testing.T) {
+	delegatorAddr := sdk.AccAddress(crypto.AddressHash([]byte("delegatorAddr")))
+
+	testCases := []struct {
+		expectedErr string
+		msg         *types.MsgClaim
+	}{
+		{
+			"", // empty means no error expected
+			types.NewMsgClaim(delegatorAddr),
+		},
+		{
+			"invalid delegator address \"\": empty address string is not allowed: invalid address",
+			types.NewMsgClaim(sdk.AccAddress{}),
+		},
+	}
+
+	for _, tc := range testCases {
+		require.IsType(t, &types.MsgClaim{}, tc.msg)
+		require.Equal(t, types.TypeMsgClaim, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDelegator(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgUpdateParams(t *testing.T) {
 	authority := sdk.AccAddress(crypto.AddressHash([]byte("authority")))
 
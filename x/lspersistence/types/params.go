@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,16 +21,43 @@ var (
 	DefaultAdminAddress = authtypes.NewModuleAddress("dummy")
 
 	DefaultFeeAddress = authtypes.NewModuleAddress("dummy")
-	// Const variables
 
-	// RebalancingTrigger if the maximum difference and needed each redelegation amount exceeds it, asset rebalacing will be executed.
-	RebalancingTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+	// DefaultRebalancingTrigger if the maximum difference and needed each redelegation amount exceeds it, asset rebalacing will be executed.
+	DefaultRebalancingTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
+
+	// DefaultScoreWeightFloor is the default minimum rate of the target weight kept by a low scoring validator.
+	DefaultScoreWeightFloor = sdk.NewDecWithPrec(5, 1) // "0.500000000000000000"
+
+	// DefaultScoreWeightCap is the default maximum rate of the target weight given to a high scoring validator.
+	DefaultScoreWeightCap = sdk.NewDecWithPrec(15, 1) // "1.500000000000000000"
+
+	// Const variables
 
 	// RewardTrigger If the sum of balance and the upcoming rewards of LiquidStakingProxyAcc exceeds it, the reward is automatically withdrawn and re-stake according to the weights.
 	RewardTrigger = sdk.NewDecWithPrec(1, 3) // "0.001000000000000000"
 
+	// UptimeScoreWeight, CommissionScoreWeight and SelfBondScoreWeight are the weights of the uptime, the commission
+	// and the self-bond in the validator score.
+	UptimeScoreWeight     = sdk.NewDecWithPrec(5, 1) // "0.500000000000000000"
+	CommissionScoreWeight = sdk.NewDecWithPrec(3, 1) // "0.300000000000000000"
+	SelfBondScoreWeight   = sdk.NewDecWithPrec(2, 1) // "0.200000000000000000"
+
+	// SelfBondScoreThreshold is the self-bond rate above which a validator gets the full self-bond score.
+	SelfBondScoreThreshold = sdk.NewDecWithPrec(1, 2) // "0.010000000000000000"
+
 	// LiquidStakingProxyAcc is a proxy reserve account for delegation and undelegation.
 	LiquidStakingProxyAcc = authtypes.NewModuleAddress(ModuleName + "-LiquidStakingProxyAcc")
+
+	// UnstakeBatchAcc is the account the epoch-batched liquid unstakes are unbonded to and claimed from.
+	UnstakeBatchAcc = authtypes.NewModuleAddress(ModuleName + "-UnstakeBatchAcc")
+)
+
+const (
+	// ScoreWeightPrecision scales the target weights when deriving the effective weights from the validator scores.
+	ScoreWeightPrecision = 1000
+
+	// MaxValidatorScoreHistory is the number of scores kept per whitelisted validator.
+	MaxValidatorScoreHistory = 100
 )
 
 // DefaultParams returns the default liquidstaking module parameters.
@@ -44,6 +72,11 @@ func DefaultParams() Params {
 		MinLiquidStakingAmount: DefaultMinLiquidStakingAmount,
 		AdminAddress:           DefaultAdminAddress.String(),
 		FeeAddress:             DefaultFeeAddress.String(),
+		WhitelistedPools:       []WhitelistedPool{},
+		LiquidityBufferRate:    sdk.ZeroDec(),
+		RebalancingTrigger:     DefaultRebalancingTrigger,
+		ScoreWeightFloor:       DefaultScoreWeightFloor,
+		ScoreWeightCap:         DefaultScoreWeightCap,
 	}
 }
 
@@ -72,6 +105,16 @@ func (p Params) Validate() error {
 		{p.MinLiquidStakingAmount, validateMinLiquidStakingAmount},
 		{p.AdminAddress, validateAdminAddress},
 		{p.FeeAddress, validateFeeAddress},
+		{p.WhitelistedPools, validateWhitelistedPools},
+		{p.LiquidityBufferRate, validateLiquidityBufferRate},
+		{p.UnstakeEpochDuration, validateUnstakeEpochDuration},
+		{p.RebalancingTrigger, validateRebalancingTrigger},
+		{p.MaxRedelegationsPerBlock, validateMaxRedelegationsPerBlock},
+		{p.RebalancingCooldownBlocks, validateRebalancingCooldownBlocks},
+		{p.ScoringEpochBlocks, validateScoringEpochBlocks},
+		{p.ScoreWeightingEnabled, validateScoreWeightingEnabled},
+		{p.ScoreWeightFloor, validateScoreWeightFloor},
+		{p.ScoreWeightCap, validateScoreWeightCap},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -214,6 +257,131 @@ func validateRedemptionFeeRate(i interface{}) error {
 	return nil
 }
 
+func validateLiquidityBufferRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquidity buffer rate must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("liquidity buffer rate must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquidity buffer rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateRebalancingTrigger(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("rebalancing trigger must not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("rebalancing trigger must not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("rebalancing trigger too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxRedelegationsPerBlock(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRebalancingCooldownBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateScoringEpochBlocks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateScoreWeightingEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateScoreWeightFloor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("score weight floor must not be nil")
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("score weight floor must be positive: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("score weight floor too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateScoreWeightCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("score weight cap must not be nil")
+	}
+
+	if v.LT(sdk.OneDec()) {
+		return fmt.Errorf("score weight cap must not be less than one: %s", v)
+	}
+
+	return nil
+}
+
+func validateUnstakeEpochDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("unstake epoch duration must not be negative: %s", v)
+	}
+
+	return nil
+}
+
 func validateMinLiquidStakingAmount(i interface{}) error {
 	v, ok := i.(math.Int)
 	if !ok {
@@ -254,3 +422,31 @@ func validateFeeAddress(i interface{}) error {
 	}
 	return nil
 }
+
+// validateWhitelistedPools validates the pools whose bTokens are attributed to their share holders.
+func validateWhitelistedPools(i interface{}) error {
+	pools, ok := i.([]WhitelistedPool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	poolsMap := map[string]struct{}{}
+	for _, pool := range pools {
+		if _, err := sdk.AccAddressFromBech32(pool.PoolAddress); err != nil {
+			return fmt.Errorf("cannot convert pool address to bech32, invalid address: %s, err: %v", pool.PoolAddress, err)
+		}
+
+		if err := sdk.ValidateDenom(pool.ShareDenom); err != nil {
+			return err
+		}
+
+		if pool.PoolAddress == LiquidStakingProxyAcc.String() {
+			return fmt.Errorf("liquid staking proxy account cannot be a whitelisted pool")
+		}
+
+		if _, ok := poolsMap[pool.PoolAddress]; ok {
+			return fmt.Errorf("whitelisted pool cannot be duplicated: %s", pool.PoolAddress)
+		}
+		poolsMap[pool.PoolAddress] = struct{}{}
+	}
+	return nil
+}
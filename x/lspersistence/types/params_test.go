@@ -3,6 +3,7 @@ package types_test
 import (
 	"cosmossdk.io/math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -23,6 +24,16 @@ restake_fee_rate: "0.050000000000000000"
 min_liquid_staking_amount: "10000"
 admin_address: persistence1kk3vjcjsvy3kd6389lavdkt5f2h5k3d2ry296c
 fee_address: persistence1kk3vjcjsvy3kd6389lavdkt5f2h5k3d2ry296c
+whitelisted_pools: []
+liquidity_buffer_rate: "0.000000000000000000"
+unstake_epoch_duration: 0s
+rebalancing_trigger: "0.001000000000000000"
+max_redelegations_per_block: 0
+rebalancing_cooldown_blocks: 0
+scoring_epoch_blocks: 0
+score_weighting_enabled: false
+score_weight_floor: "0.500000000000000000"
+score_weight_cap: "1.500000000000000000"
 `
 	require.Equal(t, paramsStr, params.String())
 
@@ -43,6 +54,16 @@ restake_fee_rate: "0.050000000000000000"
 min_liquid_staking_amount: "10000"
 admin_address: persistence1kk3vjcjsvy3kd6389lavdkt5f2h5k3d2ry296c
 fee_address: persistence1kk3vjcjsvy3kd6389lavdkt5f2h5k3d2ry296c
+whitelisted_pools: []
+liquidity_buffer_rate: "0.000000000000000000"
+unstake_epoch_duration: 0s
+rebalancing_trigger: "0.001000000000000000"
+max_redelegations_per_block: 0
+rebalancing_cooldown_blocks: 0
+scoring_epoch_blocks: 0
+score_weighting_enabled: false
+score_weight_floor: "0.500000000000000000"
+score_weight_cap: "1.500000000000000000"
 `
 	require.Equal(t, paramsStr, params.String())
 }
@@ -191,6 +212,102 @@ func TestValidateWhitelistedValidators(t *testing.T) {
 			},
 			"min liquid staking amount must not be negative: -1",
 		},
+		{
+			"negative liquidity buffer rate",
+			func(params *types.Params) {
+				params.LiquidityBufferRate = sdk.NewDec(-1)
+			},
+			"liquidity buffer rate must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large liquidity buffer rate",
+			func(params *types.Params) {
+				params.LiquidityBufferRate = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"liquidity buffer rate too large: 1.000000100000000000",
+		},
+		{
+			"negative unstake epoch duration",
+			func(params *types.Params) {
+				params.UnstakeEpochDuration = -time.Hour
+			},
+			"unstake epoch duration must not be negative: -1h0m0s",
+		},
+		{
+			"negative rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.NewDec(-1)
+			},
+			"rebalancing trigger must not be negative: -1.000000000000000000",
+		},
+		{
+			"too large rebalancing trigger",
+			func(params *types.Params) {
+				params.RebalancingTrigger = sdk.MustNewDecFromStr("1.0000001")
+			},
+			"rebalancing trigger too large: 1.000000100000000000",
+		},
+		{
+			"zero score weight floor",
+			func(params *types.Params) {
+				params.ScoreWeightFloor = sdk.ZeroDec()
+			},
+			"score weight floor must be positive: 0.000000000000000000",
+		},
+		{
+			"too large score weight floor",
+			func(params *types.Params) {
+				params.ScoreWeightFloor = sdk.MustNewDecFromStr("1.1")
+			},
+			"score weight floor too large: 1.100000000000000000",
+		},
+		{
+			"too small score weight cap",
+			func(params *types.Params) {
+				params.ScoreWeightCap = sdk.MustNewDecFromStr("0.9")
+			},
+			"score weight cap must not be less than one: 0.900000000000000000",
+		},
+		{
+			"duplicated whitelisted pools",
+			func(params *types.Params) {
+				params.WhitelistedPools = []types.WhitelistedPool{
+					{
+						PoolAddress: "persistence1kk3vjcjsvy3kd6389lavdkt5f2h5k3d2ry296c",
+						ShareDenom:  "pool1",
+					},
+					{
+						PoolAddress: "persistence1kk3vjcjsvy3kd6389lavdkt5f2h5k3d2ry296c",
+						ShareDenom:  "pool2",
+					},
+				}
+			},
+			"whitelisted pool cannot be duplicated: persistence1kk3vjcjsvy3kd6389lavdkt5f2h5k3d2ry296c",
+		},
+		{
+			"invalid whitelisted pool share denom",
+			func(params *types.Params) {
+				params.WhitelistedPools = []types.WhitelistedPool{
+					{
+						PoolAddress: "persistence1kk3vjcjsvy3kd6389lavdkt5f2h5k3d2ry296c",
+						ShareDenom:  "a",
+					},
+				}
+			},
+			"invalid denom: a",
+		},
+		{
+			"proxy account as whitelisted pool",
+			func(params *types.Params) {
+				params.WhitelistedPools = []types.WhitelistedPool{
+					{
+						PoolAddress: types.LiquidStakingProxyAcc.String(),
+						ShareDenom:  "pool1",
+					},
+				}
+			},
+			"liquid staking proxy account cannot be a whitelisted pool",
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			params := types.DefaultParams()
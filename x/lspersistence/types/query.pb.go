@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -275,465 +276,2375 @@ func (m *QueryStatesResponse) GetNetAmountState() NetAmountState {
 	return NetAmountState{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.lspersistence.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.lspersistence.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "pstake.lspersistence.v1beta1.QueryLiquidValidatorsRequest")
-	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "pstake.lspersistence.v1beta1.QueryLiquidValidatorsResponse")
-	proto.RegisterType((*QueryStatesRequest)(nil), "pstake.lspersistence.v1beta1.QueryStatesRequest")
-	proto.RegisterType((*QueryStatesResponse)(nil), "pstake.lspersistence.v1beta1.QueryStatesResponse")
+// QueryVotingPowerRequest is the request type for the Query/VotingPower RPC method.
+type QueryVotingPowerRequest struct {
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("pstake/lspersistence/v1beta1/query.proto", fileDescriptor_686f3882ea1eb1bb)
+func (m *QueryVotingPowerRequest) Reset()         { *m = QueryVotingPowerRequest{} }
+func (m *QueryVotingPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerRequest) ProtoMessage()    {}
+func (*QueryVotingPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{6}
 }
-
-var fileDescriptor_686f3882ea1eb1bb = []byte{
-	// 466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x5a, 0x73, 0x98, 0x82, 0xc4, 0xb1, 0x87, 0x12, 0xe2, 0x5a, 0x42, 0x91, 0x20,
-	0x75, 0x27, 0x59, 0x6f, 0x7a, 0xd1, 0x9c, 0xc5, 0x1f, 0x15, 0x04, 0x8b, 0x10, 0x26, 0xed, 0x63,
-	0x1d, 0xdc, 0xcc, 0x6c, 0x76, 0xde, 0x06, 0x7b, 0x55, 0xf0, 0x2c, 0x78, 0xf4, 0x6f, 0xf1, 0xde,
-	0x63, 0xc1, 0x8b, 0x27, 0x91, 0xc4, 0x3f, 0x44, 0x32, 0x33, 0xae, 0xdd, 0x95, 0x2c, 0xab, 0xb7,
-	0xe5, 0xcd, 0xfb, 0xbe, 0xf7, 0x79, 0xdf, 0xf7, 0x58, 0x3a, 0x48, 0x0d, 0x8a, 0x37, 0xc0, 0x13,
-	0x93, 0x42, 0x66, 0xa4, 0x41, 0x50, 0xc7, 0xc0, 0x17, 0xa3, 0x29, 0xa0, 0x18, 0xf1, 0x79, 0x0e,
-	0xd9, 0x69, 0x98, 0x66, 0x1a, 0x35, 0xeb, 0xb9, 0xcc, 0xb0, 0x94, 0x19, 0xfa, 0xcc, 0x6e, 0x2f,
-	0xd6, 0x3a, 0x4e, 0x80, 0x8b, 0x54, 0x72, 0xa1, 0x94, 0x46, 0x81, 0x52, 0x2b, 0xe3, 0xb4, 0xdd,
-	0x61, 0x6d, 0x97, 0x44, 0xce, 0x73, 0x79, 0xb2, 0xce, 0x90, 0x2a, 0xf6, 0x8a, 0x9d, 0x58, 0xc7,
-	0xda, 0x7e, 0xf2, 0xf5, 0x97, 0x8b, 0xf6, 0x77, 0x28, 0x7b, 0xb6, 0x46, 0x7a, 0x2a, 0x32, 0x31,
-	0x33, 0x87, 0x30, 0xcf, 0xc1, 0x60, 0xff, 0x25, 0xbd, 0x5e, 0x8a, 0x9a, 0x54, 0x2b, 0x03, 0x6c,
-	0x4c, 0xdb, 0xa9, 0x8d, 0xec, 0x92, 0x3d, 0x32, 0xd8, 0x8e, 0xf6, 0xc3, 0xba, 0x09, 0x42, 0xa7,
-	0x1e, 0x6f, 0x9d, 0x7d, 0xbf, 0xd9, 0x3a, 0xf4, 0xca, 0x7e, 0x40, 0x7b, 0xb6, 0xf4, 0x23, 0x8b,
-	0xf8, 0x42, 0x24, 0xf2, 0x44, 0xa0, 0xce, 0x8a, 0xd6, 0x1f, 0x08, 0xbd, 0xb1, 0x21, 0xc1, 0x53,
-	0x00, 0xbd, 0xe6, 0xe6, 0x9b, 0x2c, 0x8a, 0xc7, 0x5d, 0xb2, 0x77, 0x79, 0xb0, 0x1d, 0x45, 0xf5,
-	0x40, 0x95, 0x92, 0xcf, 0x51, 0x20, 0x78, 0xbc, 0x4e, 0x52, 0x69, 0x57, 0x38, 0x63, 0xb3, 0x0a,
-	0x3c, 0xe3, 0x9d, 0xf9, 0x1d, 0xf5, 0x4c, 0xaf, 0x68, 0x47, 0x01, 0x4e, 0xc4, 0x4c, 0xe7, 0x0a,
-	0x27, 0x66, 0xfd, 0xe8, 0x3d, 0x3a, 0xa8, 0x47, 0x7a, 0x0c, 0xf8, 0xd0, 0x8a, 0x2e, 0xc2, 0x5c,
-	0x55, 0xa5, 0x68, 0xf4, 0x7e, 0x8b, 0x5e, 0xb1, 0x5d, 0xd9, 0x67, 0x42, 0xdb, 0xce, 0x56, 0x36,
-	0xac, 0x2f, 0xfc, 0xf7, 0x56, 0xbb, 0xa3, 0x7f, 0x50, 0xb8, 0xb9, 0xfa, 0x07, 0xef, 0xbe, 0xfe,
-	0xfc, 0x74, 0xe9, 0x16, 0xdb, 0xe7, 0xb5, 0xf7, 0xe6, 0x76, 0xcb, 0xbe, 0x10, 0xda, 0xa9, 0xae,
-	0x8d, 0xdd, 0x6b, 0xd0, 0x75, 0xc3, 0x31, 0x74, 0xef, 0xff, 0x97, 0xd6, 0xb3, 0x0f, 0x2d, 0xfb,
-	0x6d, 0x36, 0xa8, 0x67, 0xff, 0x73, 0x44, 0xd6, 0x5d, 0xb7, 0xd8, 0x46, 0xee, 0x96, 0x2e, 0xa3,
-	0x91, 0xbb, 0xe5, 0xab, 0x69, 0xea, 0xae, 0x3d, 0x27, 0x33, 0x3e, 0x3a, 0x5b, 0x06, 0xe4, 0x7c,
-	0x19, 0x90, 0x1f, 0xcb, 0x80, 0x7c, 0x5c, 0x05, 0xad, 0xf3, 0x55, 0xd0, 0xfa, 0xb6, 0x0a, 0x5a,
-	0x47, 0x0f, 0x62, 0x89, 0xaf, 0xf3, 0x69, 0x78, 0xac, 0x67, 0xfc, 0x42, 0x81, 0x27, 0x0a, 0x7c,
-	0xe1, 0x3b, 0x4a, 0xa0, 0x5c, 0x00, 0x5f, 0x44, 0xfc, 0x6d, 0xa5, 0x09, 0x9e, 0xa6, 0x60, 0xa6,
-	0x6d, 0xfb, 0x37, 0xb8, 0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x46, 0x87, 0xb0, 0x44, 0xbd, 0x04,
-	0x00, 0x00,
+func (m *QueryVotingPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns parameters of the liquidstaking module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstaking module.
-	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
-	// States returns states of the liquidstaking module.
-	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+func (m *QueryVotingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryVotingPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerRequest.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryVotingPowerRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryVotingPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
-	out := new(QueryLiquidValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/LiquidValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryVotingPowerRequest proto.InternalMessageInfo
 
-func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
-	out := new(QueryStatesResponse)
-	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/States", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVotingPowerRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the liquidstaking module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// LiquidValidators returns liquid validators with states of the liquidstaking module.
-	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
-	// States returns states of the liquidstaking module.
-	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+// QueryVotingPowerResponse is the response type for the Query/VotingPower RPC method.
+type QueryVotingPowerResponse struct {
+	VotingPower VotingPower `protobuf:"bytes,1,opt,name=voting_power,json=votingPower,proto3" json:"voting_power"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVotingPowerResponse) Reset()         { *m = QueryVotingPowerResponse{} }
+func (m *QueryVotingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVotingPowerResponse) ProtoMessage()    {}
+func (*QueryVotingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{7}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryVotingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+func (m *QueryVotingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVotingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+func (m *QueryVotingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVotingPowerResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryVotingPowerResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lspersistence.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryVotingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVotingPowerResponse.DiscardUnknown(m)
 }
 
-func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lspersistence.v1beta1.Query/LiquidValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryVotingPowerResponse proto.InternalMessageInfo
 
-func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).States(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pstake.lspersistence.v1beta1.Query/States",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
+func (m *QueryVotingPowerResponse) GetVotingPower() VotingPower {
+	if m != nil {
+		return m.VotingPower
 	}
-	return interceptor(ctx, in, info, handler)
+	return VotingPower{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pstake.lspersistence.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "LiquidValidators",
-			Handler:    _Query_LiquidValidators_Handler,
-		},
-		{
-			MethodName: "States",
-			Handler:    _Query_States_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "pstake/lspersistence/v1beta1/query.proto",
+// QueryPendingUnstakesRequest is the request type for the Query/PendingUnstakes RPC method.
+type QueryPendingUnstakesRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingUnstakesRequest) Reset()         { *m = QueryPendingUnstakesRequest{} }
+func (m *QueryPendingUnstakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingUnstakesRequest) ProtoMessage()    {}
+func (*QueryPendingUnstakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{8}
+}
+func (m *QueryPendingUnstakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingUnstakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingUnstakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingUnstakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingUnstakesRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingUnstakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingUnstakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingUnstakesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingUnstakesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingUnstakesRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingUnstakesResponse is the response type for the Query/PendingUnstakes RPC method.
+type QueryPendingUnstakesResponse struct {
+	PendingUnstakes []PendingUnstake `protobuf:"bytes,1,rep,name=pending_unstakes,json=pendingUnstakes,proto3" json:"pending_unstakes"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingUnstakesResponse) Reset()         { *m = QueryPendingUnstakesResponse{} }
+func (m *QueryPendingUnstakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingUnstakesResponse) ProtoMessage()    {}
+func (*QueryPendingUnstakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{9}
+}
+func (m *QueryPendingUnstakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingUnstakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingUnstakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingUnstakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingUnstakesResponse.Merge(m, src)
+}
+func (m *QueryPendingUnstakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingUnstakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingUnstakesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingUnstakesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingUnstakesResponse) GetPendingUnstakes() []PendingUnstake {
+	if m != nil {
+		return m.PendingUnstakes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryClaimableUnstakesRequest is the request type for the Query/ClaimableUnstakes RPC method.
+type QueryClaimableUnstakesRequest struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 }
 
-func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryClaimableUnstakesRequest) Reset()         { *m = QueryClaimableUnstakesRequest{} }
+func (m *QueryClaimableUnstakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableUnstakesRequest) ProtoMessage()    {}
+func (*QueryClaimableUnstakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{10}
+}
+func (m *QueryClaimableUnstakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableUnstakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableUnstakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableUnstakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableUnstakesRequest.Merge(m, src)
+}
+func (m *QueryClaimableUnstakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableUnstakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableUnstakesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableUnstakesRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableUnstakesRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryClaimableUnstakesResponse is the response type for the Query/ClaimableUnstakes RPC method.
+type QueryClaimableUnstakesResponse struct {
+	ClaimableUnstakes []PendingUnstake `protobuf:"bytes,1,rep,name=claimable_unstakes,json=claimableUnstakes,proto3" json:"claimable_unstakes"`
+	// amount defines the native token amount that can be claimed
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryClaimableUnstakesResponse) Reset()         { *m = QueryClaimableUnstakesResponse{} }
+func (m *QueryClaimableUnstakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableUnstakesResponse) ProtoMessage()    {}
+func (*QueryClaimableUnstakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{11}
+}
+func (m *QueryClaimableUnstakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableUnstakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableUnstakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClaimableUnstakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableUnstakesResponse.Merge(m, src)
+}
+func (m *QueryClaimableUnstakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableUnstakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableUnstakesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableUnstakesResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableUnstakesResponse) GetClaimableUnstakes() []PendingUnstake {
+	if m != nil {
+		return m.ClaimableUnstakes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryClaimableUnstakesResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryRebalancingRequest is the request type for the Query/Rebalancing RPC method.
+type QueryRebalancingRequest struct {
 }
 
-func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRebalancingRequest) Reset()         { *m = QueryRebalancingRequest{} }
+func (m *QueryRebalancingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancingRequest) ProtoMessage()    {}
+func (*QueryRebalancingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{12}
+}
+func (m *QueryRebalancingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRebalancingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancingRequest.Merge(m, src)
+}
+func (m *QueryRebalancingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalancingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancingRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRebalancingRequest proto.InternalMessageInfo
+
+// QueryRebalancingResponse is the response type for the Query/Rebalancing RPC method.
+type QueryRebalancingResponse struct {
+	Targets               []RebalancingTarget    `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets"`
+	InFlightRedelegations []InFlightRedelegation `protobuf:"bytes,2,rep,name=in_flight_redelegations,json=inFlightRedelegations,proto3" json:"in_flight_redelegations"`
 }
 
-func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRebalancingResponse) Reset()         { *m = QueryRebalancingResponse{} }
+func (m *QueryRebalancingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRebalancingResponse) ProtoMessage()    {}
+func (*QueryRebalancingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{13}
+}
+func (m *QueryRebalancingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRebalancingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRebalancingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRebalancingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRebalancingResponse.Merge(m, src)
+}
+func (m *QueryRebalancingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRebalancingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRebalancingResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryRebalancingResponse proto.InternalMessageInfo
+
+func (m *QueryRebalancingResponse) GetTargets() []RebalancingTarget {
+	if m != nil {
+		return m.Targets
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryRebalancingResponse) GetInFlightRedelegations() []InFlightRedelegation {
+	if m != nil {
+		return m.InFlightRedelegations
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryValidatorScoresRequest is the request type for the Query/ValidatorScores RPC method.
+type QueryValidatorScoresRequest struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorScoresRequest) Reset()         { *m = QueryValidatorScoresRequest{} }
+func (m *QueryValidatorScoresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresRequest) ProtoMessage()    {}
+func (*QueryValidatorScoresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{14}
+}
+func (m *QueryValidatorScoresRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryValidatorScoresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresRequest.Merge(m, src)
+}
+func (m *QueryValidatorScoresRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryValidatorScoresRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryLiquidValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryValidatorScoresResponse is the response type for the Query/ValidatorScores RPC method.
+type QueryValidatorScoresResponse struct {
+	Scores []ValidatorScore `protobuf:"bytes,1,rep,name=scores,proto3" json:"scores"`
+}
+
+func (m *QueryValidatorScoresResponse) Reset()         { *m = QueryValidatorScoresResponse{} }
+func (m *QueryValidatorScoresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorScoresResponse) ProtoMessage()    {}
+func (*QueryValidatorScoresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_686f3882ea1eb1bb, []int{15}
+}
+func (m *QueryValidatorScoresResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorScoresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorScoresResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.LiquidValidators) > 0 {
-		for _, e := range m.LiquidValidators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryValidatorScoresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorScoresResponse.Merge(m, src)
+}
+func (m *QueryValidatorScoresResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorScoresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorScoresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorScoresResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorScoresResponse) GetScores() []ValidatorScore {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "pstake.lspersistence.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "pstake.lspersistence.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryLiquidValidatorsRequest)(nil), "pstake.lspersistence.v1beta1.QueryLiquidValidatorsRequest")
+	proto.RegisterType((*QueryLiquidValidatorsResponse)(nil), "pstake.lspersistence.v1beta1.QueryLiquidValidatorsResponse")
+	proto.RegisterType((*QueryStatesRequest)(nil), "pstake.lspersistence.v1beta1.QueryStatesRequest")
+	proto.RegisterType((*QueryStatesResponse)(nil), "pstake.lspersistence.v1beta1.QueryStatesResponse")
+	proto.RegisterType((*QueryVotingPowerRequest)(nil), "pstake.lspersistence.v1beta1.QueryVotingPowerRequest")
+	proto.RegisterType((*QueryVotingPowerResponse)(nil), "pstake.lspersistence.v1beta1.QueryVotingPowerResponse")
+	proto.RegisterType((*QueryPendingUnstakesRequest)(nil), "pstake.lspersistence.v1beta1.QueryPendingUnstakesRequest")
+	proto.RegisterType((*QueryPendingUnstakesResponse)(nil), "pstake.lspersistence.v1beta1.QueryPendingUnstakesResponse")
+	proto.RegisterType((*QueryClaimableUnstakesRequest)(nil), "pstake.lspersistence.v1beta1.QueryClaimableUnstakesRequest")
+	proto.RegisterType((*QueryClaimableUnstakesResponse)(nil), "pstake.lspersistence.v1beta1.QueryClaimableUnstakesResponse")
+	proto.RegisterType((*QueryRebalancingRequest)(nil), "pstake.lspersistence.v1beta1.QueryRebalancingRequest")
+	proto.RegisterType((*QueryRebalancingResponse)(nil), "pstake.lspersistence.v1beta1.QueryRebalancingResponse")
+	proto.RegisterType((*QueryValidatorScoresRequest)(nil), "pstake.lspersistence.v1beta1.QueryValidatorScoresRequest")
+	proto.RegisterType((*QueryValidatorScoresResponse)(nil), "pstake.lspersistence.v1beta1.QueryValidatorScoresResponse")
+}
+
+func init() {
+	proto.RegisterFile("pstake/lspersistence/v1beta1/query.proto", fileDescriptor_686f3882ea1eb1bb)
+}
+
+var fileDescriptor_686f3882ea1eb1bb = []byte{
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x06, 0x6a, 0xc4, 0x33, 0xa2, 0xce, 0x34, 0xa8, 0xee, 0x62, 0x96, 0x6a, 0x55, 0xa1,
+	0x94, 0x16, 0x4f, 0x6c, 0xa0, 0x88, 0xc0, 0x81, 0xa6, 0x55, 0x25, 0xa2, 0x88, 0x16, 0x03, 0x95,
+	0xa8, 0x40, 0xd6, 0xd8, 0x1e, 0xb6, 0x03, 0xeb, 0x99, 0xcd, 0xce, 0xd8, 0xa5, 0x8a, 0x72, 0xe1,
+	0xc0, 0x19, 0x89, 0x23, 0x7f, 0x04, 0x57, 0x0e, 0x70, 0xef, 0xb1, 0x12, 0x1c, 0x38, 0x00, 0x42,
+	0x09, 0xff, 0x06, 0x12, 0xf2, 0xcc, 0xd8, 0xde, 0x1f, 0xce, 0x66, 0x1d, 0x7a, 0x73, 0xe6, 0xcd,
+	0xf7, 0xde, 0xf7, 0xbd, 0x79, 0xfb, 0x3e, 0x05, 0x36, 0x22, 0xa9, 0xc8, 0x57, 0x14, 0x87, 0x32,
+	0xa2, 0xb1, 0x64, 0x52, 0x51, 0xde, 0xa7, 0x78, 0xdc, 0xea, 0x51, 0x45, 0x5a, 0x78, 0x6f, 0x44,
+	0xe3, 0x87, 0xcd, 0x28, 0x16, 0x4a, 0xa0, 0x86, 0xb9, 0xd9, 0x4c, 0xdd, 0x6c, 0xda, 0x9b, 0x6e,
+	0x23, 0x10, 0x22, 0x08, 0x29, 0x26, 0x11, 0xc3, 0x84, 0x73, 0xa1, 0x88, 0x62, 0x82, 0x4b, 0x83,
+	0x75, 0x37, 0x0b, 0xab, 0x84, 0x6c, 0x6f, 0xc4, 0x06, 0x93, 0x1b, 0x8c, 0x07, 0x16, 0xb1, 0x1e,
+	0x88, 0x40, 0xe8, 0x9f, 0x78, 0xf2, 0xcb, 0x9e, 0x7a, 0x7d, 0x21, 0x87, 0x42, 0xe2, 0x1e, 0x91,
+	0x73, 0x78, 0x5f, 0x30, 0x6e, 0xe2, 0xfe, 0x3a, 0xa0, 0x0f, 0x27, 0x94, 0xef, 0x90, 0x98, 0x0c,
+	0x65, 0x87, 0xee, 0x8d, 0xa8, 0x54, 0xfe, 0xa7, 0x70, 0x2e, 0x75, 0x2a, 0x23, 0xc1, 0x25, 0x45,
+	0xdb, 0x50, 0x89, 0xf4, 0x49, 0xdd, 0xb9, 0xe8, 0x6c, 0x54, 0xdb, 0x97, 0x9a, 0x45, 0x0a, 0x9b,
+	0x06, 0xbd, 0xfd, 0xf4, 0xa3, 0xbf, 0x5e, 0x5e, 0xe9, 0x58, 0xa4, 0xef, 0x41, 0x43, 0xa7, 0xde,
+	0xd5, 0x12, 0xee, 0x92, 0x90, 0x0d, 0x88, 0x12, 0xf1, 0xac, 0xf4, 0xb7, 0x0e, 0xbc, 0x74, 0xcc,
+	0x05, 0xcb, 0x82, 0xc2, 0x9a, 0xd1, 0xdf, 0x1d, 0xcf, 0x82, 0x75, 0xe7, 0xe2, 0x53, 0x1b, 0xd5,
+	0x76, 0xbb, 0x98, 0x50, 0x26, 0xe5, 0x47, 0x8a, 0x28, 0x6a, 0xe9, 0xd5, 0xc2, 0x4c, 0xb9, 0x59,
+	0x67, 0xf4, 0xad, 0x19, 0x3d, 0x09, 0xe7, 0x52, 0xa7, 0x96, 0xd3, 0x67, 0x50, 0xe3, 0x54, 0x75,
+	0xc9, 0x50, 0x8c, 0xb8, 0xea, 0xca, 0x49, 0xd0, 0xf6, 0xe8, 0x6a, 0x31, 0xa5, 0x0f, 0xa8, 0xba,
+	0xae, 0x41, 0x49, 0x32, 0xcf, 0xf3, 0xd4, 0xa9, 0x8f, 0xe1, 0xbc, 0x2e, 0x7a, 0x57, 0x28, 0xc6,
+	0x83, 0x3b, 0xe2, 0x01, 0x8d, 0x2d, 0x1f, 0xb4, 0x0e, 0x67, 0xc6, 0x42, 0xd1, 0x58, 0x57, 0x7b,
+	0xb6, 0x63, 0xfe, 0xf0, 0x39, 0xd4, 0xf3, 0x00, 0x4b, 0xb5, 0x03, 0xcf, 0x8d, 0xf5, 0x71, 0x37,
+	0x12, 0x0f, 0x2c, 0xb0, 0xda, 0xbe, 0x5c, 0x4c, 0x33, 0x91, 0xc8, 0x72, 0xac, 0x8e, 0xe7, 0x47,
+	0xfe, 0x0e, 0xbc, 0x68, 0xe6, 0x85, 0xf2, 0x01, 0xe3, 0xc1, 0x27, 0x5c, 0xe7, 0x9a, 0x36, 0x0d,
+	0x5d, 0x81, 0xb5, 0x01, 0x0d, 0x69, 0x30, 0x69, 0x6c, 0x97, 0x0c, 0x06, 0x31, 0x95, 0xd2, 0x12,
+	0xae, 0xcd, 0x02, 0xd7, 0xcd, 0xb9, 0x7f, 0x00, 0x8d, 0xc5, 0xb9, 0x2c, 0xff, 0xcf, 0xa1, 0x16,
+	0x99, 0x50, 0x77, 0x64, 0x63, 0xf6, 0xf5, 0x4f, 0x68, 0x75, 0x3a, 0xa1, 0x95, 0x71, 0x36, 0x4a,
+	0x97, 0xf1, 0x77, 0xed, 0xf8, 0xdd, 0x08, 0x09, 0x1b, 0x92, 0x5e, 0x48, 0xff, 0x97, 0x98, 0x9f,
+	0x1d, 0xf0, 0x8e, 0x4b, 0x67, 0xf5, 0x10, 0x40, 0xfd, 0x69, 0xf0, 0x49, 0x28, 0x5a, 0xeb, 0x67,
+	0x4b, 0xa1, 0xb7, 0xa0, 0x62, 0x26, 0xb3, 0xbe, 0xaa, 0x1f, 0xfb, 0x42, 0xd3, 0x6c, 0x85, 0x66,
+	0x8f, 0xc8, 0x79, 0xb6, 0x1b, 0x82, 0xf1, 0xe9, 0xc7, 0x6a, 0xae, 0xfb, 0x17, 0xec, 0xe0, 0x75,
+	0x68, 0x8f, 0x84, 0x84, 0xf7, 0x19, 0x0f, 0xa6, 0x1f, 0xc2, 0x9f, 0x0e, 0xd4, 0xf3, 0x31, 0xab,
+	0xe9, 0x36, 0x3c, 0xa3, 0x48, 0x1c, 0x50, 0x35, 0x15, 0x82, 0x8b, 0x85, 0x24, 0x72, 0x7c, 0xac,
+	0x71, 0x96, 0xc7, 0x34, 0x0b, 0x8a, 0xe0, 0x3c, 0xe3, 0xdd, 0x2f, 0x42, 0x16, 0xdc, 0x57, 0xdd,
+	0x98, 0xda, 0x3e, 0x4f, 0xf6, 0x65, 0x7d, 0xb5, 0xcc, 0x97, 0xff, 0x3e, 0xbf, 0xa5, 0xb1, 0x9d,
+	0x04, 0xd4, 0xd6, 0x78, 0x81, 0x2d, 0x88, 0xc9, 0xd9, 0x48, 0xcf, 0xb7, 0x45, 0x5f, 0xc4, 0xa9,
+	0x29, 0x98, 0x6d, 0x9f, 0xec, 0x14, 0xcc, 0x02, 0xd3, 0x29, 0xf8, 0x12, 0x1a, 0x8b, 0x73, 0xd9,
+	0x76, 0xed, 0x40, 0x45, 0xea, 0x93, 0x72, 0xcf, 0x9e, 0x4e, 0x33, 0x7d, 0x32, 0x93, 0xa1, 0xfd,
+	0x6f, 0x15, 0xce, 0xe8, 0x62, 0xe8, 0x07, 0x07, 0x2a, 0x66, 0x05, 0xa3, 0xcd, 0xe2, 0x84, 0x79,
+	0x07, 0x70, 0x5b, 0x4b, 0x20, 0x8c, 0x0a, 0xff, 0xea, 0x37, 0xbf, 0xfe, 0xf3, 0xfd, 0xea, 0x2b,
+	0xe8, 0x12, 0x2e, 0xf4, 0x2e, 0xe3, 0x03, 0xe8, 0x17, 0x07, 0x6a, 0xd9, 0x15, 0x8f, 0xb6, 0x4a,
+	0x54, 0x3d, 0xc6, 0x38, 0xdc, 0x77, 0x4e, 0x85, 0xb5, 0xdc, 0x37, 0x35, 0xf7, 0x57, 0xd1, 0x46,
+	0x31, 0xf7, 0xb9, 0xe1, 0xe8, 0xee, 0x1a, 0x13, 0x28, 0xd5, 0xdd, 0x94, 0x8b, 0xb8, 0xad, 0x25,
+	0x10, 0xcb, 0x75, 0x57, 0x1a, 0x4a, 0x3f, 0x39, 0x50, 0x4d, 0xec, 0x6c, 0xf4, 0x66, 0x89, 0x82,
+	0x79, 0x77, 0x71, 0xaf, 0x2d, 0x0b, 0xb3, 0x64, 0xb7, 0x34, 0xd9, 0x37, 0x50, 0xfb, 0x84, 0x76,
+	0x26, 0x7c, 0x08, 0xef, 0x6b, 0xeb, 0x3a, 0x40, 0xbf, 0x39, 0x70, 0x36, 0xb3, 0xfb, 0xd1, 0xdb,
+	0x65, 0xa6, 0x71, 0xa1, 0xf7, 0xb8, 0x5b, 0xa7, 0x81, 0x5a, 0x19, 0x3b, 0x5a, 0xc6, 0x4d, 0xb4,
+	0x7d, 0xc2, 0x44, 0x67, 0xec, 0x08, 0xef, 0xe7, 0x0c, 0xe2, 0x00, 0xfd, 0xe1, 0xc0, 0x5a, 0xce,
+	0x04, 0x50, 0x99, 0xa1, 0x3d, 0xce, 0x89, 0xdc, 0x77, 0x4f, 0x07, 0xb6, 0xe2, 0x76, 0xb5, 0xb8,
+	0x5b, 0xe8, 0x66, 0xb1, 0xb8, 0xbc, 0x37, 0x2d, 0x94, 0xf7, 0xa3, 0x03, 0xd5, 0xc4, 0x16, 0x2f,
+	0x35, 0x70, 0x79, 0x57, 0x71, 0xaf, 0x2d, 0x0b, 0xb3, 0x62, 0x5a, 0x5a, 0xcc, 0x15, 0x74, 0xb9,
+	0x58, 0x4c, 0x9c, 0x60, 0x38, 0x99, 0xb3, 0xcc, 0x42, 0x2e, 0x35, 0x67, 0x8b, 0x0d, 0xc1, 0xdd,
+	0x3a, 0x0d, 0x74, 0xb9, 0x39, 0x9b, 0x1b, 0x8e, 0xd9, 0xf5, 0x78, 0x3f, 0x67, 0x41, 0x07, 0xdb,
+	0xf7, 0x1e, 0x1d, 0x7a, 0xce, 0xe3, 0x43, 0xcf, 0xf9, 0xfb, 0xd0, 0x73, 0xbe, 0x3b, 0xf2, 0x56,
+	0x1e, 0x1f, 0x79, 0x2b, 0xbf, 0x1f, 0x79, 0x2b, 0xf7, 0xde, 0x0b, 0x98, 0xba, 0x3f, 0xea, 0x35,
+	0xfb, 0x62, 0x88, 0x13, 0xe9, 0x6f, 0x73, 0x6a, 0xcb, 0xbe, 0xc6, 0x89, 0x62, 0x63, 0x8a, 0xc7,
+	0x6d, 0xfc, 0x75, 0x86, 0x82, 0x7a, 0x18, 0x51, 0xd9, 0xab, 0xe8, 0xff, 0x19, 0x5e, 0xff, 0x6f,
+	0x00, 0x5b, 0x97, 0x7b, 0x28, 0x03, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns parameters of the liquidstaking module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstaking module.
+	LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error)
+	// States returns states of the liquidstaking module.
+	States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error)
+	// VotingPower returns the governance voting power of a voter, including its liquid staking voting power.
+	VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error)
+	// PendingUnstakes returns the queued liquid unstakes of a delegator that are not claimable yet.
+	PendingUnstakes(ctx context.Context, in *QueryPendingUnstakesRequest, opts ...grpc.CallOption) (*QueryPendingUnstakesResponse, error)
+	// ClaimableUnstakes returns the matured liquid unstakes of a delegator.
+	ClaimableUnstakes(ctx context.Context, in *QueryClaimableUnstakesRequest, opts ...grpc.CallOption) (*QueryClaimableUnstakesResponse, error)
+	// Rebalancing returns the target and the actual liquid tokens of the liquid validators and the in-flight
+	// rebalancing redelegations.
+	Rebalancing(ctx context.Context, in *QueryRebalancingRequest, opts ...grpc.CallOption) (*QueryRebalancingResponse, error)
+	// ValidatorScores returns the score history of a whitelisted validator, the latest score first.
+	ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidValidators(ctx context.Context, in *QueryLiquidValidatorsRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorsResponse, error) {
+	out := new(QueryLiquidValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/LiquidValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) States(ctx context.Context, in *QueryStatesRequest, opts ...grpc.CallOption) (*QueryStatesResponse, error) {
+	out := new(QueryStatesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/States", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPower(ctx context.Context, in *QueryVotingPowerRequest, opts ...grpc.CallOption) (*QueryVotingPowerResponse, error) {
+	out := new(QueryVotingPowerResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/VotingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingUnstakes(ctx context.Context, in *QueryPendingUnstakesRequest, opts ...grpc.CallOption) (*QueryPendingUnstakesResponse, error) {
+	out := new(QueryPendingUnstakesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/PendingUnstakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableUnstakes(ctx context.Context, in *QueryClaimableUnstakesRequest, opts ...grpc.CallOption) (*QueryClaimableUnstakesResponse, error) {
+	out := new(QueryClaimableUnstakesResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/ClaimableUnstakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Rebalancing(ctx context.Context, in *QueryRebalancingRequest, opts ...grpc.CallOption) (*QueryRebalancingResponse, error) {
+	out := new(QueryRebalancingResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/Rebalancing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorScores(ctx context.Context, in *QueryValidatorScoresRequest, opts ...grpc.CallOption) (*QueryValidatorScoresResponse, error) {
+	out := new(QueryValidatorScoresResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Query/ValidatorScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the liquidstaking module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// LiquidValidators returns liquid validators with states of the liquidstaking module.
+	LiquidValidators(context.Context, *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error)
+	// States returns states of the liquidstaking module.
+	States(context.Context, *QueryStatesRequest) (*QueryStatesResponse, error)
+	// VotingPower returns the governance voting power of a voter, including its liquid staking voting power.
+	VotingPower(context.Context, *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error)
+	// PendingUnstakes returns the queued liquid unstakes of a delegator that are not claimable yet.
+	PendingUnstakes(context.Context, *QueryPendingUnstakesRequest) (*QueryPendingUnstakesResponse, error)
+	// ClaimableUnstakes returns the matured liquid unstakes of a delegator.
+	ClaimableUnstakes(context.Context, *QueryClaimableUnstakesRequest) (*QueryClaimableUnstakesResponse, error)
+	// Rebalancing returns the target and the actual liquid tokens of the liquid validators and the in-flight
+	// rebalancing redelegations.
+	Rebalancing(context.Context, *QueryRebalancingRequest) (*QueryRebalancingResponse, error)
+	// ValidatorScores returns the score history of a whitelisted validator, the latest score first.
+	ValidatorScores(context.Context, *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) LiquidValidators(ctx context.Context, req *QueryLiquidValidatorsRequest) (*QueryLiquidValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidators not implemented")
+}
+func (*UnimplementedQueryServer) States(ctx context.Context, req *QueryStatesRequest) (*QueryStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method States not implemented")
+}
+func (*UnimplementedQueryServer) VotingPower(ctx context.Context, req *QueryVotingPowerRequest) (*QueryVotingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPower not implemented")
+}
+func (*UnimplementedQueryServer) PendingUnstakes(ctx context.Context, req *QueryPendingUnstakesRequest) (*QueryPendingUnstakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUnstakes not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableUnstakes(ctx context.Context, req *QueryClaimableUnstakesRequest) (*QueryClaimableUnstakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableUnstakes not implemented")
+}
+func (*UnimplementedQueryServer) Rebalancing(ctx context.Context, req *QueryRebalancingRequest) (*QueryRebalancingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rebalancing not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorScores(ctx context.Context, req *QueryValidatorScoresRequest) (*QueryValidatorScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorScores not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/LiquidValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidValidators(ctx, req.(*QueryLiquidValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_States_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).States(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/States",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).States(ctx, req.(*QueryStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/VotingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPower(ctx, req.(*QueryVotingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingUnstakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingUnstakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUnstakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/PendingUnstakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUnstakes(ctx, req.(*QueryPendingUnstakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableUnstakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableUnstakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableUnstakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/ClaimableUnstakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableUnstakes(ctx, req.(*QueryClaimableUnstakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Rebalancing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRebalancingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Rebalancing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/Rebalancing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Rebalancing(ctx, req.(*QueryRebalancingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Query/ValidatorScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorScores(ctx, req.(*QueryValidatorScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pstake.lspersistence.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "LiquidValidators",
+			Handler:    _Query_LiquidValidators_Handler,
+		},
+		{
+			MethodName: "States",
+			Handler:    _Query_States_Handler,
+		},
+		{
+			MethodName: "VotingPower",
+			Handler:    _Query_VotingPower_Handler,
+		},
+		{
+			MethodName: "PendingUnstakes",
+			Handler:    _Query_PendingUnstakes_Handler,
+		},
+		{
+			MethodName: "ClaimableUnstakes",
+			Handler:    _Query_ClaimableUnstakes_Handler,
+		},
+		{
+			MethodName: "Rebalancing",
+			Handler:    _Query_Rebalancing_Handler,
+		},
+		{
+			MethodName: "ValidatorScores",
+			Handler:    _Query_ValidatorScores_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pstake/lspersistence/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for iNdEx := len(m.LiquidValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NetAmountState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VotingPower.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnstakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnstakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnstakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingUnstakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingUnstakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingUnstakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingUnstakes) > 0 {
+		for iNdEx := len(m.PendingUnstakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUnstakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableUnstakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableUnstakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableUnstakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableUnstakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableUnstakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableUnstakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClaimableUnstakes) > 0 {
+		for iNdEx := len(m.ClaimableUnstakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableUnstakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRebalancingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRebalancingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRebalancingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InFlightRedelegations) > 0 {
+		for iNdEx := len(m.InFlightRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorScoresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScoresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScoresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorScoresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorScoresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorScoresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for iNdEx := len(m.Scores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidValidators) > 0 {
+		for _, e := range m.LiquidValidators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetAmountState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingUnstakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingUnstakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingUnstakes) > 0 {
+		for _, e := range m.PendingUnstakes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimableUnstakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableUnstakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableUnstakes) > 0 {
+		for _, e := range m.ClaimableUnstakes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRebalancingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRebalancingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.InFlightRedelegations) > 0 {
+		for _, e := range m.InFlightRedelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorScoresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorScoresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Scores) > 0 {
+		for _, e := range m.Scores {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
+			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingUnstakesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingUnstakesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingUnstakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryStatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryStatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingUnstakesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingUnstakesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingUnstakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUnstakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingUnstakes = append(m.PendingUnstakes, PendingUnstake{})
+			if err := m.PendingUnstakes[len(m.PendingUnstakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.NetAmountState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableUnstakesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -756,12 +2667,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimableUnstakesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimableUnstakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -783,7 +2726,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableUnstakesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -806,15 +2749,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimableUnstakesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimableUnstakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableUnstakes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -841,7 +2784,41 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimableUnstakes = append(m.ClaimableUnstakes, PendingUnstake{})
+			if err := m.ClaimableUnstakes[len(m.ClaimableUnstakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -866,7 +2843,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalancingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -889,10 +2866,10 @@ func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalancingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalancingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -916,7 +2893,7 @@ func (m *QueryLiquidValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRebalancingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -939,15 +2916,15 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRebalancingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRebalancingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -974,8 +2951,42 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidValidators = append(m.LiquidValidators, LiquidValidatorState{})
-			if err := m.LiquidValidators[len(m.LiquidValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Targets = append(m.Targets, RebalancingTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightRedelegations = append(m.InFlightRedelegations, InFlightRedelegation{})
+			if err := m.InFlightRedelegations[len(m.InFlightRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1000,7 +3011,7 @@ func (m *QueryLiquidValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorScoresRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1023,12 +3034,44 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorScoresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1050,7 +3093,7 @@ func (m *QueryStatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorScoresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1073,15 +3116,15 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorScoresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetAmountState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scores", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1108,7 +3151,8 @@ func (m *QueryStatesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetAmountState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Scores = append(m.Scores, ValidatorScore{})
+			if err := m.Scores[len(m.Scores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
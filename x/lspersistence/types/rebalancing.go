@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -14,6 +16,20 @@ type Redelegation struct {
 	Error        error
 }
 
+// Validate validates the in-flight redelegation.
+func (red InFlightRedelegation) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(red.SrcValidatorAddress); err != nil {
+		return err
+	}
+	if _, err := sdk.ValAddressFromBech32(red.DstValidatorAddress); err != nil {
+		return err
+	}
+	if red.Amount.IsNil() || !red.Amount.IsPositive() {
+		return fmt.Errorf("redelegation amount must be positive: %s", red.Amount)
+	}
+	return nil
+}
+
 // DivideByWeight divide the input value by the ratio of the param weight of the liquid validator and return it with crumb
 // which is may occur while dividing according to the weight of active liquid validators by decimal error.
 func DivideByWeight(avs ActiveLiquidValidators, input math.Int, whitelistedValsMap WhitelistedValsMap) (outputs []math.Int, crumb math.Int) {
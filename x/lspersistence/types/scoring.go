@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the validator score.
+func (s ValidatorScore) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(s.ValidatorAddress); err != nil {
+		return err
+	}
+	if s.Height <= 0 {
+		return fmt.Errorf("score height must be positive: %d", s.Height)
+	}
+	for _, rate := range []sdk.Dec{s.Uptime, s.CommissionRate, s.SelfBondRate, s.Score} {
+		if rate.IsNil() || rate.IsNegative() || rate.GT(sdk.OneDec()) {
+			return fmt.Errorf("score rates must be between zero and one: %s", rate)
+		}
+	}
+	return nil
+}
+
+// CalcScore returns the score of a validator, the weighted sum of its uptime, its commission rate complement and its
+// self-bond rate relative to the SelfBondScoreThreshold.
+func CalcScore(uptime, commissionRate, selfBondRate sdk.Dec) sdk.Dec {
+	selfBondScore := sdk.MinDec(selfBondRate.Quo(SelfBondScoreThreshold), sdk.OneDec())
+	return UptimeScoreWeight.Mul(uptime).
+		Add(CommissionScoreWeight.Mul(sdk.OneDec().Sub(commissionRate))).
+		Add(SelfBondScoreWeight.Mul(selfBondScore))
+}
+
+// ScoreWeighted returns the whitelisted validators with their effective weight as target weight. The target weight is
+// scaled by ScoreWeightPrecision and by the score of the validator relative to the average score of the scored
+// validators, bounded by the floor and the cap. The validators not scored yet keep their scaled target weight.
+func (whitelistedValsMap WhitelistedValsMap) ScoreWeighted(scores map[string]sdk.Dec, floor, cap sdk.Dec) WhitelistedValsMap {
+	totalScore := sdk.ZeroDec()
+	scoredCount := int64(0)
+	for operatorAddr := range whitelistedValsMap {
+		if score, ok := scores[operatorAddr]; ok {
+			totalScore = totalScore.Add(score)
+			scoredCount++
+		}
+	}
+
+	weightedValsMap := make(WhitelistedValsMap, len(whitelistedValsMap))
+	for operatorAddr, wv := range whitelistedValsMap {
+		multiplier := sdk.OneDec()
+		if score, ok := scores[operatorAddr]; ok && totalScore.IsPositive() {
+			multiplier = score.MulInt64(scoredCount).Quo(totalScore)
+			multiplier = sdk.MinDec(sdk.MaxDec(multiplier, floor), cap)
+		}
+		weight := multiplier.MulInt(wv.TargetWeight).MulInt64(ScoreWeightPrecision).TruncateInt()
+		if !weight.IsPositive() {
+			weight = sdk.OneInt()
+		}
+		weightedValsMap[operatorAddr] = WhitelistedValidator{
+			ValidatorAddress: wv.ValidatorAddress,
+			TargetWeight:     weight,
+		}
+	}
+	return weightedValsMap
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/persistenceOne/pstake-native/v2/x/lspersistence/types"
+)
+
+func TestCalcScore(t *testing.T) {
+	require.Equal(t, sdk.OneDec(), types.CalcScore(sdk.OneDec(), sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1)))
+	require.Equal(t, sdk.ZeroDec(), types.CalcScore(sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()))
+	// 0.5*0.9 + 0.3*0.9 + 0.2*0.5
+	require.Equal(
+		t,
+		sdk.MustNewDecFromStr("0.82"),
+		types.CalcScore(sdk.MustNewDecFromStr("0.9"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.005")),
+	)
+}
+
+func TestScoreWeighted(t *testing.T) {
+	wvm := types.GetWhitelistedValsMap([]types.WhitelistedValidator{
+		{ValidatorAddress: liquidValidators[0].OperatorAddress, TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: liquidValidators[1].OperatorAddress, TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: liquidValidators[2].OperatorAddress, TargetWeight: sdk.NewInt(10)},
+		{ValidatorAddress: liquidValidators[3].OperatorAddress, TargetWeight: sdk.NewInt(10)},
+	})
+	scores := map[string]sdk.Dec{
+		liquidValidators[0].OperatorAddress: sdk.MustNewDecFromStr("0.8"),
+		liquidValidators[1].OperatorAddress: sdk.MustNewDecFromStr("0.6"),
+		liquidValidators[2].OperatorAddress: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	weighted := wvm.ScoreWeighted(scores, sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(15, 1))
+	require.Len(t, weighted, 4)
+	// 0.8 / 0.5 average score, capped to 1.5
+	require.Equal(t, sdk.NewInt(15000), weighted[liquidValidators[0].OperatorAddress].TargetWeight)
+	// 0.6 / 0.5 average score
+	require.Equal(t, sdk.NewInt(12000), weighted[liquidValidators[1].OperatorAddress].TargetWeight)
+	// 0.1 / 0.5 average score, floored to 0.5
+	require.Equal(t, sdk.NewInt(5000), weighted[liquidValidators[2].OperatorAddress].TargetWeight)
+	// not scored yet
+	require.Equal(t, sdk.NewInt(10000), weighted[liquidValidators[3].OperatorAddress].TargetWeight)
+
+	// the whitelisted validators are left untouched
+	require.Equal(t, sdk.NewInt(10), wvm[liquidValidators[0].OperatorAddress].TargetWeight)
+
+	// no positive score, the target weights are only scaled
+	weighted = wvm.ScoreWeighted(
+		map[string]sdk.Dec{liquidValidators[0].OperatorAddress: sdk.ZeroDec()},
+		sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(15, 1),
+	)
+	require.Equal(t, sdk.NewInt(10000), weighted[liquidValidators[0].OperatorAddress].TargetWeight)
+}
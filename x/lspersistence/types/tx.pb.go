@@ -197,6 +197,165 @@ func (m *MsgLiquidUnstakeResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgRedeem defines a SDK message for instantly redeeming bTokens for native tokens.
+type MsgRedeem struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Amount           types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeem) Reset()         { *m = MsgRedeem{} }
+func (m *MsgRedeem) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeem) ProtoMessage()    {}
+func (*MsgRedeem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{4}
+}
+func (m *MsgRedeem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeem.Merge(m, src)
+}
+func (m *MsgRedeem) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeem proto.InternalMessageInfo
+
+// MsgRedeemResponse defines the Msg/Redeem response type.
+type MsgRedeemResponse struct {
+}
+
+func (m *MsgRedeemResponse) Reset()         { *m = MsgRedeemResponse{} }
+func (m *MsgRedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemResponse) ProtoMessage()    {}
+func (*MsgRedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{5}
+}
+func (m *MsgRedeemResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemResponse.Merge(m, src)
+}
+func (m *MsgRedeemResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemResponse proto.InternalMessageInfo
+
+// MsgClaim defines a SDK message for claiming the matured epoch-batched liquid unstakes.
+type MsgClaim struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+}
+
+func (m *MsgClaim) Reset()         { *m = MsgClaim{} }
+func (m *MsgClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgClaim) ProtoMessage()    {}
+func (*MsgClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{6}
+}
+func (m *MsgClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaim.Merge(m, src)
+}
+func (m *MsgClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaim proto.InternalMessageInfo
+
+// MsgClaimResponse defines the Msg/Claim response type.
+type MsgClaimResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgClaimResponse) Reset()         { *m = MsgClaimResponse{} }
+func (m *MsgClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimResponse) ProtoMessage()    {}
+func (*MsgClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d46e981836fefd9, []int{7}
+}
+func (m *MsgClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimResponse.Merge(m, src)
+}
+func (m *MsgClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
+
+func (m *MsgClaimResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 type MsgUpdateParams struct {
 	// authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
@@ -210,7 +369,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d46e981836fefd9, []int{4}
+	return fileDescriptor_7d46e981836fefd9, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,7 +409,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d46e981836fefd9, []int{5}
+	return fileDescriptor_7d46e981836fefd9, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,6 +443,10 @@ func init() {
 	proto.RegisterType((*MsgLiquidStakeResponse)(nil), "pstake.lspersistence.v1beta1.MsgLiquidStakeResponse")
 	proto.RegisterType((*MsgLiquidUnstake)(nil), "pstake.lspersistence.v1beta1.MsgLiquidUnstake")
 	proto.RegisterType((*MsgLiquidUnstakeResponse)(nil), "pstake.lspersistence.v1beta1.MsgLiquidUnstakeResponse")
+	proto.RegisterType((*MsgRedeem)(nil), "pstake.lspersistence.v1beta1.MsgRedeem")
+	proto.RegisterType((*MsgRedeemResponse)(nil), "pstake.lspersistence.v1beta1.MsgRedeemResponse")
+	proto.RegisterType((*MsgClaim)(nil), "pstake.lspersistence.v1beta1.MsgClaim")
+	proto.RegisterType((*MsgClaimResponse)(nil), "pstake.lspersistence.v1beta1.MsgClaimResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "pstake.lspersistence.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "pstake.lspersistence.v1beta1.MsgUpdateParamsResponse")
 }
@@ -293,44 +456,48 @@ func init() {
 }
 
 var fileDescriptor_7d46e981836fefd9 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0x3d, 0x6f, 0xd3, 0x4e,
-	0x1c, 0xc7, 0xed, 0xfe, 0xff, 0xaa, 0xda, 0x2b, 0xb4, 0xc5, 0xaa, 0x5a, 0xc7, 0x42, 0x76, 0x65,
-	0x81, 0x54, 0x21, 0x72, 0x47, 0x42, 0x29, 0x52, 0x26, 0x08, 0x2b, 0x11, 0x28, 0xa5, 0x4b, 0x97,
-	0xe8, 0x92, 0x1c, 0xd7, 0x13, 0xf6, 0x9d, 0xeb, 0xbb, 0x84, 0x66, 0x65, 0x62, 0xec, 0x4b, 0xe8,
-	0xc2, 0xce, 0x80, 0x10, 0x2f, 0xa1, 0x63, 0xc5, 0xc4, 0x54, 0x50, 0x32, 0x94, 0x99, 0x57, 0x80,
-	0x6c, 0x9f, 0xf3, 0x84, 0x08, 0x64, 0x64, 0x4a, 0xee, 0x7e, 0x9f, 0xdf, 0xd3, 0xf7, 0xbe, 0x09,
-	0xb8, 0x1d, 0x49, 0x85, 0x5f, 0x11, 0x14, 0xc8, 0x88, 0xc4, 0x92, 0x49, 0x45, 0x78, 0x8b, 0xa0,
-	0x6e, 0xa9, 0x49, 0x14, 0x2e, 0x21, 0x75, 0x02, 0xa3, 0x58, 0x28, 0x61, 0xdd, 0xcc, 0x30, 0x38,
-	0x81, 0x41, 0x8d, 0x39, 0x1b, 0x54, 0x50, 0x91, 0x82, 0x28, 0xf9, 0x96, 0xe5, 0x38, 0x85, 0x96,
-	0x90, 0xa1, 0x90, 0x8d, 0x2c, 0x90, 0x1d, 0x74, 0xc8, 0xcd, 0x4e, 0xa8, 0x89, 0xe5, 0xa8, 0x59,
-	0x4b, 0x30, 0xae, 0xe3, 0x1e, 0x15, 0x82, 0x06, 0x04, 0xa5, 0xa7, 0x66, 0xe7, 0x25, 0x52, 0x2c,
-	0x24, 0x52, 0xe1, 0x30, 0xd2, 0xc0, 0x96, 0x2e, 0x10, 0x4a, 0x8a, 0xba, 0xa5, 0xe4, 0x43, 0x07,
-	0xee, 0xcd, 0xdc, 0x27, 0x60, 0xc7, 0x1d, 0xd6, 0x4e, 0x08, 0xc6, 0x75, 0x86, 0xff, 0xd1, 0x04,
-	0xab, 0x35, 0x49, 0x9f, 0xa6, 0xa1, 0xfd, 0x24, 0xd9, 0xc2, 0xe0, 0x46, 0x9b, 0x04, 0x84, 0x62,
-	0x25, 0xe2, 0x06, 0x6e, 0xb7, 0x63, 0x22, 0xa5, 0x6d, 0x6e, 0x9b, 0x3b, 0xcb, 0xd5, 0xdd, 0x1f,
-	0x97, 0x9e, 0xdd, 0xc3, 0x61, 0x50, 0xf1, 0x7f, 0x41, 0xfc, 0xcf, 0x1f, 0x8a, 0x1b, 0x7a, 0xcf,
-	0xc7, 0xd9, 0xd5, 0xbe, 0x8a, 0x19, 0xa7, 0xf5, 0xf5, 0x21, 0xab, 0xef, 0xad, 0x87, 0x60, 0x11,
-	0x87, 0xa2, 0xc3, 0x95, 0xbd, 0xb0, 0x6d, 0xee, 0xac, 0x94, 0x0b, 0x50, 0x27, 0x26, 0x92, 0xe4,
-	0xc2, 0xc2, 0x27, 0x82, 0xf1, 0xea, 0xff, 0xe7, 0x97, 0x9e, 0x51, 0xd7, 0x78, 0x65, 0xe9, 0xed,
-	0x99, 0x67, 0x7c, 0x3f, 0xf3, 0x0c, 0xdf, 0x06, 0x9b, 0x93, 0x73, 0xd7, 0x89, 0x8c, 0x04, 0x97,
-	0xc4, 0xff, 0x64, 0x82, 0xf5, 0x61, 0xe8, 0x80, 0xcb, 0x7f, 0x68, 0x29, 0x06, 0xec, 0xe9, 0xc9,
-	0xf3, 0xb5, 0xac, 0x1a, 0x58, 0x6b, 0x89, 0x30, 0x0a, 0x88, 0x62, 0x82, 0x37, 0x12, 0x4b, 0xa4,
-	0xf3, 0xaf, 0x94, 0x1d, 0x98, 0xf9, 0x05, 0xe6, 0x7e, 0x81, 0x2f, 0x72, 0xbf, 0x54, 0x97, 0x92,
-	0x46, 0xa7, 0x5f, 0x3d, 0xb3, 0xbe, 0x3a, 0x4a, 0x4e, 0xc2, 0xfe, 0x3b, 0x13, 0xac, 0xd5, 0x24,
-	0x3d, 0x88, 0xda, 0x58, 0x91, 0xe7, 0x38, 0xc6, 0xa1, 0xb4, 0xf6, 0xc0, 0x32, 0xee, 0xa8, 0x23,
-	0x11, 0x33, 0xd5, 0xd3, 0xe2, 0xd8, 0xbf, 0x15, 0x60, 0x84, 0x5a, 0x55, 0xb0, 0x18, 0xa5, 0x15,
-	0xf4, 0xe6, 0xb7, 0xe0, 0xac, 0x1f, 0x0c, 0xcc, 0xba, 0xe5, 0x22, 0x64, 0x99, 0x95, 0xcd, 0x5c,
-	0x84, 0x37, 0x57, 0xef, 0xef, 0x8c, 0x6a, 0xfb, 0x05, 0xb0, 0x35, 0x35, 0x66, 0xae, 0x48, 0xf9,
-	0x6a, 0x01, 0xfc, 0x57, 0x93, 0xd4, 0x3a, 0x06, 0x2b, 0xe3, 0xfe, 0xbd, 0x3b, 0xbb, 0xfb, 0xa4,
-	0x6b, 0x9c, 0xdd, 0x79, 0xe8, 0xe1, 0x63, 0xbc, 0x06, 0xd7, 0x27, 0xfd, 0x05, 0xff, 0xb2, 0x8c,
-	0xe6, 0x9d, 0xbd, 0xf9, 0xf8, 0x61, 0x63, 0x05, 0xae, 0x4d, 0x3c, 0x59, 0xf1, 0x8f, 0x75, 0xc6,
-	0x71, 0xe7, 0xc1, 0x5c, 0x78, 0xde, 0xb5, 0x7a, 0x78, 0xde, 0x77, 0xcd, 0x8b, 0xbe, 0x6b, 0x7e,
-	0xeb, 0xbb, 0xe6, 0xe9, 0xc0, 0x35, 0x2e, 0x06, 0xae, 0xf1, 0x65, 0xe0, 0x1a, 0x87, 0x8f, 0x28,
-	0x53, 0x47, 0x9d, 0x26, 0x6c, 0x89, 0x10, 0x8d, 0x55, 0x7c, 0xc6, 0x09, 0xca, 0x3a, 0x15, 0x39,
-	0x56, 0xac, 0x4b, 0x50, 0xb7, 0x8c, 0x4e, 0xa6, 0xfe, 0x97, 0x54, 0x2f, 0x22, 0xb2, 0xb9, 0x98,
-	0xda, 0xf6, 0xfe, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x41, 0x25, 0x49, 0x12, 0x8c, 0x05, 0x00,
-	0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0x8a, 0x0d, 0x0c, 0xca, 0x8f, 0x95, 0x40, 0xd9, 0x98, 0x2d, 0xd9, 0xf8, 0x83,
+	0x18, 0x99, 0x91, 0x8a, 0x98, 0x70, 0xd2, 0x72, 0xd4, 0x46, 0x53, 0xe4, 0xc2, 0xa5, 0x99, 0xb6,
+	0xe3, 0x30, 0x71, 0x77, 0x67, 0xd9, 0x99, 0x16, 0xb8, 0x7a, 0xf2, 0xc8, 0x9f, 0xc0, 0xc5, 0x9b,
+	0x07, 0x4d, 0x8c, 0xf1, 0x4f, 0xe0, 0x48, 0x3c, 0x79, 0x42, 0x03, 0x07, 0x3d, 0xfb, 0x17, 0x98,
+	0xdd, 0x99, 0xdd, 0xb6, 0x18, 0xa1, 0xbd, 0x98, 0x70, 0x6a, 0x67, 0xdf, 0xe7, 0xbd, 0xef, 0xfb,
+	0xce, 0xbe, 0x99, 0x85, 0xb7, 0x42, 0xa9, 0xc8, 0x6b, 0x8a, 0x3d, 0x19, 0xd2, 0x48, 0x72, 0xa9,
+	0x68, 0xd0, 0xa0, 0xb8, 0xbd, 0x58, 0xa7, 0x8a, 0x2c, 0x62, 0xb5, 0x83, 0xc2, 0x48, 0x28, 0x61,
+	0xdd, 0xd0, 0x18, 0xea, 0xc1, 0x90, 0xc1, 0xec, 0x29, 0x26, 0x98, 0x48, 0x40, 0x1c, 0xff, 0xd3,
+	0x39, 0xf6, 0x6c, 0x43, 0x48, 0x5f, 0xc8, 0x9a, 0x0e, 0xe8, 0x85, 0x09, 0x39, 0x7a, 0x85, 0xeb,
+	0x44, 0x76, 0xc4, 0x1a, 0x82, 0x07, 0x26, 0x5e, 0x64, 0x42, 0x30, 0x8f, 0xe2, 0x64, 0x55, 0x6f,
+	0xbd, 0xc2, 0x8a, 0xfb, 0x54, 0x2a, 0xe2, 0x87, 0x06, 0x98, 0x31, 0x05, 0x7c, 0xc9, 0x70, 0x7b,
+	0x31, 0xfe, 0x31, 0x81, 0xfb, 0x67, 0xfa, 0xf1, 0xf8, 0x56, 0x8b, 0x37, 0x63, 0x82, 0x07, 0x26,
+	0xc3, 0xfd, 0x0c, 0xe0, 0x58, 0x45, 0xb2, 0x67, 0x49, 0x68, 0x2d, 0x4e, 0xb6, 0x08, 0x9c, 0x6c,
+	0x52, 0x8f, 0x32, 0xa2, 0x44, 0x54, 0x23, 0xcd, 0x66, 0x44, 0xa5, 0x2c, 0x80, 0x39, 0x30, 0x3f,
+	0x52, 0x5e, 0xfa, 0x7d, 0x54, 0x2c, 0xec, 0x12, 0xdf, 0x5b, 0x71, 0xff, 0x42, 0xdc, 0xaf, 0x9f,
+	0x16, 0xa6, 0x8c, 0xcf, 0x27, 0xfa, 0xd1, 0x9a, 0x8a, 0x78, 0xc0, 0xaa, 0x13, 0x19, 0x6b, 0x9e,
+	0x5b, 0x8f, 0x60, 0x9e, 0xf8, 0xa2, 0x15, 0xa8, 0xc2, 0xa5, 0x39, 0x30, 0x3f, 0x5a, 0x9a, 0x45,
+	0x26, 0x31, 0xde, 0x92, 0x74, 0x63, 0xd1, 0xaa, 0xe0, 0x41, 0x79, 0xe8, 0xe0, 0xa8, 0x98, 0xab,
+	0x1a, 0x7c, 0x65, 0xf8, 0xed, 0x7e, 0x31, 0xf7, 0x6b, 0xbf, 0x98, 0x73, 0x0b, 0x70, 0xba, 0xb7,
+	0xef, 0x2a, 0x95, 0xa1, 0x08, 0x24, 0x75, 0xbf, 0x00, 0x38, 0x91, 0x85, 0xd6, 0x03, 0x79, 0x81,
+	0x4c, 0x71, 0x58, 0x38, 0xdd, 0x79, 0x6a, 0xcb, 0xaa, 0xc0, 0xf1, 0x86, 0xf0, 0x43, 0x8f, 0x2a,
+	0x2e, 0x82, 0x5a, 0x3c, 0x12, 0x49, 0xff, 0xa3, 0x25, 0x1b, 0xe9, 0x79, 0x41, 0xe9, 0xbc, 0xa0,
+	0x97, 0xe9, 0xbc, 0x94, 0x87, 0x63, 0xa1, 0xbd, 0xef, 0x45, 0x50, 0x1d, 0xeb, 0x24, 0xc7, 0x61,
+	0xf7, 0x23, 0x80, 0x23, 0x15, 0xc9, 0xaa, 0xb4, 0x49, 0xa9, 0x7f, 0x41, 0xb6, 0xe7, 0x3a, 0x9c,
+	0xcc, 0x5a, 0xce, 0x5e, 0xf7, 0x36, 0x1c, 0xae, 0x48, 0xb6, 0xea, 0x11, 0xfe, 0x3f, 0x6c, 0x74,
+	0x75, 0xf3, 0x14, 0x4e, 0xa4, 0xc2, 0xd9, 0x4b, 0xea, 0x98, 0x04, 0x03, 0x99, 0x74, 0xdf, 0x01,
+	0x38, 0x5e, 0x91, 0x6c, 0x3d, 0x6c, 0x12, 0x45, 0x5f, 0x90, 0x88, 0xf8, 0xd2, 0x5a, 0x86, 0x23,
+	0xa4, 0xa5, 0x36, 0x45, 0xc4, 0xd5, 0xae, 0x71, 0x51, 0xf8, 0x67, 0xa7, 0x1d, 0xd4, 0x2a, 0xc3,
+	0x7c, 0x98, 0x54, 0x30, 0x3b, 0x7d, 0x13, 0x9d, 0x75, 0x7f, 0x21, 0xad, 0x96, 0xf6, 0xa3, 0x33,
+	0x57, 0xa6, 0x53, 0x9b, 0x6f, 0x7e, 0x7e, 0xb8, 0xdb, 0xa9, 0xed, 0xce, 0xc2, 0x99, 0x53, 0x6d,
+	0xa6, 0xde, 0x4b, 0xef, 0x87, 0xe0, 0xe5, 0x8a, 0x64, 0xd6, 0x16, 0x1c, 0xed, 0xbe, 0x4e, 0xee,
+	0x9d, 0xad, 0xde, 0x7b, 0x88, 0xed, 0xa5, 0x41, 0xe8, 0x6c, 0xdb, 0xb7, 0xe1, 0xb5, 0xde, 0xe3,
+	0x8e, 0xfa, 0x2c, 0x63, 0x78, 0x7b, 0x79, 0x30, 0x3e, 0x13, 0xae, 0xc3, 0xbc, 0x39, 0x41, 0x77,
+	0xce, 0xad, 0xa0, 0x41, 0x1b, 0xf7, 0x09, 0x66, 0x1a, 0x35, 0x78, 0x45, 0x4f, 0xf7, 0xed, 0x73,
+	0x33, 0x13, 0xce, 0x46, 0xfd, 0x71, 0x99, 0x80, 0x82, 0x57, 0x7b, 0xe6, 0x6e, 0xe1, 0xdc, 0xfc,
+	0x6e, 0xdc, 0x7e, 0x38, 0x10, 0x9e, 0xaa, 0x96, 0x37, 0x0e, 0x8e, 0x1d, 0x70, 0x78, 0xec, 0x80,
+	0x1f, 0xc7, 0x0e, 0xd8, 0x3b, 0x71, 0x72, 0x87, 0x27, 0x4e, 0xee, 0xdb, 0x89, 0x93, 0xdb, 0x78,
+	0xcc, 0xb8, 0xda, 0x6c, 0xd5, 0x51, 0x43, 0xf8, 0xb8, 0xab, 0xe2, 0xf3, 0x80, 0x62, 0xad, 0xb4,
+	0x10, 0x10, 0xc5, 0xdb, 0x14, 0xb7, 0x4b, 0x78, 0xe7, 0xd4, 0xb7, 0x4e, 0xed, 0x86, 0x54, 0xd6,
+	0xf3, 0xc9, 0x55, 0xf8, 0xe0, 0xcf, 0x00, 0xcf, 0x91, 0x3b, 0xe1, 0xe0, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -351,6 +518,11 @@ type MsgClient interface {
 	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
 	// delegate.
 	LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opts ...grpc.CallOption) (*MsgLiquidUnstakeResponse, error)
+	// Redeem defines a method for instantly redeeming bTokens for native tokens out of the
+	// liquidity kept undelegated by the proxy account.
+	Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error)
+	// Claim defines a method for claiming the matured epoch-batched liquid unstakes.
+	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
@@ -380,6 +552,24 @@ func (c *msgClient) LiquidUnstake(ctx context.Context, in *MsgLiquidUnstake, opt
 	return out, nil
 }
 
+func (c *msgClient) Redeem(ctx context.Context, in *MsgRedeem, opts ...grpc.CallOption) (*MsgRedeemResponse, error) {
+	out := new(MsgRedeemResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Msg/Redeem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
+	out := new(MsgClaimResponse)
+	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Msg/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/pstake.lspersistence.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -397,6 +587,11 @@ type MsgServer interface {
 	// LiquidUnstake defines a method for performing an undelegation of liquid staking from a
 	// delegate.
 	LiquidUnstake(context.Context, *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error)
+	// Redeem defines a method for instantly redeeming bTokens for native tokens out of the
+	// liquidity kept undelegated by the proxy account.
+	Redeem(context.Context, *MsgRedeem) (*MsgRedeemResponse, error)
+	// Claim defines a method for claiming the matured epoch-batched liquid unstakes.
+	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
@@ -410,6 +605,12 @@ func (*UnimplementedMsgServer) LiquidStake(ctx context.Context, req *MsgLiquidSt
 func (*UnimplementedMsgServer) LiquidUnstake(ctx context.Context, req *MsgLiquidUnstake) (*MsgLiquidUnstakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidUnstake not implemented")
 }
+func (*UnimplementedMsgServer) Redeem(ctx context.Context, req *MsgRedeem) (*MsgRedeemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeem not implemented")
+}
+func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -454,6 +655,42 @@ func _Msg_LiquidUnstake_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Redeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Redeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Msg/Redeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Redeem(ctx, req.(*MsgRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Claim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pstake.lspersistence.v1beta1.Msg/Claim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Claim(ctx, req.(*MsgClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -484,6 +721,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidUnstake",
 			Handler:    _Msg_LiquidUnstake_Handler,
 		},
+		{
+			MethodName: "Redeem",
+			Handler:    _Msg_Redeem_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Msg_Claim_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -627,6 +872,132 @@ func (m *MsgLiquidUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -751,17 +1122,65 @@ func (m *MsgLiquidUnstakeResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgRedeem) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.DelegatorAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
 	n += 1 + l + sovTx(uint64(l))
 	return n
 }
@@ -1144,6 +1563,336 @@ func (m *MsgLiquidUnstakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRedeem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0